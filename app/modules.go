@@ -25,6 +25,9 @@ import (
 	// Evidence
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 
+	// Authz
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+
 	// FeeGrant
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 
@@ -78,6 +81,7 @@ var appModuleBasics = []module.AppModuleBasic{
 	crisis.AppModuleBasic{},
 	slashing.AppModuleBasic{},
 	feegrantmodule.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
 	ibc.AppModuleBasic{},
 	upgrade.AppModuleBasic{},
 	evidence.AppModuleBasic{},
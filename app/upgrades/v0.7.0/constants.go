@@ -0,0 +1,4 @@
+package v0_7_0
+
+// UpgradeName defines the on-chain upgrade name for the KYVE v0.7.0 upgrade.
+const UpgradeName = "v0.7.0"
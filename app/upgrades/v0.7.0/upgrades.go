@@ -0,0 +1,86 @@
+package v0_7_0
+
+import (
+	registrykeeper "github.com/KYVENetwork/chain/x/registry/keeper"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func createStakerTransferParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyStakerTransferCooldown, types.DefaultStakerTransferCooldown)
+}
+
+func createAutoCompoundParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyMaxAutoCompoundsPerBlock, types.DefaultMaxAutoCompoundsPerBlock)
+}
+
+// reindexUnbondingDelegations stores all pending delegator unbondings again,
+// so that they are indexed by pool and staker for slashing.
+func reindexUnbondingDelegations(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	for _, entry := range registryKeeper.GetAllUnbondingDelegationQueueEntries(ctx) {
+		registryKeeper.SetUnbondingDelegationQueueEntry(ctx, entry)
+	}
+}
+
+func createWithdrawAllParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyMaxWithdrawAllPositions, types.DefaultMaxWithdrawAllPositions)
+}
+
+func createDelegationCapParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyMaxDelegationSelfStakeMultiple, types.DefaultMaxDelegationSelfStakeMultiple)
+	registryKeeper.ParamStore().Set(ctx, types.KeyMaxDelegationPoolShare, types.DefaultMaxDelegationPoolShare)
+}
+
+func createUploaderRoleSkipParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyUploaderRoleSkipCooldown, types.DefaultUploaderRoleSkipCooldown)
+}
+
+func createVoterRewardParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyVoterRewardShare, types.DefaultVoterRewardShare)
+}
+
+func createRunwayAlertParameters(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.ParamStore().Set(ctx, types.KeyRunwayAlertThresholds, types.DefaultRunwayAlertThresholds)
+}
+
+// migrateAmountsToInt converts all stored token amounts from uint64 to sdk.Int.
+func migrateAmountsToInt(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.MigrateAmountsToInt(ctx)
+}
+
+// migrateRedelegationCooldowns re-keys all redelegation cooldowns by a per-delegator sequence.
+func migrateRedelegationCooldowns(registryKeeper *registrykeeper.Keeper, ctx sdk.Context) {
+	registryKeeper.MigrateRedelegationCooldowns(ctx)
+}
+
+func CreateUpgradeHandler(
+	registryKeeper *registrykeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+
+		// Amounts have to be converted first, all other steps decode the stored entries with the new types.
+		migrateAmountsToInt(registryKeeper, ctx)
+
+		createStakerTransferParameters(registryKeeper, ctx)
+
+		reindexUnbondingDelegations(registryKeeper, ctx)
+
+		createAutoCompoundParameters(registryKeeper, ctx)
+
+		createWithdrawAllParameters(registryKeeper, ctx)
+
+		createDelegationCapParameters(registryKeeper, ctx)
+
+		migrateRedelegationCooldowns(registryKeeper, ctx)
+
+		createUploaderRoleSkipParameters(registryKeeper, ctx)
+
+		createVoterRewardParameters(registryKeeper, ctx)
+
+		createRunwayAlertParameters(registryKeeper, ctx)
+
+		return vm, nil
+	}
+}
@@ -25,6 +25,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	// POOL
 	cmd.AddCommand(CmdShowPool())
 	cmd.AddCommand(CmdListPool())
+	cmd.AddCommand(CmdShowStorageProvider())
+	cmd.AddCommand(CmdListStorageProvider())
+	cmd.AddCommand(CmdShowRuntime())
+	cmd.AddCommand(CmdListRuntime())
+	cmd.AddCommand(CmdShowRuntimeVersion())
+	cmd.AddCommand(CmdUpgradeReadiness())
+	cmd.AddCommand(CmdFundingStreams())
+	cmd.AddCommand(CmdListProtocolFunding())
 	cmd.AddCommand(CmdFundersList())
 	cmd.AddCommand(CmdFunder())
 	cmd.AddCommand(CmdStakersList())
@@ -46,6 +54,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdAccountStakedList())
 	cmd.AddCommand(CmdAccountDelegationList())
 	cmd.AddCommand(CmdAccountRedelegation())
+	cmd.AddCommand(CmdAccountWithdrawAddress())
+	cmd.AddCommand(CmdAccountPendingRewards())
+	cmd.AddCommand(CmdDelegationCapacity())
+	cmd.AddCommand(CmdOpenBundleProposals())
+	cmd.AddCommand(CmdArchivedProposals())
+	cmd.AddCommand(CmdSimulateDelegationRewards())
 
 	// DELEGATION
 	cmd.AddCommand(CmdDelegator())
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAccountPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-pending-rewards [address]",
+		Short: "Query the outstanding delegation rewards of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAccountPendingRewardsRequest{
+				Address:    reqAddress,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AccountPendingRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdAccountWithdrawAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-withdraw-address [address]",
+		Short: "Query the account which receives the rewards of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAccountWithdrawAddressRequest{
+				Address: reqAddress,
+			}
+
+			res, err := queryClient.AccountWithdrawAddress(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
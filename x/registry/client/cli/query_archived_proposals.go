@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdArchivedProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-proposals [pool_id]",
+		Short: "Query all archived proposals of a retired pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryArchivedProposalsRequest{
+				PoolId:     reqPoolId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ArchivedProposals(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
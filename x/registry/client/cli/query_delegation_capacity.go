@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdDelegationCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-capacity [pool_id]",
+		Short: "Query the remaining delegation capacity of all stakers in a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDelegationCapacityRequest{
+				PoolId: reqPoolId,
+			}
+
+			res, err := queryClient.DelegationCapacity(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
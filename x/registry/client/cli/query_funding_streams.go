@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdFundingStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-streams [pool_id]",
+		Short: "Query the funding streams of a pool with their remaining budget and projected runway",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFundingStreamsRequest{
+				PoolId: reqPoolId,
+			}
+
+			res, err := queryClient.FundingStreams(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
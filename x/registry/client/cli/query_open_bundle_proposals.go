@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdOpenBundleProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-bundle-proposals [pool_id]",
+		Short: "Query all bundle proposals of a pool which are not finalized yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryOpenBundleProposalsRequest{
+				PoolId: reqPoolId,
+			}
+
+			res, err := queryClient.OpenBundleProposals(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
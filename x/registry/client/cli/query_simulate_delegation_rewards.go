@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSimulateDelegationRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-delegation-rewards [pool_id] [staker] [amount]",
+		Short: "Estimate the upload probability and rewards of a delegation to a staker",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqPoolId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+
+			reqStaker := args[1]
+
+			reqAmount := args[2]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySimulateDelegationRewardsRequest{
+				PoolId: reqPoolId,
+				Staker: reqStaker,
+				Amount: reqAmount,
+			}
+
+			res, err := queryClient.SimulateDelegationRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -33,17 +35,27 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdFundPool())
 	cmd.AddCommand(CmdDefundPool())
+	cmd.AddCommand(CmdCreateFundingStream())
+	cmd.AddCommand(CmdCloseFundingStream())
 	cmd.AddCommand(CmdStakePool())
 	cmd.AddCommand(CmdUnstakePool())
 	cmd.AddCommand(CmdSubmitBundleProposal())
 	cmd.AddCommand(CmdVoteProposal())
 	cmd.AddCommand(CmdClaimUploaderRole())
+	cmd.AddCommand(CmdSkipUploaderRole())
+	cmd.AddCommand(CmdAttestVersion())
 	cmd.AddCommand(CmdDelegatePool())
 	cmd.AddCommand(CmdWithdrawPool())
 	cmd.AddCommand(CmdUndelegatePool())
 	cmd.AddCommand(CmdRedelegatePool())
 	cmd.AddCommand(CmdUpdateMetadata())
 	cmd.AddCommand(CmdUpdateCommission())
+	cmd.AddCommand(CmdTransferStaker())
+	cmd.AddCommand(CmdGrantPoolAuthorization())
+	cmd.AddCommand(CmdGrantDelegationAuthorization())
+	cmd.AddCommand(CmdSetAutoCompound())
+	cmd.AddCommand(CmdSetWithdrawAddress())
+	cmd.AddCommand(CmdWithdrawAllRewards())
 
 	cmd.AddCommand(CmdSubmitCreatePoolProposal())
 	cmd.AddCommand(CmdSubmitUpdatePoolProposal())
@@ -52,14 +64,50 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdSubmitSchedulePoolUpgradeProposal())
 	cmd.AddCommand(CmdSubmitCancelPoolUpgradeProposal())
 	cmd.AddCommand(CmdSubmitResetPoolProposal())
+	cmd.AddCommand(CmdSubmitCreateStorageProviderProposal())
+	cmd.AddCommand(CmdSubmitUpdateStorageProviderProposal())
+	cmd.AddCommand(CmdSubmitRetirePoolProposal())
+	cmd.AddCommand(CmdSubmitCreateRuntimeProposal())
+	cmd.AddCommand(CmdSubmitUpdateRuntimeProposal())
+	cmd.AddCommand(CmdSubmitAddRuntimeVersionProposal())
+	cmd.AddCommand(CmdSubmitSetProtocolFundingProposal())
 
 	return cmd
 }
 
+// parsePoolIds parses a comma separated list of pool ids, an empty string selects no pool.
+func parsePoolIds(arg string) ([]uint64, error) {
+	var poolIds []uint64
+
+	for _, id := range strings.Split(arg, ",") {
+		if strings.TrimSpace(id) == "" {
+			continue
+		}
+
+		poolId, err := strconv.ParseUint(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		poolIds = append(poolIds, poolId)
+	}
+
+	return poolIds, nil
+}
+
+// parseAllowedCompressions parses a comma separated list of compressions, an empty string allows no compression.
+func parseAllowedCompressions(arg string) []string {
+	if arg == "" {
+		return nil
+	}
+
+	return strings.Split(arg, ",")
+}
+
 func CmdSubmitCreatePoolProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
-		Args:  cobra.ExactArgs(11),
+		Args:  cobra.ExactArgs(18),
 		Short: "Submit a proposal to create a pool.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -87,6 +135,28 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 				return err
 			}
 
+			pipelineDepth, err := strconv.ParseUint(args[12], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			storageProviderId, err := strconv.ParseUint(args[13], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			allowedCompressions := parseAllowedCompressions(args[14])
+
+			chargeUncompressedSize, err := strconv.ParseBool(args[15])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseUint(args[17], 10, 64)
+			if err != nil {
+				return err
+			}
+
 			title, err := cmd.Flags().GetString(cli.FlagTitle)
 			if err != nil {
 				return err
@@ -108,7 +178,7 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewCreatePoolProposal(title, description, args[0], args[1], args[2], args[3], uploadInterval, operatingCost, maxBundleSize, args[8], args[9], args[10], minStake)
+			content := types.NewCreatePoolProposal(title, description, args[0], args[1], args[2], args[3], uploadInterval, operatingCost, maxBundleSize, args[8], args[9], args[10], minStake, pipelineDepth, storageProviderId, allowedCompressions, chargeUncompressedSize, args[16], endHeight)
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -141,7 +211,7 @@ func CmdSubmitCreatePoolProposal() *cobra.Command {
 func CmdSubmitUpdatePoolProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-pool [flags]",
-		Args:  cobra.ExactArgs(9),
+		Args:  cobra.ExactArgs(15),
 		Short: "Submit a proposal to update a pool.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -174,6 +244,28 @@ func CmdSubmitUpdatePoolProposal() *cobra.Command {
 				return err
 			}
 
+			pipelineDepth, err := strconv.ParseUint(args[9], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			storageProviderId, err := strconv.ParseUint(args[10], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			allowedCompressions := parseAllowedCompressions(args[11])
+
+			chargeUncompressedSize, err := strconv.ParseBool(args[12])
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseUint(args[14], 10, 64)
+			if err != nil {
+				return err
+			}
+
 			title, err := cmd.Flags().GetString(cli.FlagTitle)
 			if err != nil {
 				return err
@@ -195,7 +287,7 @@ func CmdSubmitUpdatePoolProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewUpdatePoolProposal(title, description, id, args[1], args[2], args[3], args[4], uploadInterval, operatingCost, maxBundleSize, minStake)
+			content := types.NewUpdatePoolProposal(title, description, id, args[1], args[2], args[3], args[4], uploadInterval, operatingCost, maxBundleSize, minStake, pipelineDepth, storageProviderId, allowedCompressions, chargeUncompressedSize, args[13], endHeight)
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -362,7 +454,7 @@ func CmdSubmitUnpausePoolProposal() *cobra.Command {
 func CmdSubmitSchedulePoolUpgradeProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "schedule-pool-upgrade [flags]",
-		Args:  cobra.ExactArgs(5),
+		Args:  cobra.ExactArgs(8),
 		Short: "Submit a proposal to schedule a pool upgrade.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -380,6 +472,21 @@ func CmdSubmitSchedulePoolUpgradeProposal() *cobra.Command {
 				return err
 			}
 
+			canaryPoolIds, err := parsePoolIds(args[5])
+			if err != nil {
+				return err
+			}
+
+			canaryDelay, err := strconv.ParseUint(args[6], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			rollbackWindow, err := strconv.ParseUint(args[7], 10, 64)
+			if err != nil {
+				return err
+			}
+
 			title, err := cmd.Flags().GetString(cli.FlagTitle)
 			if err != nil {
 				return err
@@ -401,7 +508,7 @@ func CmdSubmitSchedulePoolUpgradeProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewSchedulePoolUpgradeProposal(title, description, args[0], args[1], scheduledAt, duration, args[4])
+			content := types.NewSchedulePoolUpgradeProposal(title, description, args[0], args[1], scheduledAt, duration, args[4], canaryPoolIds, canaryDelay, rollbackWindow)
 
 			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
 			if err != nil {
@@ -564,3 +671,483 @@ func CmdSubmitResetPoolProposal() *cobra.Command {
 
 	return cmd
 }
+
+// parseAmount parses a $KYVE amount in tkyve given as command argument.
+func parseAmount(arg string) (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(arg)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid amount %s", arg)
+	}
+	return amount, nil
+}
+
+func CmdSubmitCreateStorageProviderProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-storage-provider [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to register a storage provider.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argStorageCost, err := parseAmount(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateStorageProviderProposal(title, description, args[0], argStorageCost, args[2])
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitUpdateStorageProviderProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-storage-provider [flags]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to update a storage provider.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argStorageCost, err := parseAmount(args[2])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateStorageProviderProposal(title, description, argId, args[1], argStorageCost, args[3])
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitRetirePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-pool [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to retire a pool.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			argId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRetirePoolProposal(title, description, argId)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitCreateRuntimeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-runtime [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to register a runtime.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateRuntimeProposal(title, description, args[0], args[1])
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitUpdateRuntimeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-runtime [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to update the config schema of a runtime.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateRuntimeProposal(title, description, args[0], args[1])
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitAddRuntimeVersionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-runtime-version [runtime] [version] [binaries] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to register a version of a runtime.",
+		Long:  `Binaries are passed as JSON, e.g. [{"platform":"linux","url":"https://...","sha256":"..."}]`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var binaries []types.RuntimeBinary
+			if err := json.Unmarshal([]byte(args[2]), &binaries); err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddRuntimeVersionProposal(title, description, args[0], args[1], binaries)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
+func CmdSubmitSetProtocolFundingProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-protocol-funding [id] [inflation_share] [community_pool_amount] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to allocate a share of the inflation and a per-block amount of the community pool to a pool.",
+		Long:  "Setting both the inflation share and the community pool amount to zero removes the allocation.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			communityPoolAmount, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetProtocolFundingProposal(title, description, id, args[1], communityPoolAmount)
+
+			isExpedited, err := cmd.Flags().GetBool(cli.FlagIsExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(cli.FlagDescription, "", "The proposal description")
+	cmd.Flags().Bool(cli.FlagIsExpedited, false, "If true, makes the proposal an expedited one")
+	cmd.Flags().String(cli.FlagDeposit, "", "The proposal deposit")
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCloseFundingStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-funding-stream [id] [stream-id]",
+		Short: "Broadcast message close-funding-stream",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argStreamId, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseFundingStream(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				argStreamId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateFundingStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-funding-stream [id] [amount] [amount-per-bundle] [amount-per-day] [end-time]",
+		Short: "Broadcast message create-funding-stream",
+		Long:  "Fund a pool with a budget which is spent at most amount-per-bundle per bundle and amount-per-day per day. A limit of zero means no limit. The unspent budget is returned at end-time (unix time), zero means no end.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			argAmount, err := parseAmount(args[1])
+			if err != nil {
+				return err
+			}
+			argAmountPerBundle, err := parseAmount(args[2])
+			if err != nil {
+				return err
+			}
+			argAmountPerDay, err := parseAmount(args[3])
+			if err != nil {
+				return err
+			}
+			argEndTime, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateFundingStream(
+				clientCtx.GetFromAddress().String(),
+				argId,
+				argAmount,
+				argAmountPerBundle,
+				argAmountPerDay,
+				argEndTime,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
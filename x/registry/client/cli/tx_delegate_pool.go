@@ -24,7 +24,7 @@ func CmdDelegatePool() *cobra.Command {
 				return err
 			}
 			argStakerAddress := args[1]
-			argAmount, err := cast.ToUint64E(args[2])
+			argAmount, err := parseAmount(args[2])
 			if err != nil {
 				return err
 			}
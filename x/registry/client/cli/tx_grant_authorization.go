@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"strings"
+	"time"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagPoolIds    = "pool-ids"
+	FlagStakers    = "stakers"
+	FlagMaxAmount  = "max-amount"
+	FlagExpiration = "expiration"
+)
+
+func CmdGrantPoolAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-pool-authorization [grantee] [msg_type_url]",
+		Short: "Grant an account the authorization to execute protocol node messages for the given pools",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := parsePoolIdsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := parseExpirationFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewPoolAuthorization(args[1], poolIds)
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPoolIds, "", "Comma separated list of allowed pool ids (empty allows all pools)")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "Unix timestamp after which the grant expires")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGrantDelegationAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-delegation-authorization [grantee] [msg_type_url]",
+		Short: "Grant an account the authorization to manage delegations for the given pools and stakers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			poolIds, err := parsePoolIdsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			stakersFlag, err := cmd.Flags().GetString(FlagStakers)
+			if err != nil {
+				return err
+			}
+
+			var stakers []string
+			if stakersFlag != "" {
+				stakers = strings.Split(stakersFlag, ",")
+			}
+
+			maxAmountString, err := cmd.Flags().GetString(FlagMaxAmount)
+			if err != nil {
+				return err
+			}
+
+			maxAmount, err := parseAmount(maxAmountString)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := parseExpirationFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := types.NewDelegationAuthorization(args[1], poolIds, stakers, maxAmount)
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPoolIds, "", "Comma separated list of allowed pool ids (empty allows all pools)")
+	cmd.Flags().String(FlagStakers, "", "Comma separated list of allowed stakers (empty allows all stakers)")
+	cmd.Flags().String(FlagMaxAmount, "0", "Maximum amount of $KYVE the grantee can move (0 for no limit)")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "Unix timestamp after which the grant expires")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parsePoolIdsFlag(cmd *cobra.Command) ([]uint64, error) {
+	poolIdsFlag, err := cmd.Flags().GetString(FlagPoolIds)
+	if err != nil || poolIdsFlag == "" {
+		return nil, err
+	}
+
+	var poolIds []uint64
+	for _, id := range strings.Split(poolIdsFlag, ",") {
+		poolId, err := cast.ToUint64E(strings.TrimSpace(id))
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+
+	return poolIds, nil
+}
+
+func parseExpirationFlag(cmd *cobra.Command) (time.Time, error) {
+	expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(expiration, 0), nil
+}
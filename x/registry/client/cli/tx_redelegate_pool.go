@@ -34,7 +34,7 @@ func CmdRedelegatePool() *cobra.Command {
 
 			toStaker := args[3]
 
-			amount, err := cast.ToUint64E(args[4])
+			amount, err := parseAmount(args[4])
 			if err != nil {
 				return err
 			}
@@ -23,7 +23,7 @@ func CmdStakePool() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			argAmount, err := cast.ToUint64E(args[1])
+			argAmount, err := parseAmount(args[1])
 			if err != nil {
 				return err
 			}
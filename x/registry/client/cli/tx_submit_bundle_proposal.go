@@ -15,9 +15,9 @@ var _ = strconv.Itoa(0)
 
 func CmdSubmitBundleProposal() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit-bundle-proposal [id] [bundle-id] [byte-size] [from-height] [to-height] [from-key] [to-key] [to-value]",
+		Use:   "submit-bundle-proposal [id] [bundle-id] [byte-size] [from-height] [to-height] [from-key] [to-key] [to-value] [bundle-hash] [compression] [data-item-count] [uncompressed-byte-size]",
 		Short: "Broadcast message submit-bundle-proposal",
-		Args:  cobra.ExactArgs(9),
+		Args:  cobra.ExactArgs(12),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argId, err := cast.ToUint64E(args[0])
 			if err != nil {
@@ -40,6 +40,17 @@ func CmdSubmitBundleProposal() *cobra.Command {
 			argFromKey := args[5]
 			argToKey := args[6]
 			argToValue := args[7]
+			argBundleHash := args[8]
+			argCompression := args[9]
+
+			argDataItemCount, err := cast.ToUint64E(args[10])
+			if err != nil {
+				return err
+			}
+			argUncompressedByteSize, err := cast.ToUint64E(args[11])
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -56,6 +67,10 @@ func CmdSubmitBundleProposal() *cobra.Command {
 				argFromKey,
 				argToKey,
 				argToValue,
+				argBundleHash,
+				argCompression,
+				argDataItemCount,
+				argUncompressedByteSize,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
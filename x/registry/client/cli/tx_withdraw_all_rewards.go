@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdWithdrawAllRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-all-rewards [limit] [start_key]",
+		Short: "Broadcast message withdraw-all-rewards",
+		Long:  "The start_key is the base64 encoded next_key of a previous withdrawal to continue with the remaining delegations.",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var argLimit uint64
+			if len(args) > 0 {
+				argLimit, err = cast.ToUint64E(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			var argStartKey []byte
+			if len(args) > 1 {
+				argStartKey, err = base64.StdEncoding.DecodeString(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAllRewards(
+				clientCtx.GetFromAddress().String(),
+				argLimit,
+				argStartKey,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,3 +13,10 @@ var UnpausePoolHandler = govclient.NewProposalHandler(cli.CmdSubmitUnpausePoolPr
 var SchedulePoolUpgradeHandler = govclient.NewProposalHandler(cli.CmdSubmitSchedulePoolUpgradeProposal, rest.ProposalSchedulePoolUpgradeRESTHandler)
 var CancelPoolUpgradeHandler = govclient.NewProposalHandler(cli.CmdSubmitCancelPoolUpgradeProposal, rest.ProposalCancelPoolUpgradeRESTHandler)
 var ResetPoolHandler = govclient.NewProposalHandler(cli.CmdSubmitResetPoolProposal, rest.ProposalResetPoolRESTHandler)
+var CreateStorageProviderHandler = govclient.NewProposalHandler(cli.CmdSubmitCreateStorageProviderProposal, rest.ProposalCreateStorageProviderRESTHandler)
+var UpdateStorageProviderHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdateStorageProviderProposal, rest.ProposalUpdateStorageProviderRESTHandler)
+var RetirePoolHandler = govclient.NewProposalHandler(cli.CmdSubmitRetirePoolProposal, rest.ProposalRetirePoolRESTHandler)
+var CreateRuntimeHandler = govclient.NewProposalHandler(cli.CmdSubmitCreateRuntimeProposal, rest.ProposalCreateRuntimeRESTHandler)
+var UpdateRuntimeHandler = govclient.NewProposalHandler(cli.CmdSubmitUpdateRuntimeProposal, rest.ProposalUpdateRuntimeRESTHandler)
+var AddRuntimeVersionHandler = govclient.NewProposalHandler(cli.CmdSubmitAddRuntimeVersionProposal, rest.ProposalAddRuntimeVersionRESTHandler)
+var SetProtocolFundingHandler = govclient.NewProposalHandler(cli.CmdSubmitSetProtocolFundingProposal, rest.ProposalSetProtocolFundingRESTHandler)
@@ -29,6 +29,12 @@ type CreatePoolRequest struct {
 	Binaries       string       `json:"binaries" yaml:"binaries"`
 	StartKey       string       `json:"startKey" yaml:"startKey"`
 	MinStake       uint64       `json:"minStake" yaml:"minStake"`
+	PipelineDepth  uint64       `json:"pipelineDepth" yaml:"pipelineDepth"`
+	StorageProviderId uint64    `json:"storageProviderId" yaml:"storageProviderId"`
+	AllowedCompressions []string `json:"allowedCompressions" yaml:"allowedCompressions"`
+	ChargeUncompressedSize bool `json:"chargeUncompressedSize" yaml:"chargeUncompressedSize"`
+	EndKey string `json:"endKey" yaml:"endKey"`
+	EndHeight uint64 `json:"endHeight" yaml:"endHeight"`
 }
 
 func ProposalCreatePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -56,7 +62,7 @@ func newCreatePoolHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewCreatePoolProposal(req.Title, req.Description, req.Name, req.Runtime, req.Logo, req.Config, req.UploadInterval, req.OperatingCost, req.MaxBundleSize, req.Version, req.Binaries, req.StartKey, req.MinStake)
+		content := types.NewCreatePoolProposal(req.Title, req.Description, req.Name, req.Runtime, req.Logo, req.Config, req.UploadInterval, req.OperatingCost, req.MaxBundleSize, req.Version, req.Binaries, req.StartKey, req.MinStake, req.PipelineDepth, req.StorageProviderId, req.AllowedCompressions, req.ChargeUncompressedSize, req.EndKey, req.EndHeight)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -84,6 +90,12 @@ type UpdatePoolRequest struct {
 	OperatingCost  uint64       `json:"operatingCost" yaml:"operatingCost"`
 	MaxBundleSize  uint64       `json:"maxBundleSize" yaml:"maxBundleSize"`
 	MinStake       uint64       `json:"minStake" yaml:"minStake"`
+	PipelineDepth  uint64       `json:"pipelineDepth" yaml:"pipelineDepth"`
+	StorageProviderId uint64    `json:"storageProviderId" yaml:"storageProviderId"`
+	AllowedCompressions []string `json:"allowedCompressions" yaml:"allowedCompressions"`
+	ChargeUncompressedSize bool `json:"chargeUncompressedSize" yaml:"chargeUncompressedSize"`
+	EndKey string `json:"endKey" yaml:"endKey"`
+	EndHeight uint64 `json:"endHeight" yaml:"endHeight"`
 }
 
 func ProposalUpdatePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -111,7 +123,7 @@ func newUpdatePoolHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewUpdatePoolProposal(req.Title, req.Description, req.Id, req.Name, req.Runtime, req.Logo, req.Config, req.UploadInterval, req.OperatingCost, req.MaxBundleSize, req.MinStake)
+		content := types.NewUpdatePoolProposal(req.Title, req.Description, req.Id, req.Name, req.Runtime, req.Logo, req.Config, req.UploadInterval, req.OperatingCost, req.MaxBundleSize, req.MinStake, req.PipelineDepth, req.StorageProviderId, req.AllowedCompressions, req.ChargeUncompressedSize, req.EndKey, req.EndHeight)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -229,6 +241,9 @@ type SchedulePoolUpgradeRequest struct {
 	ScheduledAt uint64       `json:"scheduled_at" yaml:"scheduled_at"`
 	Duration    uint64       `json:"duration" yaml:"duration"`
 	Binaries    string       `json:"binaries" yaml:"binaries"`
+	CanaryPoolIds  []uint64  `json:"canaryPoolIds" yaml:"canaryPoolIds"`
+	CanaryDelay    uint64    `json:"canaryDelay" yaml:"canaryDelay"`
+	RollbackWindow uint64    `json:"rollbackWindow" yaml:"rollbackWindow"`
 }
 
 func ProposalSchedulePoolUpgradeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
@@ -256,7 +271,7 @@ func newSchedulePoolUpgradeHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewSchedulePoolUpgradeProposal(req.Title, req.Description, req.Runtime, req.Version, req.ScheduledAt, req.Duration, req.Binaries)
+		content := types.NewSchedulePoolUpgradeProposal(req.Title, req.Description, req.Runtime, req.Version, req.ScheduledAt, req.Duration, req.Binaries, req.CanaryPoolIds, req.CanaryDelay, req.RollbackWindow)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -363,3 +378,343 @@ func newResetPoolHandler(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+type CreateStorageProviderRequest struct {
+	BaseReq         rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title           string       `json:"title" yaml:"title"`
+	Description     string       `json:"description" yaml:"description"`
+	IsExpedited     bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit         sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name            string       `json:"name" yaml:"name"`
+	StorageCost     sdk.Int      `json:"storageCost" yaml:"storageCost"`
+	StorageIdFormat string       `json:"storageIdFormat" yaml:"storageIdFormat"`
+}
+
+func ProposalCreateStorageProviderRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create-storage-provider",
+		Handler:  newCreateStorageProviderHandler(clientCtx),
+	}
+}
+
+func newCreateStorageProviderHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateStorageProviderRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCreateStorageProviderProposal(req.Title, req.Description, req.Name, req.StorageCost, req.StorageIdFormat)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type UpdateStorageProviderRequest struct {
+	BaseReq         rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title           string       `json:"title" yaml:"title"`
+	Description     string       `json:"description" yaml:"description"`
+	IsExpedited     bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit         sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Id              uint64       `json:"id" yaml:"id"`
+	Name            string       `json:"name" yaml:"name"`
+	StorageCost     sdk.Int      `json:"storageCost" yaml:"storageCost"`
+	StorageIdFormat string       `json:"storageIdFormat" yaml:"storageIdFormat"`
+}
+
+func ProposalUpdateStorageProviderRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-storage-provider",
+		Handler:  newUpdateStorageProviderHandler(clientCtx),
+	}
+}
+
+func newUpdateStorageProviderHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateStorageProviderRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateStorageProviderProposal(req.Title, req.Description, req.Id, req.Name, req.StorageCost, req.StorageIdFormat)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type RetirePoolRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	IsExpedited bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Id          uint64       `json:"id" yaml:"id"`
+}
+
+func ProposalRetirePoolRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "retire-pool",
+		Handler:  newRetirePoolHandler(clientCtx),
+	}
+}
+
+func newRetirePoolHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RetirePoolRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewRetirePoolProposal(req.Title, req.Description, req.Id)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type CreateRuntimeRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	IsExpedited  bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name         string       `json:"name" yaml:"name"`
+	ConfigSchema string       `json:"configSchema" yaml:"configSchema"`
+}
+
+func ProposalCreateRuntimeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "create-runtime",
+		Handler:  newCreateRuntimeHandler(clientCtx),
+	}
+}
+
+func newCreateRuntimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateRuntimeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewCreateRuntimeProposal(req.Title, req.Description, req.Name, req.ConfigSchema)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type UpdateRuntimeRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	IsExpedited  bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Name         string       `json:"name" yaml:"name"`
+	ConfigSchema string       `json:"configSchema" yaml:"configSchema"`
+}
+
+func ProposalUpdateRuntimeRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update-runtime",
+		Handler:  newUpdateRuntimeHandler(clientCtx),
+	}
+}
+
+func newUpdateRuntimeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateRuntimeRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateRuntimeProposal(req.Title, req.Description, req.Name, req.ConfigSchema)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type AddRuntimeVersionRequest struct {
+	BaseReq     rest.BaseReq          `json:"base_req" yaml:"base_req"`
+	Title       string                `json:"title" yaml:"title"`
+	Description string                `json:"description" yaml:"description"`
+	IsExpedited bool                  `json:"is_expedited" yaml:"is_expedited"`
+	Deposit     sdk.Coins             `json:"deposit" yaml:"deposit"`
+	Runtime     string                `json:"runtime" yaml:"runtime"`
+	Version     string                `json:"version" yaml:"version"`
+	Binaries    []types.RuntimeBinary `json:"binaries" yaml:"binaries"`
+}
+
+func ProposalAddRuntimeVersionRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add-runtime-version",
+		Handler:  newAddRuntimeVersionHandler(clientCtx),
+	}
+}
+
+func newAddRuntimeVersionHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddRuntimeVersionRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewAddRuntimeVersionProposal(req.Title, req.Description, req.Runtime, req.Version, req.Binaries)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+type SetProtocolFundingRequest struct {
+	BaseReq             rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title               string       `json:"title" yaml:"title"`
+	Description         string       `json:"description" yaml:"description"`
+	IsExpedited         bool         `json:"is_expedited" yaml:"is_expedited"`
+	Deposit             sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Id                  uint64       `json:"id" yaml:"id"`
+	InflationShare      string       `json:"inflation_share" yaml:"inflation_share"`
+	CommunityPoolAmount uint64       `json:"community_pool_amount" yaml:"community_pool_amount"`
+}
+
+func ProposalSetProtocolFundingRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set-protocol-funding",
+		Handler:  newSetProtocolFundingHandler(clientCtx),
+	}
+}
+
+func newSetProtocolFundingHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetProtocolFundingRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewSetProtocolFundingProposal(req.Title, req.Description, req.Id, req.InflationShare, req.CommunityPoolAmount)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr, req.IsExpedited)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -70,6 +70,62 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetRedelegationCooldown(ctx, elem)
 	}
 
+	// Set all the delegationSlashes
+	for _, elem := range genState.DelegationSlashList {
+		k.SetDelegationSlash(ctx, elem)
+	}
+
+	// Set state of redelegation-queue
+	k.SetRedelegationQueueState(ctx, genState.RedelegationQueueState)
+	// Set all the redelegationEntries
+	for _, elem := range genState.RedelegationQueueEntries {
+		k.SetRedelegationQueueEntry(ctx, elem)
+	}
+
+	// Set all the autoCompounds
+	for _, elem := range genState.AutoCompoundList {
+		k.SetAutoCompound(ctx, elem)
+	}
+
+	// Set auto compound cursor
+	k.SetAutoCompoundCursor(ctx, genState.AutoCompoundCursor)
+
+	// Set all the withdrawAddresses
+	for _, elem := range genState.WithdrawAddressList {
+		k.SetWithdrawAddress(ctx, elem)
+	}
+
+	// Set all the storageProviders
+	for _, elem := range genState.StorageProviderList {
+		k.SetStorageProvider(ctx, elem)
+	}
+
+	// Set storage provider count
+	k.SetStorageProviderCount(ctx, genState.StorageProviderCount)
+
+	// Set all the archivedProposals
+	for _, elem := range genState.ArchivedProposalList {
+		k.SetArchivedProposal(ctx, elem)
+	}
+
+	// Set all the runtimes
+	for _, elem := range genState.RuntimeList {
+		k.SetRuntime(ctx, elem)
+	}
+
+	// Set all the fundingStreams
+	for _, elem := range genState.FundingStreamList {
+		k.SetFundingStream(ctx, elem)
+	}
+
+	// Set funding stream count
+	k.SetFundingStreamCount(ctx, genState.FundingStreamCount)
+
+	// Set all the protocolFundings
+	for _, elem := range genState.ProtocolFundingList {
+		k.SetProtocolFunding(ctx, elem)
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -94,6 +150,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.RedelegationCooldownList = k.GetAllRedelegationCooldownEntries(ctx)
 	genesis.CommissionChangeQueueEntry = k.GetAllCommissionChangeQueueEntries(ctx)
 	genesis.CommissionChangeQueueState = k.GetCommissionChangeQueueState(ctx)
+	genesis.DelegationSlashList = k.GetAllDelegationSlashes(ctx)
+	genesis.RedelegationQueueState = k.GetRedelegationQueueState(ctx)
+	genesis.RedelegationQueueEntries = k.GetAllRedelegationQueueEntries(ctx)
+	genesis.AutoCompoundList = k.GetAllAutoCompounds(ctx)
+	genesis.AutoCompoundCursor = k.GetAutoCompoundCursor(ctx)
+	genesis.WithdrawAddressList = k.GetAllWithdrawAddresses(ctx)
+	genesis.StorageProviderList = k.GetAllStorageProviders(ctx)
+	genesis.StorageProviderCount = k.GetStorageProviderCount(ctx)
+	genesis.ArchivedProposalList = k.GetAllArchivedProposals(ctx)
+	genesis.RuntimeList = k.GetAllRuntimes(ctx)
+	genesis.FundingStreamList = k.GetAllFundingStreams(ctx)
+	genesis.FundingStreamCount = k.GetFundingStreamCount(ctx)
+	genesis.ProtocolFundingList = k.GetAllProtocolFundings(ctx)
 
 	return genesis
 }
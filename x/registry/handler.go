@@ -23,6 +23,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDefundPool:
 			res, err := msgServer.DefundPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateFundingStream:
+			res, err := msgServer.CreateFundingStream(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCloseFundingStream:
+			res, err := msgServer.CloseFundingStream(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgStakePool:
 			res, err := msgServer.StakePool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -41,6 +47,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClaimUploaderRole:
 			res, err := msgServer.ClaimUploaderRole(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSkipUploaderRole:
+			res, err := msgServer.SkipUploaderRole(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAttestVersion:
+			res, err := msgServer.AttestVersion(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgDelegatePool:
 			res, err := msgServer.DelegatePool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -59,6 +71,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUpdateCommission:
 			res, err := msgServer.UpdateCommission(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferStaker:
+			res, err := msgServer.TransferStaker(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAutoCompound:
+			res, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetWithdrawAddress:
+			res, err := msgServer.SetWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawAllRewards:
+			res, err := msgServer.WithdrawAllRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
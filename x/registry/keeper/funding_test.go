@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -16,7 +17,7 @@ func testFunding(t *testing.T) {
 	fundPool0 := runTx(&types.MsgFundPool{
 		Creator: ALICE_ADDR,
 		Id:      0,
-		Amount:  99 * KYVE,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
 	})
 
 	_, foundFunder0 := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 0)
@@ -26,7 +27,7 @@ func testFunding(t *testing.T) {
 	fundPool1 := runTx(&types.MsgFundPool{
 		Creator: ALICE_ADDR,
 		Id:      1,
-		Amount:  0,
+		Amount:  sdk.ZeroInt(),
 	})
 
 	_, foundFunder1 := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 1)
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetArchivedProposal set a specific archived proposal in the store from its index
+func (k Keeper) SetArchivedProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+	b := k.cdc.MustMarshal(&proposal)
+	store.Set(types.ArchivedProposalKey(proposal.PoolId, proposal.Id), b)
+}
+
+// GetArchivedProposal returns an archived proposal from its index
+func (k Keeper) GetArchivedProposal(ctx sdk.Context, poolId uint64, bundleId uint64) (val types.Proposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+
+	b := store.Get(types.ArchivedProposalKey(poolId, bundleId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllArchivedProposals returns all archived proposals
+func (k Keeper) GetAllArchivedProposals(ctx sdk.Context) (list []types.Proposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Proposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
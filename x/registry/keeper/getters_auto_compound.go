@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound set a specific autoCompound in the store from its index
+func (k Keeper) SetAutoCompound(ctx sdk.Context, autoCompound types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	b := k.cdc.MustMarshal(&autoCompound)
+	store.Set(types.AutoCompoundKey(
+		autoCompound.PoolId,
+		autoCompound.Staker,
+		autoCompound.Delegator,
+	), b)
+}
+
+// RemoveAutoCompound removes an autoCompound from the store
+func (k Keeper) RemoveAutoCompound(ctx sdk.Context, poolId uint64, stakerAddress string, delegatorAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	store.Delete(types.AutoCompoundKey(poolId, stakerAddress, delegatorAddress))
+}
+
+// GetAutoCompound returns an autoCompound from its index
+func (k Keeper) GetAutoCompound(ctx sdk.Context, poolId uint64, stakerAddress string, delegatorAddress string) (val types.AutoCompound, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+
+	b := store.Get(types.AutoCompoundKey(poolId, stakerAddress, delegatorAddress))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAutoCompoundsOfStaker returns all autoCompounds of a staker in a pool
+func (k Keeper) GetAutoCompoundsOfStaker(ctx sdk.Context, poolId uint64, stakerAddress string) (list []types.AutoCompound) {
+	stakerPrefix := types.KeyPrefixBuilder{Key: types.AutoCompoundKeyPrefix}.AInt(poolId).AString(stakerAddress).Key
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), stakerPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllAutoCompounds returns all autoCompounds
+func (k Keeper) GetAllAutoCompounds(ctx sdk.Context) (list []types.AutoCompound) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAutoCompoundCursor returns the store key of the last auto-compound entry
+// which was processed. An empty cursor starts at the beginning of the store.
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	return store.Get(types.AutoCompoundCursorKey)
+}
+
+// SetAutoCompoundCursor saves the store key of the last processed auto-compound entry
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, cursor []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	if len(cursor) == 0 {
+		store.Delete(types.AutoCompoundCursorKey)
+		return
+	}
+	store.Set(types.AutoCompoundCursorKey, cursor)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDelegationSlash set a specific delegationSlash in the store from its index
+func (k Keeper) SetDelegationSlash(ctx sdk.Context, delegationSlash types.DelegationSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationSlashKeyPrefix)
+	b := k.cdc.MustMarshal(&delegationSlash)
+	store.Set(types.DelegationSlashKey(
+		delegationSlash.Id,
+		delegationSlash.Staker,
+		delegationSlash.KIndex,
+	), b)
+}
+
+// RemoveDelegationSlash removes a delegationSlash from the store
+func (k Keeper) RemoveDelegationSlash(ctx sdk.Context, poolId uint64, stakerAddress string, kIndex uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationSlashKeyPrefix)
+	store.Delete(types.DelegationSlashKey(poolId, stakerAddress, kIndex))
+}
+
+// GetDelegationSlashesOfStaker returns all delegationSlashes of a staker in a pool ordered by their k-index
+func (k Keeper) GetDelegationSlashesOfStaker(ctx sdk.Context, poolId uint64, stakerAddress string) (list []types.DelegationSlash) {
+	stakerPrefix := types.KeyPrefixBuilder{Key: types.DelegationSlashKeyPrefix}.AInt(poolId).AString(stakerAddress).Key
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), stakerPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegationSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllDelegationSlashes returns all delegationSlashes
+func (k Keeper) GetAllDelegationSlashes(ctx sdk.Context) (list []types.DelegationSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelegationSlashKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegationSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
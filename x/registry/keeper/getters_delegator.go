@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/KYVENetwork/chain/x/registry/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -82,3 +84,54 @@ func (k Keeper) GetAllDelegator(ctx sdk.Context) (list []types.Delegator) {
 
 	return
 }
+
+// GetDelegationsOfDelegator returns up to limit delegations of a delegator across all pools and stakers,
+// starting at the given index key. A limit of zero returns all delegations. If there are more delegations,
+// nextKey is the index key of the first delegation which was not returned.
+func (k Keeper) GetDelegationsOfDelegator(ctx sdk.Context, delegatorAddress string, startKey []byte, limit uint64) (list []types.Delegator, nextKey []byte) {
+	delegatorPrefix := types.KeyPrefixBuilder{Key: types.DelegatorKeyPrefixIndex2}.AString(delegatorAddress).Key
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegatorPrefix)
+
+	var start []byte
+	if len(startKey) > 0 {
+		start = startKey
+	}
+	iterator := indexStore.Iterator(start, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && uint64(len(list)) >= limit {
+			nextKey = append([]byte{}, iterator.Key()...)
+			break
+		}
+
+		// Key: poolId (8 bytes) / staker /
+		key := iterator.Key()
+		poolId := binary.BigEndian.Uint64(key[0:8])
+		staker := string(key[9 : len(key)-1])
+
+		if delegator, found := k.GetDelegator(ctx, poolId, staker, delegatorAddress); found {
+			list = append(list, delegator)
+		}
+	}
+
+	return
+}
+
+// GetDelegatorsOfPool returns all delegators of all stakers of a given pool.
+func (k Keeper) GetDelegatorsOfPool(ctx sdk.Context, poolId uint64) (list []types.Delegator) {
+	delegatorPrefix := types.KeyPrefixBuilder{Key: types.KeyPrefix(types.DelegatorKeyPrefix)}.AInt(poolId).Key
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegatorPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Delegator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
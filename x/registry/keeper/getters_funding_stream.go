@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFundingStreamCount get the total number of funding streams ever created
+func (k Keeper) GetFundingStreamCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.FundingStreamCountKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetFundingStreamCount set the total number of funding streams ever created
+func (k Keeper) SetFundingStreamCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.FundingStreamCountKey, bz)
+}
+
+// AppendFundingStream appends a funding stream in the store with a new id and updates the count.
+func (k Keeper) AppendFundingStream(ctx sdk.Context, fundingStream types.FundingStream) uint64 {
+	count := k.GetFundingStreamCount(ctx)
+
+	// Set the ID of the appended value
+	fundingStream.Id = count + 1
+	k.SetFundingStream(ctx, fundingStream)
+
+	// Update funding stream count
+	k.SetFundingStreamCount(ctx, count+1)
+
+	return fundingStream.Id
+}
+
+// SetFundingStream set a specific fundingStream in the store from its index
+func (k Keeper) SetFundingStream(ctx sdk.Context, fundingStream types.FundingStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefix)
+	b := k.cdc.MustMarshal(&fundingStream)
+	store.Set(types.FundingStreamKey(fundingStream.PoolId, fundingStream.Id), b)
+
+	// Index funding streams with an end time, so that expired ones are found without iterating all of them.
+	if fundingStream.EndTime > 0 {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefixIndex2)
+		indexStore.Set(types.FundingStreamKeyIndex2(fundingStream.EndTime, fundingStream.PoolId, fundingStream.Id), []byte{0})
+	}
+}
+
+// GetFundingStream returns a fundingStream from its index
+func (k Keeper) GetFundingStream(ctx sdk.Context, poolId uint64, id uint64) (val types.FundingStream, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefix)
+
+	b := store.Get(types.FundingStreamKey(poolId, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFundingStream removes a fundingStream from the store
+func (k Keeper) RemoveFundingStream(ctx sdk.Context, poolId uint64, id uint64) {
+	fundingStream, found := k.GetFundingStream(ctx, poolId, id)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefix)
+	store.Delete(types.FundingStreamKey(poolId, id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefixIndex2)
+	indexStore.Delete(types.FundingStreamKeyIndex2(fundingStream.EndTime, poolId, id))
+}
+
+// GetExpiredFundingStreams returns all fundingStreams whose end time is at or before the given time, ordered by their end time
+func (k Keeper) GetExpiredFundingStreams(ctx sdk.Context, time uint64) (list []types.FundingStream) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefixIndex2)
+	iterator := indexStore.Iterator(nil, types.KeyPrefixBuilder{}.AInt(time+1).Key)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// The key is built from the end time, the pool id and the id, each followed by a separator
+		poolId := binary.BigEndian.Uint64(iterator.Key()[9:17])
+		id := binary.BigEndian.Uint64(iterator.Key()[18:26])
+
+		if fundingStream, found := k.GetFundingStream(ctx, poolId, id); found {
+			list = append(list, fundingStream)
+		}
+	}
+
+	return
+}
+
+// GetFundingStreamsByPool returns all fundingStreams of a pool ordered by their id
+func (k Keeper) GetFundingStreamsByPool(ctx sdk.Context, poolId uint64) (list []types.FundingStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixBuilder{}.AInt(poolId).Key)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FundingStream
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllFundingStreams returns all fundingStreams
+func (k Keeper) GetAllFundingStreams(ctx sdk.Context) (list []types.FundingStream) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FundingStream
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -21,6 +21,14 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.RedelegationCooldown(ctx),
 		k.RedelegationMaxAmount(ctx),
 		k.CommissionChangeTime(ctx),
+		k.StakerTransferCooldown(ctx),
+		k.MaxAutoCompoundsPerBlock(ctx),
+		k.MaxWithdrawAllPositions(ctx),
+		k.MaxDelegationSelfStakeMultiple(ctx),
+		k.MaxDelegationPoolShare(ctx),
+		k.UploaderRoleSkipCooldown(ctx),
+		k.VoterRewardShare(ctx),
+		k.RunwayAlertThresholds(ctx),
 	)
 }
 
@@ -101,6 +109,54 @@ func (k Keeper) CommissionChangeTime(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// StakerTransferCooldown ...
+func (k Keeper) StakerTransferCooldown(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyStakerTransferCooldown, &res)
+	return
+}
+
+// MaxAutoCompoundsPerBlock ...
+func (k Keeper) MaxAutoCompoundsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxAutoCompoundsPerBlock, &res)
+	return
+}
+
+// MaxWithdrawAllPositions ...
+func (k Keeper) MaxWithdrawAllPositions(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxWithdrawAllPositions, &res)
+	return
+}
+
+// MaxDelegationSelfStakeMultiple ...
+func (k Keeper) MaxDelegationSelfStakeMultiple(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxDelegationSelfStakeMultiple, &res)
+	return
+}
+
+// MaxDelegationPoolShare ...
+func (k Keeper) MaxDelegationPoolShare(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyMaxDelegationPoolShare, &res)
+	return
+}
+
+// UploaderRoleSkipCooldown ...
+func (k Keeper) UploaderRoleSkipCooldown(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyUploaderRoleSkipCooldown, &res)
+	return
+}
+
+// VoterRewardShare ...
+func (k Keeper) VoterRewardShare(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyVoterRewardShare, &res)
+	return
+}
+
+// RunwayAlertThresholds ...
+func (k Keeper) RunwayAlertThresholds(ctx sdk.Context) (res []uint64) {
+	k.paramstore.Get(ctx, types.KeyRunwayAlertThresholds, &res)
+	return
+}
+
 // ParamStore ...
 func (k Keeper) ParamStore() (paramStore paramtypes.Subspace) {
 	return k.paramstore
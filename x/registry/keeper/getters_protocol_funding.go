@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetProtocolFunding set a specific protocolFunding in the store from its index
+func (k Keeper) SetProtocolFunding(ctx sdk.Context, protocolFunding types.ProtocolFunding) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProtocolFundingKeyPrefix)
+	b := k.cdc.MustMarshal(&protocolFunding)
+	store.Set(types.ProtocolFundingKey(protocolFunding.PoolId), b)
+}
+
+// GetProtocolFunding returns a protocolFunding from its index
+func (k Keeper) GetProtocolFunding(ctx sdk.Context, poolId uint64) (val types.ProtocolFunding, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProtocolFundingKeyPrefix)
+
+	b := store.Get(types.ProtocolFundingKey(poolId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProtocolFunding removes a protocolFunding from the store
+func (k Keeper) RemoveProtocolFunding(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProtocolFundingKeyPrefix)
+	store.Delete(types.ProtocolFundingKey(poolId))
+}
+
+// GetAllProtocolFundings returns all protocolFundings
+func (k Keeper) GetAllProtocolFundings(ctx sdk.Context) (list []types.ProtocolFunding) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProtocolFundingKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProtocolFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
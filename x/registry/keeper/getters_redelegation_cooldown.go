@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"encoding/binary"
 	"github.com/KYVENetwork/chain/x/registry/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,29 +9,48 @@ import (
 // SetRedelegationCooldown ...
 func (k Keeper) SetRedelegationCooldown(ctx sdk.Context, redelegationCooldown types.RedelegationCooldown) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationCooldownPrefix)
+	b := k.cdc.MustMarshal(&redelegationCooldown)
 	store.Set(types.RedelegationCooldownKey(
 		redelegationCooldown.Address,
-		redelegationCooldown.CreationDate,
-	), []byte{1})
+		redelegationCooldown.Index,
+	), b)
 }
 
-// GetRedelegationCooldownEntries ...
-func (k Keeper) GetRedelegationCooldownEntries(ctx sdk.Context, delegatorAddress string) (creationDates []uint64) {
+// GetRedelegationCooldownEntries returns all cooldown entries of a delegator ordered by their index.
+func (k Keeper) GetRedelegationCooldownEntries(ctx sdk.Context, delegatorAddress string) (list []types.RedelegationCooldown) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBuilder{Key: types.RedelegationCooldownPrefix}.AString(delegatorAddress).Key)
 	iterator := sdk.KVStorePrefixIterator(store, nil)
 
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		creationDates = append(creationDates, binary.BigEndian.Uint64(iterator.Key()[0:8]))
+		var val types.RedelegationCooldown
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
 	}
 	return
 }
 
+// GetNextRedelegationCooldownIndex returns the index for the next cooldown entry of a delegator.
+func (k Keeper) GetNextRedelegationCooldownIndex(ctx sdk.Context, delegatorAddress string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBuilder{Key: types.RedelegationCooldownPrefix}.AString(delegatorAddress).Key)
+	iterator := sdk.KVStoreReversePrefixIterator(store, nil)
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	var val types.RedelegationCooldown
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val.Index + 1
+}
+
 // RemoveRedelegationCooldown ...
-func (k Keeper) RemoveRedelegationCooldown(ctx sdk.Context, delegatorAddress string, block uint64) {
+func (k Keeper) RemoveRedelegationCooldown(ctx sdk.Context, delegatorAddress string, index uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationCooldownPrefix)
-	store.Delete(types.RedelegationCooldownKey(delegatorAddress, block))
+	store.Delete(types.RedelegationCooldownKey(delegatorAddress, index))
 }
 
 // GetAllRedelegationCooldownEntries ...
@@ -43,10 +61,8 @@ func (k Keeper) GetAllRedelegationCooldownEntries(ctx sdk.Context) (list []types
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		val := types.RedelegationCooldown{
-			Address:      string(iterator.Key()[0:43]),
-			CreationDate: binary.BigEndian.Uint64(iterator.Key()[44:52]),
-		}
+		var val types.RedelegationCooldown
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
 		list = append(list, val)
 	}
 
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// #####################
+// === QUEUE ENTRIES ===
+// #####################
+
+// SetRedelegationQueueEntry ...
+func (k Keeper) SetRedelegationQueueEntry(ctx sdk.Context, redelegationQueueEntry types.RedelegationQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefix)
+	b := k.cdc.MustMarshal(&redelegationQueueEntry)
+	store.Set(types.RedelegationQueueEntryKey(
+		redelegationQueueEntry.Index,
+	), b)
+
+	// Insert the same entry ordered by source pool and staker for slashing
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefixIndex2)
+	indexStore.Set(types.RedelegationQueueEntryKeyIndex2(
+		redelegationQueueEntry.FromPoolId,
+		redelegationQueueEntry.FromStaker,
+		redelegationQueueEntry.Index,
+	), []byte{1})
+}
+
+// GetRedelegationQueueEntry returns a RedelegationQueueEntry from its index
+func (k Keeper) GetRedelegationQueueEntry(ctx sdk.Context, index uint64) (val types.RedelegationQueueEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefix)
+
+	b := store.Get(types.RedelegationQueueEntryKey(index))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedelegationQueueEntry removes a RedelegationQueueEntry from the store
+func (k Keeper) RemoveRedelegationQueueEntry(ctx sdk.Context, redelegationQueueEntry *types.RedelegationQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefix)
+	store.Delete(types.RedelegationQueueEntryKey(redelegationQueueEntry.Index))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefixIndex2)
+	indexStore.Delete(types.RedelegationQueueEntryKeyIndex2(
+		redelegationQueueEntry.FromPoolId,
+		redelegationQueueEntry.FromStaker,
+		redelegationQueueEntry.Index,
+	))
+}
+
+// GetRedelegationQueueEntriesOfStaker returns all pending redelegations away from a staker in a pool
+func (k Keeper) GetRedelegationQueueEntriesOfStaker(ctx sdk.Context, poolId uint64, staker string) (list []types.RedelegationQueueEntry) {
+	stakerPrefix := types.KeyPrefixBuilder{Key: types.RedelegationQueueEntryKeyPrefixIndex2}.AInt(poolId).AString(staker).Key
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), stakerPrefix)
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := binary.BigEndian.Uint64(iterator.Key()[0:8])
+
+		entry, found := k.GetRedelegationQueueEntry(ctx, index)
+		if found {
+			list = append(list, entry)
+		}
+	}
+
+	return
+}
+
+// GetAllRedelegationQueueEntries returns all pending redelegations
+func (k Keeper) GetAllRedelegationQueueEntries(ctx sdk.Context) (list []types.RedelegationQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationQueueEntryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedelegationQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ###################
+// === QUEUE STATE ===
+// ###################
+
+// GetRedelegationQueueState returns the state for the redelegation queue
+func (k Keeper) GetRedelegationQueueState(ctx sdk.Context) (state types.RedelegationQueueState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	b := store.Get(types.RedelegationQueueStateKey)
+
+	if b == nil {
+		return state
+	}
+
+	k.cdc.MustUnmarshal(b, &state)
+	return
+}
+
+// SetRedelegationQueueState saves the redelegation queue state
+func (k Keeper) SetRedelegationQueueState(ctx sdk.Context, state types.RedelegationQueueState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.RedelegationQueueStateKey, b)
+}
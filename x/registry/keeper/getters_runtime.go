@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRuntime set a specific runtime in the store from its index
+func (k Keeper) SetRuntime(ctx sdk.Context, runtime types.Runtime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+	b := k.cdc.MustMarshal(&runtime)
+	store.Set(types.RuntimeKey(runtime.Name), b)
+}
+
+// GetRuntime returns a runtime from its index
+func (k Keeper) GetRuntime(ctx sdk.Context, name string) (val types.Runtime, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+
+	b := store.Get(types.RuntimeKey(name))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllRuntimes returns all runtimes
+func (k Keeper) GetAllRuntimes(ctx sdk.Context) (list []types.Runtime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RuntimeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Runtime
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetStorageProviderCount get the total number of storage providers
+func (k Keeper) GetStorageProviderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := store.Get(types.StorageProviderCountKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStorageProviderCount set the total number of storage providers
+func (k Keeper) SetStorageProviderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.StorageProviderCountKey, bz)
+}
+
+// AppendStorageProvider appends a storage provider in the store with a new id and updates the count.
+// Ids start at one, as zero refers to the default storage provider.
+func (k Keeper) AppendStorageProvider(ctx sdk.Context, storageProvider types.StorageProvider) uint64 {
+	count := k.GetStorageProviderCount(ctx)
+
+	// Set the ID of the appended value
+	storageProvider.Id = count + 1
+	k.SetStorageProvider(ctx, storageProvider)
+
+	// Update storage provider count
+	k.SetStorageProviderCount(ctx, count+1)
+
+	return storageProvider.Id
+}
+
+// SetStorageProvider set a specific storageProvider in the store from its index
+func (k Keeper) SetStorageProvider(ctx sdk.Context, storageProvider types.StorageProvider) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageProviderKeyPrefix)
+	b := k.cdc.MustMarshal(&storageProvider)
+	store.Set(types.StorageProviderKey(storageProvider.Id), b)
+}
+
+// GetStorageProvider returns a storageProvider from its index
+func (k Keeper) GetStorageProvider(ctx sdk.Context, id uint64) (val types.StorageProvider, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageProviderKeyPrefix)
+
+	b := store.Get(types.StorageProviderKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllStorageProviders returns all storageProviders
+func (k Keeper) GetAllStorageProviders(ctx sdk.Context) (list []types.StorageProvider) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StorageProviderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StorageProvider
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
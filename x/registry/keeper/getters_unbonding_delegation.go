@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/KYVENetwork/chain/x/registry/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +26,14 @@ func (k Keeper) SetUnbondingDelegationQueueEntry(ctx sdk.Context, unbondingDeleg
 		unbondingDelegationQueueEntry.Delegator,
 		unbondingDelegationQueueEntry.Index,
 	), []byte{1})
+
+	// Insert the same entry ordered by pool and staker for slashing
+	stakerIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingDelegationQueueEntryKeyPrefixIndex3)
+	stakerIndexStore.Set(types.UnbondingDelegationQueueEntryKeyIndex3(
+		unbondingDelegationQueueEntry.PoolId,
+		unbondingDelegationQueueEntry.Staker,
+		unbondingDelegationQueueEntry.Index,
+	), []byte{1})
 }
 
 // GetUnbondingDelegationQueueEntry returns a UnbondingDelegationQueueEntry from its index
@@ -49,6 +59,33 @@ func (k Keeper) RemoveUnbondingDelegationQueueEntry(ctx sdk.Context, unbondingDe
 		unbondingDelegationQueueEntry.Delegator,
 		unbondingDelegationQueueEntry.Index,
 	))
+
+	stakerIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnbondingDelegationQueueEntryKeyPrefixIndex3)
+	stakerIndexStore.Delete(types.UnbondingDelegationQueueEntryKeyIndex3(
+		unbondingDelegationQueueEntry.PoolId,
+		unbondingDelegationQueueEntry.Staker,
+		unbondingDelegationQueueEntry.Index,
+	))
+}
+
+// GetUnbondingDelegationQueueEntriesOfStaker returns all pending delegator unbondings of a staker in a pool
+func (k Keeper) GetUnbondingDelegationQueueEntriesOfStaker(ctx sdk.Context, poolId uint64, staker string) (list []types.UnbondingDelegationQueueEntry) {
+	stakerPrefix := types.KeyPrefixBuilder{Key: types.UnbondingDelegationQueueEntryKeyPrefixIndex3}.AInt(poolId).AString(staker).Key
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), stakerPrefix)
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := binary.BigEndian.Uint64(iterator.Key()[0:8])
+
+		entry, found := k.GetUnbondingDelegationQueueEntry(ctx, index)
+		if found {
+			list = append(list, entry)
+		}
+	}
+
+	return
 }
 
 // GetAllUnbondingDelegationQueueEntries returns all delegator unbondings
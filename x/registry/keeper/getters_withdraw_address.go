@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetWithdrawAddress set a specific withdrawAddress in the store from its index
+func (k Keeper) SetWithdrawAddress(ctx sdk.Context, withdrawAddress types.WithdrawAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithdrawAddressKeyPrefix)
+	b := k.cdc.MustMarshal(&withdrawAddress)
+	store.Set(types.WithdrawAddressKey(withdrawAddress.Address), b)
+}
+
+// RemoveWithdrawAddress removes a withdrawAddress from the store
+func (k Keeper) RemoveWithdrawAddress(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithdrawAddressKeyPrefix)
+	store.Delete(types.WithdrawAddressKey(address))
+}
+
+// GetWithdrawAddress returns the account which receives the rewards of the given address.
+// If no withdraw address was set, the address itself is returned.
+func (k Keeper) GetWithdrawAddress(ctx sdk.Context, address string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithdrawAddressKeyPrefix)
+
+	b := store.Get(types.WithdrawAddressKey(address))
+	if b == nil {
+		return address
+	}
+
+	var val types.WithdrawAddress
+	k.cdc.MustUnmarshal(b, &val)
+	return val.WithdrawAddress
+}
+
+// GetAllWithdrawAddresses returns all withdrawAddresses
+func (k Keeper) GetAllWithdrawAddresses(ctx sdk.Context) (list []types.WithdrawAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WithdrawAddressKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.WithdrawAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
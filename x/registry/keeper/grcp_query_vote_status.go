@@ -21,7 +21,15 @@ func (k Keeper) VoteStatus(c context.Context, req *types.QueryVoteStatusRequest)
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool)
+	proposal := pool.BundleProposal
+	if req.StorageId != "" {
+		proposal, found = getOpenBundleProposal(&pool, req.StorageId)
+		if !found {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+	}
+
+	valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool, proposal)
 
 	return &types.QueryVoteStatusResponse{
 		VoteStatus: &types.VoteStatusResponse{
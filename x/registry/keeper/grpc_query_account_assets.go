@@ -22,19 +22,19 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	response := types.QueryAccountAssetsResponse{
-		Balance:                     0,
-		ProtocolStaking:             0,
-		ProtocolStakingUnbonding:    0,
-		ProtocolDelegation:          0,
-		ProtocolDelegationUnbonding: 0,
-		ProtocolRewards:             0,
-		ProtocolFunding:             0,
+		Balance:                     sdk.ZeroInt(),
+		ProtocolStaking:             sdk.ZeroInt(),
+		ProtocolStakingUnbonding:    sdk.ZeroInt(),
+		ProtocolDelegation:          sdk.ZeroInt(),
+		ProtocolDelegationUnbonding: sdk.ZeroInt(),
+		ProtocolRewards:             sdk.ZeroInt(),
+		ProtocolFunding:             sdk.ZeroInt(),
 	}
 
 	// Fetch account balance
 	account, _ := sdk.AccAddressFromBech32(req.Address)
 	balance := k.bankKeeper.GetBalance(ctx, account, "tkyve")
-	response.Balance = balance.Amount.Uint64()
+	response.Balance = balance.Amount
 
 	// Iterate all Delegator entries
 	// Fetches the total delegation and calculates the outstanding rewards
@@ -64,8 +64,8 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 			delegatorAddress: delegator.Delegator,
 		}
 
-		response.ProtocolRewards += f1.getCurrentReward()
-		response.ProtocolDelegation += delegator.DelegationAmount
+		response.ProtocolRewards = response.ProtocolRewards.Add(f1.getCurrentReward())
+		response.ProtocolDelegation = response.ProtocolDelegation.Add(f1.getCurrentDelegation())
 	}
 
 	// Iterate all Staker entries
@@ -82,7 +82,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 		var val types.Staker
 		k.cdc.MustUnmarshal(stakerIterator.Value(), &val)
 
-		response.ProtocolStaking += val.Amount
+		response.ProtocolStaking = response.ProtocolStaking.Add(val.Amount)
 	}
 
 	// Iterate all funding entries
@@ -95,7 +95,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 		var val types.Funder
 		k.cdc.MustUnmarshal(funderIterator.Value(), &val)
 
-		response.ProtocolFunding += val.Amount
+		response.ProtocolFunding = response.ProtocolFunding.Add(val.Amount)
 	}
 
 	// Unbondings
@@ -109,7 +109,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 		var val types.UnbondingStaker
 		k.cdc.MustUnmarshal(unbondingStakerIterator.Value(), &val)
 
-		response.ProtocolStakingUnbonding += val.UnbondingAmount
+		response.ProtocolStakingUnbonding = response.ProtocolStakingUnbonding.Add(val.UnbondingAmount)
 	}
 
 	// Iterate all UnbondingDelegation entries to get total delegation unbonding amount
@@ -122,7 +122,7 @@ func (k Keeper) AccountAssets(goCtx context.Context, req *types.QueryAccountAsse
 		delegationKey := binary.BigEndian.Uint64(unbondingDelegatorIterator.Key()[44 : 44+8])
 
 		unbondingDelegationEntry, _ := k.GetUnbondingDelegationQueueEntry(ctx, delegationKey)
-		response.ProtocolDelegationUnbonding += unbondingDelegationEntry.Amount
+		response.ProtocolDelegationUnbonding = response.ProtocolDelegationUnbonding.Add(unbondingDelegationEntry.Amount)
 	}
 
 	return &response, nil
@@ -66,7 +66,7 @@ func (k Keeper) AccountDelegationList(goCtx context.Context, req *types.QueryAcc
 				Account:                 req.Address,
 				Pool:                    &pool,
 				CurrentReward:           f1.getCurrentReward(),
-				DelegationAmount:        delegator.DelegationAmount,
+				DelegationAmount:        f1.getCurrentDelegation(),
 				Staker:                  delegator.Staker,
 				PendingCommissionChange: pendingCommissionChange,
 				DelegationPoolData:      &delegationPoolData,
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountPendingRewards returns the outstanding rewards of all delegations of an address.
+// Pagination is supported, the total only covers the rewards of the returned page.
+func (k Keeper) AccountPendingRewards(goCtx context.Context, req *types.QueryAccountPendingRewardsRequest) (*types.QueryAccountPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	response := types.QueryAccountPendingRewardsResponse{Total: sdk.ZeroInt()}
+
+	delegatorPrefix := types.KeyPrefixBuilder{Key: types.DelegatorKeyPrefixIndex2}.AString(req.Address).Key
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegatorPrefix)
+
+	pageRes, err := query.Paginate(delegatorStore, req.Pagination, func(key []byte, value []byte) error {
+		staker := string(key[9:52])
+		poolId := binary.BigEndian.Uint64(key[0:8])
+
+		f1 := F1Distribution{
+			k:                k,
+			ctx:              ctx,
+			poolId:           poolId,
+			stakerAddress:    staker,
+			delegatorAddress: req.Address,
+		}
+
+		reward := f1.getCurrentReward()
+
+		response.Rewards = append(response.Rewards, types.PendingReward{
+			PoolId: poolId,
+			Staker: staker,
+			Amount: reward,
+		})
+		response.Total = response.Total.Add(reward)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response.Pagination = pageRes
+
+	return &response, nil
+}
@@ -8,14 +8,29 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// AccountRedelegation ...
+// AccountRedelegation returns the active redelegation cooldowns of an address
+// and the number of redelegations it can still perform.
 func (k Keeper) AccountRedelegation(goCtx context.Context, req *types.QueryAccountRedelegationRequest) (*types.QueryAccountRedelegationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	return &types.QueryAccountRedelegationResponse{
-		RedelegationCooldownEntries: k.GetRedelegationCooldownEntries(ctx, req.Address),
-	}, nil
+	response := types.QueryAccountRedelegationResponse{}
+
+	// Expired entries are only removed on the next redelegation, therefore they are skipped here.
+	for _, cooldown := range k.GetRedelegationCooldownEntries(ctx, req.Address) {
+		if ctx.BlockTime().Unix()-int64(cooldown.CreationDate) > int64(k.RedelegationCooldown(ctx)) {
+			continue
+		}
+
+		response.RedelegationCooldownEntries = append(response.RedelegationCooldownEntries, cooldown.CreationDate)
+		response.Cooldowns = append(response.Cooldowns, cooldown)
+	}
+
+	if maxAmount := k.RedelegationMaxAmount(ctx); uint64(len(response.Cooldowns)) < maxAmount {
+		response.AvailableSlots = maxAmount - uint64(len(response.Cooldowns))
+	}
+
+	return &response, nil
 }
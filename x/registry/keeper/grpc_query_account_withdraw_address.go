@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccountWithdrawAddress returns the account which receives the rewards of an address.
+func (k Keeper) AccountWithdrawAddress(goCtx context.Context, req *types.QueryAccountWithdrawAddressRequest) (*types.QueryAccountWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAccountWithdrawAddressResponse{
+		WithdrawAddress: k.GetWithdrawAddress(ctx, req.Address),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ArchivedProposals returns all proposals of a retired pool ordered by their bundle id.
+func (k Keeper) ArchivedProposals(c context.Context, req *types.QueryArchivedProposalsRequest) (*types.QueryArchivedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var proposals []types.Proposal
+	ctx := sdk.UnwrapSDKContext(c)
+
+	archivedProposalPrefix := types.KeyPrefixBuilder{Key: types.ArchivedProposalKeyPrefix}.AInt(req.PoolId).Key
+	archivedProposalStore := prefix.NewStore(ctx.KVStore(k.storeKey), archivedProposalPrefix)
+
+	pageRes, err := query.Paginate(archivedProposalStore, req.Pagination, func(key []byte, value []byte) error {
+		var proposal types.Proposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return err
+		}
+
+		proposals = append(proposals, proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryArchivedProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
@@ -32,7 +32,7 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 	}
 
 	// Check if minimum stake is reached
-	if pool.TotalStake < pool.MinStake {
+	if pool.TotalStake.LT(pool.MinStake) {
 		return &types.QueryCanProposeResponse{
 			Possible: false,
 			Reason:   "Not enough stake in pool",
@@ -40,7 +40,7 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 	}
 
 	// Check if pool has funds
-	if pool.TotalFunds == 0 {
+	if pool.TotalFunds.IsZero() && !k.hasActiveFundingStreams(ctx, pool.Id) {
 		return &types.QueryCanProposeResponse{
 			Possible: false,
 			Reason:   "Pool has run out of funds",
@@ -55,6 +55,14 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 		}, nil
 	}
 
+	// Check if pool is completed
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return &types.QueryCanProposeResponse{
+			Possible: false,
+			Reason:   "Pool is completed",
+		}, nil
+	}
+
 	// Check if pool is upgrading
 	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 		return &types.QueryCanProposeResponse{
@@ -64,7 +72,7 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 	}
 
 	// Check if sender is a staker in pool
-	_, isStaker := k.GetStaker(ctx, req.Proposer, req.PoolId)
+	staker, isStaker := k.GetStaker(ctx, req.Proposer, req.PoolId)
 	if !isStaker {
 		return &types.QueryCanProposeResponse{
 			Possible: false,
@@ -72,8 +80,19 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 		}, nil
 	}
 
+	// Check if staker runs the required protocol version
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
+		return &types.QueryCanProposeResponse{
+			Possible: false,
+			Reason:   "Proposer runs an outdated protocol version",
+		}, nil
+	}
+
+	// Get the most recent bundle proposal, new bundle proposals chain from it
+	lastProposal := lastOpenBundleProposal(&pool)
+
 	// Check if from_height matches
-	if pool.BundleProposal.ToHeight != req.FromHeight {
+	if lastProposal.ToHeight != req.FromHeight {
 		return &types.QueryCanProposeResponse{
 			Possible: false,
 			Reason:   "Invalid from_height",
@@ -89,7 +108,7 @@ func (k Keeper) CanPropose(goCtx context.Context, req *types.QueryCanProposeRequ
 	}
 
 	// Check if upload interval has been surpassed
-	if uint64(ctx.BlockTime().Unix()) < (pool.BundleProposal.CreatedAt + pool.UploadInterval) {
+	if uint64(ctx.BlockTime().Unix()) < (uploadRoundStart(&pool) + pool.UploadInterval) {
 		return &types.QueryCanProposeResponse{
 			Possible: false,
 			Reason:   "Upload interval not surpassed",
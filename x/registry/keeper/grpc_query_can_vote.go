@@ -33,7 +33,7 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 	}
 
 	// Check if minimum stake is reached
-	if pool.TotalStake < pool.MinStake {
+	if pool.TotalStake.LT(pool.MinStake) {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
 			Reason:   "Not enough stake in pool",
@@ -41,7 +41,7 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 	}
 
 	// Check if pool has funds
-	if pool.TotalFunds == 0 {
+	if pool.TotalFunds.IsZero() && !k.hasActiveFundingStreams(ctx, pool.Id) {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
 			Reason:   "Pool has run out of funds",
@@ -56,6 +56,14 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 		}, nil
 	}
 
+	// Check if pool is completed
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return &types.QueryCanVoteResponse{
+			Possible: false,
+			Reason:   "Pool is completed",
+		}, nil
+	}
+
 	// Check if pool is upgrading
 	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 		return &types.QueryCanVoteResponse{
@@ -65,7 +73,7 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 	}
 
 	// Check if sender is a staker in pool
-	_, isStaker := k.GetStaker(ctx, req.Voter, req.PoolId)
+	staker, isStaker := k.GetStaker(ctx, req.Voter, req.PoolId)
 	if !isStaker {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
@@ -73,32 +81,41 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 		}, nil
 	}
 
-	// Check if dropped bundle
-	if pool.BundleProposal.StorageId == "" {
+	// Check if staker runs the required protocol version
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
-			Reason:   "Can not vote on dropped bundle",
+			Reason:   "Voter runs an outdated protocol version",
 		}, nil
 	}
 
-	// Check if empty bundle
-	if strings.HasPrefix(pool.BundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+	// Check if tx matches an open bundle proposal
+	bundleProposal, found := getOpenBundleProposal(&pool, req.StorageId)
+	if !found {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
-			Reason:   "Can not vote on NO_DATA_BUNDLE",
+			Reason:   "Provided storageId does not match current one",
 		}, nil
 	}
 
-	// Check if tx matches current bundleProposal
-	if req.StorageId != pool.BundleProposal.StorageId {
+	// Check if dropped bundle
+	if bundleProposal.StorageId == "" {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
-			Reason:   "Provided storageId does not match current one",
+			Reason:   "Can not vote on dropped bundle",
+		}, nil
+	}
+
+	// Check if empty bundle
+	if strings.HasPrefix(bundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+		return &types.QueryCanVoteResponse{
+			Possible: false,
+			Reason:   "Can not vote on NO_DATA_BUNDLE",
 		}, nil
 	}
 
 	// check if voter is not uploader
-	if pool.BundleProposal.Uploader == req.Voter {
+	if bundleProposal.Uploader == req.Voter {
 		return &types.QueryCanVoteResponse{
 			Possible: false,
 			Reason:   "Voter is uploader",
@@ -108,19 +125,19 @@ func (k Keeper) CanVote(goCtx context.Context, req *types.QueryCanVoteRequest) (
 	// Check if sender has not voted yet
 	hasVotedValid, hasVotedInvalid, hasVotedAbstain := false, false, false
 
-	for _, voter := range pool.BundleProposal.VotersValid {
+	for _, voter := range bundleProposal.VotersValid {
 		if voter == req.Voter {
 			hasVotedValid = true
 		}
 	}
 
-	for _, voter := range pool.BundleProposal.VotersInvalid {
+	for _, voter := range bundleProposal.VotersInvalid {
 		if voter == req.Voter {
 			hasVotedInvalid = true
 		}
 	}
 
-	for _, voter := range pool.BundleProposal.VotersAbstain {
+	for _, voter := range bundleProposal.VotersAbstain {
 		if voter == req.Voter {
 			hasVotedAbstain = true
 		}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DelegationCapacity returns the current delegation and the remaining delegation capacity
+// of every active and inactive staker of the given pool.
+func (k Keeper) DelegationCapacity(goCtx context.Context, req *types.QueryDelegationCapacityRequest) (*types.QueryDelegationCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.PoolId)
+	}
+
+	capacities := make([]types.DelegationCapacity, 0, len(pool.Stakers)+len(pool.InactiveStakers))
+
+	for _, staker := range append(append([]string{}, pool.Stakers...), pool.InactiveStakers...) {
+		remaining, unlimited := k.GetDelegationCapacity(ctx, &pool, staker)
+
+		capacities = append(capacities, types.DelegationCapacity{
+			Staker:          staker,
+			TotalDelegation: k.getStakerDelegation(ctx, pool.Id, staker),
+			Unlimited:       unlimited,
+			Remaining:       remaining,
+		})
+	}
+
+	return &types.QueryDelegationCapacityResponse{Capacities: capacities}, nil
+}
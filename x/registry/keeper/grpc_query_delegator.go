@@ -32,8 +32,8 @@ func (k Keeper) Delegator(goCtx context.Context, req *types.QueryDelegatorReques
 	if !found {
 		response.Delegator = &types.StakerDelegatorResponse{
 			Delegator: "",
-			CurrentReward: 0,
-			DelegationAmount: 0,
+			CurrentReward: sdk.ZeroInt(),
+			DelegationAmount: sdk.ZeroInt(),
 			Staker: "",
 		}
 
@@ -51,7 +51,7 @@ func (k Keeper) Delegator(goCtx context.Context, req *types.QueryDelegatorReques
 	response.Delegator = &types.StakerDelegatorResponse{
 		Delegator: delegator.Delegator,
 		CurrentReward: f1.getCurrentReward(),
-		DelegationAmount: delegator.DelegationAmount,
+		DelegationAmount: f1.getCurrentDelegation(),
 		Staker: delegator.Staker,
 	}
 
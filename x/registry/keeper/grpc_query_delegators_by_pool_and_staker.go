@@ -56,7 +56,7 @@ func (k Keeper) DelegatorsByPoolAndStaker(goCtx context.Context, req *types.Quer
 			delegators = append(delegators, types.StakerDelegatorResponse{
 				Delegator:        delegator.Delegator,
 				CurrentReward:    f1.getCurrentReward(),
-				DelegationAmount: delegator.DelegationAmount,
+				DelegationAmount: f1.getCurrentDelegation(),
 				Staker:           req.Staker,
 			})
 		}
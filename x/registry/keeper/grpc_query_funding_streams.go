@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FundingStreams returns all funding streams of a pool with their remaining budget and projected runway.
+// The projection assumes that a bundle is finalized every upload interval and that future bundle rewards
+// match the average bundle reward of the pool so far, or its operating cost if no bundle was finalized yet.
+func (k Keeper) FundingStreams(goCtx context.Context, req *types.QueryFundingStreamsRequest) (*types.QueryFundingStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.PoolId)
+	}
+
+	streams := k.GetFundingStreamsByPool(ctx, pool.Id)
+	response := types.QueryFundingStreamsResponse{FundingStreams: []types.FundingStreamStatus{}}
+
+	if len(streams) == 0 {
+		return &response, nil
+	}
+
+	bundleReward := pool.OperatingCost
+	if pool.TotalBundles > 0 {
+		bundleReward = pool.TotalBundleRewards.QuoRaw(int64(pool.TotalBundles))
+	}
+
+	bundlesPerDay := secondsPerDay
+	if pool.UploadInterval > 0 {
+		bundlesPerDay = secondsPerDay / pool.UploadInterval
+	}
+
+	share := bundleReward.QuoRaw(int64(len(pool.Funders) + len(streams)))
+	now := uint64(ctx.BlockTime().Unix())
+
+	for _, stream := range streams {
+		spendPerBundle := share
+		if stream.AmountPerBundle.IsPositive() {
+			spendPerBundle = sdk.MinInt(spendPerBundle, stream.AmountPerBundle)
+		}
+
+		spendPerDay := spendPerBundle.Mul(sdk.NewIntFromUint64(bundlesPerDay))
+		if stream.AmountPerDay.IsPositive() {
+			spendPerDay = sdk.MinInt(spendPerDay, stream.AmountPerDay)
+		}
+
+		// The runway is zero if neither spending nor an end time is projected.
+		runway := uint64(0)
+		if spendPerDay.IsPositive() {
+			seconds := stream.Amount.Mul(sdk.NewIntFromUint64(secondsPerDay)).Quo(spendPerDay)
+			if seconds.IsUint64() {
+				runway = seconds.Uint64()
+			} else {
+				runway = ^uint64(0)
+			}
+		}
+
+		if stream.EndTime > 0 {
+			remaining := uint64(0)
+			if stream.EndTime > now {
+				remaining = stream.EndTime - now
+			}
+
+			if runway == 0 || remaining < runway {
+				runway = remaining
+			}
+		}
+
+		response.FundingStreams = append(response.FundingStreams, types.FundingStreamStatus{
+			FundingStream: stream,
+			SpendPerDay:   spendPerDay,
+			Runway:        runway,
+		})
+	}
+
+	return &response, nil
+}
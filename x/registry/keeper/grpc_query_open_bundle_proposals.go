@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenBundleProposals returns all bundle proposals of the given pool which are not finalized yet,
+// starting with the one which is finalized next.
+func (k Keeper) OpenBundleProposals(goCtx context.Context, req *types.QueryOpenBundleProposalsRequest) (*types.QueryOpenBundleProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.PoolId)
+	}
+
+	proposals := make([]types.OpenBundleProposal, 0, 1+len(pool.PipelinedProposals))
+
+	// A dropped bundle proposal has no storage id and is not open anymore.
+	if pool.BundleProposal.StorageId == "" {
+		return &types.QueryOpenBundleProposalsResponse{Proposals: proposals}, nil
+	}
+
+	fromHeight := pool.CurrentHeight
+
+	for _, proposal := range append([]*types.BundleProposal{pool.BundleProposal}, pool.PipelinedProposals...) {
+		proposals = append(proposals, types.OpenBundleProposal{
+			FromHeight:     fromHeight,
+			BundleProposal: proposal,
+		})
+
+		fromHeight = proposal.ToHeight
+	}
+
+	return &types.QueryOpenBundleProposalsResponse{Proposals: proposals}, nil
+}
@@ -30,6 +30,11 @@ func (k Keeper) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.Q
 			return false, err
 		}
 
+		// skip retired pools
+		if pool.Status == types.POOL_STATUS_RETIRED {
+			return false, nil
+		}
+
 		// filter search
 		if !strings.Contains(strings.ToLower(pool.Name), strings.ToLower(req.Search)) {
 			return false, nil
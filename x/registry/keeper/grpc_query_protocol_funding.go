@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ProtocolFundings(goCtx context.Context, req *types.QueryProtocolFundingsRequest) (*types.QueryProtocolFundingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryProtocolFundingsResponse{ProtocolFundings: k.GetAllProtocolFundings(ctx)}, nil
+}
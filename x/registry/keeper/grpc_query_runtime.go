@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Runtime(goCtx context.Context, req *types.QueryRuntimeRequest) (*types.QueryRuntimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	runtime, found := k.GetRuntime(ctx, req.Name)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), req.Name)
+	}
+
+	return &types.QueryRuntimeResponse{Runtime: runtime}, nil
+}
+
+func (k Keeper) Runtimes(goCtx context.Context, req *types.QueryRuntimesRequest) (*types.QueryRuntimesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRuntimesResponse{Runtimes: k.GetAllRuntimes(ctx)}, nil
+}
+
+func (k Keeper) RuntimeVersion(goCtx context.Context, req *types.QueryRuntimeVersionRequest) (*types.QueryRuntimeVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	runtime, found := k.GetRuntime(ctx, req.Runtime)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), req.Runtime)
+	}
+
+	version, found := runtime.GetVersion(req.Version)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrRuntimeVersionNotFound.Error(), req.Version, req.Runtime)
+	}
+
+	return &types.QueryRuntimeVersionResponse{RuntimeVersion: version}, nil
+}
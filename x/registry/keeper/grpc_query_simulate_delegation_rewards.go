@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// simulationBundleWindow is the number of recently finalized bundles
+// which are used to estimate the byte size of future bundles.
+const simulationBundleWindow = 100
+
+// SimulateDelegationRewards estimates the upload probability of a staker and the rewards
+// a delegator would receive for delegating the given amount to it. The estimation assumes
+// that the pool keeps its current stakers, parameters and recent average bundle size
+// and that a bundle is finalized every upload interval.
+func (k Keeper) SimulateDelegationRewards(goCtx context.Context, req *types.QuerySimulateDelegationRewardsRequest) (*types.QuerySimulateDelegationRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "invalid amount")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.PoolId)
+	}
+
+	staker, found := k.GetStaker(ctx, req.Staker, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrNotFound, types.ErrNoStaker.Error())
+	}
+
+	response := types.QuerySimulateDelegationRewardsResponse{
+		BundleReward:    sdk.ZeroInt(),
+		RewardPerBundle: sdk.ZeroInt(),
+		RewardPerDay:    sdk.ZeroInt(),
+	}
+
+	// Inactive stakers are never selected as uploader.
+	probability := sdk.ZeroDec()
+	if containsElement(pool.Stakers, req.Staker) {
+		probability = k.getUploadProbability(ctx, &pool, req.Staker, amount)
+	}
+	response.UploadProbability = probability.String()
+
+	// Calculate the expected bundle reward in the same way as it is paid out on finalization.
+	response.AverageByteSize = k.getAverageBundleByteSize(ctx, &pool)
+	response.BundleReward = pool.OperatingCost.Add(sdk.NewIntFromUint64(response.AverageByteSize).Mul(k.getStorageCost(ctx, pool.StorageProviderId)))
+
+	networkFee, err := sdk.NewDecFromStr(k.NetworkFee(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrLogic, err.Error())
+	}
+
+	commission, err := sdk.NewDecFromStr(staker.Commission)
+	if err != nil {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrLogic, err.Error())
+	}
+
+	treasuryPayout := sdk.NewDecFromInt(response.BundleReward).Mul(networkFee).RoundInt()
+	uploaderPayout := response.BundleReward.Sub(treasuryPayout)
+	uploaderPayout = uploaderPayout.Sub(k.getVoterPayout(ctx, uploaderPayout))
+	delegationReward := sdk.NewDecFromInt(uploaderPayout).Mul(sdk.OneDec().Sub(commission))
+
+	// The delegation reward is shared among all delegators of the staker proportional to their delegation.
+	totalDelegation := k.getStakerDelegation(ctx, pool.Id, req.Staker).Add(amount)
+	if totalDelegation.IsZero() {
+		return &response, nil
+	}
+
+	rewardPerBundle := delegationReward.Mul(probability).MulInt(amount).QuoInt(totalDelegation)
+	response.RewardPerBundle = rewardPerBundle.TruncateInt()
+
+	if pool.UploadInterval > 0 {
+		bundlesPerDay := sdk.NewDec(60 * 60 * 24).QuoInt64(int64(pool.UploadInterval))
+		response.RewardPerDay = rewardPerBundle.Mul(bundlesPerDay).TruncateInt()
+	}
+
+	return &response, nil
+}
+
+// getAverageBundleByteSize returns the average charged byte size of the recently finalized bundles of a pool.
+// Proposals which were finalized before the byte size was recorded are skipped. If no such proposal
+// exists, the average over the whole lifetime of the pool is used instead.
+func (k Keeper) getAverageBundleByteSize(ctx sdk.Context, pool *types.Pool) uint64 {
+	minBundleId := uint64(0)
+	if pool.TotalBundles > simulationBundleWindow {
+		minBundleId = pool.TotalBundles - simulationBundleWindow
+	}
+
+	totalBytes, count := uint64(0), uint64(0)
+	for _, proposal := range k.GetProposalsByPoolIdSinceBundleId(ctx, pool.Id, minBundleId) {
+		if proposal.ByteSize > 0 {
+			totalBytes += getChargedByteSize(pool, proposal.ByteSize, proposal.UncompressedByteSize)
+			count++
+		}
+	}
+
+	if count > 0 {
+		return totalBytes / count
+	}
+
+	if pool.TotalBundles > 0 {
+		return pool.TotalBytes / pool.TotalBundles
+	}
+
+	return 0
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/KYVENetwork/chain/x/registry/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,7 +40,7 @@ func (k Keeper) StakeInfo(goCtx context.Context, req *types.QueryStakeInfoReques
 	// Current Stake amount
 	staker, exists := k.GetStaker(ctx, req.Staker, req.PoolId)
 	if exists {
-		response.CurrentStake = strconv.FormatUint(staker.Amount, 10)
+		response.CurrentStake = staker.Amount.String()
 	}
 
 	response.Status = staker.Status
@@ -55,7 +54,7 @@ func (k Keeper) StakeInfo(goCtx context.Context, req *types.QueryStakeInfoReques
 	// Fetch current lowest staker only if all stacker slots are occupied
 	if len(pool.Stakers) >= types.MaxStakers {
 		lowestStaker, _ := k.GetStaker(ctx, pool.LowestStaker, req.PoolId)
-		response.MinimumStake = strconv.FormatUint(lowestStaker.Amount, 10)
+		response.MinimumStake = lowestStaker.Amount.String()
 	}
 
 	return &response, nil
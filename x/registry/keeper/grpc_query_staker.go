@@ -41,7 +41,7 @@ func (k Keeper) Staker(goCtx context.Context, req *types.QueryStakerRequest) (*t
 		PoolId:                  staker.PoolId,
 		Account:                 staker.Account,
 		Amount:                  staker.Amount,
-		TotalDelegation:         0,
+		TotalDelegation:         sdk.ZeroInt(),
 		Commission:              staker.Commission,
 		Moniker:                 staker.Moniker,
 		Website:                 staker.Website,
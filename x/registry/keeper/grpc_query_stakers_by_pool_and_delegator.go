@@ -58,7 +58,7 @@ func (k Keeper) StakersByPoolAndDelegator(goCtx context.Context, req *types.Quer
 			stakers = append(stakers, types.DelegationForStakerResponse{
 				Staker:                delegator.Staker,
 				CurrentReward:         f1.getCurrentReward(),
-				DelegationAmount:      delegator.DelegationAmount,
+				DelegationAmount:      f1.getCurrentDelegation(),
 				TotalDelegationAmount: delegationPoolData.TotalDelegation,
 				DelegatorCount:        delegationPoolData.DelegatorCount,
 			})
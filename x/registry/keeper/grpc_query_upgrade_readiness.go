@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpgradeReadiness returns the attested versions of all active stakers of a pool compared against the
+// version of the scheduled upgrade, or the current protocol version if no upgrade is scheduled.
+func (k Keeper) UpgradeReadiness(goCtx context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), req.PoolId)
+	}
+
+	targetVersion := pool.Protocol.Version
+	if pool.UpgradePlan.ScheduledAt > 0 {
+		targetVersion = pool.UpgradePlan.Version
+	}
+
+	response := types.QueryUpgradeReadinessResponse{
+		TargetVersion: targetVersion,
+		Stakers:       []types.StakerReadiness{},
+		ReadyStake:    sdk.ZeroInt(),
+		TotalStake:    sdk.ZeroInt(),
+	}
+
+	for _, account := range pool.Stakers {
+		staker, foundStaker := k.GetStaker(ctx, account, pool.Id)
+		if !foundStaker {
+			continue
+		}
+
+		ready := isVersionReady(staker.Version, targetVersion)
+
+		response.Stakers = append(response.Stakers, types.StakerReadiness{
+			Account: staker.Account,
+			Version: staker.Version,
+			Amount:  staker.Amount,
+			Ready:   ready,
+		})
+
+		response.TotalStake = response.TotalStake.Add(staker.Amount)
+		if ready {
+			response.ReadyStake = response.ReadyStake.Add(staker.Amount)
+		}
+	}
+
+	return &response, nil
+}
@@ -25,7 +25,7 @@ func testIntegrationFunding(t *testing.T) {
 	fundPool0 := runTx(&types.MsgFundPool{
 		Creator: ALICE_ADDR,
 		Id:      0,
-		Amount:  99 * KYVE,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
 	})
 
 	_, foundFunder0 := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 0)
@@ -35,7 +35,7 @@ func testIntegrationFunding(t *testing.T) {
 	fundPool1 := runTx(&types.MsgFundPool{
 		Creator: ALICE_ADDR,
 		Id:      1,
-		Amount:  0,
+		Amount:  sdk.ZeroInt(),
 	})
 
 	_, foundFunder1 := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 1)
@@ -53,7 +53,7 @@ func testCommissionChange(t *testing.T) {
 	stakePool := runTx(&types.MsgStakePool{
 		Creator: ALICE_ADDR,
 		Id:      0,
-		Amount:  10 * KYVE,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
 	})
 	require.True(t, stakePool)
 	s.Commit()
@@ -92,7 +92,7 @@ func testCommissionChange(t *testing.T) {
 	stakePool2 := runTx(&types.MsgStakePool{
 		Creator: BOB_ADDR,
 		Id:      0,
-		Amount:  10 * KYVE,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
 	})
 	require.True(t, stakePool2)
 	s.Commit()
@@ -177,8 +177,8 @@ func testStaking(t *testing.T) {
 	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
 	require.Len(t, pool.Stakers, 0)
 	require.Len(t, pool.InactiveStakers, 0)
-	require.Equal(t, uint64(0), pool.TotalStake)
-	require.Equal(t, uint64(0), pool.TotalInactiveStake)
+	require.Equal(t, uint64(0), pool.TotalStake.Uint64())
+	require.Equal(t, uint64(0), pool.TotalInactiveStake.Uint64())
 
 	s.CommitAfterSeconds(UploadTimeout + 10)
 	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
@@ -186,14 +186,14 @@ func testStaking(t *testing.T) {
 	stakePool := runTx(&types.MsgStakePool{
 		Creator: ALICE_ADDR,
 		Id:      0,
-		Amount:  10 * KYVE,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
 	})
 	require.True(t, stakePool)
 
 	stakePool2 := runTx(&types.MsgStakePool{
 		Creator: BOB_ADDR,
 		Id:      0,
-		Amount:  10 * KYVE,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
 	})
 	require.True(t, stakePool2)
 
@@ -247,7 +247,7 @@ func testStaking(t *testing.T) {
 		dummyStake := runTx(&types.MsgStakePool{
 			Creator: DUMMY_ACCOUNTS[i],
 			Id:      0,
-			Amount:  5 * KYVE,
+			Amount:  sdk.NewIntFromUint64(5 * KYVE),
 		})
 		require.True(t, dummyStake)
 	}
@@ -255,7 +255,7 @@ func testStaking(t *testing.T) {
 	failedStake := runTx(&types.MsgStakePool{
 		Creator: DUMMY_ACCOUNTS[48],
 		Id:      0,
-		Amount:  2 * KYVE,
+		Amount:  sdk.NewIntFromUint64(2 * KYVE),
 	})
 	require.False(t, failedStake)
 	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
@@ -268,14 +268,14 @@ func testStaking(t *testing.T) {
 	runTxSuccess(t, &types.MsgUnstakePool{
 		Creator: BOB_ADDR,
 		Id:      0,
-		Amount:  9_800_000_000,
+		Amount:  sdk.NewInt(9_800_000_000),
 	})
 
 	s.CommitAfterSeconds(10)
 
 	// bobs stake should still be 10
 	bobStaker, _ = s.app.RegistryKeeper.GetStaker(s.ctx, BOB_ADDR, 0)
-	require.Equal(t, 10*KYVE, bobStaker.Amount)
+	require.Equal(t, 10*KYVE, bobStaker.Amount.Uint64())
 
 	// User is currently NextUploader, after this long timeout he will get slashed
 	// Simultaneously the user is unstaking all of his tokens.
@@ -302,7 +302,7 @@ func testStaking(t *testing.T) {
 	runTxSuccess(t, &types.MsgStakePool{
 		Creator: DUMMY_ACCOUNTS[48],
 		Id:      0,
-		Amount:  1 * KYVE,
+		Amount:  sdk.NewIntFromUint64(1 * KYVE),
 	})
 
 	s.Commit()
@@ -320,7 +320,7 @@ func testStaking(t *testing.T) {
 	runTxSuccess(t, &types.MsgStakePool{
 		Creator: DUMMY_ACCOUNTS[49],
 		Id:      0,
-		Amount:  100 * KYVE,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
 	})
 
 	s.CommitAfterSeconds(1)
@@ -347,7 +347,7 @@ func testStaking(t *testing.T) {
 	runTxSuccess(t, &types.MsgStakePool{
 		Creator: DUMMY_ACCOUNTS[48],
 		Id:      0,
-		Amount:  100 * KYVE,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
 	})
 
 	// Reactivate Staker now
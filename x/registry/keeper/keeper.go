@@ -22,6 +22,7 @@ type (
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
 		upgradeKeeper types.UpgradeKeeper
+		mintKeeper    types.MintKeeper
 	}
 )
 
@@ -35,6 +36,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
 	upgradeKeeper types.UpgradeKeeper,
+	mintKeeper types.MintKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -51,6 +53,7 @@ func NewKeeper(
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
 		upgradeKeeper: upgradeKeeper,
+		mintKeeper:    mintKeeper,
 	}
 }
 
@@ -64,12 +67,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) IterateProtocolBonding(ctx sdk.Context, address sdk.AccAddress, fn func(poolId uint64, amount sdk.Int) (stop bool)) {
 	for _, pool := range k.GetAllPool(ctx) {
-		total := uint64(0)
+		total := sdk.ZeroInt()
 
 		//
 		staker, isStaker := k.GetStaker(ctx, address.String(), pool.Id)
 		if isStaker {
-			total += staker.Amount
+			total = total.Add(staker.Amount)
 		}
 
 		//
@@ -79,15 +82,21 @@ func (k Keeper) IterateProtocolBonding(ctx sdk.Context, address sdk.AccAddress,
 
 		for ; delegatorIterator.Valid(); delegatorIterator.Next() {
 			key := delegatorIterator.Key()
-			delegator, _ := k.GetDelegator(ctx, pool.Id, string(key[0:43]), address.String())
-
-			total += delegator.DelegationAmount
+			f1 := F1Distribution{
+				k:                k,
+				ctx:              ctx,
+				poolId:           pool.Id,
+				stakerAddress:    string(key[0:43]),
+				delegatorAddress: address.String(),
+			}
+
+			total = total.Add(f1.getCurrentDelegation())
 		}
 
 		delegatorIterator.Close()
 
 		//
-		stop := fn(pool.Id, sdk.NewIntFromUint64(total))
+		stop := fn(pool.Id, total)
 		if stop {
 			break
 		}
@@ -95,12 +104,11 @@ func (k Keeper) IterateProtocolBonding(ctx sdk.Context, address sdk.AccAddress,
 }
 
 func (k Keeper) TotalProtocolBonding(ctx sdk.Context) sdk.Int {
-	total := uint64(0)
+	total := sdk.ZeroInt()
 
 	for _, pool := range k.GetAllPool(ctx) {
-		total += pool.TotalStake
-		total += pool.TotalDelegation
+		total = total.Add(pool.TotalStake).Add(pool.TotalDelegation)
 	}
 
-	return sdk.NewIntFromUint64(total)
+	return total
 }
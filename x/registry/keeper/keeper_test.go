@@ -124,7 +124,7 @@ func createGenesis(t *testing.T) {
 		Logo:           "9FJDam56yBbmvn8rlamEucATH5UcYqSBw468rlCXn8E",
 		Config:         "{\"rpc\":\"https://rpc.api.moonbeam.network\",\"github\":\"https://github.com/KYVENetwork/evm\"}",
 		UploadInterval: 60,
-		OperatingCost:  100,
+		OperatingCost:  sdk.NewInt(100),
 		BundleProposal: &types.BundleProposal{},
 		MaxBundleSize:  100,
 		Protocol: &types.Protocol{
@@ -135,7 +135,7 @@ func createGenesis(t *testing.T) {
 		UpgradePlan: &types.UpgradePlan{},
 		StartKey:    "0",
 		Status:      types.POOL_STATUS_NOT_ENOUGH_VALIDATORS,
-		MinStake:    0,
+		MinStake:    sdk.ZeroInt(),
 	}
 
 	s.app.RegistryKeeper.AppendPool(s.ctx, pool)
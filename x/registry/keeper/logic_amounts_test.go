@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmountsBeyondUint64(t *testing.T) {
+	createGenesis(t)
+	testAmountsBeyondUint64(t)
+}
+
+func TestNonPositiveAmounts(t *testing.T) {
+	createGenesis(t)
+	testNonPositiveAmounts(t)
+}
+
+func execAuthz(grantee string, msg sdk.Msg) error {
+	granteeAddr, _ := sdk.AccAddressFromBech32(grantee)
+	msgExec := authz.NewMsgExec(granteeAddr, []sdk.Msg{msg})
+	cachedCtx, commit := s.ctx.CacheContext()
+	_, err := s.app.AuthzKeeper.Exec(sdk.WrapSDKContext(cachedCtx), &msgExec)
+	if err == nil {
+		commit()
+	}
+	return err
+}
+
+func testAmountsBeyondUint64(t *testing.T) {
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	// Delegations and rewards which add up to far more than the maximum uint64
+	// are tracked without overflowing.
+	amount := sdk.NewIntFromUint64(math.MaxUint64)
+	total := sdk.ZeroInt()
+
+	for i, delegator := range DUMMY_ACCOUNTS[:10] {
+		delegation := amount.MulRaw(int64(i + 1))
+		require.NoError(t, s.app.RegistryKeeper.Delegate(s.ctx, BOB_ADDR, 0, delegator, delegation))
+		total = total.Add(delegation)
+	}
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, total.Equal(pool.TotalDelegation))
+
+	delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.True(t, total.Equal(delegationPoolData.TotalDelegation))
+
+	// Rewards are distributed proportionally to the delegation.
+	delegationPoolData.CurrentRewards = delegationPoolData.CurrentRewards.Add(total)
+	s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+
+	for i, delegator := range DUMMY_ACCOUNTS[:10] {
+		pending, err := s.app.RegistryKeeper.AccountPendingRewards(sdk.WrapSDKContext(s.ctx), &types.QueryAccountPendingRewardsRequest{
+			Address: delegator,
+		})
+		require.NoError(t, err)
+		require.True(t, amount.MulRaw(int64(i+1)).Equal(pending.Total))
+	}
+
+	// The upload weight of the staker accounts for the whole delegation.
+	require.True(t, s.app.RegistryKeeper.GetUploadProbability(s.ctx, BOB_ADDR, 0).Equal(sdk.OneDec()))
+
+	// The module does not hold the injected rewards, so they are removed before undelegating.
+	delegationPoolData.CurrentRewards = sdk.ZeroInt()
+	s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+
+	// Undelegating everything leaves no remainder.
+	for i, delegator := range DUMMY_ACCOUNTS[:10] {
+		require.NoError(t, s.app.RegistryKeeper.Undelegate(s.ctx, BOB_ADDR, 0, delegator, amount.MulRaw(int64(i+1))))
+	}
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, pool.TotalDelegation.IsZero())
+}
+
+func testNonPositiveAmounts(t *testing.T) {
+	granter := DUMMY_ACCOUNTS[0]
+	grantee := DUMMY_ACCOUNTS[1]
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: granter,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: granter,
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: granter,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	})
+
+	msgs := func(amount sdk.Int) []sdk.Msg {
+		return []sdk.Msg{
+			&types.MsgStakePool{Creator: granter, Id: 0, Amount: amount},
+			&types.MsgUnstakePool{Creator: granter, Id: 0, Amount: amount},
+			&types.MsgDelegatePool{Creator: granter, Id: 0, Staker: BOB_ADDR, Amount: amount},
+			&types.MsgUndelegatePool{Creator: granter, Id: 0, Staker: BOB_ADDR, Amount: amount},
+			&types.MsgRedelegatePool{Creator: granter, FromPoolId: 0, FromStaker: BOB_ADDR, ToPoolId: 0, ToStaker: ALICE_ADDR, Amount: amount},
+			&types.MsgFundPool{Creator: granter, Id: 0, Amount: amount},
+			&types.MsgDefundPool{Creator: granter, Id: 0, Amount: amount},
+			&types.MsgCreateFundingStream{Creator: granter, Id: 0, Amount: amount, AmountPerBundle: sdk.ZeroInt(), AmountPerDay: sdk.ZeroInt()},
+		}
+	}
+
+	granterAddr, _ := sdk.AccAddressFromBech32(granter)
+	granteeAddr, _ := sdk.AccAddressFromBech32(grantee)
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+
+	for _, msg := range msgs(sdk.ZeroInt()) {
+		authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(msg))
+		require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, authorization, &expiration))
+	}
+
+	balance := getBalance(granter)
+
+	// Messages executed through x/authz skip ValidateBasic, the keeper rejects them anyway.
+	for _, amount := range []sdk.Int{sdk.NewInt(-1), sdk.ZeroInt()} {
+		for _, msg := range msgs(amount) {
+			require.Error(t, msg.ValidateBasic())
+			require.Error(t, execAuthz(grantee, msg))
+		}
+	}
+
+	// Negative limits of a funding stream are rejected as well.
+	require.Error(t, execAuthz(grantee, &types.MsgCreateFundingStream{
+		Creator:         granter,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.NewInt(-1),
+		AmountPerDay:    sdk.ZeroInt(),
+	}))
+
+	require.Equal(t, balance, getBalance(granter))
+
+	staker, _ := s.app.RegistryKeeper.GetStaker(s.ctx, granter, 0)
+	require.True(t, sdk.NewIntFromUint64(10*KYVE).Equal(staker.Amount))
+
+	delegator, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, granter)
+	require.True(t, sdk.NewIntFromUint64(10*KYVE).Equal(delegator.DelegationAmount))
+
+	funder, _ := s.app.RegistryKeeper.GetFunder(s.ctx, granter, 0)
+	require.True(t, sdk.NewIntFromUint64(10*KYVE).Equal(funder.Amount))
+
+	require.Empty(t, s.app.RegistryKeeper.GetAllUnbondingStakingQueueEntries(s.ctx))
+	require.Empty(t, s.app.RegistryKeeper.GetAllUnbondingDelegationQueueEntries(s.ctx))
+	require.Empty(t, s.app.RegistryKeeper.GetRedelegationCooldownEntries(s.ctx, granter))
+	require.Empty(t, getFundingStreams(t, 0))
+
+	// A negative amount does not raise the limit of a delegation authorization.
+	authorization := types.NewDelegationAuthorization(sdk.MsgTypeURL(&types.MsgUndelegatePool{}), nil, nil, sdk.NewIntFromUint64(KYVE))
+	_, err := authorization.Accept(s.ctx, &types.MsgUndelegatePool{Creator: granter, Id: 0, Staker: BOB_ADDR, Amount: sdk.NewInt(-1)})
+	require.Error(t, err)
+
+	// Keeper entry points reject non-positive amounts directly.
+	require.Error(t, s.app.RegistryKeeper.Delegate(s.ctx, BOB_ADDR, 0, granter, sdk.NewInt(-1)))
+	require.Error(t, s.app.RegistryKeeper.Undelegate(s.ctx, BOB_ADDR, 0, granter, sdk.NewInt(-1)))
+	require.Error(t, s.app.RegistryKeeper.StartUnbondingStaker(s.ctx, 0, granter, sdk.NewInt(-1)))
+	require.Error(t, s.app.RegistryKeeper.StartUnbondingDelegator(s.ctx, 0, BOB_ADDR, granter, sdk.ZeroInt()))
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDelegationAuthorization(t *testing.T) {
+	createGenesis(t)
+	testDelegationAuthorization(t)
+}
+
+func dispatchAuthz(grantee string, msg sdk.Msg) error {
+	granteeAddr, _ := sdk.AccAddressFromBech32(grantee)
+	cachedCtx, commit := s.ctx.CacheContext()
+	_, err := s.app.AuthzKeeper.DispatchActions(cachedCtx, granteeAddr, []sdk.Msg{msg})
+	if err == nil {
+		commit()
+	}
+	return err
+}
+
+func testDelegationAuthorization(t *testing.T) {
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	granter, _ := sdk.AccAddressFromBech32(DUMMY_ACCOUNTS[0])
+	grantee, _ := sdk.AccAddressFromBech32(DUMMY_ACCOUNTS[1])
+
+	authorization := types.NewDelegationAuthorization(
+		sdk.MsgTypeURL(&types.MsgDelegatePool{}),
+		[]uint64{0},
+		[]string{BOB_ADDR},
+		sdk.NewIntFromUint64(50*KYVE),
+	)
+	require.NoError(t, authorization.ValidateBasic())
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, s.app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, authorization, &expiration))
+
+	// Staker is not allowed by the grant
+	require.Error(t, dispatchAuthz(DUMMY_ACCOUNTS[1], &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  ALICE_ADDR,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	}))
+
+	// Amount exceeds the limit of the grant
+	require.Error(t, dispatchAuthz(DUMMY_ACCOUNTS[1], &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(60 * KYVE),
+	}))
+
+	require.NoError(t, dispatchAuthz(DUMMY_ACCOUNTS[1], &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(30 * KYVE),
+	}))
+
+	delegator, found := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	require.True(t, found)
+	require.Equal(t, 30*KYVE, delegator.DelegationAmount.Uint64())
+
+	// Remaining limit is used up and the grant gets deleted
+	require.NoError(t, dispatchAuthz(DUMMY_ACCOUNTS[1], &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(20 * KYVE),
+	}))
+
+	require.Error(t, dispatchAuthz(DUMMY_ACCOUNTS[1], &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(1 * KYVE),
+	}))
+
+	// Protocol messages can not be granted with a delegation authorization
+	require.Error(t, types.NewDelegationAuthorization(sdk.MsgTypeURL(&types.MsgVoteProposal{}), nil, nil, sdk.ZeroInt()).ValidateBasic())
+}
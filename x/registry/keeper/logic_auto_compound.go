@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessAutoCompounding compounds the outstanding rewards of delegators who opted in
+// back into their delegation. To keep the gas usage of the end block bounded, at most
+// MaxAutoCompoundsPerBlock delegations are processed per block. A cursor remembers
+// where the sweep stopped, so that the next block continues with the following entries.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	maxCompounds := k.MaxAutoCompoundsPerBlock(ctx)
+	if maxCompounds == 0 {
+		return
+	}
+
+	// Start right after the last processed entry
+	var start []byte
+	if cursor := k.GetAutoCompoundCursor(ctx); len(cursor) > 0 {
+		start = append(append([]byte{}, cursor...), 0)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundKeyPrefix)
+	iterator := store.Iterator(start, nil)
+
+	var entries []types.AutoCompound
+	var lastKey []byte
+
+	for ; iterator.Valid() && uint64(len(entries)) < maxCompounds; iterator.Next() {
+		var val types.AutoCompound
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		entries = append(entries, val)
+		lastKey = append([]byte{}, iterator.Key()...)
+	}
+
+	// Reset the cursor once the end of the store is reached
+	if !iterator.Valid() {
+		lastKey = nil
+	}
+	iterator.Close()
+
+	for _, entry := range entries {
+		k.compoundDelegation(ctx, entry)
+	}
+
+	k.SetAutoCompoundCursor(ctx, lastKey)
+}
+
+// compoundDelegation withdraws the rewards of a single delegation and adds them to
+// the delegated amount, as far as the delegation caps allow. Flags of delegations
+// which no longer exist are removed.
+func (k Keeper) compoundDelegation(ctx sdk.Context, entry types.AutoCompound) {
+	pool, poolFound := k.GetPool(ctx, entry.PoolId)
+	_, delegatorFound := k.GetDelegator(ctx, entry.PoolId, entry.Staker, entry.Delegator)
+
+	if !poolFound || !delegatorFound {
+		k.RemoveAutoCompound(ctx, entry.PoolId, entry.Staker, entry.Delegator)
+		return
+	}
+
+	f1Distribution := F1Distribution{
+		k:                k,
+		ctx:              ctx,
+		poolId:           entry.PoolId,
+		stakerAddress:    entry.Staker,
+		delegatorAddress: entry.Delegator,
+	}
+
+	// The rewards already reside in the module, so they only need to be re-delegated.
+	reward := f1Distribution.Withdraw()
+	if reward.IsZero() {
+		return
+	}
+
+	// Compounding must not exceed the delegation caps, the excess is withdrawn instead.
+	compound := reward
+	if remaining, unlimited := k.GetDelegationCapacity(ctx, &pool, entry.Staker); !unlimited && compound.GT(remaining) {
+		compound = remaining
+	}
+
+	if excess := reward.Sub(compound); excess.IsPositive() {
+		if err := k.transferRewardToAddress(ctx, entry.Delegator, excess); err != nil {
+			k.PanicHalt(ctx, "Not enough money in module: "+err.Error())
+		}
+
+		ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewards{
+			PoolId:  entry.PoolId,
+			Address: entry.Delegator,
+			Node:    entry.Staker,
+			Amount:  excess,
+		})
+	}
+
+	if compound.IsZero() {
+		return
+	}
+
+	delegationAmount := f1Distribution.Undelegate()
+	f1Distribution.Delegate(delegationAmount.Add(compound))
+
+	pool.TotalDelegation = pool.TotalDelegation.Add(compound)
+	k.SetPool(ctx, pool)
+
+	ctx.EventManager().EmitTypedEvent(&types.EventAutoCompound{
+		PoolId:  entry.PoolId,
+		Address: entry.Delegator,
+		Node:    entry.Staker,
+		Amount:  compound,
+	})
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAutoCompound(t *testing.T) {
+	createGenesis(t)
+	testAutoCompound(t)
+}
+
+func testAutoCompound(t *testing.T) {
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	for i := 0; i < 3; i++ {
+		runTxSuccess(t, &types.MsgDelegatePool{
+			Creator: DUMMY_ACCOUNTS[i],
+			Id:      0,
+			Staker:  BOB_ADDR,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	// Only delegators can opt in
+	require.False(t, runTx(&types.MsgSetAutoCompound{
+		Creator: DUMMY_ACCOUNTS[3],
+		PoolId:  0,
+		Staker:  BOB_ADDR,
+		Enabled: true,
+	}))
+
+	for i := 0; i < 2; i++ {
+		runTxSuccess(t, &types.MsgSetAutoCompound{
+			Creator: DUMMY_ACCOUNTS[i],
+			PoolId:  0,
+			Staker:  BOB_ADDR,
+			Enabled: true,
+		})
+	}
+	require.Len(t, s.app.RegistryKeeper.GetAllAutoCompounds(s.ctx), 2)
+
+	// At least one delegation has to be compounded per block
+	params := types.DefaultParams()
+	params.MaxAutoCompoundsPerBlock = 0
+	require.Error(t, params.Validate())
+
+	// Only one delegation is compounded per block
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxAutoCompoundsPerBlock, uint64(1))
+
+	// Distribute rewards to all delegators
+	delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	delegationPoolData.CurrentRewards = delegationPoolData.CurrentRewards.Add(sdk.NewIntFromUint64(30 * KYVE))
+	s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+	s.Commit()
+
+	first, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	second, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[1])
+	require.Equal(t, 110*KYVE, first.DelegationAmount.Uint64())
+	require.Equal(t, 100*KYVE, second.DelegationAmount.Uint64())
+
+	// The sweep position is part of the genesis
+	cursor := s.app.RegistryKeeper.GetAutoCompoundCursor(s.ctx)
+	require.NotEmpty(t, cursor)
+
+	genesis := registry.ExportGenesis(s.ctx, s.app.RegistryKeeper)
+	require.Equal(t, cursor, genesis.AutoCompoundCursor)
+
+	s.app.RegistryKeeper.SetAutoCompoundCursor(s.ctx, nil)
+	registry.InitGenesis(s.ctx, s.app.RegistryKeeper, *genesis)
+	require.Equal(t, cursor, s.app.RegistryKeeper.GetAutoCompoundCursor(s.ctx))
+
+	s.Commit()
+
+	second, _ = s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[1])
+	require.Equal(t, 110*KYVE, second.DelegationAmount.Uint64())
+
+	// Delegators without the flag keep their rewards outstanding
+	third, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[2])
+	require.Equal(t, 100*KYVE, third.DelegationAmount.Uint64())
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, 320*KYVE, pool.TotalDelegation.Uint64())
+
+	delegationPoolData, _ = s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.Equal(t, 320*KYVE, delegationPoolData.TotalDelegation.Uint64())
+
+	// Opting out removes the flag
+	runTxSuccess(t, &types.MsgSetAutoCompound{
+		Creator: DUMMY_ACCOUNTS[0],
+		PoolId:  0,
+		Staker:  BOB_ADDR,
+		Enabled: false,
+	})
+	require.Len(t, s.app.RegistryKeeper.GetAllAutoCompounds(s.ctx), 1)
+}
+
+func TestAutoCompoundDelegationCap(t *testing.T) {
+	createGenesis(t)
+	testAutoCompoundDelegationCap(t)
+}
+
+func testAutoCompoundDelegationCap(t *testing.T) {
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(195 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgSetAutoCompound{
+		Creator: DUMMY_ACCOUNTS[0],
+		PoolId:  0,
+		Staker:  BOB_ADDR,
+		Enabled: true,
+	})
+
+	// Only 5 more KYVE can be delegated to Bob
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationSelfStakeMultiple, uint64(3))
+
+	// The first delegator earns 10 KYVE
+	delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	delegationPoolData.CurrentRewards = delegationPoolData.CurrentRewards.Add(sdk.NewIntFromUint64(29_500_000_000))
+	s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, sdk.MustAccAddressFromBech32(DUMMY_ACCOUNTS[0]), "tkyve")
+	s.Commit()
+
+	// Compounding stops at the cap, the excess is withdrawn
+	delegator, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	require.Equal(t, 105*KYVE, delegator.DelegationAmount.Uint64())
+
+	balanceAfter := s.app.BankKeeper.GetBalance(s.ctx, sdk.MustAccAddressFromBech32(DUMMY_ACCOUNTS[0]), "tkyve")
+	require.Equal(t, 5*KYVE, balanceAfter.Amount.Sub(balanceBefore.Amount).Uint64())
+
+	delegationPoolData, _ = s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.Equal(t, 300*KYVE, delegationPoolData.TotalDelegation.Uint64())
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, 300*KYVE, pool.TotalDelegation.Uint64())
+}
@@ -3,30 +3,109 @@ package keeper
 import (
 	"github.com/KYVENetwork/chain/x/registry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 // TransferToAddress sends tokens from this module to a specified address.
-func (k Keeper) TransferToAddress(ctx sdk.Context, address string, amount uint64) error {
+func (k Keeper) TransferToAddress(ctx sdk.Context, address string, amount sdk.Int) error {
 	recipient, _ := sdk.AccAddressFromBech32(address)
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
 
 	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
 	return err
 }
 
+// transferRewardToAddress sends rewards from this module to the withdraw address of the
+// specified address. Unbonded stake and delegation are redirected with transferUnbondingToAddress,
+// defunded and refunded funds always go back to the owner.
+func (k Keeper) transferRewardToAddress(ctx sdk.Context, address string, amount sdk.Int) error {
+	return k.TransferToAddress(ctx, k.GetWithdrawAddress(ctx, address), amount)
+}
+
+// transferUnbondingToAddress returns unbonded stake or delegation, which was sent with transferToRegistry,
+// to the withdraw address of the specified address. Vesting accounts always receive the tokens themselves,
+// as they can still be locked and must not be moved to another account.
+func (k Keeper) transferUnbondingToAddress(ctx sdk.Context, address string, amount sdk.Int) error {
+	withdrawAddress := k.GetWithdrawAddress(ctx, address)
+	if withdrawAddress == address || k.isVestingAccount(ctx, address) {
+		return k.undelegateToAddress(ctx, address, amount)
+	}
+
+	return k.TransferToAddress(ctx, withdrawAddress, amount)
+}
+
 // transferToRegistry sends tokens from a specified address to this module.
-func (k Keeper) transferToRegistry(ctx sdk.Context, address string, amount uint64) error {
+// The tokens are delegated like in x/staking, so vesting accounts are able to
+// stake, delegate and fund with their locked tokens.
+func (k Keeper) transferToRegistry(ctx sdk.Context, address string, amount sdk.Int) error {
 	sender, _ := sdk.AccAddressFromBech32(address)
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
 
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins)
+	err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins)
 	return err
 }
 
+// undelegateToAddress returns tokens which were sent with transferToRegistry
+// from this module back to a specified address. For vesting accounts the
+// delegated free and delegated vesting amounts are reduced accordingly.
+func (k Keeper) undelegateToAddress(ctx sdk.Context, address string, amount sdk.Int) error {
+	recipient, _ := sdk.AccAddressFromBech32(address)
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
+
+	err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	return err
+}
+
+// trackUndelegation updates the delegation bookkeeping of a vesting account for
+// tokens which were sent with transferToRegistry but will never be returned,
+// e.g. because they got slashed or were paid out as bundle rewards.
+func (k Keeper) trackUndelegation(ctx sdk.Context, address string, amount sdk.Int) {
+	addr, _ := sdk.AccAddressFromBech32(address)
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if vestingAcc, ok := acc.(vestingexported.VestingAccount); ok && !coins.IsZero() {
+		vestingAcc.TrackUndelegation(coins)
+		k.accountKeeper.SetAccount(ctx, vestingAcc)
+	}
+}
+
+// canReleaseDelegation returns true if the given amount can stop being tracked as delegated by the
+// address without unlocking vesting tokens. Undelegating reduces the delegated free tokens first,
+// the delegated vesting tokens which are released on top have to be covered by the spendable balance,
+// so that they stay locked in the account.
+func (k Keeper) canReleaseDelegation(ctx sdk.Context, address string, amount sdk.Int) bool {
+	addr, _ := sdk.AccAddressFromBech32(address)
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return true
+	}
+
+	released := sdk.MinInt(
+		amount.Sub(vestingAcc.GetDelegatedFree().AmountOf("tkyve")),
+		vestingAcc.GetDelegatedVesting().AmountOf("tkyve"),
+	)
+	if !released.IsPositive() {
+		return true
+	}
+
+	return released.LTE(k.bankKeeper.SpendableCoins(ctx, addr).AmountOf("tkyve"))
+}
+
+// isVestingAccount returns true if the address is a vesting account.
+func (k Keeper) isVestingAccount(ctx sdk.Context, address string) bool {
+	addr, _ := sdk.AccAddressFromBech32(address)
+
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount)
+	return ok
+}
+
 // transferToTreasury sends tokens from this module to the treasury (community spend pool).
-func (k Keeper) transferToTreasury(ctx sdk.Context, amount uint64) error {
+func (k Keeper) transferToTreasury(ctx sdk.Context, amount sdk.Int) error {
 	sender := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	coins := sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount)))
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
 
 	err := k.distrKeeper.FundCommunityPool(ctx, coins, sender)
 	return err
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// isPoolEndReached checks if the last finalized bundle of a given pool reached the end key or end height of the pool.
+// Pools without an end key and end height run forever.
+func isPoolEndReached(pool *types.Pool) bool {
+	if pool.EndHeight > 0 && pool.CurrentHeight >= pool.EndHeight {
+		return true
+	}
+
+	return pool.EndKey != "" && pool.CurrentKey != "" && compareKeys(pool.CurrentKey, pool.EndKey) >= 0
+}
+
+// compareKeys compares two keys of a pool. Keys which are both integers are compared numerically,
+// all other keys are compared lexicographically.
+func compareKeys(a string, b string) int {
+	x, okX := new(big.Int).SetString(a, 10)
+	y, okY := new(big.Int).SetString(b, 10)
+
+	if okX && okY {
+		return x.Cmp(y)
+	}
+
+	return strings.Compare(a, b)
+}
+
+// completePool is an internal function that transitions a pool which reached its end into the completed status.
+// All open bundle proposals are dropped, no new uploader is selected and all funders are refunded.
+func (k Keeper) completePool(ctx sdk.Context, pool *types.Pool) error {
+	// Drop all bundle proposals which chained after the last finalized bundle.
+	if err := k.dropPipelinedProposals(ctx, pool); err != nil {
+		return err
+	}
+
+	pool.Status = types.POOL_STATUS_COMPLETED
+	pool.BundleProposal = &types.BundleProposal{
+		CreatedAt: uint64(ctx.BlockTime().Unix()),
+	}
+
+	if err := k.refundFunders(ctx, pool); err != nil {
+		return err
+	}
+
+	k.RemoveProtocolFunding(ctx, pool.Id)
+
+	return k.refundFundingStreams(ctx, pool.Id)
+}
+
+// refundFunders is an internal function that removes all funders of a given pool and refunds their remaining balances.
+func (k Keeper) refundFunders(ctx sdk.Context, pool *types.Pool) error {
+	for _, account := range append([]string{}, pool.Funders...) {
+		funder, found := k.GetFunder(ctx, account, pool.Id)
+		if !found {
+			continue
+		}
+
+		k.removeFunder(ctx, pool, &funder)
+
+		if err := k.refundFunder(ctx, &funder); err != nil {
+			return err
+		}
+
+		errEmit := ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+			PoolId:  pool.Id,
+			Address: funder.Account,
+			Amount:  funder.Amount,
+		})
+		if errEmit != nil {
+			return errEmit
+		}
+	}
+
+	k.updateLowestFunder(ctx, pool)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundedPool(t *testing.T) {
+	createGenesis(t)
+	testBoundedPool(t)
+}
+
+func TestBoundedPoolEndKey(t *testing.T) {
+	createGenesis(t)
+	testBoundedPoolEndKey(t)
+}
+
+func testBoundedPool(t *testing.T) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.EndHeight = 20
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	// Bundles can not go beyond the end height
+	require.False(t, submitBundle(BOB_ADDR, "b", 10, 25, "a_key"))
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_YES)
+
+	setNextUploader(ALICE_ADDR)
+	s.CommitAfterSeconds(60)
+
+	funder, _ := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 0)
+	aliceBalance := getBalance(ALICE_ADDR)
+
+	// Only an empty bundle can follow the last bundle of the pool
+	runTxSuccess(t, &types.MsgSubmitBundleProposal{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		StorageId:  types.KYVE_NO_DATA_BUNDLE,
+		FromHeight: 20,
+		ToHeight:   20,
+		FromKey:    "b_key",
+	})
+
+	// The pool is completed once the end height is finalized
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_COMPLETED, pool.Status)
+	require.Equal(t, uint64(20), pool.CurrentHeight)
+	require.Equal(t, "", pool.BundleProposal.StorageId)
+
+	// The remaining funds are refunded
+	bundleReward := 100 + 100*s.app.RegistryKeeper.StorageCost(s.ctx)
+	require.Empty(t, pool.Funders)
+	require.True(t, pool.TotalFunds.IsZero())
+	require.Equal(t, aliceBalance+funder.Amount.Uint64()-bundleReward, getBalance(ALICE_ADDR))
+
+	// No uploader is selected anymore
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(60)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_COMPLETED, pool.Status)
+	require.Equal(t, "", pool.BundleProposal.NextUploader)
+
+	require.False(t, runTx(&types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(KYVE),
+	}))
+
+	// Stakers can withdraw without unbonding time
+	bobBalance := getBalance(BOB_ADDR)
+
+	runTxSuccess(t, &types.MsgUnstakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	require.Equal(t, bobBalance+100*KYVE, getBalance(BOB_ADDR))
+
+	_, found := s.app.RegistryKeeper.GetStaker(s.ctx, BOB_ADDR, 0)
+	require.False(t, found)
+}
+
+func testBoundedPoolEndKey(t *testing.T) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.EndKey = "b_key"
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	// Bundles can not go beyond the end key
+	require.False(t, submitBundle(ALICE_ADDR, "c", 0, 10, ""))
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.False(t, submitBundle(BOB_ADDR, "c", 10, 20, "a_key"))
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_YES)
+
+	setNextUploader(ALICE_ADDR)
+	s.CommitAfterSeconds(60)
+
+	runTxSuccess(t, &types.MsgSubmitBundleProposal{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		StorageId:  types.KYVE_NO_DATA_BUNDLE,
+		FromHeight: 20,
+		ToHeight:   20,
+		FromKey:    "b_key",
+	})
+
+	// The pool is completed once the end key is finalized
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_COMPLETED, pool.Status)
+	require.Equal(t, "b_key", pool.CurrentKey)
+}
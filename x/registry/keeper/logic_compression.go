@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validateCompression checks if bundles of the given pool are allowed to use the given compression.
+func validateCompression(pool *types.Pool, compression string) error {
+	if compression == types.CompressionNone {
+		return nil
+	}
+
+	for _, allowedCompression := range pool.AllowedCompressions {
+		if allowedCompression == compression {
+			return nil
+		}
+	}
+
+	return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrCompressionNotAllowed.Error(), compression, pool.Id)
+}
+
+// getChargedByteSize returns the amount of bytes the storage cost of a bundle is charged for,
+// depending on the policy of the pool. Bundles without an uncompressed size are charged by their byte size.
+func getChargedByteSize(pool *types.Pool, byteSize uint64, uncompressedByteSize uint64) uint64 {
+	if pool.ChargeUncompressedSize && uncompressedByteSize > 0 {
+		return uncompressedByteSize
+	}
+
+	return byteSize
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleCompression(t *testing.T) {
+	createGenesis(t)
+	testBundleCompression(t)
+}
+
+func submitCompressedBundle(creator string, storageId string, compression string, uncompressedByteSize uint64) bool {
+	return runTx(&types.MsgSubmitBundleProposal{
+		Creator:              creator,
+		Id:                   0,
+		StorageId:            storageId,
+		ByteSize:             100,
+		FromHeight:           0,
+		ToHeight:             10,
+		FromKey:              "",
+		ToKey:                storageId + "_key",
+		ToValue:              storageId + "_value",
+		BundleHash:           storageId + "_hash",
+		Compression:          compression,
+		DataItemCount:        10,
+		UncompressedByteSize: uncompressedByteSize,
+	})
+}
+
+func testBundleCompression(t *testing.T) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.AllowedCompressions = []string{"gzip"}
+	pool.ChargeUncompressedSize = true
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	// The compression has to be allowed by the pool
+	require.False(t, submitCompressedBundle(ALICE_ADDR, "a", "zstd", 500))
+
+	// Compressed bundles have to specify their uncompressed size
+	require.False(t, submitCompressedBundle(ALICE_ADDR, "a", "gzip", 0))
+
+	// Uncompressed bundles can not have a different uncompressed size
+	require.False(t, submitCompressedBundle(ALICE_ADDR, "a", "", 500))
+
+	require.True(t, submitCompressedBundle(ALICE_ADDR, "a", "gzip", 500))
+
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// The bundle is charged by its uncompressed size
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	expectedReward := 100 + 500*s.app.RegistryKeeper.StorageCost(s.ctx)
+	require.Equal(t, expectedReward, pool.TotalBundleRewards.Uint64())
+	require.Equal(t, uint64(100), pool.TotalBytes)
+
+	proposal, _ := s.app.RegistryKeeper.GetProposal(s.ctx, "a")
+	require.Equal(t, "gzip", proposal.Compression)
+	require.Equal(t, uint64(10), proposal.DataItemCount)
+	require.Equal(t, uint64(500), proposal.UncompressedByteSize)
+
+	// Uncompressed bundles are recorded as such
+	require.Equal(t, types.CompressionNone, pool.BundleProposal.Compression)
+	require.Equal(t, uint64(100), pool.BundleProposal.UncompressedByteSize)
+}
@@ -8,7 +8,12 @@ import (
 
 // Delegate performs a safe delegation with all necessary checks
 // Warning: does not transfer the amount (only the rewards)
-func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, delegatorAddress string, amount uint64) error {
+func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, delegatorAddress string, amount sdk.Int) error {
+
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(amount); err != nil {
+		return err
+	}
 
 	pool, found := k.GetPool(ctx, poolId)
 
@@ -17,6 +22,11 @@ func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, d
 		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
 	}
 
+	// Error if the pool is retired.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
 	// Check if the sender is delegating to themselves.
 	if delegatorAddress == stakerAddress {
 		return sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrSelfDelegation.Error())
@@ -37,14 +47,14 @@ func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, d
 	if delegatorExists {
 		// If the sender is already a delegator, first perform an undelegation, before then delegating.
 		reward := f1Distribution.Withdraw()
-		err := k.TransferToAddress(ctx, delegatorAddress, reward)
+		err := k.transferRewardToAddress(ctx, delegatorAddress, reward)
 		if err != nil {
 			return err
 		}
 
 		// Perform redelegation
 		unDelegateAmount := f1Distribution.Undelegate()
-		f1Distribution.Delegate(unDelegateAmount + amount)
+		f1Distribution.Delegate(unDelegateAmount.Add(amount))
 
 	} else {
 		// If the sender isn't already a delegator, simply create a new delegation entry.
@@ -52,7 +62,7 @@ func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, d
 	}
 
 	// Update and return.
-	pool.TotalDelegation += amount
+	pool.TotalDelegation = pool.TotalDelegation.Add(amount)
 	k.SetPool(ctx, pool)
 
 	return nil
@@ -60,7 +70,12 @@ func (k Keeper) Delegate(ctx sdk.Context, stakerAddress string, poolId uint64, d
 
 // Undelegate performs a safe undelegation
 // Warning: It does not create an unbonding entry; it does not transfer the delegation back (only the rewards)
-func (k Keeper) Undelegate(ctx sdk.Context, stakerAddress string, poolId uint64, delegatorAddress string, amount uint64) error {
+func (k Keeper) Undelegate(ctx sdk.Context, stakerAddress string, poolId uint64, delegatorAddress string, amount sdk.Int) error {
+
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(amount); err != nil {
+		return err
+	}
 
 	pool, poolFound := k.GetPool(ctx, poolId)
 	if !poolFound {
@@ -68,16 +83,11 @@ func (k Keeper) Undelegate(ctx sdk.Context, stakerAddress string, poolId uint64,
 	}
 
 	// Check if the sender is already a delegator.
-	delegator, delegatorExists := k.GetDelegator(ctx, poolId, stakerAddress, delegatorAddress)
+	_, delegatorExists := k.GetDelegator(ctx, poolId, stakerAddress, delegatorAddress)
 	if !delegatorExists {
 		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrNotADelegator.Error(), poolId)
 	}
 
-	// Check if the sender is trying to undelegate more than they have delegated.
-	if amount > delegator.DelegationAmount {
-		return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, types.ErrNotEnoughDelegation.Error(), amount)
-	}
-
 	// Create a new F1Distribution struct for interacting with delegations.
 	f1Distribution := F1Distribution{
 		k:                k,
@@ -87,23 +97,152 @@ func (k Keeper) Undelegate(ctx sdk.Context, stakerAddress string, poolId uint64,
 		delegatorAddress: delegatorAddress,
 	}
 
+	// Check if the sender is trying to undelegate more than they have delegated.
+	if amount.GT(f1Distribution.getCurrentDelegation()) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, types.ErrNotEnoughDelegation.Error(), amount)
+	}
+
 	// Withdraw all rewards for the sender.
 	reward := f1Distribution.Withdraw()
 
-	// Transfer tokens from this module to sender.
-	err := k.TransferToAddress(ctx, delegatorAddress, reward)
+	// Transfer tokens from this module to the withdraw address of the sender.
+	err := k.transferRewardToAddress(ctx, delegatorAddress, reward)
 	if err != nil {
 		return err
 	}
 
 	// Perform an internal re-delegation.
 	undelegatedAmount := f1Distribution.Undelegate()
-	redelegation := undelegatedAmount - amount
+	redelegation := undelegatedAmount.Sub(amount)
 	f1Distribution.Delegate(redelegation)
 
 	// Update and return.
-	pool.TotalDelegation -= amount
+	pool.TotalDelegation = pool.TotalDelegation.Sub(amount)
 	k.SetPool(ctx, pool)
 
 	return nil
 }
+
+// slashDelegation slashes the given fraction of the delegation of a staker.
+// Besides the active delegation, all unbonding and redelegation entries which were created after
+// the infraction are slashed as well, so that delegators can not escape a slash by leaving early.
+// Warning: does not transfer the slashed amount (to the treasury)
+func (k Keeper) slashDelegation(ctx sdk.Context, pool *types.Pool, stakerAddress string, fraction sdk.Dec) (slash sdk.Int) {
+	// The infraction happened within the current bundle round.
+	infractionTime := uint64(ctx.BlockTime().Unix())
+	if pool.BundleProposal != nil {
+		infractionTime = pool.BundleProposal.CreatedAt
+	}
+
+	// Slash the active delegation with the F1Distribution.
+	f1Distribution := F1Distribution{
+		k:             k,
+		ctx:           ctx,
+		poolId:        pool.Id,
+		stakerAddress: stakerAddress,
+	}
+
+	amount := f1Distribution.Slash(fraction)
+	if amount.GT(pool.TotalDelegation) {
+		amount = pool.TotalDelegation
+	}
+	pool.TotalDelegation = pool.TotalDelegation.Sub(amount)
+
+	// Slash all unbonding entries which were created after the infraction.
+	unbondingAmount := sdk.ZeroInt()
+	for _, entry := range k.GetUnbondingDelegationQueueEntriesOfStaker(ctx, pool.Id, stakerAddress) {
+		if entry.CreationTime < infractionTime {
+			continue
+		}
+
+		cut := sdk.NewDecFromInt(entry.Amount).Mul(fraction).TruncateInt()
+		entry.Amount = entry.Amount.Sub(cut)
+		k.SetUnbondingDelegationQueueEntry(ctx, entry)
+
+		// The slashed amount is never returned to the delegator.
+		k.trackUndelegation(ctx, entry.Delegator, cut)
+
+		unbondingAmount = unbondingAmount.Add(cut)
+	}
+
+	// Slash all redelegations which were created after the infraction.
+	redelegationAmount := sdk.ZeroInt()
+	for _, entry := range k.GetRedelegationQueueEntriesOfStaker(ctx, pool.Id, stakerAddress) {
+		if entry.CreationTime < infractionTime {
+			continue
+		}
+
+		redelegationAmount = redelegationAmount.Add(k.slashRedelegation(ctx, pool, entry, fraction))
+	}
+
+	slash = amount.Add(unbondingAmount).Add(redelegationAmount)
+
+	if slash.IsPositive() {
+		ctx.EventManager().EmitTypedEvent(&types.EventSlashDelegation{
+			PoolId:             pool.Id,
+			Staker:             stakerAddress,
+			Fraction:           fraction.String(),
+			Amount:             amount,
+			UnbondingAmount:    unbondingAmount,
+			RedelegationAmount: redelegationAmount,
+		})
+	}
+
+	return slash
+}
+
+// slashRedelegation slashes the given fraction of a redelegation from the delegation
+// the redelegation went to. The slash is limited by the current delegation of the delegator.
+// Warning: does not transfer the slashed amount (to the treasury)
+func (k Keeper) slashRedelegation(ctx sdk.Context, pool *types.Pool, entry types.RedelegationQueueEntry, fraction sdk.Dec) (slash sdk.Int) {
+	f1Distribution := F1Distribution{
+		k:                k,
+		ctx:              ctx,
+		poolId:           entry.ToPoolId,
+		stakerAddress:    entry.ToStaker,
+		delegatorAddress: entry.Delegator,
+	}
+
+	slash = sdk.NewDecFromInt(entry.Amount).Mul(fraction).TruncateInt()
+	if currentDelegation := f1Distribution.getCurrentDelegation(); slash.GT(currentDelegation) {
+		slash = currentDelegation
+	}
+
+	if slash.IsZero() {
+		return slash
+	}
+
+	// Withdraw all rewards, as the delegation gets reduced.
+	reward := f1Distribution.Withdraw()
+	if err := k.transferRewardToAddress(ctx, entry.Delegator, reward); err != nil {
+		k.PanicHalt(ctx, "Not enough money in module: "+err.Error())
+	}
+
+	// Perform an internal re-delegation with the reduced amount.
+	undelegatedAmount := f1Distribution.Undelegate()
+	if slash.GT(undelegatedAmount) {
+		slash = undelegatedAmount
+	}
+	f1Distribution.Delegate(undelegatedAmount.Sub(slash))
+
+	// The slashed amount is never returned to the delegator.
+	k.trackUndelegation(ctx, entry.Delegator, slash)
+
+	entry.Amount = entry.Amount.Sub(slash)
+	k.SetRedelegationQueueEntry(ctx, entry)
+
+	// The pool of the redelegation can be the pool which is currently processed by the caller.
+	if entry.ToPoolId == pool.Id {
+		pool.TotalDelegation = pool.TotalDelegation.Sub(slash)
+	} else {
+		toPool, found := k.GetPool(ctx, entry.ToPoolId)
+		if !found {
+			k.PanicHalt(ctx, "Pool should exist")
+		}
+
+		toPool.TotalDelegation = toPool.TotalDelegation.Sub(slash)
+		k.SetPool(ctx, toPool)
+	}
+
+	return slash
+}
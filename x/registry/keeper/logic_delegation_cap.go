@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getSelfStakeCapacity returns the amount which can still be delegated to a staker
+// before its delegation exceeds the allowed multiple of its self-stake.
+func (k Keeper) getSelfStakeCapacity(ctx sdk.Context, poolId uint64, stakerAddress string) (remaining sdk.Int, unlimited bool) {
+	multiple := k.MaxDelegationSelfStakeMultiple(ctx)
+	if multiple == 0 {
+		return sdk.ZeroInt(), true
+	}
+
+	selfStake := sdk.ZeroInt()
+	if staker, found := k.GetStaker(ctx, stakerAddress, poolId); found {
+		selfStake = staker.Amount
+	}
+
+	remaining = selfStake.Mul(sdk.NewIntFromUint64(multiple)).Sub(k.getStakerDelegation(ctx, poolId, stakerAddress))
+	if remaining.IsNegative() {
+		return sdk.ZeroInt(), false
+	}
+
+	return remaining, false
+}
+
+// getPoolShareCapacity returns the amount which can still be delegated to a staker before its
+// delegation exceeds the allowed share of the total delegation of the pool.
+// As the delegation itself increases the total of the pool, the capacity x solves
+// d + x = share * (total + x), with d being the current delegation of the staker.
+// The cap does not apply to the first delegation of a pool, as it would always exceed it.
+func (k Keeper) getPoolShareCapacity(ctx sdk.Context, pool *types.Pool, stakerAddress string) (remaining sdk.Int, unlimited bool) {
+	share, err := sdk.NewDecFromStr(k.MaxDelegationPoolShare(ctx))
+	if err != nil || share.GTE(sdk.OneDec()) {
+		return sdk.ZeroInt(), true
+	}
+
+	if pool.TotalDelegation.IsZero() {
+		return sdk.ZeroInt(), true
+	}
+
+	delegation := k.getStakerDelegation(ctx, pool.Id, stakerAddress)
+
+	capacity := share.MulInt(pool.TotalDelegation).Sub(sdk.NewDecFromInt(delegation)).Quo(sdk.OneDec().Sub(share))
+	if capacity.IsNegative() {
+		return sdk.ZeroInt(), false
+	}
+
+	return capacity.TruncateInt(), false
+}
+
+// GetDelegationCapacity returns the amount which can still be delegated to a staker
+// with respect to all delegation caps.
+func (k Keeper) GetDelegationCapacity(ctx sdk.Context, pool *types.Pool, stakerAddress string) (remaining sdk.Int, unlimited bool) {
+	selfStakeCapacity, selfStakeUnlimited := k.getSelfStakeCapacity(ctx, pool.Id, stakerAddress)
+	poolShareCapacity, poolShareUnlimited := k.getPoolShareCapacity(ctx, pool, stakerAddress)
+
+	switch {
+	case selfStakeUnlimited && poolShareUnlimited:
+		return sdk.ZeroInt(), true
+	case selfStakeUnlimited:
+		return poolShareCapacity, false
+	case poolShareUnlimited:
+		return selfStakeCapacity, false
+	default:
+		return sdk.MinInt(selfStakeCapacity, poolShareCapacity), false
+	}
+}
+
+// checkDelegationCaps returns an error if delegating the given amount to a staker
+// would exceed one of the delegation caps.
+func (k Keeper) checkDelegationCaps(ctx sdk.Context, poolId uint64, stakerAddress string, amount sdk.Int) error {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
+	}
+
+	if remaining, unlimited := k.getSelfStakeCapacity(ctx, poolId, stakerAddress); !unlimited && amount.GT(remaining) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrDelegationSelfStakeCap.Error(),
+			k.MaxDelegationSelfStakeMultiple(ctx), remaining)
+	}
+
+	if remaining, unlimited := k.getPoolShareCapacity(ctx, &pool, stakerAddress); !unlimited && amount.GT(remaining) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrDelegationPoolShareCap.Error(),
+			k.MaxDelegationPoolShare(ctx), remaining)
+	}
+
+	return nil
+}
+
+// getStakerDelegation returns the total delegation of a staker in a pool.
+func (k Keeper) getStakerDelegation(ctx sdk.Context, poolId uint64, stakerAddress string) sdk.Int {
+	delegationPoolData, found := k.GetDelegationPoolData(ctx, poolId, stakerAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return delegationPoolData.TotalDelegation
+}
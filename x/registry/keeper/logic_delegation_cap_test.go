@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelegationCaps(t *testing.T) {
+	createGenesis(t)
+	testDelegationCaps(t)
+}
+
+func queryDelegationCapacity(t *testing.T, staker string) types.DelegationCapacity {
+	res, err := s.app.RegistryKeeper.DelegationCapacity(sdk.WrapSDKContext(s.ctx), &types.QueryDelegationCapacityRequest{
+		PoolId: 0,
+	})
+	require.NoError(t, err)
+
+	for _, capacity := range res.Capacities {
+		if capacity.Staker == staker {
+			return capacity
+		}
+	}
+
+	require.Failf(t, "staker not found", staker)
+	return types.DelegationCapacity{}
+}
+
+func testDelegationCaps(t *testing.T) {
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	// Without caps delegations are unlimited
+	require.True(t, queryDelegationCapacity(t, BOB_ADDR).Unlimited)
+
+	// The pool share cap does not apply to the first delegation of a pool
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationPoolShare, "0.5")
+	require.True(t, queryDelegationCapacity(t, BOB_ADDR).Unlimited)
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationPoolShare, "1")
+
+	// Limit delegations to twice the self-stake
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationSelfStakeMultiple, uint64(2))
+
+	capacity := queryDelegationCapacity(t, BOB_ADDR)
+	require.False(t, capacity.Unlimited)
+	require.Equal(t, 200*KYVE, capacity.Remaining.Uint64())
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(150 * KYVE),
+	})
+
+	// Delegation exceeding the remaining capacity is rejected
+	require.False(t, runTx(&types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(60 * KYVE),
+	}))
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	capacity = queryDelegationCapacity(t, BOB_ADDR)
+	require.Equal(t, 200*KYVE, capacity.TotalDelegation.Uint64())
+	require.True(t, capacity.Remaining.IsZero())
+
+	// Limit delegations to half of the total delegation of the pool instead
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationSelfStakeMultiple, uint64(0))
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationPoolShare, "0.5")
+
+	// Total delegation of the pool is 200 KYVE, the stake is not counted. Alice can receive
+	// 200 KYVE until her delegation is half of 400 KYVE, Bob already holds all of it.
+	require.Equal(t, 200*KYVE, queryDelegationCapacity(t, ALICE_ADDR).Remaining.Uint64())
+	require.False(t, queryDelegationCapacity(t, BOB_ADDR).Unlimited)
+	require.True(t, queryDelegationCapacity(t, BOB_ADDR).Remaining.IsZero())
+
+	require.False(t, runTx(&types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  ALICE_ADDR,
+		Amount:  sdk.NewIntFromUint64(201 * KYVE),
+	}))
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  ALICE_ADDR,
+		Amount:  sdk.NewIntFromUint64(200 * KYVE),
+	})
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, 400*KYVE, pool.TotalDelegation.Uint64())
+	require.True(t, queryDelegationCapacity(t, ALICE_ADDR).Remaining.IsZero())
+
+	// Redelegations are subject to the caps of the new staker
+	require.False(t, runTx(&types.MsgRedelegatePool{
+		Creator:    DUMMY_ACCOUNTS[0],
+		FromPoolId: 0,
+		FromStaker: BOB_ADDR,
+		ToPoolId:   0,
+		ToStaker:   ALICE_ADDR,
+		Amount:     sdk.NewIntFromUint64(10 * KYVE),
+	}))
+
+	delegator, _ := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	require.Equal(t, 200*KYVE, delegator.DelegationAmount.Uint64())
+
+	// Removing the caps allows the redelegation
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyMaxDelegationPoolShare, "1")
+
+	runTxSuccess(t, &types.MsgRedelegatePool{
+		Creator:    DUMMY_ACCOUNTS[0],
+		FromPoolId: 0,
+		FromStaker: BOB_ADDR,
+		ToPoolId:   0,
+		ToStaker:   ALICE_ADDR,
+		Amount:     sdk.NewIntFromUint64(10 * KYVE),
+	})
+
+	require.True(t, queryDelegationCapacity(t, ALICE_ADDR).Unlimited)
+}
@@ -10,26 +10,43 @@ import (
 
 // HandleUploadTimeout is an end block hook that triggers an upload timeout for every pool (if applicable).
 func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
-	// Unwrap context and fetch all pools.
+	// Unwrap context and confirm or roll back monitored pool upgrades.
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.handleUpgradeRollbacks(ctx)
+
+	// Return the unspent budget of funding streams which have ended.
+	k.handleExpiredFundingStreams(ctx)
+
+	// Fetch all pools.
 	pools := k.GetAllPool(ctx)
 
 	// Iterate over all pools.
 	for _, pool := range pools {
+		// Completed and retired pools never select an uploader again.
+		if pool.Status == types.POOL_STATUS_COMPLETED || pool.Status == types.POOL_STATUS_RETIRED {
+			continue
+		}
+
+		// Project the runway of the pool and alert if it runs low on funds.
+		if k.updatePoolRunway(ctx, &pool) {
+			k.SetPool(ctx, pool)
+		}
+
 		// Check if there is an upcoming pool upgrade
 		if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 			// Check if pool upgrade already has been applied
 			if pool.Protocol.Version != pool.UpgradePlan.Version || pool.Protocol.Binaries != pool.UpgradePlan.Binaries {
 				// perform pool upgrade
-				pool.Protocol.Version = pool.UpgradePlan.Version
-				pool.Protocol.Binaries = pool.UpgradePlan.Binaries
-				pool.Protocol.LastUpgrade = pool.UpgradePlan.ScheduledAt
+				k.applyPoolUpgrade(ctx, &pool)
 			}
 
 			// Check if upgrade duration was reached
 			if uint64(ctx.BlockTime().Unix()) >= (pool.UpgradePlan.ScheduledAt + pool.UpgradePlan.Duration) {
 				// reset upgrade plan to default values
 				pool.UpgradePlan = &types.UpgradePlan{}
+
+				// from now on stakers have to run at least the upgraded version
+				pool.Protocol.VersionEnforced = true
 			}
 
 			k.SetPool(ctx, pool)
@@ -42,9 +59,9 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 			pool.Status = types.POOL_STATUS_PAUSED
 		} else if len(pool.Stakers) < 2 {
 			pool.Status = types.POOL_STATUS_NOT_ENOUGH_VALIDATORS
-		} else if pool.TotalStake < pool.MinStake {
+		} else if pool.TotalStake.LT(pool.MinStake) {
 			pool.Status = types.POOL_STATUS_NOT_ENOUGH_STAKE
-		} else if pool.TotalFunds == 0 {
+		} else if pool.TotalFunds.IsZero() && !k.hasActiveFundingStreams(ctx, pool.Id) {
 			pool.Status = types.POOL_STATUS_NO_FUNDS
 		} else {
 			pool.Status = types.POOL_STATUS_ACTIVE
@@ -58,19 +75,20 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 		}
 
 		// Skip if we haven't reached the upload interval.
-		if uint64(ctx.BlockTime().Unix()) < (pool.BundleProposal.CreatedAt + pool.UploadInterval) {
+		if uint64(ctx.BlockTime().Unix()) < (uploadRoundStart(&pool) + pool.UploadInterval) {
 			continue
 		}
 
 		// Check if bundle needs to be dropped
 		if pool.BundleProposal.StorageId != "" && !strings.HasPrefix(pool.BundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
 			// check if the quorum was actually reached
-			valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool)
+			valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool, pool.BundleProposal)
 			quorum := k.getQuorumStatus(valid, invalid, abstain, total)
 
-			if quorum == types.BUNDLE_STATUS_NO_QUORUM {
+			// Bundle proposals which can still be chained after stay open.
+			if quorum == types.BUNDLE_STATUS_NO_QUORUM && !canPipelineBundleProposal(&pool) {
 				// handle stakers who did not vote at all
-				k.handleNonVoters(ctx, &pool)
+				k.handleNonVoters(ctx, &pool, pool.BundleProposal)
 
 				// Get next uploader
 				voters := append(pool.BundleProposal.VotersValid, pool.BundleProposal.VotersInvalid...)
@@ -89,7 +107,8 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 					ByteSize:     pool.BundleProposal.ByteSize,
 					Uploader:     pool.BundleProposal.Uploader,
 					NextUploader: pool.BundleProposal.NextUploader,
-					Reward:       0,
+					Reward:       sdk.ZeroInt(),
+					VoterReward:  sdk.ZeroInt(),
 					Valid:        valid,
 					Invalid:      invalid,
 					FromHeight:   pool.BundleProposal.FromHeight,
@@ -100,8 +119,14 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 					Id:           0,
 					Abstain: abstain,
 					Total: total,
+					Compression: pool.BundleProposal.Compression,
+					DataItemCount: pool.BundleProposal.DataItemCount,
+					UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
 				})
 
+				// Drop all bundle proposals which chained after the dropped bundle.
+				k.dropPipelinedProposals(ctx, &pool)
+
 				pool.BundleProposal = &types.BundleProposal{
 					NextUploader: nextUploader,
 					CreatedAt:    uint64(ctx.BlockTime().Unix()),
@@ -112,7 +137,7 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 		}
 
 		// Skip if we haven't reached the upload timeout.
-		if uint64(ctx.BlockTime().Unix()) < (pool.BundleProposal.CreatedAt + pool.UploadInterval + k.UploadTimeout(ctx)) {
+		if uint64(ctx.BlockTime().Unix()) < (uploadRoundStart(&pool) + pool.UploadInterval + k.UploadTimeout(ctx)) {
 			continue
 		}
 
@@ -161,7 +186,7 @@ func (k Keeper) HandleUploadTimeout(goCtx context.Context) {
 
 		// update bundle proposal
 		pool.BundleProposal.NextUploader = nextUploader
-		pool.BundleProposal.CreatedAt = uint64(ctx.BlockTime().Unix())
+		lastOpenBundleProposal(&pool).CreatedAt = uint64(ctx.BlockTime().Unix())
 
 		k.SetPool(ctx, pool)
 	}
@@ -15,8 +15,8 @@ type F1Distribution struct {
 
 func (f1 F1Distribution) updateEntries(
 	fMinus1Index uint64,
-	currentRewards uint64,
-	totalDelegation uint64,
+	currentRewards sdk.Int,
+	totalDelegation sdk.Int,
 	deleteOldEntry bool,
 ) (entryFBalance sdk.Dec, indexF uint64) {
 	// F1Paper: Current period f = delegationPoolData.LatestIndexK + 1
@@ -31,9 +31,9 @@ func (f1 F1Distribution) updateEntries(
 
 	// F1Paper: T_f / n_f
 	f1FinalBalance := sdk.NewDec(0)
-	if totalDelegation != 0 {
-		decCurrentRewards := sdk.NewDec(int64(currentRewards))
-		decTotalDelegation := sdk.NewDec(int64(totalDelegation))
+	if !totalDelegation.IsZero() {
+		decCurrentRewards := sdk.NewDecFromInt(currentRewards)
+		decTotalDelegation := sdk.NewDecFromInt(totalDelegation)
 
 		f1FinalBalance = decCurrentRewards.Quo(decTotalDelegation)
 	}
@@ -59,9 +59,9 @@ func (f1 F1Distribution) updateEntries(
 	return entryFBalance, indexF
 }
 
-func (f1 F1Distribution) Delegate(amount uint64) {
+func (f1 F1Distribution) Delegate(amount sdk.Int) {
 
-	if amount == 0 {
+	if amount.IsZero() {
 		return
 	}
 
@@ -72,8 +72,8 @@ func (f1 F1Distribution) Delegate(amount uint64) {
 	if !found {
 		delegationPoolData = types.DelegationPoolData{
 			Id:              f1.poolId,
-			CurrentRewards:  0,
-			TotalDelegation: 0,
+			CurrentRewards:  sdk.ZeroInt(),
+			TotalDelegation: sdk.ZeroInt(),
 			LatestIndexK:    0,
 			DelegatorCount:  0,
 			Staker:          f1.stakerAddress,
@@ -85,10 +85,10 @@ func (f1 F1Distribution) Delegate(amount uint64) {
 
 	delegationPoolData.LatestIndexWasUndelegation = false
 	// Reset Values according to F1Paper, i.e T=0
-	delegationPoolData.CurrentRewards = 0
+	delegationPoolData.CurrentRewards = sdk.ZeroInt()
 
 	// Update metadata
-	delegationPoolData.TotalDelegation += amount
+	delegationPoolData.TotalDelegation = delegationPoolData.TotalDelegation.Add(amount)
 	delegationPoolData.DelegatorCount += 1
 
 	delegationPoolData.LatestIndexK = indexF
@@ -107,7 +107,7 @@ func (f1 F1Distribution) Delegate(amount uint64) {
 // Undelegate
 // Undelegates the full amount.
 // Withdraw() must be called before, otherwise the reward is gone
-func (f1 F1Distribution) Undelegate() (undelegatedAmount uint64) {
+func (f1 F1Distribution) Undelegate() (undelegatedAmount sdk.Int) {
 
 	// Fetch metadata
 	delegationPoolData, found := f1.k.GetDelegationPoolData(f1.ctx, f1.poolId, f1.stakerAddress)
@@ -122,6 +122,15 @@ func (f1 F1Distribution) Undelegate() (undelegatedAmount uint64) {
 		f1.k.PanicHalt(f1.ctx, "Not a delegator")
 	}
 
+	// Apply all slashes which occurred since the last interaction of the delegator
+	stake, _ := f1.applySlashes(delegator, sdk.NewDec(0))
+	undelegatedAmount = f1.settleSlashes(&delegationPoolData, delegator, stake)
+
+	// The last delegator receives what is left of the total delegation
+	if delegationPoolData.DelegatorCount == 1 {
+		undelegatedAmount = delegationPoolData.TotalDelegation
+	}
+
 	_, indexF := f1.updateEntries(delegationPoolData.LatestIndexK, delegationPoolData.CurrentRewards,
 		delegationPoolData.TotalDelegation, delegationPoolData.LatestIndexWasUndelegation)
 
@@ -129,11 +138,14 @@ func (f1 F1Distribution) Undelegate() (undelegatedAmount uint64) {
 	delegationPoolData.LatestIndexWasUndelegation = true
 
 	// Reset Values according to F1Paper, i.e T=0
-	delegationPoolData.CurrentRewards = 0
+	delegationPoolData.CurrentRewards = sdk.ZeroInt()
 	delegationPoolData.LatestIndexK = indexF
 
 	// Update Metadata
-	delegationPoolData.TotalDelegation -= delegator.DelegationAmount
+	if undelegatedAmount.GT(delegationPoolData.TotalDelegation) {
+		undelegatedAmount = delegationPoolData.TotalDelegation
+	}
+	delegationPoolData.TotalDelegation = delegationPoolData.TotalDelegation.Sub(undelegatedAmount)
 	delegationPoolData.DelegatorCount -= 1
 
 	//Remove Delegator
@@ -145,17 +157,22 @@ func (f1 F1Distribution) Undelegate() (undelegatedAmount uint64) {
 	if delegationPoolData.DelegatorCount == 0 {
 		f1.k.RemoveDelegationPoolData(f1.ctx, delegationPoolData.Id, delegationPoolData.Staker)
 		f1.k.RemoveDelegationEntries(f1.ctx, f1.poolId, f1.stakerAddress, indexF)
+
+		// Slashes are only needed as long as there are delegators
+		for _, slash := range f1.k.GetDelegationSlashesOfStaker(f1.ctx, f1.poolId, f1.stakerAddress) {
+			f1.k.RemoveDelegationSlash(f1.ctx, slash.Id, slash.Staker, slash.KIndex)
+		}
 	} else {
 		f1.k.SetDelegationPoolData(f1.ctx, delegationPoolData)
 	}
 
-	return delegator.DelegationAmount
+	return undelegatedAmount
 }
 
 // Withdraw
 // F1Withdraw updates the states for F1-Algorithm and returns the amount of coins the user has earned.
 // The Method does NOT transfer the money.
-func (f1 F1Distribution) Withdraw() (reward uint64) {
+func (f1 F1Distribution) Withdraw() (reward sdk.Int) {
 	// Fetch metadata
 	delegationPoolData, found := f1.k.GetDelegationPoolData(f1.ctx, f1.poolId, f1.stakerAddress)
 
@@ -175,31 +192,177 @@ func (f1 F1Distribution) Withdraw() (reward uint64) {
 	delegationPoolData.LatestIndexWasUndelegation = false
 
 	// Reset Values according to F1Paper, i.e T=0
-	delegationPoolData.CurrentRewards = 0
+	delegationPoolData.CurrentRewards = sdk.ZeroInt()
 	delegationPoolData.LatestIndexK = indexF
 
-	f1.k.SetDelegationPoolData(f1.ctx, delegationPoolData)
+	//Calculate Reward and apply all slashes which occurred since the last interaction of the delegator
+	stake, reward := f1.applySlashes(delegator, entryFBalance)
+	delegationAmount := f1.settleSlashes(&delegationPoolData, delegator, stake)
 
-	//Calculate Reward
-	f1K, found := f1.k.GetDelegationEntries(f1.ctx, f1.poolId, f1.stakerAddress, delegator.KIndex)
-	if !found {
-		f1.k.PanicHalt(f1.ctx, "Delegator does not have entry")
-	}
+	f1.k.SetDelegationPoolData(f1.ctx, delegationPoolData)
 
 	//Remove Old entry
 	f1.k.RemoveDelegationEntries(f1.ctx, f1.poolId, f1.stakerAddress, delegator.KIndex)
 
+	// The slashed amount is never returned to the delegator.
+	if delegationAmount.LT(delegator.DelegationAmount) {
+		f1.k.trackUndelegation(f1.ctx, f1.delegatorAddress, delegator.DelegationAmount.Sub(delegationAmount))
+	}
+
 	//Update Delegator
 	delegator.KIndex = indexF
+	delegator.DelegationAmount = delegationAmount
 	f1.k.SetDelegator(f1.ctx, delegator)
 
-	f1kBalance, _ := sdk.NewDecFromStr(f1K.Balance)
-	return uint64((entryFBalance.Sub(f1kBalance)).Mul(sdk.NewDec(int64(delegator.DelegationAmount))).RoundInt64())
+	return reward
+}
+
+// Slash
+// Slashes the given fraction of the total delegation of the staker.
+// The individual delegations are reduced lazily on the next interaction of each delegator.
+func (f1 F1Distribution) Slash(fraction sdk.Dec) (slashedAmount sdk.Int) {
+	// Fetch metadata
+	delegationPoolData, found := f1.k.GetDelegationPoolData(f1.ctx, f1.poolId, f1.stakerAddress)
+	if !found || delegationPoolData.TotalDelegation.IsZero() {
+		return sdk.ZeroInt()
+	}
+
+	// Close the current period, so that rewards before the slash are paid out on the full delegation
+	entryFBalance, indexF := f1.updateEntries(delegationPoolData.LatestIndexK, delegationPoolData.CurrentRewards,
+		delegationPoolData.TotalDelegation, delegationPoolData.LatestIndexWasUndelegation)
+
+	// The entry is not referenced by any delegator, its balance is kept in the slash event
+	delegationPoolData.LatestIndexWasUndelegation = true
+
+	// Reset Values according to F1Paper, i.e T=0
+	delegationPoolData.CurrentRewards = sdk.ZeroInt()
+	delegationPoolData.LatestIndexK = indexF
+
+	// The total delegation is rounded up, the difference to the exact delegation is kept as remainder.
+	// The remainder is used to round the individual delegations when the slash is applied to them,
+	// so that the total delegation stays equal to the sum of all delegations.
+	exactDelegation := sdk.NewDecFromInt(delegationPoolData.TotalDelegation).Sub(getSlashRemainder(delegationPoolData)).Mul(sdk.OneDec().Sub(fraction))
+	totalDelegation := exactDelegation.Ceil().TruncateInt()
+
+	slashedAmount = delegationPoolData.TotalDelegation.Sub(totalDelegation)
+	delegationPoolData.TotalDelegation = totalDelegation
+	delegationPoolData.SlashRemainder = sdk.NewDecFromInt(totalDelegation).Sub(exactDelegation).String()
+
+	f1.k.SetDelegationPoolData(f1.ctx, delegationPoolData)
+
+	f1.k.SetDelegationSlash(f1.ctx, types.DelegationSlash{
+		Id:                f1.poolId,
+		Staker:            f1.stakerAddress,
+		KIndex:            indexF,
+		Fraction:          fraction.String(),
+		Balance:           entryFBalance.String(),
+		PendingDelegators: delegationPoolData.DelegatorCount,
+	})
+
+	return slashedAmount
+}
+
+// applySlashes
+// Calculates the exact delegation of the delegator after all slashes since its last interaction
+// and the reward up to the F1 balance entryFBalance, *without* performing any state changes.
+func (f1 F1Distribution) applySlashes(delegator types.Delegator, entryFBalance sdk.Dec) (stake sdk.Dec, reward sdk.Int) {
+	f1K, found := f1.k.GetDelegationEntries(f1.ctx, f1.poolId, f1.stakerAddress, delegator.KIndex)
+	if !found {
+		f1.k.PanicHalt(f1.ctx, "Delegator does not have entry")
+	}
+
+	stake = sdk.NewDecFromInt(delegator.DelegationAmount)
+	periodStart, _ := sdk.NewDecFromStr(f1K.Balance)
+	decReward := sdk.NewDec(0)
+
+	for _, slash := range f1.k.GetDelegationSlashesOfStaker(f1.ctx, f1.poolId, f1.stakerAddress) {
+		if slash.KIndex <= delegator.KIndex {
+			continue
+		}
+
+		slashBalance, _ := sdk.NewDecFromStr(slash.Balance)
+		slashFraction, _ := sdk.NewDecFromStr(slash.Fraction)
+
+		// Rewards until the slash are earned with the full stake
+		decReward = decReward.Add(slashBalance.Sub(periodStart).Mul(stake))
+
+		stake = stake.Mul(sdk.OneDec().Sub(slashFraction))
+		periodStart = slashBalance
+	}
+
+	if entryFBalance.GT(periodStart) {
+		decReward = decReward.Add(entryFBalance.Sub(periodStart).Mul(stake))
+	}
+
+	return stake, decReward.RoundInt()
+}
+
+// settleSlashes
+// Rounds the exact delegation of the delegator after all slashes since its last interaction with the
+// remainder of the staker. Slashes which have been applied by all of their delegators are removed.
+func (f1 F1Distribution) settleSlashes(delegationPoolData *types.DelegationPoolData, delegator types.Delegator, stake sdk.Dec) (delegationAmount sdk.Int) {
+	delegationAmount, remainder := roundSlashedStake(stake, getSlashRemainder(*delegationPoolData))
+	delegationPoolData.SlashRemainder = remainder.String()
+
+	for _, slash := range f1.k.GetDelegationSlashesOfStaker(f1.ctx, f1.poolId, f1.stakerAddress) {
+		if slash.KIndex <= delegator.KIndex {
+			continue
+		}
+
+		if slash.PendingDelegators <= 1 {
+			f1.k.RemoveDelegationSlash(f1.ctx, slash.Id, slash.Staker, slash.KIndex)
+		} else {
+			slash.PendingDelegators -= 1
+			f1.k.SetDelegationSlash(f1.ctx, slash)
+		}
+	}
+
+	return delegationAmount
+}
+
+// roundSlashedStake
+// Truncates the exact delegation and adds its fractional part to the remainder. Once the remainder
+// covers a whole token, the delegation is rounded up instead.
+func roundSlashedStake(stake sdk.Dec, remainder sdk.Dec) (delegationAmount sdk.Int, newRemainder sdk.Dec) {
+	delegationAmount = stake.TruncateInt()
+	newRemainder = remainder.Add(stake.Sub(sdk.NewDecFromInt(delegationAmount)))
+
+	if newRemainder.GTE(sdk.OneDec()) {
+		delegationAmount = delegationAmount.AddRaw(1)
+		newRemainder = newRemainder.Sub(sdk.OneDec())
+	}
+
+	return delegationAmount, newRemainder
+}
+
+// getSlashRemainder returns the remainder of the total delegation which is not yet assigned to the delegators
+func getSlashRemainder(delegationPoolData types.DelegationPoolData) sdk.Dec {
+	remainder, err := sdk.NewDecFromStr(delegationPoolData.SlashRemainder)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+
+	return remainder
+}
+
+// getCurrentDelegation
+// Calculates and returns the current delegation after all slashes, *without* performing any state changes
+func (f1 F1Distribution) getCurrentDelegation() (delegationAmount sdk.Int) {
+	delegator, found := f1.k.GetDelegator(f1.ctx, f1.poolId, f1.stakerAddress, f1.delegatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	delegationPoolData, _ := f1.k.GetDelegationPoolData(f1.ctx, f1.poolId, f1.stakerAddress)
+
+	stake, _ := f1.applySlashes(delegator, sdk.NewDec(0))
+	delegationAmount, _ = roundSlashedStake(stake, getSlashRemainder(delegationPoolData))
+	return delegationAmount
 }
 
 // getCurrentReward
 // Calculates and returns the current reward, *without* performing any state changes
-func (f1 F1Distribution) getCurrentReward() (reward uint64) {
+func (f1 F1Distribution) getCurrentReward() (reward sdk.Int) {
 
 	delegator, found := f1.k.GetDelegator(f1.ctx, f1.poolId, f1.stakerAddress, f1.delegatorAddress)
 	if !found {
@@ -222,9 +385,9 @@ func (f1 F1Distribution) getCurrentReward() (reward uint64) {
 
 	// F1Paper: T_f / n_f
 	f1FinalBalance := sdk.NewDec(0)
-	if delegationPoolData.TotalDelegation != 0 {
-		decCurrentRewards := sdk.NewDec(int64(delegationPoolData.CurrentRewards))
-		decTotalDelegation := sdk.NewDec(int64(delegationPoolData.TotalDelegation))
+	if !delegationPoolData.TotalDelegation.IsZero() {
+		decCurrentRewards := sdk.NewDecFromInt(delegationPoolData.CurrentRewards)
+		decTotalDelegation := sdk.NewDecFromInt(delegationPoolData.TotalDelegation)
 
 		f1FinalBalance = decCurrentRewards.Quo(decTotalDelegation)
 	}
@@ -232,11 +395,6 @@ func (f1 F1Distribution) getCurrentReward() (reward uint64) {
 	f1FinalBalance = f1FinalBalance.Add(f1fMinus1Balance)
 
 	//Calculate Reward
-	f1K, found := f1.k.GetDelegationEntries(f1.ctx, f1.poolId, f1.stakerAddress, delegator.KIndex)
-	if !found {
-		f1.k.PanicHalt(f1.ctx, "Delegator does not have entry")
-	}
-
-	f1kBalance, _ := sdk.NewDecFromStr(f1K.Balance)
-	return uint64(sdk.NewDec(int64(delegator.DelegationAmount)).Mul(f1FinalBalance.Sub(f1kBalance)).RoundInt64())
+	_, reward = f1.applySlashes(delegator, f1FinalBalance)
+	return reward
 }
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// secondsPerDay is the length of a day, e.g. the rolling window the daily spending limit of a funding stream applies to.
+const secondsPerDay = uint64(24 * 60 * 60)
+
+// fundingStreamCharge is the amount a funding stream pays for the current bundle.
+type fundingStreamCharge struct {
+	stream types.FundingStream
+	amount sdk.Int
+}
+
+// isFundingStreamExpired returns true if the end time of the funding stream has been reached.
+func isFundingStreamExpired(ctx sdk.Context, stream *types.FundingStream) bool {
+	return stream.EndTime > 0 && uint64(ctx.BlockTime().Unix()) >= stream.EndTime
+}
+
+// resetFundingStreamDay starts a new spending day if the current one is over.
+func resetFundingStreamDay(ctx sdk.Context, stream *types.FundingStream) {
+	now := uint64(ctx.BlockTime().Unix())
+
+	if now >= stream.DayStart+secondsPerDay {
+		stream.DayStart = now
+		stream.SpentToday = sdk.ZeroInt()
+	}
+}
+
+// getFundingStreamAllowance returns the amount a funding stream is currently able to spend,
+// which is limited by its remaining budget and its daily spending limit.
+func getFundingStreamAllowance(ctx sdk.Context, stream types.FundingStream) sdk.Int {
+	if isFundingStreamExpired(ctx, &stream) {
+		return sdk.ZeroInt()
+	}
+
+	resetFundingStreamDay(ctx, &stream)
+
+	allowance := stream.Amount
+
+	if stream.AmountPerDay.IsPositive() {
+		allowance = sdk.MinInt(allowance, stream.AmountPerDay.Sub(stream.SpentToday))
+	}
+
+	if allowance.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return allowance
+}
+
+// hasActiveFundingStreams returns true if at least one funding stream of the pool is able to pay for bundles.
+func (k Keeper) hasActiveFundingStreams(ctx sdk.Context, poolId uint64) bool {
+	for _, stream := range k.GetFundingStreamsByPool(ctx, poolId) {
+		if getFundingStreamAllowance(ctx, stream).IsPositive() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getFundingStreamCharges calculates how much every funding stream of the pool pays for a bundle.
+// Every payer, funders and active funding streams alike, is assigned an equal share of the bundle reward.
+// A funding stream pays at most its share, capped by its spending limits. The funders cover the rest.
+func (k Keeper) getFundingStreamCharges(ctx sdk.Context, pool *types.Pool, bundleReward sdk.Int) (charges []fundingStreamCharge, total sdk.Int) {
+	total = sdk.ZeroInt()
+
+	var active []types.FundingStream
+	for _, stream := range k.GetFundingStreamsByPool(ctx, pool.Id) {
+		if getFundingStreamAllowance(ctx, stream).IsPositive() {
+			active = append(active, stream)
+		}
+	}
+
+	if len(active) == 0 {
+		return nil, total
+	}
+
+	share := bundleReward.QuoRaw(int64(len(pool.Funders) + len(active)))
+
+	for _, stream := range active {
+		amount := sdk.MinInt(share, getFundingStreamAllowance(ctx, stream))
+
+		if stream.AmountPerBundle.IsPositive() {
+			amount = sdk.MinInt(amount, stream.AmountPerBundle)
+		}
+
+		if amount.IsPositive() {
+			charges = append(charges, fundingStreamCharge{stream: stream, amount: amount})
+			total = total.Add(amount)
+		}
+	}
+
+	return charges, total
+}
+
+// chargeFundingStreams deducts the given charges from the funding streams.
+// Funding streams which have spent their entire budget are removed.
+func (k Keeper) chargeFundingStreams(ctx sdk.Context, charges []fundingStreamCharge) error {
+	for _, charge := range charges {
+		stream := charge.stream
+
+		resetFundingStreamDay(ctx, &stream)
+
+		stream.Amount = stream.Amount.Sub(charge.amount)
+		stream.SpentToday = stream.SpentToday.Add(charge.amount)
+		k.trackUndelegation(ctx, stream.Account, charge.amount)
+
+		if stream.Amount.IsZero() {
+			k.RemoveFundingStream(ctx, stream.PoolId, stream.Id)
+
+			errEmit := ctx.EventManager().EmitTypedEvent(&types.EventCloseFundingStream{
+				PoolId:   stream.PoolId,
+				StreamId: stream.Id,
+				Address:  stream.Account,
+				Refund:   sdk.ZeroInt(),
+			})
+			if errEmit != nil {
+				return errEmit
+			}
+
+			continue
+		}
+
+		k.SetFundingStream(ctx, stream)
+	}
+
+	return nil
+}
+
+// closeFundingStream removes a funding stream and returns its unspent budget to the sponsor.
+func (k Keeper) closeFundingStream(ctx sdk.Context, stream types.FundingStream) error {
+	k.RemoveFundingStream(ctx, stream.PoolId, stream.Id)
+
+	if stream.Amount.IsPositive() {
+		if err := k.undelegateToAddress(ctx, stream.Account, stream.Amount); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCloseFundingStream{
+		PoolId:   stream.PoolId,
+		StreamId: stream.Id,
+		Address:  stream.Account,
+		Refund:   stream.Amount,
+	})
+}
+
+// refundFundingStreams closes all funding streams of a given pool and returns their unspent budgets.
+func (k Keeper) refundFundingStreams(ctx sdk.Context, poolId uint64) error {
+	for _, stream := range k.GetFundingStreamsByPool(ctx, poolId) {
+		if err := k.closeFundingStream(ctx, stream); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleExpiredFundingStreams closes all funding streams whose end time has been reached.
+func (k Keeper) handleExpiredFundingStreams(ctx sdk.Context) {
+	for _, stream := range k.GetExpiredFundingStreams(ctx, uint64(ctx.BlockTime().Unix())) {
+		if err := k.closeFundingStream(ctx, stream); err != nil {
+			k.PanicHalt(ctx, "Failed to refund expired funding stream: "+err.Error())
+		}
+	}
+}
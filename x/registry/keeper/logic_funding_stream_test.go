@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingStream(t *testing.T) {
+	createGenesis(t)
+	testFundingStream(t)
+}
+
+func TestFundingStreamExpiry(t *testing.T) {
+	createGenesis(t)
+	testFundingStreamExpiry(t)
+}
+
+func TestFundingStreamLimits(t *testing.T) {
+	createGenesis(t)
+	testFundingStreamLimits(t)
+}
+
+func TestFundingStreamWithoutFunders(t *testing.T) {
+	createGenesis(t)
+	testFundingStreamWithoutFunders(t)
+}
+
+func getFundingStreams(t *testing.T, poolId uint64) []types.FundingStreamStatus {
+	res, err := s.app.RegistryKeeper.FundingStreams(sdk.WrapSDKContext(s.ctx), &types.QueryFundingStreamsRequest{PoolId: poolId})
+	require.Nil(t, err)
+	return res.FundingStreams
+}
+
+func testFundingStream(t *testing.T) {
+	sponsor := DUMMY_ACCOUNTS[1]
+
+	// Spend at most 50 per bundle and 80 per day
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         sponsor,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.NewInt(50),
+		AmountPerDay:    sdk.NewInt(80),
+	})
+
+	streams := getFundingStreams(t, 0)
+	require.Len(t, streams, 1)
+	require.True(t, sdk.NewInt(80).Equal(streams[0].SpendPerDay))
+	require.Equal(t, uint64(KYVE/80*24*60*60), streams[0].Runway)
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR, DUMMY_ACCOUNTS[0]} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	// The funding stream alone is enough to run the pool
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// The bundle reward is capped by the per bundle limit
+	stream, _ := s.app.RegistryKeeper.GetFundingStream(s.ctx, 0, 1)
+	require.True(t, sdk.NewIntFromUint64(KYVE).SubRaw(50).Equal(stream.Amount))
+	require.True(t, sdk.NewInt(50).Equal(stream.SpentToday))
+
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "b", types.VOTE_TYPE_YES)
+
+	setNextUploader(ALICE_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "c", 20, 30, "b_key"))
+
+	// The second bundle is capped by the remaining daily limit
+	stream, _ = s.app.RegistryKeeper.GetFundingStream(s.ctx, 0, 1)
+	require.True(t, sdk.NewIntFromUint64(KYVE).SubRaw(80).Equal(stream.Amount))
+	require.True(t, sdk.NewInt(80).Equal(stream.SpentToday))
+
+	// The pool runs out of funds until the next day
+	s.CommitAfterSeconds(60)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_NO_FUNDS, pool.Status)
+
+	s.CommitAfterSeconds(24 * 60 * 60)
+	s.CommitAfterSeconds(1)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_ACTIVE, pool.Status)
+
+	// Only the sponsor is able to close the funding stream
+	require.False(t, runTx(&types.MsgCloseFundingStream{
+		Creator:  BOB_ADDR,
+		Id:       0,
+		StreamId: 1,
+	}))
+
+	balance := getBalance(sponsor)
+
+	runTxSuccess(t, &types.MsgCloseFundingStream{
+		Creator:  sponsor,
+		Id:       0,
+		StreamId: 1,
+	})
+
+	require.Equal(t, balance+KYVE-80, getBalance(sponsor))
+	require.Empty(t, getFundingStreams(t, 0))
+}
+
+func testFundingStreamExpiry(t *testing.T) {
+	sponsor := DUMMY_ACCOUNTS[1]
+	balance := getBalance(sponsor)
+
+	// The end time has to be in the future
+	require.False(t, runTx(&types.MsgCreateFundingStream{
+		Creator:         sponsor,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+		EndTime:         uint64(s.ctx.BlockTime().Unix()),
+	}))
+
+	endTime := uint64(s.ctx.BlockTime().Unix()) + 120
+
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         sponsor,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+		EndTime:         endTime,
+	})
+
+	require.Equal(t, balance-KYVE, getBalance(sponsor))
+
+	// The runway is bounded by the end time
+	streams := getFundingStreams(t, 0)
+	require.Len(t, streams, 1)
+	require.Equal(t, uint64(120), streams[0].Runway)
+
+	s.CommitAfterSeconds(60)
+	require.Len(t, getFundingStreams(t, 0), 1)
+
+	// The unspent budget is returned at the end time
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(1)
+
+	require.Empty(t, getFundingStreams(t, 0))
+	require.Equal(t, balance, getBalance(sponsor))
+}
+
+func testFundingStreamLimits(t *testing.T) {
+	sponsor := DUMMY_ACCOUNTS[1]
+
+	// The budget has to reach the minimum
+	require.False(t, runTx(&types.MsgCreateFundingStream{
+		Creator:         sponsor,
+		Id:              0,
+		Amount:          sdk.NewInt(types.MinFundingStream - 1),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+	}))
+
+	for i := 0; i < types.MaxFundingStreams; i++ {
+		runTxSuccess(t, &types.MsgCreateFundingStream{
+			Creator:         sponsor,
+			Id:              0,
+			Amount:          sdk.NewIntFromUint64(KYVE + uint64(i)),
+			AmountPerBundle: sdk.ZeroInt(),
+			AmountPerDay:    sdk.ZeroInt(),
+		})
+	}
+
+	require.Len(t, getFundingStreams(t, 0), types.MaxFundingStreams)
+
+	// A full pool only accepts a funding stream with a larger budget than the lowest one
+	require.False(t, runTx(&types.MsgCreateFundingStream{
+		Creator:         BOB_ADDR,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+	}))
+
+	balance := getBalance(sponsor)
+
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         BOB_ADDR,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(2 * KYVE),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+	})
+
+	// The lowest funding stream is closed and refunded
+	require.Len(t, getFundingStreams(t, 0), types.MaxFundingStreams)
+	require.Equal(t, balance+KYVE, getBalance(sponsor))
+
+	_, found := s.app.RegistryKeeper.GetFundingStream(s.ctx, 0, 1)
+	require.False(t, found)
+}
+
+func testFundingStreamWithoutFunders(t *testing.T) {
+	sponsor := DUMMY_ACCOUNTS[1]
+
+	// The funder can't afford its share of the first bundle
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: DUMMY_ACCOUNTS[2],
+		Id:      0,
+		Amount:  sdk.NewInt(10),
+	})
+
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         sponsor,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(KYVE),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR, DUMMY_ACCOUNTS[0]} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// The funder is removed, but the funding stream pays for the whole bundle instead of dropping it
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Empty(t, pool.Funders)
+	require.True(t, pool.TotalFunds.IsZero())
+	require.Equal(t, uint64(10), pool.CurrentHeight)
+
+	stream, _ := s.app.RegistryKeeper.GetFundingStream(s.ctx, 0, 1)
+	require.True(t, stream.SpentToday.Equal(pool.AverageBundleCost))
+	require.True(t, sdk.NewIntFromUint64(KYVE).Sub(stream.SpentToday).Equal(stream.Amount))
+}
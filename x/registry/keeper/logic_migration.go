@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// MigrateAmountsToInt converts all token amounts in the store from uint64 to sdk.Int.
+// Before the migration amounts were encoded as varints, afterwards they are encoded
+// as decimal strings. The field numbers did not change.
+func (k Keeper) MigrateAmountsToInt(ctx sdk.Context) {
+	k.migrateAmountsToInt(ctx, types.KeyPrefix(types.PoolKey),
+		func() codec.ProtoMarshaler { return &types.Pool{} }, 11, 14, 18, 21, 22, 31, 32)
+	k.migrateAmountsToInt(ctx, types.KeyPrefix(types.StakerKeyPrefix),
+		func() codec.ProtoMarshaler { return &types.Staker{} }, 3, 4)
+	k.migrateAmountsToInt(ctx, types.KeyPrefix(types.FunderKeyPrefix),
+		func() codec.ProtoMarshaler { return &types.Funder{} }, 3)
+	k.migrateAmountsToInt(ctx, types.KeyPrefix(types.DelegatorKeyPrefix),
+		func() codec.ProtoMarshaler { return &types.Delegator{} }, 3)
+	k.migrateAmountsToInt(ctx, types.KeyPrefix(types.DelegationPoolDataKeyPrefix),
+		func() codec.ProtoMarshaler { return &types.DelegationPoolData{} }, 3, 4)
+	k.migrateAmountsToInt(ctx, types.UnbondingStakingQueueEntryKeyPrefix,
+		func() codec.ProtoMarshaler { return &types.UnbondingStakingQueueEntry{} }, 4)
+	k.migrateAmountsToInt(ctx, types.UnbondingStakerKeyPrefix,
+		func() codec.ProtoMarshaler { return &types.UnbondingStaker{} }, 3)
+	k.migrateAmountsToInt(ctx, types.UnbondingDelegationQueueEntryKeyPrefix,
+		func() codec.ProtoMarshaler { return &types.UnbondingDelegationQueueEntry{} }, 5)
+}
+
+// migrateAmountsToInt rewrites the given varint fields of all entries stored under
+// keyPrefix as decimal strings. Every entry is unmarshalled and marshalled again afterwards,
+// so that amounts which were omitted because they were zero are stored as "0".
+func (k Keeper) migrateAmountsToInt(ctx sdk.Context, keyPrefix []byte, newValue func() codec.ProtoMarshaler, fields ...protowire.Number) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		value, err := convertVarintFieldsToString(values[i], fields)
+		if err != nil {
+			k.PanicHalt(ctx, "Failed to migrate amounts: "+err.Error())
+		}
+
+		val := newValue()
+		k.cdc.MustUnmarshal(value, val)
+		store.Set(key, k.cdc.MustMarshal(val))
+	}
+}
+
+// convertVarintFieldsToString re-encodes the given varint fields of a serialized message
+// as length-delimited decimal strings. All other fields are copied unchanged.
+func convertVarintFieldsToString(b []byte, fields []protowire.Number) ([]byte, error) {
+	var out []byte
+
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+
+		if typ == protowire.VarintType && containsFieldNumber(fields, num) {
+			v, m := protowire.ConsumeVarint(b[n:])
+			if m < 0 {
+				return nil, protowire.ParseError(m)
+			}
+
+			out = protowire.AppendTag(out, num, protowire.BytesType)
+			out = protowire.AppendString(out, strconv.FormatUint(v, 10))
+			b = b[n+m:]
+			continue
+		}
+
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+
+		out = append(out, b[:n+m]...)
+		b = b[n+m:]
+	}
+
+	return out, nil
+}
+
+func containsFieldNumber(fields []protowire.Number, num protowire.Number) bool {
+	for _, field := range fields {
+		if field == num {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateRedelegationCooldowns converts the redelegation cooldown entries from the previous layout,
+// which used (delegator, creation date) as key without a value, to entries keyed by
+// (delegator, index). Indices are assigned per delegator in the order of the creation dates.
+func (k Keeper) MigrateRedelegationCooldowns(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationCooldownPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	var cooldowns []types.RedelegationCooldown
+	for ; iterator.Valid(); iterator.Next() {
+		// Old key layout: <delegator>/<creation date (8 bytes)>/
+		key := iterator.Key()
+		if len(key) < 10 {
+			k.PanicHalt(ctx, "Failed to migrate redelegation cooldowns: invalid key")
+		}
+
+		keys = append(keys, key)
+		cooldowns = append(cooldowns, types.RedelegationCooldown{
+			Address:      string(key[:len(key)-10]),
+			CreationDate: binary.BigEndian.Uint64(key[len(key)-9 : len(key)-1]),
+		})
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// Entries are iterated in the order of the old keys, which sorts them by creation date per delegator.
+	nextIndex := make(map[string]uint64)
+	for _, cooldown := range cooldowns {
+		cooldown.Index = nextIndex[cooldown.Address]
+		nextIndex[cooldown.Address]++
+		k.SetRedelegationCooldown(ctx, cooldown)
+	}
+}
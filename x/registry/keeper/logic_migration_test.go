@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	v0_7_0 "github.com/KYVENetwork/chain/app/upgrades/v0.7.0"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"testing"
+)
+
+func TestMigrateAmountsToInt(t *testing.T) {
+	createGenesis(t)
+	testMigrateAmountsToInt(t)
+}
+
+func testMigrateAmountsToInt(t *testing.T) {
+	// Staker encoded before the migration, the unbonding amount is zero and therefore omitted.
+	var old []byte
+	old = protowire.AppendTag(old, 1, protowire.BytesType)
+	old = protowire.AppendString(old, BOB_ADDR)
+	old = protowire.AppendTag(old, 3, protowire.VarintType)
+	old = protowire.AppendVarint(old, 18_000_000_000_000_000_000)
+	old = protowire.AppendTag(old, 5, protowire.BytesType)
+	old = protowire.AppendString(old, "0.1")
+	old = protowire.AppendTag(old, 9, protowire.VarintType)
+	old = protowire.AppendVarint(old, 2)
+
+	store := prefix.NewStore(s.ctx.KVStore(s.app.RegistryKeeper.StoreKey()), types.KeyPrefix(types.StakerKeyPrefix))
+	store.Set(types.StakerKey(BOB_ADDR, 0), old)
+
+	s.app.RegistryKeeper.MigrateAmountsToInt(s.ctx)
+
+	staker, found := s.app.RegistryKeeper.GetStaker(s.ctx, BOB_ADDR, 0)
+	require.True(t, found)
+	require.Equal(t, BOB_ADDR, staker.Account)
+	require.Equal(t, uint64(18_000_000_000_000_000_000), staker.Amount.Uint64())
+	require.True(t, staker.UnbondingAmount.IsZero())
+	require.Equal(t, "0.1", staker.Commission)
+	require.Equal(t, uint64(2), staker.Points)
+
+	// Entries which are already encoded as sdk.Int stay unchanged.
+	pool, found := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, found)
+	require.Equal(t, uint64(100), pool.OperatingCost.Uint64())
+	require.True(t, pool.TotalFunds.IsZero())
+}
+
+func TestMigrateRedelegationCooldowns(t *testing.T) {
+	createGenesis(t)
+	testMigrateRedelegationCooldowns(t)
+}
+
+func testMigrateRedelegationCooldowns(t *testing.T) {
+	// Cooldowns stored before the migration, keyed by delegator and creation date.
+	store := prefix.NewStore(s.ctx.KVStore(s.app.RegistryKeeper.StoreKey()), types.RedelegationCooldownPrefix)
+	for _, delegator := range []string{ALICE_ADDR, BOB_ADDR} {
+		for _, creationDate := range []uint64{300, 100, 200} {
+			key := append([]byte(delegator+"/"), make([]byte, 8)...)
+			binary.BigEndian.PutUint64(key[len(delegator)+1:], creationDate)
+			store.Set(append(key, '/'), []byte{1})
+		}
+	}
+
+	s.app.RegistryKeeper.MigrateRedelegationCooldowns(s.ctx)
+
+	for _, delegator := range []string{ALICE_ADDR, BOB_ADDR} {
+		cooldowns := s.app.RegistryKeeper.GetRedelegationCooldownEntries(s.ctx, delegator)
+		require.Len(t, cooldowns, 3)
+
+		for i, creationDate := range []uint64{100, 200, 300} {
+			require.Equal(t, delegator, cooldowns[i].Address)
+			require.Equal(t, creationDate, cooldowns[i].CreationDate)
+			require.Equal(t, uint64(i), cooldowns[i].Index)
+		}
+
+		require.Equal(t, uint64(3), s.app.RegistryKeeper.GetNextRedelegationCooldownIndex(s.ctx, delegator))
+	}
+
+	require.Len(t, s.app.RegistryKeeper.GetAllRedelegationCooldownEntries(s.ctx), 6)
+}
+
+func TestUpgradeV070(t *testing.T) {
+	createGenesis(t)
+	testUpgradeV070(t)
+}
+
+func testUpgradeV070(t *testing.T) {
+	// Pending unbonding encoded before the upgrade, with the amount as varint and without the staker index.
+	var old []byte
+	old = protowire.AppendTag(old, 1, protowire.VarintType)
+	old = protowire.AppendVarint(old, 1)
+	old = protowire.AppendTag(old, 2, protowire.BytesType)
+	old = protowire.AppendString(old, BOB_ADDR)
+	old = protowire.AppendTag(old, 3, protowire.BytesType)
+	old = protowire.AppendString(old, ALICE_ADDR)
+	old = protowire.AppendTag(old, 5, protowire.VarintType)
+	old = protowire.AppendVarint(old, 50*KYVE)
+	old = protowire.AppendTag(old, 6, protowire.VarintType)
+	old = protowire.AppendVarint(old, 100)
+
+	store := prefix.NewStore(s.ctx.KVStore(s.app.RegistryKeeper.StoreKey()), types.UnbondingDelegationQueueEntryKeyPrefix)
+	store.Set(types.UnbondingDelegationQueueEntryKey(1), old)
+
+	indexStore := prefix.NewStore(s.ctx.KVStore(s.app.RegistryKeeper.StoreKey()), types.UnbondingDelegationQueueEntryKeyPrefixIndex2)
+	indexStore.Set(types.UnbondingDelegationQueueEntryKeyIndex2(ALICE_ADDR, 1), []byte{1})
+
+	s.app.RegistryKeeper.SetUnbondingDelegationQueueState(s.ctx, types.UnbondingDelegationQueueState{LowIndex: 0, HighIndex: 1})
+
+	_, err := v0_7_0.CreateUpgradeHandler(&s.app.RegistryKeeper)(s.ctx, upgradetypes.Plan{Name: v0_7_0.UpgradeName}, module.VersionMap{})
+	require.NoError(t, err)
+
+	// The entry is converted and indexed by pool and staker
+	entries := s.app.RegistryKeeper.GetUnbondingDelegationQueueEntriesOfStaker(s.ctx, 0, BOB_ADDR)
+	require.Len(t, entries, 1)
+	require.Equal(t, ALICE_ADDR, entries[0].Delegator)
+	require.Equal(t, 50*KYVE, entries[0].Amount.Uint64())
+	require.Equal(t, uint64(100), entries[0].CreationTime)
+}
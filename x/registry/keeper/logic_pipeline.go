@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// pipelineDepth returns the maximum amount of bundle proposals which can be open at the same time in a given pool.
+func pipelineDepth(pool *types.Pool) uint64 {
+	if pool.PipelineDepth == 0 {
+		return 1
+	}
+
+	return pool.PipelineDepth
+}
+
+// lastOpenBundleProposal returns the most recent bundle proposal of a given pool,
+// which is the one the next bundle proposal has to chain from.
+func lastOpenBundleProposal(pool *types.Pool) *types.BundleProposal {
+	if len(pool.PipelinedProposals) > 0 {
+		return pool.PipelinedProposals[len(pool.PipelinedProposals)-1]
+	}
+
+	return pool.BundleProposal
+}
+
+// uploadRoundStart returns the time from which the upload interval and the upload timeout of the next
+// uploader of a given pool are counted. This is the creation time of the most recent bundle proposal,
+// unless the uploader role was handed over afterwards.
+func uploadRoundStart(pool *types.Pool) uint64 {
+	createdAt := lastOpenBundleProposal(pool).CreatedAt
+
+	if pool.BundleProposal.UploaderAssignedAt > createdAt {
+		return pool.BundleProposal.UploaderAssignedAt
+	}
+
+	return createdAt
+}
+
+// openBundleProposals returns all open bundle proposals of a given pool, starting with the current one.
+func openBundleProposals(pool *types.Pool) []*types.BundleProposal {
+	if pool.BundleProposal == nil {
+		return pool.PipelinedProposals
+	}
+
+	return append([]*types.BundleProposal{pool.BundleProposal}, pool.PipelinedProposals...)
+}
+
+// getOpenBundleProposal searches all open bundle proposals of a given pool for the given storage id.
+func getOpenBundleProposal(pool *types.Pool, storageId string) (*types.BundleProposal, bool) {
+	if pool.BundleProposal.StorageId == storageId {
+		return pool.BundleProposal, true
+	}
+
+	for _, proposal := range pool.PipelinedProposals {
+		if proposal.StorageId == storageId {
+			return proposal, true
+		}
+	}
+
+	return nil, false
+}
+
+// canPipelineBundleProposal checks if another bundle proposal can be submitted
+// while the current bundle proposal has not been finalized yet.
+func canPipelineBundleProposal(pool *types.Pool) bool {
+	// Only real bundle proposals can be chained from.
+	if pool.BundleProposal.StorageId == "" || strings.HasPrefix(pool.BundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+		return false
+	}
+
+	return uint64(1+len(pool.PipelinedProposals)) < pipelineDepth(pool)
+}
+
+// setNextBundleProposal sets the submitted bundle proposal as the new bundle proposal of a given pool.
+// If bundle proposals are pipelined, the oldest one becomes the new bundle proposal instead and the
+// submitted one is chained after the remaining ones. A bundle proposal without data is not chained,
+// as the pipelined bundle proposals already continue from its height.
+func setNextBundleProposal(pool *types.Pool, submitted *types.BundleProposal, nextUploader string) {
+	if len(pool.PipelinedProposals) == 0 {
+		submitted.NextUploader = nextUploader
+		pool.BundleProposal = submitted
+		return
+	}
+
+	pool.BundleProposal = pool.PipelinedProposals[0]
+	pool.BundleProposal.NextUploader = nextUploader
+	pool.PipelinedProposals = pool.PipelinedProposals[1:]
+
+	if !strings.HasPrefix(submitted.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+		submitted.NextUploader = ""
+		pool.PipelinedProposals = append(pool.PipelinedProposals, submitted)
+	}
+}
+
+// dropPipelinedProposals is an internal function that drops all bundle proposals chained after the
+// current bundle proposal. This is required once the current bundle proposal is not finalized as valid.
+func (k Keeper) dropPipelinedProposals(ctx sdk.Context, pool *types.Pool) error {
+	fromHeight := pool.BundleProposal.ToHeight
+
+	for _, proposal := range pool.PipelinedProposals {
+		errEmit := ctx.EventManager().EmitTypedEvent(&types.EventBundleFinalised{
+			PoolId:               pool.Id,
+			StorageId:            proposal.StorageId,
+			ByteSize:             proposal.ByteSize,
+			Uploader:             proposal.Uploader,
+			NextUploader:         proposal.NextUploader,
+			Reward:               sdk.ZeroInt(),
+			VoterReward:          sdk.ZeroInt(),
+			Valid:                sdk.ZeroInt(),
+			Invalid:              sdk.ZeroInt(),
+			FromHeight:           fromHeight,
+			ToHeight:             proposal.ToHeight,
+			Status:               types.BUNDLE_STATUS_DROPPED,
+			ToKey:                proposal.ToKey,
+			ToValue:              proposal.ToValue,
+			Id:                   0,
+			BundleHash:           proposal.BundleHash,
+			Abstain:              sdk.ZeroInt(),
+			Total:                sdk.ZeroInt(),
+			Compression:          proposal.Compression,
+			DataItemCount:        proposal.DataItemCount,
+			UncompressedByteSize: proposal.UncompressedByteSize,
+		})
+		if errEmit != nil {
+			return errEmit
+		}
+
+		fromHeight = proposal.ToHeight
+	}
+
+	pool.PipelinedProposals = nil
+
+	return nil
+}
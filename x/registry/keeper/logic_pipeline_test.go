@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelinedBundleProposals(t *testing.T) {
+	createGenesis(t)
+	testPipelinedBundleProposals(t)
+}
+
+func TestPipelinedNoDataBundles(t *testing.T) {
+	createGenesis(t)
+	testPipelinedNoDataBundles(t)
+}
+
+func submitBundle(creator string, storageId string, fromHeight uint64, toHeight uint64, fromKey string) bool {
+	return runTx(&types.MsgSubmitBundleProposal{
+		Creator:    creator,
+		Id:         0,
+		StorageId:  storageId,
+		ByteSize:   100,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		FromKey:    fromKey,
+		ToKey:      storageId + "_key",
+		ToValue:    storageId + "_value",
+		BundleHash: storageId + "_hash",
+	})
+}
+
+func submitNoDataBundle(creator string, height uint64, fromKey string) bool {
+	return runTx(&types.MsgSubmitBundleProposal{
+		Creator:    creator,
+		Id:         0,
+		StorageId:  types.KYVE_NO_DATA_BUNDLE,
+		FromHeight: height,
+		ToHeight:   height,
+		FromKey:    fromKey,
+	})
+}
+
+func voteBundle(t *testing.T, voter string, storageId string, vote types.VoteType) {
+	runTxSuccess(t, &types.MsgVoteProposal{
+		Creator:   voter,
+		Id:        0,
+		StorageId: storageId,
+		Vote:      vote,
+	})
+}
+
+func getOpenBundleProposals(t *testing.T) []types.OpenBundleProposal {
+	res, err := s.app.RegistryKeeper.OpenBundleProposals(sdk.WrapSDKContext(s.ctx), &types.QueryOpenBundleProposalsRequest{PoolId: 0})
+	require.Nil(t, err)
+	return res.Proposals
+}
+
+func setupPipelinedPool(t *testing.T) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.PipelineDepth = 2
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR, DUMMY_ACCOUNTS[0]} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+}
+
+func testPipelinedBundleProposals(t *testing.T) {
+	setupPipelinedPool(t)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	// The second bundle chains from the first one while it is still open
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	proposals := getOpenBundleProposals(t)
+	require.Len(t, proposals, 2)
+	require.Equal(t, uint64(0), proposals[0].FromHeight)
+	require.Equal(t, "a", proposals[0].BundleProposal.StorageId)
+	require.Equal(t, uint64(10), proposals[1].FromHeight)
+	require.Equal(t, "b", proposals[1].BundleProposal.StorageId)
+
+	// The pipeline is full
+	setNextUploader(DUMMY_ACCOUNTS[0])
+	s.CommitAfterSeconds(60)
+
+	require.False(t, submitBundle(DUMMY_ACCOUNTS[0], "c", 20, 30, "b_key"))
+
+	// Every open bundle proposal has its own voters
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_YES)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Len(t, pool.BundleProposal.VotersValid, 2)
+	require.Len(t, pool.PipelinedProposals[0].VotersValid, 1)
+
+	// Bundles are finalized in order
+	require.True(t, submitBundle(DUMMY_ACCOUNTS[0], "c", 20, 30, "b_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(10), pool.CurrentHeight)
+	require.Equal(t, uint64(1), pool.TotalBundles)
+	require.Equal(t, "b", pool.BundleProposal.StorageId)
+	require.Equal(t, []string{ALICE_ADDR}, pool.BundleProposal.VotersValid)
+
+	_, found := s.app.RegistryKeeper.GetProposal(s.ctx, "a")
+	require.True(t, found)
+
+	proposals = getOpenBundleProposals(t)
+	require.Len(t, proposals, 2)
+	require.Equal(t, uint64(10), proposals[0].FromHeight)
+	require.Equal(t, uint64(20), proposals[1].FromHeight)
+	require.Equal(t, "c", proposals[1].BundleProposal.StorageId)
+
+	// Once a bundle is invalid, all bundles chained after it are dropped
+	voteBundle(t, DUMMY_ACCOUNTS[0], "b", types.VOTE_TYPE_NO)
+
+	setNextUploader(ALICE_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "d", 30, 40, "c_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(10), pool.CurrentHeight)
+	require.Equal(t, "", pool.BundleProposal.StorageId)
+	require.Empty(t, pool.PipelinedProposals)
+	require.Empty(t, getOpenBundleProposals(t))
+
+	_, found = s.app.RegistryKeeper.GetProposal(s.ctx, "b")
+	require.False(t, found)
+}
+
+func testPipelinedNoDataBundles(t *testing.T) {
+	setupPipelinedPool(t)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	// A bundle without data can not be chained after an open bundle proposal
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.False(t, submitNoDataBundle(BOB_ADDR, 10, "a_key"))
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// A bundle without data which finalizes a bundle is not chained after the pipelined bundles
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(DUMMY_ACCOUNTS[0])
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitNoDataBundle(DUMMY_ACCOUNTS[0], 20, "b_key"))
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(10), pool.CurrentHeight)
+	require.Equal(t, "b", pool.BundleProposal.StorageId)
+	require.Empty(t, pool.PipelinedProposals)
+
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "b", types.VOTE_TYPE_YES)
+
+	setNextUploader(ALICE_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "c", 20, 30, "b_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(20), pool.CurrentHeight)
+	require.Equal(t, "c", pool.BundleProposal.StorageId)
+
+	// A bundle without data becomes the bundle proposal once nothing else is open
+	voteBundle(t, BOB_ADDR, "c", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "c", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitNoDataBundle(BOB_ADDR, 30, "c_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(30), pool.CurrentHeight)
+	require.Equal(t, types.KYVE_NO_DATA_BUNDLE, pool.BundleProposal.StorageId)
+	require.Empty(t, pool.PipelinedProposals)
+
+	setNextUploader(DUMMY_ACCOUNTS[0])
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(DUMMY_ACCOUNTS[0], "d", 30, 40, "c_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(30), pool.CurrentHeight)
+	require.Equal(t, "d", pool.BundleProposal.StorageId)
+	require.Empty(t, pool.PipelinedProposals)
+
+	// Bundles chained after a bundle without data move up instead of being left behind
+	pool.PipelinedProposals = []*types.BundleProposal{pool.BundleProposal}
+	pool.BundleProposal = &types.BundleProposal{
+		Uploader:     BOB_ADDR,
+		NextUploader: ALICE_ADDR,
+		StorageId:    types.KYVE_NO_DATA_BUNDLE,
+		ToHeight:     30,
+		CreatedAt:    uint64(s.ctx.BlockTime().Unix()),
+	}
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "e", 40, 50, "d_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(30), pool.CurrentHeight)
+	require.Equal(t, "d", pool.BundleProposal.StorageId)
+	require.Len(t, pool.PipelinedProposals, 1)
+	require.Equal(t, "e", pool.PipelinedProposals[0].StorageId)
+
+	voteBundle(t, ALICE_ADDR, "d", types.VOTE_TYPE_YES)
+	voteBundle(t, BOB_ADDR, "d", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "f", 50, 60, "e_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(40), pool.CurrentHeight)
+	require.Equal(t, "e", pool.BundleProposal.StorageId)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"math"
+	"math/big"
 	"math/rand"
 	"sort"
 
@@ -18,18 +19,18 @@ func containsElement(array []string, element string) bool {
 	return false
 }
 
-// updateLowestFunder is an internal function that updates the lowest funder entry in a given pool.
-func (k Keeper) handleNonVoters(ctx sdk.Context, pool *types.Pool) {
+// handleNonVoters is an internal function that penalizes all stakers who did not vote on the given bundle proposal.
+func (k Keeper) handleNonVoters(ctx sdk.Context, pool *types.Pool, proposal *types.BundleProposal) {
 	nonVoters := make([]string, 0)
 
 	for _, staker := range pool.Stakers {
-		if staker == pool.BundleProposal.Uploader {
+		if staker == proposal.Uploader {
 			continue
 		}
 
-		valid := containsElement(pool.BundleProposal.VotersValid, staker)
-		invalid := containsElement(pool.BundleProposal.VotersInvalid, staker)
-		abstain := containsElement(pool.BundleProposal.VotersAbstain, staker)
+		valid := containsElement(proposal.VotersValid, staker)
+		invalid := containsElement(proposal.VotersInvalid, staker)
+		abstain := containsElement(proposal.VotersAbstain, staker)
 
 		if !valid && !invalid && !abstain {
 			nonVoters = append(nonVoters, staker)
@@ -82,13 +83,13 @@ func (k Keeper) handleNonVoters(ctx sdk.Context, pool *types.Pool) {
 
 // updateLowestFunder is an internal function that updates the lowest funder entry in a given pool.
 func (k Keeper) updateLowestFunder(ctx sdk.Context, pool *types.Pool) {
-	minAmount := uint64(math.Inf(0))
+	minAmount := sdk.ZeroInt()
 	minFunder := ""
 
 	for _, account := range pool.Funders {
 		funder, _ := k.GetFunder(ctx, account, pool.Id)
 
-		if funder.Amount <= minAmount {
+		if minFunder == "" || funder.Amount.LTE(minAmount) {
 			minAmount = funder.Amount
 			minFunder = funder.Account
 		}
@@ -103,13 +104,13 @@ func (k Keeper) UpdateLowestStaker(ctx sdk.Context, pool *types.Pool) {
 
 // updateLowestStaker is an internal function that updates the lowest staker entry in a given pool.
 func (k Keeper) updateLowestStaker(ctx sdk.Context, pool *types.Pool) {
-	minAmount := uint64(math.Inf(0))
+	minAmount := sdk.ZeroInt()
 	minStaker := ""
 
 	for _, account := range pool.Stakers {
 		staker, _ := k.GetStaker(ctx, account, pool.Id)
 
-		if staker.Amount <= minAmount {
+		if minStaker == "" || staker.Amount.LTE(minAmount) {
 			minAmount = staker.Amount
 			minStaker = staker.Account
 		}
@@ -142,7 +143,7 @@ func (k Keeper) removeFunder(ctx sdk.Context, pool *types.Pool, funder *types.Fu
 	k.RemoveFunder(ctx, funder.Account, funder.PoolId)
 
 	// Decrease the pool's total funds.
-	pool.TotalFunds -= funder.Amount
+	pool.TotalFunds = pool.TotalFunds.Sub(funder.Amount)
 }
 
 // removeStaker is an internal function that removes a staker from a given pool.
@@ -152,12 +153,12 @@ func (k Keeper) removeStaker(ctx sdk.Context, pool *types.Pool, staker *types.St
 		pool.Stakers = removeStringFromList(pool.Stakers, staker.Account)
 
 		// Decrease the pool's total stake.
-		pool.TotalStake -= staker.Amount
+		pool.TotalStake = pool.TotalStake.Sub(staker.Amount)
 
 	} else if staker.Status == types.STAKER_STATUS_INACTIVE {
 		pool.InactiveStakers = removeStringFromList(pool.InactiveStakers, staker.Account)
 
-		pool.TotalInactiveStake -= staker.Amount
+		pool.TotalInactiveStake = pool.TotalInactiveStake.Sub(staker.Amount)
 	}
 	k.RemoveStaker(ctx, staker.Account, staker.PoolId)
 }
@@ -165,39 +166,41 @@ func (k Keeper) removeStaker(ctx sdk.Context, pool *types.Pool, staker *types.St
 // RandomChoiceCandidate ...
 type RandomChoiceCandidate struct {
 	Account string
-	Amount  uint64
+	Amount  sdk.Int
 }
 
 // getWeightedRandomChoice is an internal function that returns a random selection out of a list of candidates.
 func (k Keeper) getWeightedRandomChoice(candidates []RandomChoiceCandidate, seed uint64) string {
 	type WeightedRandomChoice struct {
 		Elements    []string
-		Weights     []uint64
-		TotalWeight uint64
+		Weights     []sdk.Int
+		TotalWeight sdk.Int
 	}
 
-	wrc := WeightedRandomChoice{}
+	wrc := WeightedRandomChoice{TotalWeight: sdk.ZeroInt()}
 
 	for _, candidate := range candidates {
-		i := sort.Search(len(wrc.Weights), func(i int) bool { return wrc.Weights[i] > candidate.Amount })
-		wrc.Weights = append(wrc.Weights, 0)
+		i := sort.Search(len(wrc.Weights), func(i int) bool { return wrc.Weights[i].GT(candidate.Amount) })
+		wrc.Weights = append(wrc.Weights, sdk.ZeroInt())
 		wrc.Elements = append(wrc.Elements, "")
 		copy(wrc.Weights[i+1:], wrc.Weights[i:])
 		copy(wrc.Elements[i+1:], wrc.Elements[i:])
 		wrc.Weights[i] = candidate.Amount
 		wrc.Elements[i] = candidate.Account
-		wrc.TotalWeight += candidate.Amount
+		wrc.TotalWeight = wrc.TotalWeight.Add(candidate.Amount)
 	}
 
 	rand.Seed(int64(seed))
-	value := uint64(math.Floor(rand.Float64() * float64(wrc.TotalWeight)))
+	totalWeight, _ := new(big.Float).SetInt(wrc.TotalWeight.BigInt()).Float64()
+	floorValue, _ := big.NewFloat(math.Floor(rand.Float64() * totalWeight)).Int(nil)
+	value := sdk.NewIntFromBigInt(floorValue)
 
 	for key, weight := range wrc.Weights {
-		if weight > value {
+		if weight.GT(value) {
 			return wrc.Elements[key]
 		}
 
-		value -= weight
+		value = value.Sub(weight)
 	}
 
 	return ""
@@ -210,51 +213,68 @@ func (k Keeper) GetUploadProbability(ctx sdk.Context, stakerAddress string, pool
 		return sdk.NewDec(0)
 	}
 
-	totalWeight := uint64(0)
-	userWeight := uint64(0)
+	return k.getUploadProbability(ctx, &pool, stakerAddress, sdk.ZeroInt())
+}
+
+// getUploadProbability returns the upload probability of a staker as if the given
+// additional amount was delegated to it.
+func (k Keeper) getUploadProbability(ctx sdk.Context, pool *types.Pool, stakerAddress string, additionalDelegation sdk.Int) sdk.Dec {
+	totalWeight := sdk.ZeroInt()
+	userWeight := sdk.ZeroInt()
 
 	for _, s := range pool.Stakers {
 		staker, _ := k.GetStaker(ctx, s, pool.Id)
-		delegation, _ := k.GetDelegationPoolData(ctx, pool.Id, s)
+		delegation, found := k.GetDelegationPoolData(ctx, pool.Id, s)
+		if !found {
+			delegation.TotalDelegation = sdk.ZeroInt()
+		}
+
+		if staker.Account == stakerAddress {
+			delegation.TotalDelegation = delegation.TotalDelegation.Add(additionalDelegation)
+		}
 
-		totalWeight += staker.Amount + getDelegationWeight(delegation.TotalDelegation)
+		totalWeight = totalWeight.Add(staker.Amount).Add(getDelegationWeight(delegation.TotalDelegation))
 		if staker.Account == stakerAddress {
-			userWeight = staker.Amount + getDelegationWeight(delegation.TotalDelegation)
+			userWeight = staker.Amount.Add(getDelegationWeight(delegation.TotalDelegation))
 		}
 	}
 
-	return sdk.NewDec(int64(userWeight)).Quo(sdk.NewDec(int64(totalWeight)))
+	if totalWeight.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDecFromInt(userWeight).Quo(sdk.NewDecFromInt(totalWeight))
 }
 
 // Calculate Delegation weight to influnce the upload probability
 // formula:
 // A = 10000, dec = 10**9
 // weight = dec * (sqrt(A * (A + x/dec)) - A)
-func getDelegationWeight(delegation uint64) uint64 {
+func getDelegationWeight(delegation sdk.Int) sdk.Int {
 
-	const A uint64 = 10000
+	A := sdk.NewInt(10000)
 
-	number := A * (A + (delegation / 1_000_000_000))
+	number := A.Mul(A.Add(delegation.QuoRaw(1_000_000_000)))
 
 	// Deterministic sqrt using only int
 	// Uses the babylon recursive formula:
 	// https://en.wikipedia.org/wiki/Methods_of_computing_square_roots#Babylonian_method
-	var x uint64 = 14142 // expected value for 10000 $KYVE as input
-	var xn uint64
-	var epsilon uint64 = 100
-	for epsilon > 2 {
+	x := sdk.NewInt(14142) // expected value for 10000 $KYVE as input
+	var xn sdk.Int
+	epsilon := sdk.NewInt(100)
+	for epsilon.GT(sdk.NewInt(2)) {
 
-		xn = (x + number/x) / 2
+		xn = x.Add(number.Quo(x)).QuoRaw(2)
 
-		if xn > x {
-			epsilon = xn - x
+		if xn.GT(x) {
+			epsilon = xn.Sub(x)
 		} else {
-			epsilon = x - xn
+			epsilon = x.Sub(xn)
 		}
 		x = xn
 	}
 
-	return (x - A) * 1_000_000_000
+	return x.Sub(A).MulRaw(1_000_000_000)
 }
 
 // getNextUploaderByRandom is an internal function that randomly selects the next uploader for a given pool.
@@ -273,7 +293,7 @@ func (k Keeper) getNextUploaderByRandom(ctx sdk.Context, pool *types.Pool, candi
 			if foundDelegation {
 				_candidates = append(_candidates, RandomChoiceCandidate{
 					Account: s,
-					Amount:  staker.Amount + getDelegationWeight(delegation.TotalDelegation),
+					Amount:  staker.Amount.Add(getDelegationWeight(delegation.TotalDelegation)),
 				})
 			} else {
 				_candidates = append(_candidates, RandomChoiceCandidate{
@@ -287,10 +307,12 @@ func (k Keeper) getNextUploaderByRandom(ctx sdk.Context, pool *types.Pool, candi
 	return k.getWeightedRandomChoice(_candidates, uint64(ctx.BlockHeight()+ctx.BlockTime().Unix()))
 }
 
-// slashStaker is an internal function that slashes a staker in a given pool by a certain percentage.
+// slashStaker is an internal function that slashes a staker and its delegators in a given pool by a certain percentage.
+// It returns the amount slashed from the staker itself.
 func (k Keeper) slashStaker(
 	ctx sdk.Context, pool *types.Pool, stakerAddress string, slashAmountRatioDecimalString string,
-) (slash uint64) {
+) (slash sdk.Int) {
+	slash = sdk.ZeroInt()
 	staker, found := k.GetStaker(ctx, stakerAddress, pool.Id)
 
 	if found {
@@ -301,60 +323,68 @@ func (k Keeper) slashStaker(
 		}
 
 		// Compute how much we're going to slash the staker.
-		slash = uint64(sdk.NewDec(int64(staker.Amount)).Mul(slashAmountRatio).RoundInt64())
+		slash = sdk.NewDecFromInt(staker.Amount).Mul(slashAmountRatio).RoundInt()
 
-		if staker.Amount == slash {
+		if staker.Amount.Equal(slash) {
 			// If we are slashing the entire staking amount, remove the staker.
 			k.removeStaker(ctx, pool, &staker)
 		} else {
 			// Subtract slashing amount from staking amount, and update the pool's total stake.
-			staker.Amount = staker.Amount - slash
+			staker.Amount = staker.Amount.Sub(slash)
 			k.SetStaker(ctx, staker)
 
-			pool.TotalStake -= slash
+			pool.TotalStake = pool.TotalStake.Sub(slash)
 		}
 
+		// Slash the delegators of the staker by the same fraction.
+		delegationSlash := k.slashDelegation(ctx, pool, stakerAddress, slashAmountRatio)
+
 		// Transfer the slashed amount to the treasury.
-		err = k.transferToTreasury(ctx, slash)
+		err = k.transferToTreasury(ctx, slash.Add(delegationSlash))
 		if err != nil {
 			k.PanicHalt(ctx, err.Error())
 		}
+
+		// The slashed amount is never returned to the staker.
+		k.trackUndelegation(ctx, stakerAddress, slash)
 	}
 
 	return slash
 }
 
 // getVoteDistribution is an internal function evaulates the quorum status of a bundle proposal.
-func (k Keeper) getVoteDistribution(ctx sdk.Context, pool *types.Pool) (valid uint64, invalid uint64, abstain uint64, total uint64) {
+func (k Keeper) getVoteDistribution(ctx sdk.Context, pool *types.Pool, proposal *types.BundleProposal) (valid sdk.Int, invalid sdk.Int, abstain sdk.Int, total sdk.Int) {
+	valid, invalid, abstain = sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+
 	// get $KYVE voted for valid
-	for _, voter := range pool.BundleProposal.VotersValid {
+	for _, voter := range proposal.VotersValid {
 		staker, found := k.GetStaker(ctx, voter, pool.Id)
 		if found && staker.Status == types.STAKER_STATUS_ACTIVE {
-			valid += staker.Amount
+			valid = valid.Add(staker.Amount)
 		}
 	}
 
 	// get $KYVE voted for invalid
-	for _, voter := range pool.BundleProposal.VotersInvalid {
+	for _, voter := range proposal.VotersInvalid {
 		staker, found := k.GetStaker(ctx, voter, pool.Id)
 		if found && staker.Status == types.STAKER_STATUS_ACTIVE {
-			invalid += staker.Amount
+			invalid = invalid.Add(staker.Amount)
 		}
 	}
 
 	// get $KYVE voted for abstain
-	for _, voter := range pool.BundleProposal.VotersAbstain {
+	for _, voter := range proposal.VotersAbstain {
 		staker, found := k.GetStaker(ctx, voter, pool.Id)
 		if found && staker.Status == types.STAKER_STATUS_ACTIVE {
-			abstain += staker.Amount
+			abstain = abstain.Add(staker.Amount)
 		}
 	}
 
 	// subtract uploader stake because he can not vote
-	uploader, found := k.GetStaker(ctx, pool.BundleProposal.Uploader, pool.Id)
+	uploader, found := k.GetStaker(ctx, proposal.Uploader, pool.Id)
 
 	if found {
-		total = pool.TotalStake - uploader.Amount
+		total = pool.TotalStake.Sub(uploader.Amount)
 	} else {
 		total = pool.TotalStake
 	}
@@ -368,12 +398,12 @@ func (k Keeper) getVoteDistribution(ctx sdk.Context, pool *types.Pool) (valid ui
 }
 
 // getQuorumStatus is an internal function evaulates if quorum was reached on a bundle proposal.
-func (k Keeper) getQuorumStatus(valid uint64, invalid uint64, abstain uint64, total uint64) (quorum types.BundleStatus) {
-	if valid*2 > total {
+func (k Keeper) getQuorumStatus(valid sdk.Int, invalid sdk.Int, abstain sdk.Int, total sdk.Int) (quorum types.BundleStatus) {
+	if valid.MulRaw(2).GT(total) {
 		return types.BUNDLE_STATUS_VALID
 	}
 
-	if invalid*2 >= total {
+	if invalid.MulRaw(2).GTE(total) {
 		return types.BUNDLE_STATUS_INVALID
 	}
 
@@ -395,8 +425,8 @@ func deactivateStaker(pool *types.Pool, staker *types.Staker) {
 		// make user an inactive staker
 		pool.Stakers = removeStringFromList(pool.Stakers, staker.Account)
 		pool.InactiveStakers = append(pool.InactiveStakers, staker.Account)
-		pool.TotalStake -= staker.Amount
-		pool.TotalInactiveStake += staker.Amount
+		pool.TotalStake = pool.TotalStake.Sub(staker.Amount)
+		pool.TotalInactiveStake = pool.TotalInactiveStake.Add(staker.Amount)
 		staker.Status = types.STAKER_STATUS_INACTIVE
 	}
 }
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// averageBundleCostWindow is the number of recent bundles the moving average of the bundle cost roughly covers.
+const averageBundleCostWindow = 10
+
+// getAverageBundleCost adds the cost of a finalized bundle to the moving average of the bundle cost.
+func getAverageBundleCost(average sdk.Int, bundleCost sdk.Int) sdk.Int {
+	if average.IsNil() || average.IsZero() {
+		return bundleCost
+	}
+
+	return average.Add(bundleCost.Sub(average).QuoRaw(averageBundleCostWindow))
+}
+
+// getPoolRunway projects how many funds a pool spends per day and how many seconds its funds last.
+// Until a bundle was finalized the cost of a bundle is estimated with the operating cost of the pool.
+// Funding streams pay at most their daily limit until their budget is spent, the funders pay the rest.
+// If the pool spends nothing the runway is unlimited.
+func getPoolRunway(pool *types.Pool, streams []types.FundingStream) (burnRate sdk.Int, runway uint64) {
+	bundleCost := pool.OperatingCost
+	if !pool.AverageBundleCost.IsNil() && pool.AverageBundleCost.IsPositive() {
+		bundleCost = pool.AverageBundleCost
+	}
+
+	uploadInterval := pool.UploadInterval
+	if uploadInterval == 0 {
+		uploadInterval = 1
+	}
+
+	burnRate = bundleCost.Mul(sdk.NewIntFromUint64(secondsPerDay)).Quo(sdk.NewIntFromUint64(uploadInterval))
+	if !burnRate.IsPositive() {
+		return sdk.ZeroInt(), ^uint64(0)
+	}
+
+	// All amounts are scaled by the length of a day, so that they can be compared with daily rates over seconds.
+	day := sdk.NewIntFromUint64(secondsPerDay)
+	funds := pool.TotalFunds.Mul(day)
+
+	// Funding streams without a daily limit are able to pay with their whole budget at once.
+	streamRate := sdk.ZeroInt()
+	limits := make(map[uint64]sdk.Int)
+
+	var limitedStreams []types.FundingStream
+	for _, stream := range streams {
+		limit := getFundingStreamDailyLimit(&stream, uploadInterval)
+		if limit.IsPositive() {
+			limits[stream.Id] = limit
+			limitedStreams = append(limitedStreams, stream)
+			streamRate = streamRate.Add(limit)
+		} else {
+			funds = funds.Add(stream.Amount.Mul(day))
+		}
+	}
+
+	// Funding streams with a daily limit run out of budget in the order of their own runway.
+	sort.SliceStable(limitedStreams, func(i, j int) bool {
+		a, b := limitedStreams[i], limitedStreams[j]
+		return a.Amount.Mul(limits[b.Id]).LT(b.Amount.Mul(limits[a.Id]))
+	})
+
+	// As long as a funding stream has budget left, it reduces the burn rate of the remaining funds by its daily limit.
+	for _, stream := range limitedStreams {
+		if burnRate.GT(streamRate) {
+			seconds := funds.Quo(burnRate.Sub(streamRate))
+			if seconds.LTE(stream.Amount.Mul(day).Quo(limits[stream.Id])) {
+				return burnRate, getRunwaySeconds(seconds)
+			}
+		}
+
+		funds = funds.Add(stream.Amount.Mul(day))
+		streamRate = streamRate.Sub(limits[stream.Id])
+	}
+
+	return burnRate, getRunwaySeconds(funds.Quo(burnRate))
+}
+
+// getFundingStreamDailyLimit returns the maximum amount a funding stream is able to spend per day.
+// If the funding stream has no spending limits, zero is returned.
+func getFundingStreamDailyLimit(stream *types.FundingStream, uploadInterval uint64) sdk.Int {
+	limit := stream.AmountPerDay
+
+	if stream.AmountPerBundle.IsPositive() {
+		bundleLimit := stream.AmountPerBundle.Mul(sdk.NewIntFromUint64(secondsPerDay)).Quo(sdk.NewIntFromUint64(uploadInterval))
+		if !limit.IsPositive() || bundleLimit.LT(limit) {
+			limit = bundleLimit
+		}
+	}
+
+	return limit
+}
+
+// getRunwaySeconds converts the projected runway into seconds, a runway which exceeds the range is unlimited.
+func getRunwaySeconds(seconds sdk.Int) uint64 {
+	if !seconds.IsUint64() {
+		return ^uint64(0)
+	}
+
+	return seconds.Uint64()
+}
+
+// updatePoolRunway updates the burn rate and runway of a pool and emits an alert once the runway
+// falls below another runway alert threshold. It returns true if the pool has changed.
+func (k Keeper) updatePoolRunway(ctx sdk.Context, pool *types.Pool) bool {
+	burnRate, runway := getPoolRunway(pool, k.GetFundingStreamsByPool(ctx, pool.Id))
+
+	// The alert level is the number of thresholds the runway is below.
+	thresholds := k.RunwayAlertThresholds(ctx)
+	alertLevel := uint64(0)
+	for _, threshold := range thresholds {
+		if runway < threshold {
+			alertLevel++
+		}
+	}
+
+	// Only alert when the runway gets worse, a recovered pool alerts again once it falls below a threshold.
+	if alertLevel > pool.RunwayAlertLevel {
+		ctx.EventManager().EmitTypedEvent(&types.EventPoolRunwayAlert{
+			PoolId:     pool.Id,
+			Runway:     runway,
+			Threshold:  thresholds[alertLevel-1],
+			TotalFunds: pool.TotalFunds,
+			BurnRate:   burnRate,
+		})
+	}
+
+	changed := pool.BurnRate.IsNil() || !pool.BurnRate.Equal(burnRate) || pool.Runway != runway || pool.RunwayAlertLevel != alertLevel
+
+	pool.BurnRate = burnRate
+	pool.Runway = runway
+	pool.RunwayAlertLevel = alertLevel
+
+	return changed
+}
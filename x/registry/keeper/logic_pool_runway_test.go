@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolRunway(t *testing.T) {
+	createGenesis(t)
+	testPoolRunway(t)
+}
+
+func TestPoolRunwayWithFundingStreams(t *testing.T) {
+	createGenesis(t)
+	testPoolRunwayWithFundingStreams(t)
+}
+
+func testPoolRunway(t *testing.T) {
+	// Thresholds have to be in descending order
+	params := types.DefaultParams()
+	params.RunwayAlertThresholds = []uint64{60, 120}
+	require.Error(t, params.Validate())
+
+	// A bundle costs the operating cost of 100 until the first bundle is finalized
+	burnRate := uint64(100 * 24 * 60)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, sdk.NewIntFromUint64(burnRate).Equal(pool.BurnRate))
+	require.Equal(t, uint64(0), pool.Runway)
+	require.Equal(t, uint64(3), pool.RunwayAlertLevel)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(10 * burnRate),
+	})
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(10*24*60*60), pool.Runway)
+	require.Equal(t, uint64(0), pool.RunwayAlertLevel)
+
+	// The runway falls below the first threshold of seven days
+	runTxSuccess(t, &types.MsgDefundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(5 * burnRate),
+	})
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(5*24*60*60), pool.Runway)
+	require.Equal(t, uint64(1), pool.RunwayAlertLevel)
+
+	// The runway falls below all thresholds at once
+	runTxSuccess(t, &types.MsgDefundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(9 * burnRate / 2),
+	})
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(12*60*60), pool.Runway)
+	require.Equal(t, uint64(3), pool.RunwayAlertLevel)
+}
+
+func testPoolRunwayWithFundingStreams(t *testing.T) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.OperatingCost = sdk.NewInt(1_000_000)
+	pool.UploadInterval = 7
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	// The burn rate is not truncated by the upload interval
+	burnRate := uint64(1_000_000 * 24 * 60 * 60 / 7)
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, sdk.NewIntFromUint64(burnRate).Equal(pool.BurnRate))
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(5 * burnRate),
+	})
+
+	// The funding stream pays half of the burn rate for four days
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         BOB_ADDR,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(2 * burnRate),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.NewIntFromUint64(burnRate / 2),
+	})
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(7*24*60*60), pool.Runway)
+
+	// A funding stream without limits adds its whole budget
+	runTxSuccess(t, &types.MsgCreateFundingStream{
+		Creator:         BOB_ADDR,
+		Id:              0,
+		Amount:          sdk.NewIntFromUint64(burnRate),
+		AmountPerBundle: sdk.ZeroInt(),
+		AmountPerDay:    sdk.ZeroInt(),
+	})
+
+	s.Commit()
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(8*24*60*60), pool.Runway)
+}
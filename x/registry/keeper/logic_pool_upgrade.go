@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// applyPoolUpgrade is an internal function that switches a pool to the version of its upgrade plan.
+// The previous version is kept, so that the upgrade can be rolled back if the pool does not finalize
+// a bundle within the rollback window after the upgrade window.
+func (k Keeper) applyPoolUpgrade(ctx sdk.Context, pool *types.Pool) {
+	previousVersion := pool.Protocol.Version
+
+	pool.Protocol.PreviousVersion = pool.Protocol.Version
+	pool.Protocol.PreviousBinaries = pool.Protocol.Binaries
+	pool.Protocol.Version = pool.UpgradePlan.Version
+	pool.Protocol.Binaries = pool.UpgradePlan.Binaries
+	pool.Protocol.LastUpgrade = pool.UpgradePlan.ScheduledAt
+
+	pool.Protocol.RollbackAt = 0
+	if pool.UpgradePlan.RollbackWindow > 0 {
+		pool.Protocol.RollbackAt = pool.UpgradePlan.ScheduledAt + pool.UpgradePlan.Duration + pool.UpgradePlan.RollbackWindow
+		pool.Protocol.RollbackTotalBundles = pool.TotalBundles
+		pool.Protocol.RollbackWindow = pool.UpgradePlan.RollbackWindow
+	}
+
+	ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgraded{
+		PoolId:          pool.Id,
+		Version:         pool.Protocol.Version,
+		PreviousVersion: previousVersion,
+	})
+}
+
+// handleUpgradeRollbacks is an internal function that checks all monitored pool upgrades.
+// An upgrade is confirmed as soon as the pool finalizes a bundle. Otherwise, the pool is rolled back
+// to its previous version once the rollback window is over and all pending upgrades to the failed
+// version of the same runtime are cancelled. The rollback window is extended as long as the pool
+// is not able to produce bundles for other reasons.
+func (k Keeper) handleUpgradeRollbacks(ctx sdk.Context) {
+	for _, p := range k.GetAllPool(ctx) {
+		// Fetch the pool again, as a rollback can cancel the upgrade of other pools.
+		pool, _ := k.GetPool(ctx, p.Id)
+
+		if pool.Protocol == nil || pool.Protocol.RollbackAt == 0 {
+			continue
+		}
+
+		if pool.TotalBundles > pool.Protocol.RollbackTotalBundles {
+			pool.Protocol.RollbackAt = 0
+			k.SetPool(ctx, pool)
+
+			ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeConfirmed{
+				PoolId:  pool.Id,
+				Version: pool.Protocol.Version,
+			})
+
+			continue
+		}
+
+		// A pool which can not produce bundles keeps a full rollback window.
+		if !k.canProduceBundles(ctx, &pool) {
+			if extendedAt := uint64(ctx.BlockTime().Unix()) + pool.Protocol.RollbackWindow; extendedAt > pool.Protocol.RollbackAt {
+				pool.Protocol.RollbackAt = extendedAt
+				k.SetPool(ctx, pool)
+			}
+
+			continue
+		}
+
+		if uint64(ctx.BlockTime().Unix()) < pool.Protocol.RollbackAt {
+			continue
+		}
+
+		failedVersion := pool.Protocol.Version
+
+		pool.Protocol.Version = pool.Protocol.PreviousVersion
+		pool.Protocol.Binaries = pool.Protocol.PreviousBinaries
+		pool.Protocol.LastUpgrade = uint64(ctx.BlockTime().Unix())
+		pool.Protocol.RollbackAt = 0
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeRolledBack{
+			PoolId:          pool.Id,
+			Version:         failedVersion,
+			RestoredVersion: pool.Protocol.Version,
+		})
+
+		k.cancelPendingUpgrades(ctx, pool.Runtime, failedVersion)
+	}
+}
+
+// canProduceBundles is an internal function that checks if a pool is able to finalize bundles,
+// which requires that it is not paused, has enough stakers and stake, and is funded.
+func (k Keeper) canProduceBundles(ctx sdk.Context, pool *types.Pool) bool {
+	if pool.Paused || len(pool.Stakers) < 2 || pool.TotalStake.LT(pool.MinStake) {
+		return false
+	}
+
+	return !pool.TotalFunds.IsZero() || k.hasActiveFundingStreams(ctx, pool.Id)
+}
+
+// cancelPendingUpgrades is an internal function that cancels all upgrades to the given version
+// of a runtime which have not been applied yet. This includes upgrades which are due in the
+// current block, as pool upgrades are applied after the rollbacks have been handled.
+func (k Keeper) cancelPendingUpgrades(ctx sdk.Context, runtime string, version string) {
+	for _, pool := range k.GetAllPool(ctx) {
+		if pool.Runtime != runtime || pool.UpgradePlan.Version != version {
+			continue
+		}
+
+		// Applied upgrades are monitored on their own.
+		if pool.Protocol.Version == version {
+			continue
+		}
+
+		pool.UpgradePlan = &types.UpgradePlan{}
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeCancelled{
+			PoolId:  pool.Id,
+			Version: version,
+		})
+	}
+}
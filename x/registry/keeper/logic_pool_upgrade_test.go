@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolUpgrade(t *testing.T) {
+	createGenesis(t)
+	testPoolUpgrade(t)
+}
+
+func TestPoolUpgradeRollbackInSameBlock(t *testing.T) {
+	createGenesis(t)
+	testPoolUpgradeRollbackInSameBlock(t)
+}
+
+func TestPoolUpgradeRollbackOfPausedPool(t *testing.T) {
+	createGenesis(t)
+	testPoolUpgradeRollbackOfPausedPool(t)
+}
+
+// setupProducingPool funds a pool and adds enough stakers, so that it is able to produce bundles.
+func setupProducingPool(t *testing.T, poolId uint64) {
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      poolId,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      poolId,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+}
+
+func testPoolUpgrade(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	s.app.RegistryKeeper.AppendPool(s.ctx, pool)
+
+	setupProducingPool(t, 0)
+	setupProducingPool(t, 1)
+
+	// The canary delay has to exceed the upgrade duration and rollback window
+	require.Error(t, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", 0, 60, "{}", []uint64{0}, 100, 120).ValidateBasic())
+	require.Error(t, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", 0, 60, "{}", []uint64{0}, 180, 120).ValidateBasic())
+	require.NoError(t, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", 0, 60, "{}", []uint64{0}, 181, 120).ValidateBasic())
+	require.Error(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", 0, 60, "{}", []uint64{5}, 600, 120)))
+
+	scheduledAt := uint64(s.ctx.BlockTime().Unix())
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", scheduledAt, 60, "{}", []uint64{0}, 600, 120)))
+
+	// Only the canary pool upgrades first
+	canary, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, canary.UpgradePlan.Canary)
+	require.Equal(t, scheduledAt, canary.UpgradePlan.ScheduledAt)
+
+	other, _ := s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.False(t, other.UpgradePlan.Canary)
+	require.Equal(t, scheduledAt+600, other.UpgradePlan.ScheduledAt)
+
+	s.CommitAfterSeconds(1)
+
+	canary, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "2.0.0", canary.Protocol.Version)
+	require.Equal(t, "1.3.0", canary.Protocol.PreviousVersion)
+	require.Equal(t, scheduledAt+180, canary.Protocol.RollbackAt)
+
+	other, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "1.3.0", other.Protocol.Version)
+
+	// The canary does not finalize a bundle within the rollback window
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(120)
+	s.CommitAfterSeconds(1)
+
+	canary, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.0", canary.Protocol.Version)
+	require.Equal(t, uint64(0), canary.Protocol.RollbackAt)
+
+	// The remaining pools do not upgrade anymore
+	other, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, uint64(0), other.UpgradePlan.ScheduledAt)
+
+	s.CommitAfterSeconds(600)
+
+	other, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "1.3.0", other.Protocol.Version)
+
+	// An upgrade is confirmed once the pool finalizes a bundle
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.1", uint64(s.ctx.BlockTime().Unix()), 60, "{}", nil, 0, 120)))
+
+	s.CommitAfterSeconds(1)
+	s.CommitAfterSeconds(60)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "2.0.1", pool.Protocol.Version)
+	pool.TotalBundles = pool.TotalBundles + 1
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	s.CommitAfterSeconds(120)
+	s.CommitAfterSeconds(1)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "2.0.1", pool.Protocol.Version)
+	require.Equal(t, uint64(0), pool.Protocol.RollbackAt)
+
+	canary, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.0", canary.Protocol.Version)
+}
+
+func testPoolUpgradeRollbackInSameBlock(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	s.app.RegistryKeeper.AppendPool(s.ctx, pool)
+
+	setupProducingPool(t, 0)
+	setupProducingPool(t, 1)
+
+	// The remaining pools are scheduled right after the rollback window of the canary
+	scheduledAt := uint64(s.ctx.BlockTime().Unix())
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", scheduledAt, 60, "{}", []uint64{0}, 181, 120)))
+
+	s.CommitAfterSeconds(1)
+
+	canary, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "2.0.0", canary.Protocol.Version)
+	require.Equal(t, scheduledAt+180, canary.Protocol.RollbackAt)
+
+	// No block is produced until both the rollback and the upgrade of the remaining pools are due
+	s.CommitAfterSeconds(300)
+	s.CommitAfterSeconds(1)
+
+	canary, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.0", canary.Protocol.Version)
+	require.Equal(t, uint64(0), canary.Protocol.RollbackAt)
+	require.Equal(t, uint64(0), canary.UpgradePlan.ScheduledAt)
+
+	other, _ := s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "1.3.0", other.Protocol.Version)
+	require.Equal(t, uint64(0), other.UpgradePlan.ScheduledAt)
+
+	s.CommitAfterSeconds(1)
+
+	canary, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.0", canary.Protocol.Version)
+
+	other, _ = s.app.RegistryKeeper.GetPool(s.ctx, 1)
+	require.Equal(t, "1.3.0", other.Protocol.Version)
+}
+
+func testPoolUpgradeRollbackOfPausedPool(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	setupProducingPool(t, 0)
+
+	scheduledAt := uint64(s.ctx.BlockTime().Unix())
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "2.0.0", scheduledAt, 60, "{}", nil, 0, 120)))
+
+	s.CommitAfterSeconds(1)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "2.0.0", pool.Protocol.Version)
+	require.Equal(t, scheduledAt+180, pool.Protocol.RollbackAt)
+
+	pool.Paused = true
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	// A paused pool is not rolled back, its rollback window is extended instead
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(120)
+	s.CommitAfterSeconds(1)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "2.0.0", pool.Protocol.Version)
+	require.Equal(t, uint64(s.ctx.BlockTime().Unix())+120-1, pool.Protocol.RollbackAt)
+
+	// Once the pool is unpaused, it has the full rollback window to finalize a bundle
+	pool.Paused = false
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	s.CommitAfterSeconds(60)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "2.0.0", pool.Protocol.Version)
+
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(1)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.0", pool.Protocol.Version)
+	require.Equal(t, uint64(0), pool.Protocol.RollbackAt)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// HandleProtocolFunding is a begin block hook that credits the allocations of public-good pools
+// from inflation and the community pool to the protocol funder of every pool. It has to run after
+// x/mint and before x/distribution, so that the tokens minted in this block are still in the fee collector.
+func (k Keeper) HandleProtocolFunding(ctx sdk.Context) {
+	fundings := k.GetAllProtocolFundings(ctx)
+	if len(fundings) == 0 {
+		return
+	}
+
+	blockProvision := k.mintKeeper.GetMinter(ctx).BlockProvision(k.mintKeeper.GetParams(ctx))
+	protocolFunder := types.ProtocolFunderAddress()
+
+	for _, funding := range fundings {
+		pool, found := k.GetPool(ctx, funding.PoolId)
+		if !found || pool.Paused || pool.Status == types.POOL_STATUS_COMPLETED || pool.Status == types.POOL_STATUS_RETIRED {
+			continue
+		}
+
+		// Nothing is allocated while all funder slots of the pool are taken by other funders.
+		funder, isFunder := k.GetFunder(ctx, protocolFunder, pool.Id)
+		if !isFunder && len(pool.Funders) >= types.MaxFunders {
+			continue
+		}
+
+		amount := sdk.ZeroInt()
+
+		inflationShare, _ := types.ParseInflationShare(funding.InflationShare)
+		if inflationShare.IsPositive() && blockProvision.Denom == "tkyve" {
+			inflation := sdk.NewDecFromInt(blockProvision.Amount).Mul(inflationShare).TruncateInt()
+			coins := sdk.NewCoins(sdk.NewCoin("tkyve", inflation))
+
+			if inflation.IsPositive() {
+				if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+					k.Logger(ctx).Error("failed to allocate inflation to protocol funding", "poolId", pool.Id, "amount", inflation, "err", err)
+				} else {
+					amount = amount.Add(inflation)
+				}
+			}
+		}
+
+		if funding.CommunityPoolAmount.IsPositive() {
+			amount = amount.Add(k.drawFromCommunityPool(ctx, pool.Id, funding.CommunityPoolAmount))
+		}
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if isFunder {
+			funder.Amount = funder.Amount.Add(amount)
+		} else {
+			pool.Funders = append(pool.Funders, protocolFunder)
+			funder = types.Funder{
+				Account: protocolFunder,
+				PoolId:  pool.Id,
+				Amount:  amount,
+			}
+		}
+
+		k.SetFunder(ctx, funder)
+
+		pool.TotalFunds = pool.TotalFunds.Add(amount)
+		k.updateLowestFunder(ctx, &pool)
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitTypedEvent(&types.EventFundPool{
+			PoolId:  pool.Id,
+			Address: protocolFunder,
+			Amount:  amount,
+		})
+	}
+}
+
+// drawFromCommunityPool transfers up to the given amount from the community pool to this module
+// for the given pool and returns the amount which was actually drawn.
+func (k Keeper) drawFromCommunityPool(ctx sdk.Context, poolId uint64, amount sdk.Int) sdk.Int {
+	feePool := k.distrKeeper.GetFeePool(ctx)
+
+	amount = sdk.MinInt(amount, feePool.CommunityPool.AmountOf("tkyve").TruncateInt())
+	if !amount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin("tkyve", amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, coins); err != nil {
+		k.Logger(ctx).Error("failed to draw protocol funding from community pool", "poolId", poolId, "amount", amount, "err", err)
+		return sdk.ZeroInt()
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(coins...))
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	return amount
+}
+
+// refundFunder returns the remaining funds of a funder. The funds of the protocol funder go back to the community pool.
+func (k Keeper) refundFunder(ctx sdk.Context, funder *types.Funder) error {
+	if funder.Account == types.ProtocolFunderAddress() {
+		return k.transferToTreasury(ctx, funder.Amount)
+	}
+
+	return k.undelegateToAddress(ctx, funder.Account, funder.Amount)
+}
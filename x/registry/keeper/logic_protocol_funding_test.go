@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolFunding(t *testing.T) {
+	createGenesis(t)
+	testProtocolFunding(t)
+}
+
+func testProtocolFunding(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	// Inflation shares have to be between zero and one
+	require.Error(t, types.NewSetProtocolFundingProposal("title", "description", 0, "1.5", 0).ValidateBasic())
+	require.Error(t, types.NewSetProtocolFundingProposal("title", "description", 0, "-0.1", 0).ValidateBasic())
+	require.Error(t, types.NewSetProtocolFundingProposal("title", "description", 0, "abc", 0).ValidateBasic())
+
+	// The pool has to exist
+	require.Error(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 1, "", 100)))
+
+	// Setting the funding again replaces it, the total inflation share of all pools can be at most one
+	require.NoError(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 0, "0.6", 0)))
+	require.NoError(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 0, "0.8", 0)))
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	s.app.RegistryKeeper.AppendPool(s.ctx, pool)
+
+	require.Error(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 1, "0.3", 0)))
+
+	// Zero for both allocations removes the protocol funding
+	require.NoError(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 0, "0", 0)))
+	require.Empty(t, s.app.RegistryKeeper.GetAllProtocolFundings(s.ctx))
+
+	// Pool 0 receives 100 tkyve per block from the community pool
+	alice, _ := sdk.AccAddressFromBech32(ALICE_ADDR)
+	require.NoError(t, s.app.DistrKeeper.FundCommunityPool(s.ctx, sdk.NewCoins(sdk.NewInt64Coin("tkyve", 250)), alice))
+	require.NoError(t, handler(s.ctx, types.NewSetProtocolFundingProposal("title", "description", 0, "", 100)))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	totalFunds := pool.TotalFunds
+
+	s.Commit()
+
+	funder, found := s.app.RegistryKeeper.GetFunder(s.ctx, types.ProtocolFunderAddress(), 0)
+	require.True(t, found)
+	require.True(t, sdk.NewInt(100).Equal(funder.Amount))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Contains(t, pool.Funders, types.ProtocolFunderAddress())
+	require.True(t, totalFunds.AddRaw(100).Equal(pool.TotalFunds))
+
+	// Paused pools receive nothing
+	pool.Paused = true
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	s.Commit()
+
+	funder, _ = s.app.RegistryKeeper.GetFunder(s.ctx, types.ProtocolFunderAddress(), 0)
+	require.True(t, sdk.NewInt(100).Equal(funder.Amount))
+
+	// The funds of the protocol funder go back to the community pool when the pool is retired
+	require.NoError(t, s.app.RegistryKeeper.RetirePool(s.ctx, 0))
+	require.Empty(t, s.app.RegistryKeeper.GetAllProtocolFundings(s.ctx))
+
+	_, found = s.app.RegistryKeeper.GetFunder(s.ctx, types.ProtocolFunderAddress(), 0)
+	require.False(t, found)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/mod/semver"
+)
+
+// isVersionReady checks if an attested version is at least the given target version.
+// Stakers which never attested a version are never ready.
+func isVersionReady(attestedVersion string, targetVersion string) bool {
+	return semver.Compare(types.CanonicalVersion(attestedVersion), types.CanonicalVersion(targetVersion)) >= 0
+}
+
+// validateProtocolVersion checks if a staker runs at least the protocol version of the pool.
+// The version is only enforced once an upgrade window of the pool has passed.
+func validateProtocolVersion(pool *types.Pool, staker *types.Staker) error {
+	if pool.Protocol == nil || !pool.Protocol.VersionEnforced {
+		return nil
+	}
+
+	if !isVersionReady(staker.Version, pool.Protocol.Version) {
+		return sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrProtocolVersionOutdated.Error(), staker.Version, pool.Protocol.Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolVersion(t *testing.T) {
+	createGenesis(t)
+	testProtocolVersion(t)
+}
+
+func testProtocolVersion(t *testing.T) {
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	require.False(t, runTx(types.NewMsgAttestVersion(ALICE_ADDR, 0, "latest")))
+
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "1.4.0", uint64(s.ctx.BlockTime().Unix()), 60, "{}", nil, 0, 0)))
+
+	s.CommitAfterSeconds(1)
+
+	// Stakers can attest their version during the upgrade
+	runTxSuccess(t, types.NewMsgAttestVersion(ALICE_ADDR, 0, "1.4.0"))
+
+	res, err := s.app.RegistryKeeper.UpgradeReadiness(sdk.WrapSDKContext(s.ctx), &types.QueryUpgradeReadinessRequest{PoolId: 0})
+	require.Nil(t, err)
+	require.Equal(t, "1.4.0", res.TargetVersion)
+	require.Len(t, res.Stakers, 2)
+	require.Equal(t, sdk.NewIntFromUint64(100*KYVE), res.ReadyStake)
+	require.Equal(t, sdk.NewIntFromUint64(200*KYVE), res.TotalStake)
+
+	// Once the upgrade window has passed the version is enforced
+	s.CommitAfterSeconds(60)
+	s.CommitAfterSeconds(1)
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.4.0", pool.Protocol.Version)
+	require.True(t, pool.Protocol.VersionEnforced)
+
+	require.False(t, runTx(&types.MsgClaimUploaderRole{
+		Creator: BOB_ADDR,
+		Id:      0,
+	}))
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	vote := &types.MsgVoteProposal{
+		Creator:   BOB_ADDR,
+		Id:        0,
+		StorageId: "a",
+		Vote:      types.VOTE_TYPE_YES,
+	}
+
+	require.False(t, runTx(vote))
+
+	runTxSuccess(t, types.NewMsgAttestVersion(BOB_ADDR, 0, "v1.4.1"))
+	runTxSuccess(t, vote)
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -16,13 +17,13 @@ func testRedelegation(t *testing.T) {
 	runTxSuccess(t, &types.MsgStakePool{
 		Creator: BOB_ADDR,
 		Id:      0,
-		Amount:  50,
+		Amount:  sdk.NewInt(50),
 	})
 
 	runTxSuccess(t, &types.MsgStakePool{
 		Creator: ALICE_ADDR,
 		Id:      0,
-		Amount:  50,
+		Amount:  sdk.NewInt(50),
 	})
 
 	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
@@ -33,14 +34,14 @@ func testRedelegation(t *testing.T) {
 		Creator: DUMMY_ACCOUNTS[0],
 		Id:      0,
 		Staker:  BOB_ADDR,
-		Amount:  100 * KYVE,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
 	})
 	s.Commit()
 
 	delegator, found := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
 
 	require.True(t, found)
-	require.Equal(t, 100*KYVE, delegator.DelegationAmount)
+	require.Equal(t, 100*KYVE, delegator.DelegationAmount.Uint64())
 
 	// Redelegate to staker that does not exist; even though it does not make sense it is allowed
 	redelegateRes := runTx(&types.MsgRedelegatePool{
@@ -49,7 +50,7 @@ func testRedelegation(t *testing.T) {
 		FromStaker: BOB_ADDR,
 		ToPoolId:   0,
 		ToStaker:   DUMMY_ACCOUNTS[2],
-		Amount:     50 * KYVE,
+		Amount:     sdk.NewIntFromUint64(50 * KYVE),
 	})
 	require.True(t, redelegateRes)
 
@@ -60,37 +61,47 @@ func testRedelegation(t *testing.T) {
 		FromStaker: DUMMY_ACCOUNTS[0],
 		ToPoolId:   0,
 		ToStaker:   ALICE_ADDR,
-		Amount:     50 * KYVE,
+		Amount:     sdk.NewIntFromUint64(50 * KYVE),
 	})
 	require.False(t, res)
 
 	s.Commit()
-	// cannot commit within same blocktime
-	res = runTx(&types.MsgRedelegatePool{
+	// multiple redelegations within the same blocktime are allowed
+	runTxSuccess(t, &types.MsgRedelegatePool{
 		Creator:    DUMMY_ACCOUNTS[0],
 		FromPoolId: 0,
 		FromStaker: BOB_ADDR,
 		ToPoolId:   0,
 		ToStaker:   ALICE_ADDR,
-		Amount:     5 * KYVE,
+		Amount:     sdk.NewIntFromUint64(5 * KYVE),
 	})
-	require.False(t, res)
+
+	redelegation, err := s.app.RegistryKeeper.AccountRedelegation(sdk.WrapSDKContext(s.ctx), &types.QueryAccountRedelegationRequest{
+		Address: DUMMY_ACCOUNTS[0],
+	})
+	require.NoError(t, err)
+	require.Len(t, redelegation.Cooldowns, 2)
+	require.Equal(t, uint64(0), redelegation.Cooldowns[0].Index)
+	require.Equal(t, uint64(1), redelegation.Cooldowns[1].Index)
+	require.Equal(t, redelegation.Cooldowns[0].CreationDate, redelegation.Cooldowns[1].CreationDate)
+	require.Equal(t, uint64(3), redelegation.AvailableSlots)
 
 	s.CommitAfterSeconds(60*60*24 - 1)
 
 	// Fill up queue
-	for i := 0; i < 4; i++ {
+	for i := 0; i < 3; i++ {
 		runTxSuccess(t, &types.MsgRedelegatePool{
 			Creator:    DUMMY_ACCOUNTS[0],
 			FromPoolId: 0,
 			FromStaker: BOB_ADDR,
 			ToPoolId:   0,
 			ToStaker:   ALICE_ADDR,
-			Amount:     5 * KYVE,
+			Amount:     sdk.NewIntFromUint64(5 * KYVE),
 		})
 		s.CommitAfterSeconds(60*60*24 - 1)
 	}
 
+	s.CommitAfterSeconds(60*60*24 - 1)
 	s.CommitAfterSeconds(1)
 
 	// All delegation spells taken
@@ -100,21 +111,23 @@ func testRedelegation(t *testing.T) {
 		FromStaker: BOB_ADDR,
 		ToPoolId:   0,
 		ToStaker:   ALICE_ADDR,
-		Amount:     5 * KYVE,
+		Amount:     sdk.NewIntFromUint64(5 * KYVE),
 	})
 	require.False(t, res)
 
-	// Wait for first entry to expire
+	// Wait for the first two entries to expire
 
 	s.CommitAfterSeconds(10)
-	runTxSuccess(t, &types.MsgRedelegatePool{
-		Creator:    DUMMY_ACCOUNTS[0],
-		FromPoolId: 0,
-		FromStaker: BOB_ADDR,
-		ToPoolId:   0,
-		ToStaker:   ALICE_ADDR,
-		Amount:     5 * KYVE,
-	})
+	for i := 0; i < 2; i++ {
+		runTxSuccess(t, &types.MsgRedelegatePool{
+			Creator:    DUMMY_ACCOUNTS[0],
+			FromPoolId: 0,
+			FromStaker: BOB_ADDR,
+			ToPoolId:   0,
+			ToStaker:   ALICE_ADDR,
+			Amount:     sdk.NewIntFromUint64(5 * KYVE),
+		})
+	}
 
 	s.CommitAfterSeconds(1)
 	res = runTx(&types.MsgRedelegatePool{
@@ -123,7 +136,7 @@ func testRedelegation(t *testing.T) {
 		FromStaker: BOB_ADDR,
 		ToPoolId:   0,
 		ToStaker:   ALICE_ADDR,
-		Amount:     5 * KYVE,
+		Amount:     sdk.NewIntFromUint64(5 * KYVE),
 	})
 	require.False(t, res)
 
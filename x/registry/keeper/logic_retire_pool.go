@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RetirePool pauses a pool, refunds all funders and starts the unbonding of all stakers and delegators.
+// Unbondings which are already in the queue are respected, only the remaining amounts start unbonding.
+// Finally all proposals of the pool are archived and the pool is marked as retired.
+func (k Keeper) RetirePool(ctx sdk.Context, poolId uint64) error {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
+	}
+
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, types.ErrPoolRetired.Error())
+	}
+
+	pool.Paused = true
+
+	// Drop all open bundle proposals, they will never be finalized.
+	if err := k.dropPipelinedProposals(ctx, &pool); err != nil {
+		return err
+	}
+
+	pool.BundleProposal = &types.BundleProposal{
+		CreatedAt: uint64(ctx.BlockTime().Unix()),
+	}
+
+	if err := k.refundFunders(ctx, &pool); err != nil {
+		return err
+	}
+
+	if err := k.refundFundingStreams(ctx, pool.Id); err != nil {
+		return err
+	}
+
+	k.RemoveProtocolFunding(ctx, pool.Id)
+
+	k.SetPool(ctx, pool)
+
+	// Start unbonding for all delegators. Undelegating updates the pool itself.
+	for _, delegator := range k.GetDelegatorsOfPool(ctx, poolId) {
+		f1Distribution := F1Distribution{
+			k:                k,
+			ctx:              ctx,
+			poolId:           poolId,
+			stakerAddress:    delegator.Staker,
+			delegatorAddress: delegator.Delegator,
+		}
+
+		amount := f1Distribution.getCurrentDelegation()
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.Undelegate(ctx, delegator.Staker, poolId, delegator.Delegator, amount); err != nil {
+			return err
+		}
+
+		if err := k.StartUnbondingDelegator(ctx, poolId, delegator.Staker, delegator.Delegator, amount); err != nil {
+			return err
+		}
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventUndelegatePool{
+			PoolId:  poolId,
+			Address: delegator.Delegator,
+			Node:    delegator.Staker,
+			Amount:  amount,
+		}); errEmit != nil {
+			return errEmit
+		}
+	}
+
+	pool, _ = k.GetPool(ctx, poolId)
+
+	// Start unbonding for the remaining stake of all active and inactive stakers.
+	for _, account := range append(append([]string{}, pool.Stakers...), pool.InactiveStakers...) {
+		staker, foundStaker := k.GetStaker(ctx, account, poolId)
+		if !foundStaker {
+			continue
+		}
+
+		amount := staker.Amount
+		if unbondingStaker, foundUnbondingStaker := k.GetUnbondingStaker(ctx, poolId, account); foundUnbondingStaker {
+			amount = amount.Sub(unbondingStaker.UnbondingAmount)
+		}
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		if err := k.StartUnbondingStaker(ctx, poolId, account, amount); err != nil {
+			return err
+		}
+	}
+
+	k.archiveProposals(ctx, poolId)
+
+	pool.Status = types.POOL_STATUS_RETIRED
+	k.SetPool(ctx, pool)
+
+	return nil
+}
+
+// archiveProposals is an internal function that moves all proposals of a given pool into the archive.
+func (k Keeper) archiveProposals(ctx sdk.Context, poolId uint64) {
+	for _, proposal := range k.GetProposalsByPoolIdSinceBundleId(ctx, poolId, 0) {
+		k.RemoveProposal(ctx, proposal)
+		k.SetArchivedProposal(ctx, proposal)
+	}
+}
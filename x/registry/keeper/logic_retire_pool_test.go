@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetirePool(t *testing.T) {
+	createGenesis(t)
+	testRetirePool(t)
+}
+
+func testRetirePool(t *testing.T) {
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  ALICE_ADDR,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// Bob already started to unbond a part of its stake
+	runTxSuccess(t, &types.MsgUnstakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(40 * KYVE),
+	})
+
+	funder, _ := s.app.RegistryKeeper.GetFunder(s.ctx, ALICE_ADDR, 0)
+	aliceBalance := getBalance(ALICE_ADDR)
+
+	require.NoError(t, s.app.RegistryKeeper.RetirePool(s.ctx, 0))
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_RETIRED, pool.Status)
+	require.True(t, pool.Paused)
+	require.Equal(t, "", pool.BundleProposal.StorageId)
+
+	// Funders are refunded immediately
+	require.Empty(t, pool.Funders)
+	require.Equal(t, aliceBalance+funder.Amount.Uint64(), getBalance(ALICE_ADDR))
+
+	// Delegations are undelegated
+	require.True(t, pool.TotalDelegation.IsZero())
+
+	// Only the remaining stake starts unbonding
+	unbondingStaker, _ := s.app.RegistryKeeper.GetUnbondingStaker(s.ctx, 0, BOB_ADDR)
+	require.Equal(t, uint64(100*KYVE), unbondingStaker.UnbondingAmount.Uint64())
+
+	// Proposals are archived
+	_, found := s.app.RegistryKeeper.GetProposal(s.ctx, "a")
+	require.False(t, found)
+
+	res, err := s.app.RegistryKeeper.ArchivedProposals(sdk.WrapSDKContext(s.ctx), &types.QueryArchivedProposalsRequest{PoolId: 0})
+	require.Nil(t, err)
+	require.Len(t, res.Proposals, 1)
+	require.Equal(t, "a", res.Proposals[0].StorageId)
+
+	// Retired pools are skipped
+	pools, err := s.app.RegistryKeeper.Pools(sdk.WrapSDKContext(s.ctx), &types.QueryPoolsRequest{Paused: true})
+	require.Nil(t, err)
+	require.Empty(t, pools.Pools)
+
+	require.False(t, runTx(&types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(KYVE),
+	}))
+
+	require.Error(t, s.app.RegistryKeeper.RetirePool(s.ctx, 0))
+
+	// Everyone receives their tokens after the unbonding time
+	dummyBalance := getBalance(DUMMY_ACCOUNTS[0])
+	bobBalance := getBalance(BOB_ADDR)
+
+	s.CommitAfterSeconds(types.DefaultUnbondingStakingTime + 1)
+	s.CommitAfterSeconds(1)
+
+	require.Equal(t, dummyBalance+50*KYVE, getBalance(DUMMY_ACCOUNTS[0]))
+	require.Equal(t, bobBalance+100*KYVE, getBalance(BOB_ADDR))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, types.POOL_STATUS_RETIRED, pool.Status)
+	require.Empty(t, pool.Stakers)
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateDelegationRewards(t *testing.T) {
+	createGenesis(t)
+	testSimulateDelegationRewards(t)
+}
+
+func simulateDelegationRewards(t *testing.T, staker string, amount uint64) *types.QuerySimulateDelegationRewardsResponse {
+	res, err := s.app.RegistryKeeper.SimulateDelegationRewards(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDelegationRewardsRequest{
+		PoolId: 0,
+		Staker: staker,
+		Amount: sdk.NewIntFromUint64(amount).String(),
+	})
+	require.NoError(t, err)
+	return res
+}
+
+func testSimulateDelegationRewards(t *testing.T) {
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	// Recently finalized bundles of the pool
+	for i, byteSize := range []uint64{100, 300} {
+		s.app.RegistryKeeper.SetProposal(s.ctx, types.Proposal{
+			StorageId:   string(rune('a' + i)),
+			PoolId:      0,
+			Id:          uint64(i),
+			FinalizedAt: uint64(s.ctx.BlockHeight()),
+			ByteSize:    byteSize,
+		})
+	}
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.TotalBundles = 2
+	pool.TotalBytes = 400
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	// Without a delegation there is no reward
+	res := simulateDelegationRewards(t, BOB_ADDR, 0)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5").String(), res.UploadProbability)
+	require.Equal(t, uint64(200), res.AverageByteSize)
+	require.Equal(t, uint64(100+200*s.app.RegistryKeeper.StorageCost(s.ctx)), res.BundleReward.Uint64())
+	require.True(t, res.RewardPerBundle.IsZero())
+	require.True(t, res.RewardPerDay.IsZero())
+
+	res = simulateDelegationRewards(t, BOB_ADDR, 100*KYVE)
+
+	// The simulated probability matches the probability after delegating
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	probability := s.app.RegistryKeeper.GetUploadProbability(s.ctx, BOB_ADDR, 0)
+	require.Equal(t, probability.String(), res.UploadProbability)
+	require.True(t, probability.GT(sdk.MustNewDecFromStr("0.5")))
+
+	// Network fee of 1% and commission of 90% are deducted, the delegator receives the whole delegation reward.
+	delegationReward := sdk.NewDecFromInt(res.BundleReward.Sub(sdk.NewDecFromInt(res.BundleReward).Mul(sdk.MustNewDecFromStr("0.01")).RoundInt())).Mul(sdk.MustNewDecFromStr("0.1"))
+	rewardPerBundle := delegationReward.Mul(probability)
+
+	require.Equal(t, rewardPerBundle.TruncateInt().Uint64(), res.RewardPerBundle.Uint64())
+	require.Equal(t, rewardPerBundle.MulInt64(60*60*24/60).TruncateInt().Uint64(), res.RewardPerDay.Uint64())
+
+	// A second delegation of the same amount only receives half of the delegation reward
+	res = simulateDelegationRewards(t, BOB_ADDR, 100*KYVE)
+	probability, _ = sdk.NewDecFromStr(res.UploadProbability)
+	require.Equal(t, delegationReward.Mul(probability).QuoInt64(2).TruncateInt().Uint64(), res.RewardPerBundle.Uint64())
+
+	// Invalid requests
+	_, err := s.app.RegistryKeeper.SimulateDelegationRewards(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDelegationRewardsRequest{
+		PoolId: 0,
+		Staker: DUMMY_ACCOUNTS[1],
+		Amount: "100",
+	})
+	require.Error(t, err)
+
+	_, err = s.app.RegistryKeeper.SimulateDelegationRewards(sdk.WrapSDKContext(s.ctx), &types.QuerySimulateDelegationRewardsRequest{
+		PoolId: 0,
+		Staker: BOB_ADDR,
+		Amount: "-1",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidatePoolConfig checks if a pool config matches the config schema of the given runtime.
+// Configs of runtimes which are not registered or have no config schema are not validated.
+func (k Keeper) ValidatePoolConfig(ctx sdk.Context, runtime string, config string) error {
+	r, found := k.GetRuntime(ctx, runtime)
+	if !found || r.ConfigSchema == "" {
+		return nil
+	}
+
+	if err := types.ValidateConfig(r.ConfigSchema, config); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidPoolConfig.Error(), runtime, err)
+	}
+
+	return nil
+}
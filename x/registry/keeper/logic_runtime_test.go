@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const evmConfigSchema = `{
+	"type": "object",
+	"required": ["rpc", "github"],
+	"additionalProperties": false,
+	"properties": {
+		"rpc": {"type": "string", "pattern": "^https?://"},
+		"github": {"type": "string"},
+		"batchSize": {"type": "integer", "minimum": 1, "maximum": 100}
+	}
+}`
+
+func TestRuntime(t *testing.T) {
+	createGenesis(t)
+	testRuntime(t)
+}
+
+func testRuntime(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	// Schemas with unsupported keywords are rejected
+	require.Error(t, types.NewCreateRuntimeProposal("title", "description", "@kyve/evm", `{"$ref": "https://example.com/schema.json"}`).ValidateBasic())
+	require.Error(t, types.NewCreateRuntimeProposal("title", "description", "@kyve/evm", `{"type": "object"`).ValidateBasic())
+
+	createRuntime := types.NewCreateRuntimeProposal("title", "description", "@kyve/evm", evmConfigSchema)
+	require.NoError(t, createRuntime.ValidateBasic())
+	require.NoError(t, handler(s.ctx, createRuntime))
+
+	// Runtimes can only be registered once
+	require.Error(t, handler(s.ctx, createRuntime))
+
+	res, err := s.app.RegistryKeeper.Runtime(sdk.WrapSDKContext(s.ctx), &types.QueryRuntimeRequest{Name: "@kyve/evm"})
+	require.Nil(t, err)
+	require.Equal(t, evmConfigSchema, res.Runtime.ConfigSchema)
+
+	validConfig := `{"rpc":"https://rpc.api.moonbeam.network","github":"https://github.com/KYVENetwork/evm","batchSize":10}`
+
+	for _, config := range []string{
+		`{"rpc":"https://rpc.api.moonbeam.network"}`,
+		`{"rpc":"wss://rpc.api.moonbeam.network","github":"https://github.com/KYVENetwork/evm"}`,
+		`{"rpc":"https://rpc.api.moonbeam.network","github":"https://github.com/KYVENetwork/evm","batchSize":1.5}`,
+		`{"rpc":"https://rpc.api.moonbeam.network","github":"https://github.com/KYVENetwork/evm","batchSize":1000}`,
+		`{"rpc":"https://rpc.api.moonbeam.network","github":"https://github.com/KYVENetwork/evm","rcp":""}`,
+		`not json`,
+	} {
+		require.Error(t, handler(s.ctx, types.NewUpdatePoolProposal("title", "description", 0, "Moontest", "@kyve/evm", "logo", config, 60, 100, 100, 0, 0, 0, nil, false, "", 0)))
+	}
+
+	require.NoError(t, handler(s.ctx, types.NewUpdatePoolProposal("title", "description", 0, "Moontest", "@kyve/evm", "logo", validConfig, 60, 100, 100, 0, 0, 0, nil, false, "", 0)))
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, validConfig, pool.Config)
+
+	// Pools of runtimes without a schema are not validated
+	require.NoError(t, handler(s.ctx, types.NewCreatePoolProposal("title", "description", "Bitcoin", "@kyve/bitcoin", "logo", "anything", 60, 100, 100, "0.0.0", "{}", "0", 0, 0, 0, nil, false, "", 0)))
+	require.Error(t, handler(s.ctx, types.NewCreatePoolProposal("title", "description", "Moontest", "@kyve/evm", "logo", "{}", 60, 100, 100, "0.0.0", "{}", "0", 0, 0, 0, nil, false, "", 0)))
+
+	// Updating the schema applies to future proposals
+	require.NoError(t, handler(s.ctx, types.NewUpdateRuntimeProposal("title", "description", "@kyve/evm", `{"type": "object"}`)))
+	require.NoError(t, handler(s.ctx, types.NewCreatePoolProposal("title", "description", "Moontest", "@kyve/evm", "logo", "{}", 60, 100, 100, "0.0.0", "{}", "0", 0, 0, 0, nil, false, "", 0)))
+
+	require.Error(t, handler(s.ctx, types.NewUpdateRuntimeProposal("title", "description", "@kyve/cosmos", `{}`)))
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeVersion(t *testing.T) {
+	createGenesis(t)
+	testRuntimeVersion(t)
+}
+
+func testRuntimeVersion(t *testing.T) {
+	handler := registry.NewRegistryProposalHandler(s.app.RegistryKeeper)
+
+	binaries := []types.RuntimeBinary{
+		{
+			Platform: "linux",
+			Url:      "https://github.com/kyve-org/evm/releases/download/v1.3.5/kyve-linux.zip",
+			Sha256:   "f37eb5178890f74cdd6ba272cc783b25e59b3abc2fb13bd0c939736425e09123",
+		},
+		{
+			Platform: "macos",
+			Url:      "https://github.com/kyve-org/evm/releases/download/v1.3.5/kyve-macos.zip",
+			Sha256:   "ddccbe416c79b1e58a76813f14f83f41571c0c72cb3b913d5ca4d32d0fb4c8c9",
+		},
+	}
+
+	// Binaries need a valid url and checksum for unique platforms
+	require.Error(t, types.NewAddRuntimeVersionProposal("title", "description", "@kyve/evm", "1.3.5", nil).ValidateBasic())
+	require.Error(t, types.NewAddRuntimeVersionProposal("title", "description", "@kyve/evm", "1.3.5", []types.RuntimeBinary{binaries[0], binaries[0]}).ValidateBasic())
+	require.Error(t, types.NewAddRuntimeVersionProposal("title", "description", "@kyve/evm", "1.3.5", []types.RuntimeBinary{{Platform: "linux", Url: binaries[0].Url, Sha256: "abc"}}).ValidateBasic())
+	require.Error(t, types.NewAddRuntimeVersionProposal("title", "description", "@kyve/evm", "1.3.5", []types.RuntimeBinary{{Platform: "linux", Url: "ftp://binary", Sha256: binaries[0].Sha256}}).ValidateBasic())
+
+	addVersion := types.NewAddRuntimeVersionProposal("title", "description", "@kyve/evm", "1.3.5", binaries)
+	require.NoError(t, addVersion.ValidateBasic())
+
+	// The runtime has to be registered first
+	require.Error(t, handler(s.ctx, addVersion))
+	require.NoError(t, handler(s.ctx, types.NewCreateRuntimeProposal("title", "description", "@kyve/evm", `{"type": "object"}`)))
+	require.NoError(t, handler(s.ctx, addVersion))
+
+	// Versions are immutable
+	require.Error(t, handler(s.ctx, addVersion))
+
+	res, err := s.app.RegistryKeeper.RuntimeVersion(sdk.WrapSDKContext(s.ctx), &types.QueryRuntimeVersionRequest{Runtime: "@kyve/evm", Version: "1.3.5"})
+	require.Nil(t, err)
+	require.Equal(t, binaries, res.RuntimeVersion.Binaries)
+
+	// Upgrades have to target a registered version with matching binaries
+	scheduledAt := uint64(s.ctx.BlockTime().Unix()) + 60
+
+	require.Error(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "1.4.0", scheduledAt, 60, "", nil, 0, 0)))
+	require.Error(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "1.3.5", scheduledAt, 60, "{\"linux\":\"https://example.com/kyve-linux.zip\"}", nil, 0, 0)))
+	require.NoError(t, handler(s.ctx, types.NewSchedulePoolUpgradeProposal("title", "description", "@kyve/evm", "1.3.5", scheduledAt, 60, "", nil, 0, 0)))
+
+	// The binaries are taken from the registry in the legacy format
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, "1.3.5", pool.UpgradePlan.Version)
+	require.Equal(t, "{\"linux\":\"https://github.com/kyve-org/evm/releases/download/v1.3.5/kyve-linux.zip?checksum=f37eb5178890f74cdd6ba272cc783b25e59b3abc2fb13bd0c939736425e09123\",\"macos\":\"https://github.com/kyve-org/evm/releases/download/v1.3.5/kyve-macos.zip?checksum=ddccbe416c79b1e58a76813f14f83f41571c0c72cb3b913d5ca4d32d0fb4c8c9\"}", pool.UpgradePlan.Binaries)
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipUploaderRole(t *testing.T) {
+	createGenesis(t)
+	testSkipUploaderRole(t)
+}
+
+func setNextUploader(address string) {
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.BundleProposal.NextUploader = address
+	pool.BundleProposal.CreatedAt = uint64(s.ctx.BlockTime().Unix())
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+}
+
+func testSkipUploaderRole(t *testing.T) {
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR, DUMMY_ACCOUNTS[0]} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	// Only the designated uploader can skip
+	require.False(t, runTx(&types.MsgSkipUploaderRole{
+		Creator:    BOB_ADDR,
+		Id:         0,
+		FromHeight: 0,
+	}))
+
+	// The from height has to match the current bundle proposal
+	require.False(t, runTx(&types.MsgSkipUploaderRole{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		FromHeight: 1,
+	}))
+
+	claimedAt := uint64(s.ctx.BlockTime().Unix())
+	s.CommitAfterSeconds(30)
+
+	runTxSuccess(t, &types.MsgSkipUploaderRole{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		FromHeight: 0,
+	})
+
+	// The bundle proposal keeps its creation time, the new uploader is tracked separately
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.NotEqual(t, ALICE_ADDR, pool.BundleProposal.NextUploader)
+	require.Contains(t, pool.Stakers, pool.BundleProposal.NextUploader)
+	require.Equal(t, claimedAt, pool.BundleProposal.CreatedAt)
+	require.Equal(t, uint64(s.ctx.BlockTime().Unix()), pool.BundleProposal.UploaderAssignedAt)
+
+	// The new uploader gets a full upload interval from the hand over
+	s.CommitAfterSeconds(40)
+
+	canPropose, err := s.app.RegistryKeeper.CanPropose(sdk.WrapSDKContext(s.ctx), &types.QueryCanProposeRequest{
+		PoolId:     0,
+		Proposer:   pool.BundleProposal.NextUploader,
+		FromHeight: 0,
+	})
+	require.NoError(t, err)
+	require.False(t, canPropose.Possible)
+	require.Equal(t, "Upload interval not surpassed", canPropose.Reason)
+
+	// Skipping is free of charge
+	staker, _ := s.app.RegistryKeeper.GetStaker(s.ctx, ALICE_ADDR, 0)
+	require.Equal(t, 100*KYVE, staker.Amount.Uint64())
+	require.Equal(t, types.STAKER_STATUS_ACTIVE, staker.Status)
+	require.Equal(t, uint64(s.ctx.BlockTime().Unix()), staker.LastUploaderRoleSkip)
+
+	// Skipping again is rate limited
+	setNextUploader(ALICE_ADDR)
+	require.False(t, runTx(&types.MsgSkipUploaderRole{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		FromHeight: 0,
+	}))
+
+	s.CommitAfterSeconds(s.app.RegistryKeeper.UploaderRoleSkipCooldown(s.ctx))
+
+	setNextUploader(ALICE_ADDR)
+	runTxSuccess(t, &types.MsgSkipUploaderRole{
+		Creator:    ALICE_ADDR,
+		Id:         0,
+		FromHeight: 0,
+	})
+
+	// Skipping is not possible once the upload timeout is reached
+	setNextUploader(BOB_ADDR)
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	s.CommitAfterSeconds(pool.UploadInterval + s.app.RegistryKeeper.UploadTimeout(s.ctx))
+
+	require.False(t, runTx(&types.MsgSkipUploaderRole{
+		Creator:    BOB_ADDR,
+		Id:         0,
+		FromHeight: 0,
+	}))
+}
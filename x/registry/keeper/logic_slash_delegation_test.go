@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSlashDelegation(t *testing.T) {
+	createGenesis(t)
+	testSlashDelegation(t)
+}
+
+func testSlashDelegation(t *testing.T) {
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	for i := 0; i < 3; i++ {
+		runTxSuccess(t, &types.MsgDelegatePool{
+			Creator: DUMMY_ACCOUNTS[i],
+			Id:      0,
+			Staker:  BOB_ADDR,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	// Small delegations are rounded with the remainder of the staker
+	for i := 3; i < 5; i++ {
+		runTxSuccess(t, &types.MsgDelegatePool{
+			Creator: DUMMY_ACCOUNTS[i],
+			Id:      0,
+			Staker:  BOB_ADDR,
+			Amount:  sdk.NewIntFromUint64(7),
+		})
+	}
+	s.Commit()
+
+	// Bob has to upload the next bundle
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.BundleProposal = &types.BundleProposal{
+		NextUploader: BOB_ADDR,
+		CreatedAt:    uint64(s.ctx.BlockTime().Unix()),
+	}
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	// Leaving after the infraction does not protect from the slash
+	runTxSuccess(t, &types.MsgUndelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgRedelegatePool{
+		Creator:    DUMMY_ACCOUNTS[2],
+		FromPoolId: 0,
+		FromStaker: BOB_ADDR,
+		ToPoolId:   0,
+		ToStaker:   ALICE_ADDR,
+		Amount:     sdk.NewIntFromUint64(50 * KYVE),
+	})
+	s.Commit()
+
+	// Bob misses the upload and gets slashed
+	s.CommitAfterSeconds(pool.UploadInterval + s.app.RegistryKeeper.UploadTimeout(s.ctx))
+	s.Commit()
+
+	fraction, _ := sdk.NewDecFromStr(s.app.RegistryKeeper.TimeoutSlash(s.ctx))
+	slashed := func(amount uint64) uint64 {
+		return uint64(sdk.NewDec(int64(amount)).Mul(sdk.OneDec().Sub(fraction)).TruncateInt64())
+	}
+
+	// The total delegation is slashed at once and rounded up
+	slashedTotal := uint64(sdk.NewDec(int64(200*KYVE + 14)).Mul(sdk.OneDec().Sub(fraction)).Ceil().TruncateInt64())
+
+	delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.Equal(t, slashedTotal, delegationPoolData.TotalDelegation.Uint64())
+	require.Len(t, s.app.RegistryKeeper.GetDelegationSlashesOfStaker(s.ctx, 0, BOB_ADDR), 1)
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, slashedTotal+slashed(50*KYVE), pool.TotalDelegation.Uint64())
+
+	// Pending unbonding is slashed
+	unbondingEntries := s.app.RegistryKeeper.GetAllUnbondingDelegationQueueEntries(s.ctx)
+	require.Len(t, unbondingEntries, 1)
+	require.Equal(t, slashed(50*KYVE), unbondingEntries[0].Amount.Uint64())
+
+	// Redelegation is slashed at the new staker
+	redelegated, found := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, ALICE_ADDR, DUMMY_ACCOUNTS[2])
+	require.True(t, found)
+	require.Equal(t, slashed(50*KYVE), redelegated.DelegationAmount.Uint64())
+
+	// Delegation is reduced on the next interaction
+	runTxSuccess(t, &types.MsgWithdrawPool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+	})
+
+	delegator, found := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	require.True(t, found)
+	require.Equal(t, slashed(100*KYVE), delegator.DelegationAmount.Uint64())
+
+	// Delegators can not undelegate more than their slashed delegation
+	require.False(t, runTx(&types.MsgUndelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	}))
+
+	runTxSuccess(t, &types.MsgUndelegatePool{
+		Creator: DUMMY_ACCOUNTS[1],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(slashed(50 * KYVE)),
+	})
+
+	// Once all slashes are applied, the total delegation equals the sum of all delegations
+	for _, account := range []string{DUMMY_ACCOUNTS[2], DUMMY_ACCOUNTS[3], DUMMY_ACCOUNTS[4]} {
+		runTxSuccess(t, &types.MsgWithdrawPool{
+			Creator: account,
+			Id:      0,
+			Staker:  BOB_ADDR,
+		})
+	}
+
+	// The small delegations receive the rounding remainder of the total delegation
+	for _, account := range []string{DUMMY_ACCOUNTS[3], DUMMY_ACCOUNTS[4]} {
+		delegator, _ = s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, account)
+		require.Equal(t, slashed(7)+1, delegator.DelegationAmount.Uint64())
+	}
+
+	// The slash is removed once every delegator applied it
+	require.Empty(t, s.app.RegistryKeeper.GetDelegationSlashesOfStaker(s.ctx, 0, BOB_ADDR))
+
+	total := sdk.ZeroInt()
+	for _, delegator := range s.app.RegistryKeeper.GetDelegatorsOfPool(s.ctx, 0) {
+		total = total.Add(delegator.DelegationAmount)
+	}
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.True(t, total.Equal(pool.TotalDelegation))
+
+	delegationPoolData, _ = s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.True(t, total.Sub(redelegated.DelegationAmount).Equal(delegationPoolData.TotalDelegation))
+}
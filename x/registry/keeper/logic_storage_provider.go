@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getStorageCost returns the cost per byte of the given storage provider.
+// The default storage provider is charged with the StorageCost param.
+func (k Keeper) getStorageCost(ctx sdk.Context, storageProviderId uint64) sdk.Int {
+	storageProvider, found := k.GetStorageProvider(ctx, storageProviderId)
+	if storageProviderId == 0 || !found {
+		return sdk.NewIntFromUint64(k.StorageCost(ctx))
+	}
+
+	return storageProvider.StorageCost
+}
+
+// validateStorageId checks if a storage id matches the format of the given storage provider.
+// NO_DATA bundles are not stored anywhere and are therefore always valid.
+func (k Keeper) validateStorageId(ctx sdk.Context, storageProviderId uint64, storageId string) error {
+	if strings.HasPrefix(storageId, types.KYVE_NO_DATA_BUNDLE) {
+		return nil
+	}
+
+	storageProvider, found := k.GetStorageProvider(ctx, storageProviderId)
+	if !found || storageProvider.StorageIdFormat == "" {
+		return nil
+	}
+
+	storageIdFormat, err := types.CompileStorageIdFormat(storageProvider.StorageIdFormat)
+	if err != nil {
+		return err
+	}
+
+	if !storageIdFormat.MatchString(storageId) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrInvalidStorageIdFormat.Error(), storageId, storageProviderId)
+	}
+
+	return nil
+}
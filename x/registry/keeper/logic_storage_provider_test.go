@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageProvider(t *testing.T) {
+	createGenesis(t)
+	testStorageProvider(t)
+}
+
+func testStorageProvider(t *testing.T) {
+	// The storage id format and the storage cost are checked by the proposal
+	require.Error(t, types.NewCreateStorageProviderProposal("title", "description", "Bundlr", sdk.NewInt(1000), "^bundlr_[a-z+$").ValidateBasic())
+	require.Error(t, types.NewUpdateStorageProviderProposal("title", "description", 1, "Bundlr", sdk.NewInt(-1), "^bundlr_[a-z]+$").ValidateBasic())
+	require.NoError(t, types.NewCreateStorageProviderProposal("title", "description", "Bundlr", sdk.NewInt(1000), "^bundlr_[a-z]+$").ValidateBasic())
+
+	storageProviderId := s.app.RegistryKeeper.AppendStorageProvider(s.ctx, types.StorageProvider{
+		Name:            "Bundlr",
+		StorageCost:     sdk.NewInt(1000),
+		StorageIdFormat: "^bundlr_[a-z]+$",
+	})
+	require.Equal(t, uint64(1), storageProviderId)
+
+	res, err := s.app.RegistryKeeper.StorageProviders(sdk.WrapSDKContext(s.ctx), &types.QueryStorageProvidersRequest{})
+	require.Nil(t, err)
+	require.Len(t, res.StorageProviders, 1)
+	require.Equal(t, "Bundlr", res.StorageProviders[0].Name)
+	require.True(t, sdk.NewInt(1000).Equal(res.StorageProviders[0].StorageCost))
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	pool.StorageProviderId = storageProviderId
+	s.app.RegistryKeeper.SetPool(s.ctx, pool)
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	// The storage id has to match the format of the storage provider
+	require.False(t, submitBundle(ALICE_ADDR, "arweave_tx", 0, 10, ""))
+	require.True(t, submitBundle(ALICE_ADDR, "bundlr_a", 0, 10, ""))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, storageProviderId, pool.BundleProposal.StorageProviderId)
+
+	voteBundle(t, BOB_ADDR, "bundlr_a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "bundlr_b", 10, 20, "bundlr_a_key"))
+
+	// The bundle reward uses the storage cost of the storage provider
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(100+100*1000), pool.TotalBundleRewards.Uint64())
+
+	proposal, found := s.app.RegistryKeeper.GetProposal(s.ctx, "bundlr_a")
+	require.True(t, found)
+	require.Equal(t, storageProviderId, proposal.StorageProviderId)
+}
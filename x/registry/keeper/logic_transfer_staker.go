@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferStaker moves the entire staking position of a staker in a given pool to a new account.
+// This includes the staker itself, a pending commission change, all unbonding entries and all
+// delegations (Delegator, DelegationEntries, DelegationSlash, DelegationPoolData and AutoCompound).
+// For a vesting account the stake is removed from its delegation bookkeeping.
+// Contract: newStaker is not a staker in the given pool and not a vesting account.
+func (k Keeper) transferStaker(ctx sdk.Context, pool *types.Pool, staker *types.Staker, newStaker string) {
+	oldStaker := staker.Account
+
+	// The stake is no longer delegated by the old account, all future payouts go to the new account.
+	k.trackUndelegation(ctx, oldStaker, staker.Amount)
+
+	// Move the staker itself
+	k.RemoveStaker(ctx, oldStaker, pool.Id)
+
+	staker.Account = newStaker
+	staker.LastTransfer = uint64(ctx.BlockTime().Unix())
+	k.SetStaker(ctx, *staker)
+
+	// Replace all references in the pool
+	pool.Stakers = replaceStringInList(pool.Stakers, oldStaker, newStaker)
+	pool.InactiveStakers = replaceStringInList(pool.InactiveStakers, oldStaker, newStaker)
+
+	if pool.LowestStaker == oldStaker {
+		pool.LowestStaker = newStaker
+	}
+
+	for _, proposal := range openBundleProposals(pool) {
+		if proposal.Uploader == oldStaker {
+			proposal.Uploader = newStaker
+		}
+		if proposal.NextUploader == oldStaker {
+			proposal.NextUploader = newStaker
+		}
+		proposal.VotersValid = replaceStringInList(proposal.VotersValid, oldStaker, newStaker)
+		proposal.VotersInvalid = replaceStringInList(proposal.VotersInvalid, oldStaker, newStaker)
+		proposal.VotersAbstain = replaceStringInList(proposal.VotersAbstain, oldStaker, newStaker)
+	}
+
+	// Move pending commission change, the queue index stays the same
+	commissionChange, found := k.GetCommissionChangeQueueEntryByIndex2(ctx, oldStaker, pool.Id)
+	if found {
+		k.RemoveCommissionChangeQueueEntry(ctx, &commissionChange)
+		commissionChange.Staker = newStaker
+		k.SetCommissionChangeQueueEntry(ctx, commissionChange)
+	}
+
+	// Move unbonding staker and all of its queue entries for this pool
+	unbondingStaker, found := k.GetUnbondingStaker(ctx, pool.Id, oldStaker)
+	if found {
+		k.RemoveUnbondingStaker(ctx, &unbondingStaker)
+		unbondingStaker.Staker = newStaker
+		k.SetUnbondingStaker(ctx, unbondingStaker)
+	}
+
+	for _, entry := range k.getUnbondingStakingQueueEntriesOfStaker(ctx, oldStaker) {
+		if entry.PoolId == pool.Id {
+			k.RemoveUnbondingStakingQueueEntry(ctx, &entry)
+			entry.Staker = newStaker
+			k.SetUnbondingStakingQueueEntry(ctx, entry)
+		}
+	}
+
+	// Move all delegations. Unbonding delegations belong to the delegator and are not touched.
+	delegationPoolData, found := k.GetDelegationPoolData(ctx, pool.Id, oldStaker)
+	if found {
+		k.RemoveDelegationPoolData(ctx, pool.Id, oldStaker)
+		delegationPoolData.Staker = newStaker
+		k.SetDelegationPoolData(ctx, delegationPoolData)
+	}
+
+	for _, entries := range k.getDelegationEntriesOfStaker(ctx, pool.Id, oldStaker) {
+		k.RemoveDelegationEntries(ctx, pool.Id, oldStaker, entries.KIndex)
+		entries.Staker = newStaker
+		k.SetDelegationEntries(ctx, entries)
+	}
+
+	for _, delegator := range k.getDelegatorsOfStaker(ctx, pool.Id, oldStaker) {
+		k.RemoveDelegator(ctx, pool.Id, oldStaker, delegator.Delegator)
+		delegator.Staker = newStaker
+		k.SetDelegator(ctx, delegator)
+	}
+
+	for _, slash := range k.GetDelegationSlashesOfStaker(ctx, pool.Id, oldStaker) {
+		k.RemoveDelegationSlash(ctx, pool.Id, oldStaker, slash.KIndex)
+		slash.Staker = newStaker
+		k.SetDelegationSlash(ctx, slash)
+	}
+
+	for _, autoCompound := range k.GetAutoCompoundsOfStaker(ctx, pool.Id, oldStaker) {
+		k.RemoveAutoCompound(ctx, pool.Id, oldStaker, autoCompound.Delegator)
+		autoCompound.Staker = newStaker
+		k.SetAutoCompound(ctx, autoCompound)
+	}
+}
+
+// getUnbondingStakingQueueEntriesOfStaker returns all unbonding queue entries of a staker across all pools
+func (k Keeper) getUnbondingStakingQueueEntriesOfStaker(ctx sdk.Context, staker string) (list []types.UnbondingStakingQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBuilder{Key: types.UnbondingStakingQueueEntryKeyPrefixIndex2}.AString(staker).Key)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := binary.BigEndian.Uint64(iterator.Key()[0:8])
+		if entry, found := k.GetUnbondingStakingQueueEntry(ctx, index); found {
+			list = append(list, entry)
+		}
+	}
+
+	return
+}
+
+// getDelegationEntriesOfStaker returns all F1 entries of a staker in a given pool
+func (k Keeper) getDelegationEntriesOfStaker(ctx sdk.Context, poolId uint64, staker string) (list []types.DelegationEntries) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBuilder{Key: types.KeyPrefix(types.DelegationEntriesKeyPrefix)}.AInt(poolId).AString(staker).Key)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelegationEntries
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getDelegatorsOfStaker returns all delegators of a staker in a given pool
+func (k Keeper) getDelegatorsOfStaker(ctx sdk.Context, poolId uint64, staker string) (list []types.Delegator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBuilder{Key: types.KeyPrefix(types.DelegatorKeyPrefix)}.AInt(poolId).AString(staker).Key)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Delegator
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func replaceStringInList(list []string, el string, replacement string) []string {
+	for i, other := range list {
+		if other == el {
+			list[i] = replacement
+		}
+	}
+	return list
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTransferStaker(t *testing.T) {
+	createGenesis(t)
+	testTransferStaker(t)
+}
+
+func TestTransferStakerWithPipelinedProposals(t *testing.T) {
+	createGenesis(t)
+	testTransferStakerWithPipelinedProposals(t)
+}
+
+func testTransferStaker(t *testing.T) {
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgUpdateCommission{
+		Creator:    BOB_ADDR,
+		Id:         0,
+		Commission: "0.5",
+	})
+
+	runTxSuccess(t, &types.MsgUnstakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	})
+	s.Commit()
+
+	// Transfer to an existing staker is not allowed
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   BOB_ADDR,
+		PoolId:    0,
+		NewStaker: ALICE_ADDR,
+	}))
+
+	// Transfer to own delegator would result in a self delegation
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   BOB_ADDR,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[0],
+	}))
+
+	unbondingIndex := s.app.RegistryKeeper.GetUnbondingStakingQueueState(s.ctx).HighIndex
+	commissionIndex := s.app.RegistryKeeper.GetCommissionChangeQueueState(s.ctx).HighIndex
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   BOB_ADDR,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[1],
+	})
+
+	// Old staker is gone
+	_, found := s.app.RegistryKeeper.GetStaker(s.ctx, BOB_ADDR, 0)
+	require.False(t, found)
+	_, found = s.app.RegistryKeeper.GetDelegator(s.ctx, 0, BOB_ADDR, DUMMY_ACCOUNTS[0])
+	require.False(t, found)
+	_, found = s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	require.False(t, found)
+	_, found = s.app.RegistryKeeper.GetUnbondingStaker(s.ctx, 0, BOB_ADDR)
+	require.False(t, found)
+
+	// New staker took over everything
+	staker, found := s.app.RegistryKeeper.GetStaker(s.ctx, DUMMY_ACCOUNTS[1], 0)
+	require.True(t, found)
+	require.Equal(t, 100*KYVE, staker.Amount.Uint64())
+
+	delegator, found := s.app.RegistryKeeper.GetDelegator(s.ctx, 0, DUMMY_ACCOUNTS[1], DUMMY_ACCOUNTS[0])
+	require.True(t, found)
+	require.Equal(t, 100*KYVE, delegator.DelegationAmount.Uint64())
+
+	delegationPoolData, found := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, DUMMY_ACCOUNTS[1])
+	require.True(t, found)
+	require.Equal(t, 100*KYVE, delegationPoolData.TotalDelegation.Uint64())
+
+	unbondingStaker, found := s.app.RegistryKeeper.GetUnbondingStaker(s.ctx, 0, DUMMY_ACCOUNTS[1])
+	require.True(t, found)
+	require.Equal(t, 10*KYVE, unbondingStaker.UnbondingAmount.Uint64())
+
+	commissionChange, found := s.app.RegistryKeeper.GetCommissionChangeQueueEntryByIndex2(s.ctx, DUMMY_ACCOUNTS[1], 0)
+	require.True(t, found)
+	require.Equal(t, "0.5", commissionChange.Commission)
+
+	// Queue entries keep their position in the queue and are re-keyed to the new staker
+	_, found = s.app.RegistryKeeper.GetCommissionChangeQueueEntryByIndex2(s.ctx, BOB_ADDR, 0)
+	require.False(t, found)
+
+	commissionChange, found = s.app.RegistryKeeper.GetCommissionChangeQueueEntry(s.ctx, commissionIndex)
+	require.True(t, found)
+	require.Equal(t, DUMMY_ACCOUNTS[1], commissionChange.Staker)
+
+	unbondingEntry, found := s.app.RegistryKeeper.GetUnbondingStakingQueueEntry(s.ctx, unbondingIndex)
+	require.True(t, found)
+	require.Equal(t, DUMMY_ACCOUNTS[1], unbondingEntry.Staker)
+	require.Equal(t, 10*KYVE, unbondingEntry.Amount.Uint64())
+
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Contains(t, pool.Stakers, DUMMY_ACCOUNTS[1])
+	require.NotContains(t, pool.Stakers, BOB_ADDR)
+
+	// Delegator can still undelegate from the new staker
+	runTxSuccess(t, &types.MsgUndelegatePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Staker:  DUMMY_ACCOUNTS[1],
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	// Transfer is on cooldown
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   DUMMY_ACCOUNTS[1],
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[2],
+	}))
+
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, sdk.MustAccAddressFromBech32(DUMMY_ACCOUNTS[1]), "tkyve")
+
+	s.CommitAfterSeconds(types.DefaultStakerTransferCooldown)
+	s.Commit()
+
+	// The unbonding and the commission change are applied to the new staker
+	staker, _ = s.app.RegistryKeeper.GetStaker(s.ctx, DUMMY_ACCOUNTS[1], 0)
+	require.Equal(t, 90*KYVE, staker.Amount.Uint64())
+	require.Equal(t, "0.5", staker.Commission)
+
+	balanceAfter := s.app.BankKeeper.GetBalance(s.ctx, sdk.MustAccAddressFromBech32(DUMMY_ACCOUNTS[1]), "tkyve")
+	require.Equal(t, 10*KYVE, balanceAfter.Amount.Sub(balanceBefore.Amount).Uint64())
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   DUMMY_ACCOUNTS[1],
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[2],
+	})
+}
+
+func testTransferStakerWithPipelinedProposals(t *testing.T) {
+	setupPipelinedPool(t)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "b", types.VOTE_TYPE_YES)
+	voteBundle(t, ALICE_ADDR, "b", types.VOTE_TYPE_ABSTAIN)
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   BOB_ADDR,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[1],
+	})
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   DUMMY_ACCOUNTS[0],
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[2],
+	})
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   ALICE_ADDR,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[3],
+	})
+
+	// The pipelined bundle proposal refers to the new stakers
+	pool, _ := s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Len(t, pool.PipelinedProposals, 1)
+	require.Equal(t, DUMMY_ACCOUNTS[3], pool.BundleProposal.Uploader)
+	require.Equal(t, []string{DUMMY_ACCOUNTS[2]}, pool.BundleProposal.VotersValid)
+	require.Equal(t, DUMMY_ACCOUNTS[1], pool.PipelinedProposals[0].Uploader)
+	require.Equal(t, []string{DUMMY_ACCOUNTS[2]}, pool.PipelinedProposals[0].VotersValid)
+	require.Equal(t, []string{DUMMY_ACCOUNTS[3]}, pool.PipelinedProposals[0].VotersAbstain)
+
+	// The new stakers keep voting on the pipelined bundle proposal
+	require.False(t, runTx(&types.MsgVoteProposal{
+		Creator:   DUMMY_ACCOUNTS[2],
+		Id:        0,
+		StorageId: "b",
+		Vote:      types.VOTE_TYPE_YES,
+	}))
+
+	voteBundle(t, DUMMY_ACCOUNTS[3], "b", types.VOTE_TYPE_YES)
+
+	res, err := s.app.RegistryKeeper.VoteStatus(sdk.WrapSDKContext(s.ctx), &types.QueryVoteStatusRequest{PoolId: 0, StorageId: "b"})
+	require.Nil(t, err)
+	require.Equal(t, 200*KYVE, res.VoteStatus.Valid.Uint64())
+	require.Equal(t, 200*KYVE, res.VoteStatus.Total.Uint64())
+
+	// Once the first bundle is finalized, the pipelined one is promoted with its votes
+	voteBundle(t, DUMMY_ACCOUNTS[1], "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(DUMMY_ACCOUNTS[2])
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(DUMMY_ACCOUNTS[2], "c", 20, 30, "b_key"))
+
+	pool, _ = s.app.RegistryKeeper.GetPool(s.ctx, 0)
+	require.Equal(t, uint64(10), pool.CurrentHeight)
+	require.Equal(t, "b", pool.BundleProposal.StorageId)
+	require.Equal(t, DUMMY_ACCOUNTS[1], pool.BundleProposal.Uploader)
+	require.ElementsMatch(t, []string{DUMMY_ACCOUNTS[2], DUMMY_ACCOUNTS[3]}, pool.BundleProposal.VotersValid)
+}
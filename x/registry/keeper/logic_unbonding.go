@@ -10,20 +10,26 @@ import (
 // ==== STAKING ====
 // #################
 
-func (k Keeper) StartUnbondingStaker(ctx sdk.Context, poolId uint64, staker string, amount uint64) (error error) {
+func (k Keeper) StartUnbondingStaker(ctx sdk.Context, poolId uint64, staker string, amount sdk.Int) (error error) {
+
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(amount); err != nil {
+		return err
+	}
 
 	// Check if user is able to unstake more
 	unbondingStaker, foundUnbondingStaker := k.GetUnbondingStaker(ctx, poolId, staker)
 	if !foundUnbondingStaker {
 		unbondingStaker.Staker = staker
 		unbondingStaker.PoolId = poolId
+		unbondingStaker.UnbondingAmount = sdk.ZeroInt()
 	}
 	poolStaker, stakerFound := k.GetStaker(ctx, staker, poolId)
 	if !stakerFound {
 		return errors.New("staker does not exist")
 	}
 
-	if amount > poolStaker.Amount-unbondingStaker.UnbondingAmount {
+	if amount.GT(poolStaker.Amount.Sub(unbondingStaker.UnbondingAmount)) {
 		return errors.New("amount is too high")
 	}
 
@@ -47,12 +53,56 @@ func (k Keeper) StartUnbondingStaker(ctx sdk.Context, poolId uint64, staker stri
 
 	k.SetUnbondingStakingQueueEntry(ctx, unbondingQueueEntry)
 
-	unbondingStaker.UnbondingAmount += amount
+	unbondingStaker.UnbondingAmount = unbondingStaker.UnbondingAmount.Add(amount)
 	k.SetUnbondingStaker(ctx, unbondingStaker)
 
 	return nil
 }
 
+// unstakeFromCompletedPool immediately returns the stake of a staker of a completed pool.
+// As no bundles are uploaded anymore the staker can't be slashed, so there is no unbonding time.
+func (k Keeper) unstakeFromCompletedPool(ctx sdk.Context, pool *types.Pool, stakerAddress string, amount sdk.Int) error {
+	unbondingAmount := sdk.ZeroInt()
+	if unbondingStaker, found := k.GetUnbondingStaker(ctx, pool.Id, stakerAddress); found {
+		unbondingAmount = unbondingStaker.UnbondingAmount
+	}
+
+	staker, stakerFound := k.GetStaker(ctx, stakerAddress, pool.Id)
+	if !stakerFound {
+		return errors.New("staker does not exist")
+	}
+
+	if amount.GT(staker.Amount.Sub(unbondingAmount)) {
+		return errors.New("amount is too high")
+	}
+
+	if amount.Equal(staker.Amount) {
+		k.removeStaker(ctx, pool, &staker)
+	} else {
+		staker.Amount = staker.Amount.Sub(amount)
+
+		if staker.Status == types.STAKER_STATUS_ACTIVE {
+			pool.TotalStake = pool.TotalStake.Sub(amount)
+		} else if staker.Status == types.STAKER_STATUS_INACTIVE {
+			pool.TotalInactiveStake = pool.TotalInactiveStake.Sub(amount)
+		}
+
+		k.SetStaker(ctx, staker)
+	}
+
+	k.updateLowestStaker(ctx, pool)
+
+	if err := k.transferUnbondingToAddress(ctx, stakerAddress, amount); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUnstakePool{
+		PoolId:  pool.Id,
+		Address: stakerAddress,
+		Amount:  amount,
+	})
+}
+
 // ProcessStakerUnbondingQueue is called at the end of every block and checks the
 // tail of the UnbondingStakingQueue for Undelegations that can be performed
 // This O(t) with t being the amount of undelegation-transactions which has been performed within
@@ -82,7 +132,7 @@ func (k Keeper) ProcessStakerUnbondingQueue(ctx sdk.Context) {
 			// Update internal UnbondingStaker value
 			unbondingStaker, foundUnbondingStaker := k.GetUnbondingStaker(ctx, unbondingStakingEntry.PoolId, unbondingStakingEntry.Staker)
 			if foundUnbondingStaker {
-				unbondingStaker.UnbondingAmount -= unbondingStakingEntry.Amount
+				unbondingStaker.UnbondingAmount = unbondingStaker.UnbondingAmount.Sub(unbondingStakingEntry.Amount)
 				k.SetUnbondingStaker(ctx, unbondingStaker)
 
 				// Update Pool Stakers and logic
@@ -95,8 +145,8 @@ func (k Keeper) ProcessStakerUnbondingQueue(ctx sdk.Context) {
 				staker, foundStaker := k.GetStaker(ctx, unbondingStakingEntry.Staker, unbondingStakingEntry.PoolId)
 				if foundStaker {
 					// Check if stake decreased during unbonding time
-					var unstakeAmount uint64 = 0
-					if unbondingStakingEntry.Amount >= staker.Amount {
+					var unstakeAmount sdk.Int
+					if unbondingStakingEntry.Amount.GTE(staker.Amount) {
 						// Remove user
 						k.removeStaker(ctx, &pool, &staker)
 						unstakeAmount = staker.Amount
@@ -107,12 +157,12 @@ func (k Keeper) ProcessStakerUnbondingQueue(ctx sdk.Context) {
 						// Reduce stake of user
 						unstakeAmount = unbondingStakingEntry.Amount
 
-						staker.Amount -= unstakeAmount
+						staker.Amount = staker.Amount.Sub(unstakeAmount)
 
 						if staker.Status == types.STAKER_STATUS_ACTIVE {
-							pool.TotalStake -= unbondingStakingEntry.Amount
+							pool.TotalStake = pool.TotalStake.Sub(unbondingStakingEntry.Amount)
 						} else if staker.Status == types.STAKER_STATUS_INACTIVE {
-							pool.TotalInactiveStake -= unbondingStakingEntry.Amount
+							pool.TotalInactiveStake = pool.TotalInactiveStake.Sub(unbondingStakingEntry.Amount)
 						}
 
 						k.SetStaker(ctx, staker)
@@ -122,7 +172,7 @@ func (k Keeper) ProcessStakerUnbondingQueue(ctx sdk.Context) {
 					k.SetPool(ctx, pool)
 
 					// Transfer the money
-					transferError := k.TransferToAddress(ctx, unbondingStakingEntry.Staker, unstakeAmount)
+					transferError := k.transferUnbondingToAddress(ctx, unbondingStakingEntry.Staker, unstakeAmount)
 					if transferError != nil {
 						k.PanicHalt(ctx, "Not enough money in module: "+transferError.Error())
 					}
@@ -152,7 +202,12 @@ func (k Keeper) ProcessStakerUnbondingQueue(ctx sdk.Context) {
 // ####################
 
 func (k Keeper) StartUnbondingDelegator(ctx sdk.Context, poolId uint64, staker string,
-	delegatorAddress string, amount uint64) (error error) {
+	delegatorAddress string, amount sdk.Int) (error error) {
+
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(amount); err != nil {
+		return err
+	}
 
 	// unbondingState stores the start and the end of the queue with all unbonding entries
 	// the queue is ordered by time
@@ -205,7 +260,7 @@ func (k Keeper) ProcessDelegatorUnbondingQueue(ctx sdk.Context) {
 		if found && unbondingDelegationEntry.CreationTime+uint64(k.UnbondingDelegationTime(ctx)) < uint64(ctx.BlockTime().Unix()) {
 
 			// Transfer the money
-			err := k.TransferToAddress(ctx, unbondingDelegationEntry.Delegator, unbondingDelegationEntry.Amount)
+			err := k.transferUnbondingToAddress(ctx, unbondingDelegationEntry.Delegator, unbondingDelegationEntry.Amount)
 			if err != nil {
 				k.PanicHalt(ctx, "Not enough money in module: "+err.Error())
 			}
@@ -221,3 +276,63 @@ func (k Keeper) ProcessDelegatorUnbondingQueue(ctx sdk.Context) {
 		}
 	}
 }
+
+// ######################
+// ==== REDELEGATION ====
+// ######################
+
+// StartRedelegation keeps track of a redelegation for the unbonding time,
+// so that it can still be slashed for infractions of the previous staker.
+func (k Keeper) StartRedelegation(ctx sdk.Context, delegatorAddress string, fromPoolId uint64, fromStaker string,
+	toPoolId uint64, toStaker string, amount sdk.Int) {
+
+	// A redelegation to the same staker is already covered by the delegation slash
+	if fromPoolId == toPoolId && fromStaker == toStaker {
+		return
+	}
+
+	redelegationQueueState := k.GetRedelegationQueueState(ctx)
+
+	// Increase topIndex as a new entry is about to be appended
+	redelegationQueueState.HighIndex += 1
+	k.SetRedelegationQueueState(ctx, redelegationQueueState)
+
+	k.SetRedelegationQueueEntry(ctx, types.RedelegationQueueEntry{
+		Index:        redelegationQueueState.HighIndex,
+		Delegator:    delegatorAddress,
+		FromPoolId:   fromPoolId,
+		FromStaker:   fromStaker,
+		ToPoolId:     toPoolId,
+		ToStaker:     toStaker,
+		Amount:       amount,
+		CreationTime: uint64(ctx.BlockTime().Unix()),
+	})
+}
+
+// ProcessRedelegationQueue is called at the end of every block and removes
+// all redelegations from the tail of the RedelegationQueue which can not be slashed anymore.
+func (k Keeper) ProcessRedelegationQueue(ctx sdk.Context) {
+
+	// Get Queue information
+	redelegationQueueState := k.GetRedelegationQueueState(ctx)
+
+	for redelegationQueueState.LowIndex < redelegationQueueState.HighIndex {
+
+		// Get end of queue
+		redelegationEntry, found := k.GetRedelegationQueueEntry(ctx, redelegationQueueState.LowIndex+1)
+
+		// Check if unbonding time is over
+		if found && redelegationEntry.CreationTime+uint64(k.UnbondingDelegationTime(ctx)) >= uint64(ctx.BlockTime().Unix()) {
+			break
+		}
+
+		if found {
+			k.RemoveRedelegationQueueEntry(ctx, &redelegationEntry)
+		}
+
+		// Update tailIndex (lowIndex) of queue
+		redelegationQueueState.LowIndex += 1
+	}
+
+	k.SetRedelegationQueueState(ctx, redelegationQueueState)
+}
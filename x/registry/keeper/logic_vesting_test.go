@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestVestingStaking(t *testing.T) {
+	createGenesis(t)
+	testVestingStaking(t)
+}
+
+func createVestingAccount(t *testing.T, amount uint64) string {
+	address := sdk.AccAddress([]byte("vesting_account_____")).String()
+	require.NoError(t, mint(address, amount))
+
+	addr, _ := sdk.AccAddressFromBech32(address)
+	baseAccount := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*authtypes.BaseAccount)
+
+	startTime := s.ctx.BlockTime().Unix()
+	vestingAccount := vestingtypes.NewContinuousVestingAccount(
+		baseAccount,
+		sdk.NewCoins(sdk.NewInt64Coin("tkyve", int64(amount))),
+		startTime,
+		startTime+60*60*24*365,
+	)
+	s.app.AccountKeeper.SetAccount(s.ctx, vestingAccount)
+
+	return address
+}
+
+func getVestingAccount(address string) *vestingtypes.ContinuousVestingAccount {
+	addr, _ := sdk.AccAddressFromBech32(address)
+	return s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+}
+
+func testVestingStaking(t *testing.T) {
+	vestingAddress := createVestingAccount(t, 100*KYVE)
+
+	// All tokens are locked, but can still be staked
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: vestingAddress,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	vestingAccount := getVestingAccount(vestingAddress)
+	require.Equal(t, int64(100*KYVE), vestingAccount.DelegatedVesting.AmountOf("tkyve").Int64())
+
+	// Delegated vesting tokens can not be moved to another account
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   vestingAddress,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[0],
+	}))
+
+	runTxSuccess(t, &types.MsgUnstakePool{
+		Creator: vestingAddress,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	s.CommitAfterSeconds(types.DefaultUnbondingStakingTime)
+	s.Commit()
+
+	// Unbonded tokens are returned as vesting tokens
+	vestingAccount = getVestingAccount(vestingAddress)
+	require.True(t, vestingAccount.DelegatedVesting.IsZero())
+	require.True(t, vestingAccount.DelegatedFree.IsZero())
+
+	addr, _ := sdk.AccAddressFromBech32(vestingAddress)
+	balance := s.app.BankKeeper.GetBalance(s.ctx, addr, "tkyve")
+	require.Equal(t, int64(100*KYVE), balance.Amount.Int64())
+}
+
+func TestVestingTransferStaker(t *testing.T) {
+	createGenesis(t)
+	testVestingTransferStaker(t)
+}
+
+func testVestingTransferStaker(t *testing.T) {
+	vestingAddress := createVestingAccount(t, 100*KYVE)
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: vestingAddress,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	// The delegated vesting tokens can not stay locked, as there are no other tokens in the account
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   vestingAddress,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[0],
+	}))
+
+	// Free tokens cover the locked amount
+	require.NoError(t, mint(vestingAddress, 100*KYVE))
+
+	runTxSuccess(t, &types.MsgTransferStaker{
+		Creator:   vestingAddress,
+		PoolId:    0,
+		NewStaker: DUMMY_ACCOUNTS[1],
+	})
+
+	vestingAccount := getVestingAccount(vestingAddress)
+	require.True(t, vestingAccount.DelegatedVesting.IsZero())
+	require.True(t, vestingAccount.DelegatedFree.IsZero())
+
+	// The remaining tokens of the account are locked now
+	addr, _ := sdk.AccAddressFromBech32(vestingAddress)
+	require.True(t, s.app.BankKeeper.SpendableCoins(s.ctx, addr).AmountOf("tkyve").LT(sdk.NewIntFromUint64(KYVE)))
+
+	staker, found := s.app.RegistryKeeper.GetStaker(s.ctx, DUMMY_ACCOUNTS[1], 0)
+	require.True(t, found)
+	require.Equal(t, 100*KYVE, staker.Amount.Uint64())
+
+	// The stake can not be moved to a vesting account
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: DUMMY_ACCOUNTS[0],
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(10 * KYVE),
+	})
+
+	require.False(t, runTx(&types.MsgTransferStaker{
+		Creator:   DUMMY_ACCOUNTS[0],
+		PoolId:    0,
+		NewStaker: vestingAddress,
+	}))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// getVoterPayout returns the part of the uploader payout which is reserved for the valid voters of a bundle.
+func (k Keeper) getVoterPayout(ctx sdk.Context, uploaderPayout sdk.Int) sdk.Int {
+	voterRewardShare, err := sdk.NewDecFromStr(k.VoterRewardShare(ctx))
+	if err != nil {
+		k.PanicHalt(ctx, "Invalid value for params: "+err.Error())
+	}
+
+	return sdk.NewDecFromInt(uploaderPayout).Mul(voterRewardShare).TruncateInt()
+}
+
+// distributeVoterRewards is an internal function that distributes the voter payout stake-weighted among
+// all active stakers who voted valid on the current bundle proposal. Like the uploader, every voter
+// shares its reward with its delegators according to its commission.
+// Returns the amount which was actually distributed. Because of integer division a small remainder can be left.
+func (k Keeper) distributeVoterRewards(ctx sdk.Context, pool *types.Pool, voterPayout sdk.Int) (sdk.Int, error) {
+	distributed := sdk.ZeroInt()
+
+	if voterPayout.IsZero() {
+		return distributed, nil
+	}
+
+	// Only active stakers are counted, exactly like in the vote distribution.
+	voters := make([]types.Staker, 0)
+	totalStake := sdk.ZeroInt()
+
+	for _, voter := range pool.BundleProposal.VotersValid {
+		staker, found := k.GetStaker(ctx, voter, pool.Id)
+		if found && staker.Status == types.STAKER_STATUS_ACTIVE {
+			voters = append(voters, staker)
+			totalStake = totalStake.Add(staker.Amount)
+		}
+	}
+
+	if totalStake.IsZero() {
+		return distributed, nil
+	}
+
+	for _, voter := range voters {
+		voterReward := voterPayout.Mul(voter.Amount).Quo(totalStake)
+
+		if voterReward.IsZero() {
+			continue
+		}
+
+		distributed = distributed.Add(voterReward)
+
+		// If the voter has no delegators, it keeps the delegation reward.
+		voterDelegation, foundVoterDelegation := k.GetDelegationPoolData(ctx, pool.Id, voter.Account)
+
+		if foundVoterDelegation && voterDelegation.DelegatorCount > 0 {
+			commission, _ := sdk.NewDecFromStr(voter.Commission)
+			delegationReward := sdk.NewDecFromInt(voterReward).Mul(sdk.NewDec(1).Sub(commission)).RoundInt()
+
+			voterReward = voterReward.Sub(delegationReward)
+			voterDelegation.CurrentRewards = voterDelegation.CurrentRewards.Add(delegationReward)
+
+			k.SetDelegationPoolData(ctx, voterDelegation)
+		}
+
+		// Send payout including the commission to the withdraw address of the voter.
+		if err := k.transferRewardToAddress(ctx, voter.Account, voterReward); err != nil {
+			return distributed, err
+		}
+	}
+
+	return distributed, nil
+}
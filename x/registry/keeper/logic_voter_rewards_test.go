@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoterRewards(t *testing.T) {
+	createGenesis(t)
+	testVoterRewards(t)
+}
+
+func testVoterRewards(t *testing.T) {
+	s.app.RegistryKeeper.ParamStore().Set(s.ctx, types.KeyVoterRewardShare, "0.5")
+
+	runTxSuccess(t, &types.MsgFundPool{
+		Creator: ALICE_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(99 * KYVE),
+	})
+
+	for _, staker := range []string{ALICE_ADDR, BOB_ADDR, DUMMY_ACCOUNTS[0]} {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+	}
+
+	s.Commit()
+
+	runTxSuccess(t, &types.MsgClaimUploaderRole{
+		Creator: ALICE_ADDR,
+		Id:      0,
+	})
+
+	s.CommitAfterSeconds(60)
+
+	require.True(t, submitBundle(ALICE_ADDR, "a", 0, 10, ""))
+
+	voteBundle(t, BOB_ADDR, "a", types.VOTE_TYPE_YES)
+	voteBundle(t, DUMMY_ACCOUNTS[0], "a", types.VOTE_TYPE_YES)
+
+	setNextUploader(BOB_ADDR)
+	s.CommitAfterSeconds(60)
+
+	aliceBalance := getBalance(ALICE_ADDR)
+	voterBalance := getBalance(DUMMY_ACCOUNTS[0])
+
+	require.True(t, submitBundle(BOB_ADDR, "b", 10, 20, "a_key"))
+
+	// Half of the payout after the network fee is shared equally among both valid voters
+	bundleReward := sdk.NewIntFromUint64(100 + 100*s.app.RegistryKeeper.StorageCost(s.ctx))
+	networkFee, _ := sdk.NewDecFromStr(s.app.RegistryKeeper.NetworkFee(s.ctx))
+	payout := bundleReward.Sub(sdk.NewDecFromInt(bundleReward).Mul(networkFee).RoundInt())
+	voterPayout := sdk.NewDecFromInt(payout).QuoInt64(2).TruncateInt()
+	voterReward := voterPayout.QuoRaw(2)
+
+	require.Equal(t, voterBalance+voterReward.Uint64(), getBalance(DUMMY_ACCOUNTS[0]))
+	require.Equal(t, aliceBalance+payout.Sub(voterReward.MulRaw(2)).Uint64(), getBalance(ALICE_ADDR))
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithdrawAddress(t *testing.T) {
+	createGenesis(t)
+	testWithdrawAddress(t)
+}
+
+func getBalance(address string) uint64 {
+	acc, _ := sdk.AccAddressFromBech32(address)
+	return s.app.BankKeeper.GetBalance(s.ctx, acc, "tkyve").Amount.Uint64()
+}
+
+func testWithdrawAddress(t *testing.T) {
+	delegator := DUMMY_ACCOUNTS[0]
+	treasury := DUMMY_ACCOUNTS[1]
+
+	runTxSuccess(t, &types.MsgStakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgDelegatePool{
+		Creator: delegator,
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(100 * KYVE),
+	})
+
+	require.Equal(t, delegator, s.app.RegistryKeeper.GetWithdrawAddress(s.ctx, delegator))
+
+	// Module accounts can not receive rewards
+	require.False(t, runTx(&types.MsgSetWithdrawAddress{
+		Creator:         delegator,
+		WithdrawAddress: authtypes.NewModuleAddress(types.ModuleName).String(),
+	}))
+
+	runTxSuccess(t, &types.MsgSetWithdrawAddress{
+		Creator:         delegator,
+		WithdrawAddress: treasury,
+	})
+
+	res, err := s.app.RegistryKeeper.AccountWithdrawAddress(sdk.WrapSDKContext(s.ctx), &types.QueryAccountWithdrawAddressRequest{
+		Address: delegator,
+	})
+	require.NoError(t, err)
+	require.Equal(t, treasury, res.WithdrawAddress)
+
+	delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, BOB_ADDR)
+	delegationPoolData.CurrentRewards = delegationPoolData.CurrentRewards.Add(sdk.NewIntFromUint64(10 * KYVE))
+	s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+
+	delegatorBalance := getBalance(delegator)
+	treasuryBalance := getBalance(treasury)
+
+	runTxSuccess(t, &types.MsgWithdrawPool{
+		Creator: delegator,
+		Id:      0,
+		Staker:  BOB_ADDR,
+	})
+
+	require.Equal(t, delegatorBalance, getBalance(delegator))
+	require.Equal(t, treasuryBalance+10*KYVE, getBalance(treasury))
+
+	// Unbonded tokens go to the withdraw address as well
+	runTxSuccess(t, &types.MsgSetWithdrawAddress{
+		Creator:         BOB_ADDR,
+		WithdrawAddress: treasury,
+	})
+
+	runTxSuccess(t, &types.MsgUndelegatePool{
+		Creator: delegator,
+		Id:      0,
+		Staker:  BOB_ADDR,
+		Amount:  sdk.NewIntFromUint64(50 * KYVE),
+	})
+
+	runTxSuccess(t, &types.MsgUnstakePool{
+		Creator: BOB_ADDR,
+		Id:      0,
+		Amount:  sdk.NewIntFromUint64(20 * KYVE),
+	})
+
+	bobBalance := getBalance(BOB_ADDR)
+	treasuryBalance = getBalance(treasury)
+
+	s.CommitAfterSeconds(types.DefaultUnbondingDelegationTime + 1)
+	s.Commit()
+
+	require.Equal(t, delegatorBalance, getBalance(delegator))
+	require.Equal(t, bobBalance, getBalance(BOB_ADDR))
+	require.Equal(t, treasuryBalance+70*KYVE, getBalance(treasury))
+
+	// Setting the own address resets the withdraw address
+	runTxSuccess(t, &types.MsgSetWithdrawAddress{
+		Creator:         delegator,
+		WithdrawAddress: delegator,
+	})
+	require.Len(t, s.app.RegistryKeeper.GetAllWithdrawAddresses(s.ctx), 1)
+}
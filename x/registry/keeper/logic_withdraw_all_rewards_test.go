@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"github.com/KYVENetwork/chain/x/registry/keeper"
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithdrawAllRewards(t *testing.T) {
+	createGenesis(t)
+	testWithdrawAllRewards(t)
+}
+
+func testWithdrawAllRewards(t *testing.T) {
+	delegator := DUMMY_ACCOUNTS[0]
+	stakers := []string{BOB_ADDR, ALICE_ADDR}
+
+	for _, staker := range stakers {
+		runTxSuccess(t, &types.MsgStakePool{
+			Creator: staker,
+			Id:      0,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+
+		runTxSuccess(t, &types.MsgDelegatePool{
+			Creator: delegator,
+			Id:      0,
+			Staker:  staker,
+			Amount:  sdk.NewIntFromUint64(100 * KYVE),
+		})
+
+		delegationPoolData, _ := s.app.RegistryKeeper.GetDelegationPoolData(s.ctx, 0, staker)
+		delegationPoolData.CurrentRewards = delegationPoolData.CurrentRewards.Add(sdk.NewIntFromUint64(10 * KYVE))
+		s.app.RegistryKeeper.SetDelegationPoolData(s.ctx, delegationPoolData)
+	}
+
+	pending, err := s.app.RegistryKeeper.AccountPendingRewards(sdk.WrapSDKContext(s.ctx), &types.QueryAccountPendingRewardsRequest{
+		Address: delegator,
+	})
+	require.NoError(t, err)
+	require.Len(t, pending.Rewards, 2)
+	require.Equal(t, 20*KYVE, pending.Total.Uint64())
+
+	// The pending rewards can be queried page by page
+	pending, err = s.app.RegistryKeeper.AccountPendingRewards(sdk.WrapSDKContext(s.ctx), &types.QueryAccountPendingRewardsRequest{
+		Address:    delegator,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, pending.Rewards, 1)
+	require.Equal(t, 10*KYVE, pending.Total.Uint64())
+	require.NotEmpty(t, pending.Pagination.NextKey)
+
+	pending, err = s.app.RegistryKeeper.AccountPendingRewards(sdk.WrapSDKContext(s.ctx), &types.QueryAccountPendingRewardsRequest{
+		Address:    delegator,
+		Pagination: &query.PageRequest{Key: pending.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, pending.Rewards, 1)
+	require.Equal(t, 10*KYVE, pending.Total.Uint64())
+	require.Empty(t, pending.Pagination.NextKey)
+
+	// At least one position has to be withdrawn per message
+	params := types.DefaultParams()
+	params.MaxWithdrawAllPositions = 0
+	require.Error(t, params.Validate())
+
+	balance := getBalance(delegator)
+
+	msgServer := keeper.NewMsgServerImpl(s.app.RegistryKeeper)
+
+	// Only one delegation is withdrawn
+	res, err := msgServer.WithdrawAllRewards(sdk.WrapSDKContext(s.ctx), &types.MsgWithdrawAllRewards{
+		Creator: delegator,
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10*KYVE, res.Amount.Uint64())
+	require.NotEmpty(t, res.NextKey)
+	require.Equal(t, balance+10*KYVE, getBalance(delegator))
+
+	// The next key continues with the remaining delegation
+	res, err = msgServer.WithdrawAllRewards(sdk.WrapSDKContext(s.ctx), &types.MsgWithdrawAllRewards{
+		Creator:  delegator,
+		Limit:    1,
+		StartKey: res.NextKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10*KYVE, res.Amount.Uint64())
+	require.Empty(t, res.NextKey)
+	require.Equal(t, balance+20*KYVE, getBalance(delegator))
+
+	pending, _ = s.app.RegistryKeeper.AccountPendingRewards(sdk.WrapSDKContext(s.ctx), &types.QueryAccountPendingRewardsRequest{
+		Address: delegator,
+	})
+	require.Equal(t, uint64(0), pending.Total.Uint64())
+}
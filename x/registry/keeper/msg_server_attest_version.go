@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AttestVersion handles the logic of an SDK message that allows a staker to attest the version of
+// the protocol node it is running. Attestations are allowed at any time, especially during an upgrade.
+func (k msgServer) AttestVersion(goCtx context.Context, msg *types.MsgAttestVersion) (*types.MsgAttestVersionResponse, error) {
+	// Unwrap context and attempt to fetch the pool.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetPool(ctx, msg.Id); !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
+	}
+
+	// Check if the sender is a protocol node (aka has staked into this pool).
+	staker, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
+	if !isStaker {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	staker.Version = msg.Version
+	k.SetStaker(ctx, staker)
+
+	// Emit an attest version event.
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventAttestVersion{
+		PoolId:  msg.Id,
+		Staker:  msg.Creator,
+		Version: msg.Version,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgAttestVersionResponse{}, nil
+}
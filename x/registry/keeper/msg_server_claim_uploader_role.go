@@ -27,23 +27,28 @@ func (k msgServer) ClaimUploaderRole(goCtx context.Context, msg *types.MsgClaimU
 	}
 
 	// Check if the sender is a protocol node (aka has staked into this pool).
-	_, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
+	staker, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
 	if !isStaker {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
 	}
 
+	// Check if the sender runs the required protocol version.
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
+		return nil, err
+	}
+
 	// Check if enough nodes are online
 	if len(pool.Stakers) < 2 {
 		return nil, types.ErrNotEnoughNodesOnline
 	}
 
 	// Check if minimum stake is reached
-	if pool.TotalStake < pool.MinStake {
+	if pool.TotalStake.LT(pool.MinStake) {
 		return nil, types.ErrNotEnoughStake
 	}
 
 	// Error if the pool has no funds.
-	if len(pool.Funders) == 0 {
+	if len(pool.Funders) == 0 && !k.hasActiveFundingStreams(ctx, pool.Id) {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrInsufficientFunds, types.ErrFundsTooLow.Error())
 	}
 
@@ -52,6 +57,11 @@ func (k msgServer) ClaimUploaderRole(goCtx context.Context, msg *types.MsgClaimU
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolPaused.Error())
 	}
 
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
 	// Error if the pool is upgrading.
 	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCurrentlyUpgrading.Error())
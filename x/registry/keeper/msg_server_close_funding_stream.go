@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CloseFundingStream handles the logic of an SDK message that allows sponsors to close their funding stream
+// and get the unspent budget back.
+func (k msgServer) CloseFundingStream(goCtx context.Context, msg *types.MsgCloseFundingStream) (*types.MsgCloseFundingStreamResponse, error) {
+	// Unwrap context and attempt to fetch the funding stream.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stream, found := k.GetFundingStream(ctx, msg.Id, msg.StreamId)
+
+	// Error if the funding stream isn't found.
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrFundingStreamNotFound.Error(), msg.StreamId, msg.Id)
+	}
+
+	// Only the sponsor is allowed to close the funding stream.
+	if stream.Account != msg.Creator {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, "funding stream %v belongs to %v", stream.Id, stream.Account)
+	}
+
+	if err := k.closeFundingStream(ctx, stream); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCloseFundingStreamResponse{}, nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateFundingStream handles the logic of an SDK message that allows sponsors to fund a specified pool
+// with a budget which is spent within the given limits.
+func (k msgServer) CreateFundingStream(goCtx context.Context, msg *types.MsgCreateFundingStream) (*types.MsgCreateFundingStreamResponse, error) {
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Error if one of the limits is negative, zero means unlimited.
+	for _, limit := range []sdk.Int{msg.AmountPerBundle, msg.AmountPerDay} {
+		if limit.IsNil() || limit.IsNegative() {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "invalid amount (%v)", limit)
+		}
+	}
+
+	// Unwrap context and attempt to fetch the pool.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, msg.Id)
+
+	// Error if the pool isn't found.
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
+	}
+
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	// Error if the pool is retired.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
+	// Error if the funding stream would already be expired.
+	if msg.EndTime > 0 && msg.EndTime <= uint64(ctx.BlockTime().Unix()) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrFundingStreamEndTime.Error(), msg.EndTime)
+	}
+
+	// Error if the budget of the funding stream is below the minimum.
+	if msg.Amount.LT(sdk.NewInt(types.MinFundingStream)) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrFundingStreamTooLow.Error(), types.MinFundingStream)
+	}
+
+	// Check if we have reached the maximum number of funding streams.
+	// If the budget exceeds the one of the lowest funding stream, close it.
+	if streams := k.GetFundingStreamsByPool(ctx, msg.Id); len(streams) >= types.MaxFundingStreams {
+		lowestStream := streams[0]
+		for _, stream := range streams[1:] {
+			if stream.Amount.LT(lowestStream.Amount) {
+				lowestStream = stream
+			}
+		}
+
+		if msg.Amount.GT(lowestStream.Amount) {
+			// Return the unspent budget of the lowest funding stream.
+			if err := k.closeFundingStream(ctx, lowestStream); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrFundsTooLow.Error(), lowestStream.Amount)
+		}
+	}
+
+	// Transfer tokens from sender to this module.
+	err := k.transferToRegistry(ctx, msg.Creator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	streamId := k.AppendFundingStream(ctx, types.FundingStream{
+		PoolId:          msg.Id,
+		Account:         msg.Creator,
+		Amount:          msg.Amount,
+		AmountPerBundle: msg.AmountPerBundle,
+		AmountPerDay:    msg.AmountPerDay,
+		EndTime:         msg.EndTime,
+		DayStart:        uint64(ctx.BlockTime().Unix()),
+		SpentToday:      sdk.ZeroInt(),
+	})
+
+	// Emit a create funding stream event.
+	errEmit := ctx.EventManager().EmitTypedEvent(&types.EventCreateFundingStream{
+		PoolId:   msg.Id,
+		StreamId: streamId,
+		Address:  msg.Creator,
+		Amount:   msg.Amount,
+	})
+	if errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgCreateFundingStreamResponse{}, nil
+}
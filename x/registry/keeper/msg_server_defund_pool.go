@@ -10,6 +10,11 @@ import (
 
 // DefundPool handles the logic of an SDK message that allows funders to defund from a specified pool.
 func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (*types.MsgDefundPoolResponse, error) {
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	pool, found := k.GetPool(ctx, msg.Id)
@@ -27,21 +32,21 @@ func (k msgServer) DefundPool(goCtx context.Context, msg *types.MsgDefundPool) (
 	}
 
 	// Check if the sender is trying to defund more than they have funded.
-	if msg.Amount > funder.Amount {
+	if msg.Amount.GT(funder.Amount) {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrDefundTooHigh.Error(), funder.Account)
 	}
 
 	// Update state variables (or completely remove if fully defunding).
-	if funder.Amount == msg.Amount {
+	if funder.Amount.Equal(msg.Amount) {
 		k.removeFunder(ctx, &pool, &funder)
 	} else {
-		funder.Amount -= msg.Amount
-		pool.TotalFunds -= msg.Amount
+		funder.Amount = funder.Amount.Sub(msg.Amount)
+		pool.TotalFunds = pool.TotalFunds.Sub(msg.Amount)
 		k.SetFunder(ctx, funder)
 	}
 
 	// Transfer tokens from this module to sender.
-	err := k.TransferToAddress(ctx, msg.Creator, msg.Amount)
+	err := k.undelegateToAddress(ctx, msg.Creator, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
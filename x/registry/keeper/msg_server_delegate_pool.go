@@ -15,6 +15,11 @@ func (k msgServer) DelegatePool(
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Check that the delegation does not exceed the delegation caps of the staker.
+	if err := k.checkDelegationCaps(ctx, msg.Id, msg.Staker, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Performs logical delegation without transferring the amount
 	if err := k.Delegate(ctx, msg.Staker, msg.Id, msg.Creator, msg.Amount); err != nil {
 		return nil, err
@@ -10,6 +10,11 @@ import (
 
 // FundPool handles the logic of an SDK message that allows funders to fund a specified pool.
 func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*types.MsgFundPoolResponse, error) {
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	pool, found := k.GetPool(ctx, msg.Id)
@@ -19,11 +24,21 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	// Error if the pool is retired.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
 	// Check if the sender is already a funder.
 	funder, funderExists := k.GetFunder(ctx, msg.Creator, msg.Id)
 
 	if funderExists {
-		funder.Amount += msg.Amount
+		funder.Amount = funder.Amount.Add(msg.Amount)
 		k.SetFunder(ctx, funder)
 	} else {
 		// Check if we have reached the maximum number of funders.
@@ -31,9 +46,9 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 		if len(pool.Funders) == types.MaxFunders {
 			lowestFunder, _ := k.GetFunder(ctx, pool.LowestFunder, msg.Id)
 
-			if msg.Amount > lowestFunder.Amount {
+			if msg.Amount.GT(lowestFunder.Amount) {
 				// Transfer tokens from this module to the lowest funder.
-				err := k.TransferToAddress(ctx, lowestFunder.Account, lowestFunder.Amount)
+				err := k.refundFunder(ctx, &lowestFunder)
 				if err != nil {
 					return nil, err
 				}
@@ -80,7 +95,7 @@ func (k msgServer) FundPool(goCtx context.Context, msg *types.MsgFundPool) (*typ
 	}
 
 	// Update and return.
-	pool.TotalFunds += msg.Amount
+	pool.TotalFunds = pool.TotalFunds.Add(msg.Amount)
 	k.updateLowestFunder(ctx, &pool)
 	k.SetPool(ctx, pool)
 
@@ -32,7 +32,7 @@ func (k msgServer) ReactivateStaker(goCtx context.Context, msg *types.MsgReactiv
 	if len(pool.Stakers) >= types.MaxStakers {
 		lowestStaker, _ := k.GetStaker(ctx, pool.LowestStaker, msg.PoolId)
 
-		if staker.Amount > lowestStaker.Amount {
+		if staker.Amount.GT(lowestStaker.Amount) {
 
 			if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventStakerStatusChanged{
 				PoolId:  pool.Id,
@@ -53,8 +53,8 @@ func (k msgServer) ReactivateStaker(goCtx context.Context, msg *types.MsgReactiv
 
 	pool.InactiveStakers = removeStringFromList(pool.InactiveStakers, staker.Account)
 	pool.Stakers = append(pool.Stakers, staker.Account)
-	pool.TotalStake += staker.Amount
-	pool.TotalInactiveStake -= staker.Amount
+	pool.TotalStake = pool.TotalStake.Add(staker.Amount)
+	pool.TotalInactiveStake = pool.TotalInactiveStake.Sub(staker.Amount)
 	staker.Status = types.STAKER_STATUS_ACTIVE
 
 	k.SetStaker(ctx, staker)
@@ -13,44 +13,55 @@ func (k msgServer) RedelegatePool(goCtx context.Context, msg *types.MsgRedelegat
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Check if cooldowns are over,
 	// Remove all expired entries
-	for _, creationDate := range k.GetRedelegationCooldownEntries(ctx, msg.Creator) {
-		if ctx.BlockTime().Unix()-int64(creationDate) > int64(k.RedelegationCooldown(ctx)) {
-			k.RemoveRedelegationCooldown(ctx, msg.Creator, creationDate)
+	for _, cooldown := range k.GetRedelegationCooldownEntries(ctx, msg.Creator) {
+		if ctx.BlockTime().Unix()-int64(cooldown.CreationDate) > int64(k.RedelegationCooldown(ctx)) {
+			k.RemoveRedelegationCooldown(ctx, msg.Creator, cooldown.Index)
 		} else {
 			break
 		}
 	}
 
 	// Get list of active cooldowns
-	creationDates := k.GetRedelegationCooldownEntries(ctx, msg.Creator)
+	cooldowns := k.GetRedelegationCooldownEntries(ctx, msg.Creator)
 
-	// Check if there are still free blocks
-	if len(creationDates) >= int(k.RedelegationMaxAmount(ctx)) {
+	// Check if there are still free slots
+	if len(cooldowns) >= int(k.RedelegationMaxAmount(ctx)) {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrRedelegationOnCooldown.Error())
 	}
-	// Check that now Redelegation occurred in this block, as it will lead to errors, as
-	// the block-time is used for an index key.
-	if len(creationDates) > 0 && creationDates[len(creationDates)-1] == uint64(ctx.BlockTime().Unix()) {
-		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrMultipleRedelegationInSameBlock.Error())
-	}
 
-	// All checks passed, create cooldown entry
+	// All checks passed, create cooldown entry. The index is a per-delegator sequence,
+	// so that multiple redelegations can be performed within the same block.
 	k.SetRedelegationCooldown(ctx, types.RedelegationCooldown{
 		Address:      msg.Creator,
-		CreationDate: uint64(ctx.BlockTime().Unix())})
+		CreationDate: uint64(ctx.BlockTime().Unix()),
+		Index:        k.GetNextRedelegationCooldownIndex(ctx, msg.Creator),
+	})
 
 	// Perform undelegation
 	if err := k.Undelegate(ctx, msg.FromStaker, msg.FromPoolId, msg.Creator, msg.Amount); err != nil {
 		return nil, err
 	}
 
+	// Check that the delegation does not exceed the delegation caps of the new staker.
+	if err := k.checkDelegationCaps(ctx, msg.ToPoolId, msg.ToStaker, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Perform undelegation
 	if err := k.Delegate(ctx, msg.ToStaker, msg.ToPoolId, msg.Creator, msg.Amount); err != nil {
 		return nil, err
 	}
 
+	// Keep track of the redelegation, so that it can be slashed for infractions of the previous staker
+	k.StartRedelegation(ctx, msg.Creator, msg.FromPoolId, msg.FromStaker, msg.ToPoolId, msg.ToStaker, msg.Amount)
+
 	// Emit a delegation event.
 	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventRedelegatePool{
 		Address:  msg.Creator,
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAutoCompound handles the logic of an SDK message that allows delegators to opt in or out of
+// automatically compounding their rewards of a delegation.
+func (k msgServer) SetAutoCompound(
+	goCtx context.Context, msg *types.MsgSetAutoCompound,
+) (*types.MsgSetAutoCompoundResponse, error) {
+	// Unwrap context and attempt to fetch the pool.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found := k.GetPool(ctx, msg.PoolId)
+
+	// Error if the pool isn't found.
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.PoolId)
+	}
+
+	// Check if the sender is a delegator in this pool.
+	_, isDelegator := k.GetDelegator(ctx, msg.PoolId, msg.Staker, msg.Creator)
+	if !isDelegator {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrNotADelegator.Error())
+	}
+
+	if msg.Enabled {
+		k.Keeper.SetAutoCompound(ctx, types.AutoCompound{
+			PoolId:    msg.PoolId,
+			Staker:    msg.Staker,
+			Delegator: msg.Creator,
+		})
+	} else {
+		k.RemoveAutoCompound(ctx, msg.PoolId, msg.Staker, msg.Creator)
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
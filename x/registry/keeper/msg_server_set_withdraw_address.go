@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetWithdrawAddress handles the logic of an SDK message that allows an account to route its
+// uploader rewards, commission and delegation rewards to a different account.
+func (k msgServer) SetWithdrawAddress(
+	goCtx context.Context, msg *types.MsgSetWithdrawAddress,
+) (*types.MsgSetWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Module accounts can not receive rewards, otherwise payouts of bundles would fail.
+	withdrawAddress, _ := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if k.bankKeeper.BlockedAddr(withdrawAddress) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrWithdrawAddressBlocked.Error(), msg.WithdrawAddress)
+	}
+
+	// Setting the own address resets the withdraw address.
+	if msg.WithdrawAddress == msg.Creator {
+		k.RemoveWithdrawAddress(ctx, msg.Creator)
+	} else {
+		k.Keeper.SetWithdrawAddress(ctx, types.WithdrawAddress{
+			Address:         msg.Creator,
+			WithdrawAddress: msg.WithdrawAddress,
+		})
+	}
+
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventSetWithdrawAddress{
+		Address:         msg.Creator,
+		WithdrawAddress: msg.WithdrawAddress,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgSetWithdrawAddressResponse{}, nil
+}
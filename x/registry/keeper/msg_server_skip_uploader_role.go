@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SkipUploaderRole handles the logic of an SDK message that allows the designated uploader to hand
+// the uploader role to a newly selected staker, e.g. if its data source is temporarily unavailable.
+// The current bundle proposal stays intact and the staker is not slashed, but a staker can only skip
+// once per UploaderRoleSkipCooldown.
+func (k msgServer) SkipUploaderRole(goCtx context.Context, msg *types.MsgSkipUploaderRole) (*types.MsgSkipUploaderRoleResponse, error) {
+	// Unwrap context and attempt to fetch the pool.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, msg.Id)
+
+	// Error if the pool isn't found.
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
+	}
+
+	// Error if the pool is paused.
+	if pool.Paused {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolPaused.Error())
+	}
+
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	// Error if the pool is upgrading.
+	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCurrentlyUpgrading.Error())
+	}
+
+	// Check if the sender is a protocol node (aka has staked into this pool).
+	staker, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
+	if !isStaker {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	// Check if the sender runs the required protocol version.
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
+		return nil, err
+	}
+
+	// Check if the sender is the designated uploader.
+	if pool.BundleProposal.NextUploader != msg.Creator {
+		return nil, types.ErrNotDesignatedUploader
+	}
+
+	// Get the most recent bundle proposal, new bundle proposals chain from it
+	lastProposal := lastOpenBundleProposal(&pool)
+
+	// Get current height from where the bundle proposal should resume
+	currentHeight := pool.CurrentHeight
+
+	if lastProposal.ToHeight != 0 {
+		currentHeight = lastProposal.ToHeight
+	}
+
+	// Validate from height
+	if msg.FromHeight != currentHeight {
+		return nil, types.ErrFromHeight
+	}
+
+	// Once the upload timeout is reached the uploader gets slashed at the end of the block.
+	if uint64(ctx.BlockTime().Unix()) >= (uploadRoundStart(&pool) + pool.UploadInterval + k.UploadTimeout(ctx)) {
+		return nil, types.ErrUploadTimeoutReached
+	}
+
+	// Check if the staker has skipped the uploader role recently.
+	if staker.LastUploaderRoleSkip > 0 && uint64(ctx.BlockTime().Unix()) < staker.LastUploaderRoleSkip+k.UploaderRoleSkipCooldown(ctx) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrUploaderRoleSkipOnCooldown.Error(), staker.LastUploaderRoleSkip+k.UploaderRoleSkipCooldown(ctx))
+	}
+
+	// Select the new uploader from all other stakers.
+	var candidates []string
+	for _, s := range pool.Stakers {
+		if s != msg.Creator {
+			candidates = append(candidates, s)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, types.ErrNotEnoughNodesOnline
+	}
+
+	nextUploader := k.getNextUploaderByRandom(ctx, &pool, candidates)
+
+	staker.LastUploaderRoleSkip = uint64(ctx.BlockTime().Unix())
+	k.SetStaker(ctx, staker)
+
+	// Hand over the uploader role, the new uploader gets a full upload interval.
+	// The creation time of the bundle proposal is kept, as slashes are dated back to it.
+	pool.BundleProposal.NextUploader = nextUploader
+	pool.BundleProposal.UploaderAssignedAt = uint64(ctx.BlockTime().Unix())
+	k.SetPool(ctx, pool)
+
+	// Emit a skipped uploader role event.
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventSkippedUploaderRole{
+		PoolId:           pool.Id,
+		Id:               pool.TotalBundles,
+		PreviousUploader: msg.Creator,
+		NewUploader:      nextUploader,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgSkipUploaderRoleResponse{}, nil
+}
@@ -10,6 +10,11 @@ import (
 
 // StakePool handles the logic of an SDK message that allows protocol nodes to stake in a specified pool.
 func (k msgServer) StakePool(goCtx context.Context, msg *types.MsgStakePool) (*types.MsgStakePoolResponse, error) {
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	pool, found := k.GetPool(ctx, msg.Id)
@@ -19,11 +24,21 @@ func (k msgServer) StakePool(goCtx context.Context, msg *types.MsgStakePool) (*t
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	// Error if the pool is retired.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
 	// Check if the sender is already a staker.
 	staker, stakerExists := k.GetStaker(ctx, msg.Creator, msg.Id)
 
 	if stakerExists {
-		staker.Amount += msg.Amount
+		staker.Amount = staker.Amount.Add(msg.Amount)
 		k.SetStaker(ctx, staker)
 	} else {
 		// Check if we have reached the maximum number of stakers.
@@ -31,7 +46,7 @@ func (k msgServer) StakePool(goCtx context.Context, msg *types.MsgStakePool) (*t
 		if len(pool.Stakers) >= types.MaxStakers {
 			lowestStaker, _ := k.GetStaker(ctx, pool.LowestStaker, msg.Id)
 
-			if msg.Amount > lowestStaker.Amount {
+			if msg.Amount.GT(lowestStaker.Amount) {
 
 				if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventStakerStatusChanged{
 					PoolId:  pool.Id,
@@ -77,9 +92,9 @@ func (k msgServer) StakePool(goCtx context.Context, msg *types.MsgStakePool) (*t
 
 	staker, _ = k.GetStaker(ctx, msg.Creator, msg.Id)
 	if staker.Status == types.STAKER_STATUS_ACTIVE {
-		pool.TotalStake += msg.Amount
+		pool.TotalStake = pool.TotalStake.Add(msg.Amount)
 	} else if staker.Status == types.STAKER_STATUS_INACTIVE {
-		pool.TotalInactiveStake += msg.Amount
+		pool.TotalInactiveStake = pool.TotalInactiveStake.Add(msg.Amount)
 	}
 
 	k.updateLowestStaker(ctx, &pool)
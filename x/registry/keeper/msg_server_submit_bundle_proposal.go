@@ -28,12 +28,12 @@ func (k msgServer) SubmitBundleProposal(
 	}
 
 	// Check if minimum stake is reached
-	if pool.TotalStake < pool.MinStake {
+	if pool.TotalStake.LT(pool.MinStake) {
 		return nil, types.ErrNotEnoughStake
 	}
 
 	// Error if the pool has no funds.
-	if len(pool.Funders) == 0 {
+	if len(pool.Funders) == 0 && !k.hasActiveFundingStreams(ctx, pool.Id) {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrInsufficientFunds, types.ErrFundsTooLow.Error())
 	}
 
@@ -42,27 +42,40 @@ func (k msgServer) SubmitBundleProposal(
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolPaused.Error())
 	}
 
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
 	// Error if the pool is upgrading.
 	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCurrentlyUpgrading.Error())
 	}
 
 	// Check if the sender is a protocol node (aka has staked into this pool).
-	_, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
+	staker, isStaker := k.GetStaker(ctx, msg.Creator, msg.Id)
 	if !isStaker {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
 	}
 
+	// Check if the sender runs the required protocol version.
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
+		return nil, err
+	}
+
 	// Validate bundle id.
 	if msg.StorageId == "" {
 		return nil, types.ErrInvalidArgs
 	}
 
+	// Get the most recent bundle proposal, new bundle proposals chain from it
+	lastProposal := lastOpenBundleProposal(&pool)
+
 	// Get current height from where the bundle proposal should resume
 	current_height := pool.CurrentHeight
 
-	if pool.BundleProposal.ToHeight != 0 {
-		current_height = pool.BundleProposal.ToHeight
+	if lastProposal.ToHeight != 0 {
+		current_height = lastProposal.ToHeight
 	}
 
 	// Validate from height
@@ -79,10 +92,20 @@ func (k msgServer) SubmitBundleProposal(
 		return nil, types.ErrMaxBundleSize
 	}
 
+	// Bounded pools can not be archived beyond their end height
+	if pool.EndHeight > 0 && msg.ToHeight > pool.EndHeight {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrEndHeight.Error(), pool.EndHeight)
+	}
+
+	// Bounded pools can not be archived beyond their end key
+	if pool.EndKey != "" && msg.ToKey != "" && compareKeys(msg.ToKey, pool.EndKey) > 0 {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, types.ErrEndKey.Error(), msg.ToKey, pool.EndKey)
+	}
+
 	current_key := pool.CurrentKey
 
-	if pool.BundleProposal.ToKey != "" {
-		current_key = pool.BundleProposal.ToKey
+	if lastProposal.ToKey != "" {
+		current_key = lastProposal.ToKey
 	}
 
 	// Validate from key
@@ -96,12 +119,18 @@ func (k msgServer) SubmitBundleProposal(
 	}
 
 	// Check if upload_interval has been surpassed
-	if uint64(ctx.BlockTime().Unix()) < (pool.BundleProposal.CreatedAt + pool.UploadInterval) {
+	if uint64(ctx.BlockTime().Unix()) < (uploadRoundStart(&pool) + pool.UploadInterval) {
 		return nil, types.ErrUploadInterval
 	}
 
 	// EVALUATE PREVIOUS ROUND
 
+	// Uncompressed bundles don't need to specify a compression
+	compression := msg.Compression
+	if compression == "" {
+		compression = types.CompressionNone
+	}
+
 	// Check args of bundle types
 	if strings.HasPrefix(msg.StorageId, types.KYVE_NO_DATA_BUNDLE) {
 		// Validate bundle args
@@ -118,6 +147,11 @@ func (k msgServer) SubmitBundleProposal(
 		if msg.BundleHash != "" {
 			return nil, types.ErrInvalidArgs
 		}
+
+		// Validate bundle metadata
+		if compression != types.CompressionNone || msg.DataItemCount != 0 || msg.UncompressedByteSize != 0 {
+			return nil, types.ErrInvalidArgs
+		}
 	} else {
 		if msg.ToHeight <= current_height || msg.ByteSize == 0 {
 			return nil, types.ErrInvalidArgs
@@ -132,13 +166,37 @@ func (k msgServer) SubmitBundleProposal(
 		if msg.BundleHash == "" {
 			return nil, types.ErrInvalidArgs
 		}
+
+		// Validate storage id against the storage provider of the pool
+		if err := k.validateStorageId(ctx, pool.StorageProviderId, msg.StorageId); err != nil {
+			return nil, err
+		}
+
+		// Validate compression
+		if err := validateCompression(&pool, compression); err != nil {
+			return nil, err
+		}
+
+		// Uncompressed bundles have the same uncompressed size, compressed bundles have to specify it
+		if compression == types.CompressionNone {
+			if msg.UncompressedByteSize != 0 && msg.UncompressedByteSize != msg.ByteSize {
+				return nil, types.ErrInvalidArgs
+			}
+		} else if msg.UncompressedByteSize == 0 {
+			return nil, types.ErrInvalidArgs
+		}
+	}
+
+	uncompressedByteSize := msg.UncompressedByteSize
+	if compression == types.CompressionNone {
+		uncompressedByteSize = msg.ByteSize
 	}
 
 	// If bundle was dropped or is of type KYVE_NO_DATA_BUNDLE just register new bundle.
+	// Bundle proposals which are chained after a KYVE_NO_DATA_BUNDLE move up instead.
 	if pool.BundleProposal.StorageId == "" || strings.HasPrefix(pool.BundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
-		pool.BundleProposal = &types.BundleProposal{
+		setNextBundleProposal(&pool, &types.BundleProposal{
 			Uploader:     msg.Creator,
-			NextUploader: k.getNextUploaderByRandom(ctx, &pool, pool.Stakers),
 			StorageId:     msg.StorageId,
 			ByteSize:     msg.ByteSize,
 			ToHeight:     msg.ToHeight,
@@ -146,15 +204,48 @@ func (k msgServer) SubmitBundleProposal(
 			ToKey:        msg.ToKey,
 			ToValue:      msg.ToValue,
 			BundleHash: msg.BundleHash,
+			StorageProviderId: pool.StorageProviderId,
+			Compression: compression,
+			DataItemCount: msg.DataItemCount,
+			UncompressedByteSize: uncompressedByteSize,
+		}, k.getNextUploaderByRandom(ctx, &pool, pool.Stakers))
+
+		k.SetPool(ctx, pool)
+
+		return &types.MsgSubmitBundleProposalResponse{}, nil
+	}
+
+	// If the current bundle proposal is still open, chain the new bundle proposal after it.
+	if canPipelineBundleProposal(&pool) && k.getQuorumStatus(k.getVoteDistribution(ctx, &pool, pool.BundleProposal)) == types.BUNDLE_STATUS_NO_QUORUM {
+		// A KYVE_NO_DATA_BUNDLE can not be chained, just like it can not replace an open bundle proposal without pipelining.
+		if strings.HasPrefix(msg.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+			return nil, types.ErrQuorumNotReached
 		}
 
+		pool.PipelinedProposals = append(pool.PipelinedProposals, &types.BundleProposal{
+			Uploader:          msg.Creator,
+			StorageId:         msg.StorageId,
+			ByteSize:          msg.ByteSize,
+			ToHeight:          msg.ToHeight,
+			CreatedAt:         uint64(ctx.BlockTime().Unix()),
+			ToKey:             msg.ToKey,
+			ToValue:           msg.ToValue,
+			BundleHash:        msg.BundleHash,
+			StorageProviderId:    pool.StorageProviderId,
+			Compression:          compression,
+			DataItemCount:        msg.DataItemCount,
+			UncompressedByteSize: uncompressedByteSize,
+		})
+
+		pool.BundleProposal.NextUploader = k.getNextUploaderByRandom(ctx, &pool, pool.Stakers)
+
 		k.SetPool(ctx, pool)
 
 		return &types.MsgSubmitBundleProposalResponse{}, nil
 	}
 
 	// handle stakers who did not vote at all
-	k.handleNonVoters(ctx, &pool)
+	k.handleNonVoters(ctx, &pool, pool.BundleProposal)
 
 	// Get next uploader
 	voters := append(pool.BundleProposal.VotersValid, pool.BundleProposal.VotersInvalid...)
@@ -167,177 +258,221 @@ func (k msgServer) SubmitBundleProposal(
 	}
 
 	// check if the quorum was actually reached
-	valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool)
+	valid, invalid, abstain, total := k.getVoteDistribution(ctx, &pool, pool.BundleProposal)
 	quorum := k.getQuorumStatus(valid, invalid, abstain, total)
 
 	// handle valid proposal
 	if quorum == types.BUNDLE_STATUS_VALID {
 		// Calculate the total reward for the bundle, and individual payouts.
-		bundleReward := pool.OperatingCost + (pool.BundleProposal.ByteSize * k.StorageCost(ctx))
+		bundleReward := pool.OperatingCost.Add(sdk.NewIntFromUint64(getChargedByteSize(&pool, pool.BundleProposal.ByteSize, pool.BundleProposal.UncompressedByteSize)).Mul(k.getStorageCost(ctx, pool.BundleProposal.StorageProviderId)))
 
-		// load and parse network fee
-		networkFee, err := sdk.NewDecFromStr(k.NetworkFee(ctx))
-		if err != nil {
-			k.PanicHalt(ctx, "Invalid value for params: "+err.Error())
-		}
+		// Track the average bundle cost to project the runway of the pool.
+		pool.AverageBundleCost = getAverageBundleCost(pool.AverageBundleCost, bundleReward)
 
-		treasuryPayout := uint64(sdk.NewDec(int64(bundleReward)).Mul(networkFee).RoundInt64())
-		uploaderPayout := bundleReward - treasuryPayout
+		// Funding streams pay their share of the bundle reward within their spending limits and the funders cover the rest.
+		// Without funders the bundle reward is reduced to what the funding streams are able to pay.
+		streamCharges, streamsReward := k.getFundingStreamCharges(ctx, &pool, bundleReward)
+		fundersReward := bundleReward.Sub(streamsReward)
 
-		// Calculate the delegation rewards for the uploader.
-		uploader, foundUploader := k.GetStaker(ctx, pool.BundleProposal.Uploader, pool.Id)
-		uploaderDelegation, foundUploaderDelegation := k.GetDelegationPoolData(ctx, pool.Id, pool.BundleProposal.Uploader)
-
-		if foundUploader && foundUploaderDelegation {
-			// If the uploader has no delegators, it keeps the delegation reward.
+		// Charge the funders if they have to pay a part of the bundle reward.
+		if len(pool.Funders) > 0 {
+			// Calculate the individual cost for each pool funder.
+			// NOTE: Because of integer division, it is possible that there is a small remainder.
+			// This remainder is in worst case MaxFundersAmount(tkyve) and is charged to the lowest funder.
+			fundersCost := fundersReward.QuoRaw(int64(len(pool.Funders)))
+			fundersCostRemainder := fundersReward.Sub(fundersCost.MulRaw(int64(len(pool.Funders))))
 
-			if uploaderDelegation.DelegatorCount > 0 {
-				// Calculate the reward, factoring in the node commission, and subtract from the uploader payout.
-				commission, _ := sdk.NewDecFromStr(uploader.Commission)
-				delegationReward := uint64(
-					sdk.NewDec(int64(uploaderPayout)).Mul(sdk.NewDec(1).Sub(commission)).RoundInt64(),
-				)
+			// Fetch the lowest funder, and find a new one if the current one isn't found.
+			lowestFunder, foundLowestFunder := k.GetFunder(ctx, pool.LowestFunder, pool.Id)
 
-				uploaderPayout -= delegationReward
-				uploaderDelegation.CurrentRewards += delegationReward
-
-				k.SetDelegationPoolData(ctx, uploaderDelegation)
+			if !foundLowestFunder {
+				k.updateLowestFunder(ctx, &pool)
+				lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
 			}
-		}
-
-		// Calculate the individual cost for each pool funder.
-		// NOTE: Because of integer division, it is possible that there is a small remainder.
-		// This remainder is in worst case MaxFundersAmount(tkyve) and is charged to the lowest funder.
-		fundersCost := bundleReward / uint64(len(pool.Funders))
-		fundersCostRemainder := bundleReward - (uint64(len(pool.Funders)) * fundersCost)
 
-		// Fetch the lowest funder, and find a new one if the current one isn't found.
-		lowestFunder, foundLowestFunder := k.GetFunder(ctx, pool.LowestFunder, pool.Id)
+			slashedFunds := sdk.ZeroInt()
+
+			// Remove every funder who can't afford the funder cost.
+			for fundersCost.Add(fundersCostRemainder).GT(lowestFunder.Amount) {
+				// Now, let's remove all other funders who have run out of funds.
+				for _, account := range pool.Funders {
+					funder, _ := k.GetFunder(ctx, account, pool.Id)
+
+					if funder.Amount.LT(fundersCost) {
+						// remove funder
+						k.removeFunder(ctx, &pool, &funder)
+
+						// transfer amount to treasury
+						slashedFunds = slashedFunds.Add(funder.Amount)
+						k.trackUndelegation(ctx, funder.Account, funder.Amount)
+
+						// Emit a defund event.
+						errEmit := ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
+							PoolId:  msg.Id,
+							Address: funder.Account,
+							Amount:  funder.Amount,
+						})
+						if errEmit != nil {
+							return nil, errEmit
+						}
+					}
+				}
 
-		if !foundLowestFunder {
-			k.updateLowestFunder(ctx, &pool)
-			lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
-		}
+				if pool.TotalFunds.IsPositive() {
+					fundersCost = fundersReward.QuoRaw(int64(len(pool.Funders)))
+					fundersCostRemainder = fundersReward.Sub(fundersCost.MulRaw(int64(len(pool.Funders))))
 
-		slashedFunds := uint64(0)
+					k.updateLowestFunder(ctx, &pool)
+					lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
+				} else {
+					// Recalculate the lowest funder, update, and return.
+					k.updateLowestFunder(ctx, &pool)
 
-		// Remove every funder who can't afford the funder cost.
-		for fundersCost+fundersCostRemainder > lowestFunder.Amount {
-			// Now, let's remove all other funders who have run out of funds.
-			for _, account := range pool.Funders {
-				funder, _ := k.GetFunder(ctx, account, pool.Id)
+					// Active funding streams pay for the bundle on their own.
+					if k.hasActiveFundingStreams(ctx, pool.Id) {
+						break
+					}
 
-				if funder.Amount < fundersCost {
-					// remove funder
-					k.removeFunder(ctx, &pool, &funder)
+					if slashedFunds.IsPositive() {
+						// transfer slashed funds to treasury
+						err := k.transferToTreasury(ctx, slashedFunds)
+						if err != nil {
+							return nil, err
+						}
+					}
 
-					// transfer amount to treasury
-					slashedFunds += funder.Amount
+					pool.BundleProposal = &types.BundleProposal{
+						Uploader:      pool.BundleProposal.Uploader,
+						NextUploader:  pool.BundleProposal.NextUploader,
+						StorageId:      pool.BundleProposal.StorageId,
+						ByteSize:      pool.BundleProposal.ByteSize,
+						ToHeight:      pool.BundleProposal.ToHeight,
+						CreatedAt:     uint64(ctx.BlockTime().Unix()),
+						VotersValid:   pool.BundleProposal.VotersValid,
+						VotersInvalid: pool.BundleProposal.VotersInvalid,
+						ToKey:         pool.BundleProposal.ToKey,
+						ToValue:       pool.BundleProposal.ToValue,
+						BundleHash: pool.BundleProposal.BundleHash,
+						StorageProviderId: pool.BundleProposal.StorageProviderId,
+						Compression: pool.BundleProposal.Compression,
+						DataItemCount: pool.BundleProposal.DataItemCount,
+						UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
+					}
 
-					// Emit a defund event.
-					errEmit := ctx.EventManager().EmitTypedEvent(&types.EventDefundPool{
-						PoolId:  msg.Id,
-						Address: funder.Account,
-						Amount:  funder.Amount,
+					k.SetPool(ctx, pool)
+
+					// Emit a bundle dropped event because of insufficient funds.
+					errEmit := ctx.EventManager().EmitTypedEvent(&types.EventBundleFinalised{
+						PoolId:       pool.Id,
+						StorageId:     pool.BundleProposal.StorageId,
+						ByteSize:     pool.BundleProposal.ByteSize,
+						Uploader:     pool.BundleProposal.Uploader,
+						NextUploader: pool.BundleProposal.NextUploader,
+						Reward:       sdk.ZeroInt(),
+						VoterReward:  sdk.ZeroInt(),
+						Valid:        valid,
+						Invalid:      invalid,
+						FromHeight:   pool.CurrentHeight,
+						ToHeight:     pool.BundleProposal.ToHeight,
+						Status:       types.BUNDLE_STATUS_NO_FUNDS,
+						ToKey:        pool.BundleProposal.ToKey,
+						ToValue:      pool.BundleProposal.ToValue,
+						Id:           0,
+						BundleHash: pool.BundleProposal.BundleHash,
+						Abstain: abstain,
+						Total: total,
+						Compression: pool.BundleProposal.Compression,
+						DataItemCount: pool.BundleProposal.DataItemCount,
+						UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
 					})
 					if errEmit != nil {
 						return nil, errEmit
 					}
+
+					return &types.MsgSubmitBundleProposalResponse{}, nil
 				}
 			}
 
-			if pool.TotalFunds > 0 {
-				fundersCost = bundleReward / uint64(len(pool.Funders))
-				fundersCostRemainder = bundleReward - (uint64(len(pool.Funders)) * fundersCost)
+			if slashedFunds.IsPositive() {
+				// transfer slashed funds to treasury
+				err := k.transferToTreasury(ctx, slashedFunds)
+				if err != nil {
+					return nil, err
+				}
+			}
 
-				k.updateLowestFunder(ctx, &pool)
-				lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
-			} else {
-				// Recalculate the lowest funder, update, and return.
-				k.updateLowestFunder(ctx, &pool)
+			// Charge every funder equally, unless all of them ran out of funds.
+			if len(pool.Funders) > 0 {
+				for _, account := range pool.Funders {
+					funder, _ := k.GetFunder(ctx, account, pool.Id)
 
-				if slashedFunds > 0 {
-					// transfer slashed funds to treasury
-					err := k.transferToTreasury(ctx, slashedFunds)
-					if err != nil {
-						return nil, err
+					if funder.Amount.GTE(fundersCost) {
+						funder.Amount = funder.Amount.Sub(fundersCost)
+						k.trackUndelegation(ctx, funder.Account, fundersCost)
 					}
-				}
 
-				pool.BundleProposal = &types.BundleProposal{
-					Uploader:      pool.BundleProposal.Uploader,
-					NextUploader:  pool.BundleProposal.NextUploader,
-					StorageId:      pool.BundleProposal.StorageId,
-					ByteSize:      pool.BundleProposal.ByteSize,
-					ToHeight:      pool.BundleProposal.ToHeight,
-					CreatedAt:     uint64(ctx.BlockTime().Unix()),
-					VotersValid:   pool.BundleProposal.VotersValid,
-					VotersInvalid: pool.BundleProposal.VotersInvalid,
-					ToKey:         pool.BundleProposal.ToKey,
-					ToValue:       pool.BundleProposal.ToValue,
-					BundleHash: pool.BundleProposal.BundleHash,
+					k.SetFunder(ctx, funder)
 				}
 
-				k.SetPool(ctx, pool)
-
-				// Emit a bundle dropped event because of insufficient funds.
-				errEmit := ctx.EventManager().EmitTypedEvent(&types.EventBundleFinalised{
-					PoolId:       pool.Id,
-					StorageId:     pool.BundleProposal.StorageId,
-					ByteSize:     pool.BundleProposal.ByteSize,
-					Uploader:     pool.BundleProposal.Uploader,
-					NextUploader: pool.BundleProposal.NextUploader,
-					Reward:       0,
-					Valid:        valid,
-					Invalid:      invalid,
-					FromHeight:   pool.CurrentHeight,
-					ToHeight:     pool.BundleProposal.ToHeight,
-					Status:       types.BUNDLE_STATUS_NO_FUNDS,
-					ToKey:        pool.BundleProposal.ToKey,
-					ToValue:      pool.BundleProposal.ToValue,
-					Id:           0,
-					BundleHash: pool.BundleProposal.BundleHash,
-					Abstain: abstain,
-					Total: total,
-				})
-				if errEmit != nil {
-					return nil, errEmit
+				// Remove any remainder cost from the lowest funder.
+				lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
+
+				if lowestFunder.Amount.GTE(fundersCostRemainder) {
+					lowestFunder.Amount = lowestFunder.Amount.Sub(fundersCostRemainder)
+					k.trackUndelegation(ctx, lowestFunder.Account, fundersCostRemainder)
 				}
 
-				return &types.MsgSubmitBundleProposalResponse{}, nil
-			}
-		}
+				k.SetFunder(ctx, lowestFunder)
 
-		if slashedFunds > 0 {
-			// transfer slashed funds to treasury
-			err := k.transferToTreasury(ctx, slashedFunds)
-			if err != nil {
-				return nil, err
+				// Subtract the funders' part of the bundle reward from the pool's total funds.
+				pool.TotalFunds = pool.TotalFunds.Sub(fundersReward)
 			}
 		}
 
-		// Charge every funder equally.
-		for _, account := range pool.Funders {
-			funder, _ := k.GetFunder(ctx, account, pool.Id)
+		if len(pool.Funders) == 0 {
+			// Funders who ran out of funds no longer take a share, so the charges of the funding streams are recalculated.
+			streamCharges, streamsReward = k.getFundingStreamCharges(ctx, &pool, bundleReward)
 
-			if funder.Amount >= fundersCost {
-				funder.Amount -= fundersCost
-			}
+			bundleReward = streamsReward
+			fundersReward = sdk.ZeroInt()
+		}
 
-			k.SetFunder(ctx, funder)
+		// load and parse network fee
+		networkFee, err := sdk.NewDecFromStr(k.NetworkFee(ctx))
+		if err != nil {
+			k.PanicHalt(ctx, "Invalid value for params: "+err.Error())
 		}
 
-		// Remove any remainder cost from the lowest funder.
-		lowestFunder, _ = k.GetFunder(ctx, pool.LowestFunder, pool.Id)
+		treasuryPayout := sdk.NewDecFromInt(bundleReward).Mul(networkFee).RoundInt()
+		uploaderPayout := bundleReward.Sub(treasuryPayout)
 
-		if lowestFunder.Amount >= fundersCostRemainder {
-			lowestFunder.Amount -= fundersCostRemainder
-		}
+		// Reserve the share of the valid voters before the uploader shares its payout with its delegators.
+		voterPayout := k.getVoterPayout(ctx, uploaderPayout)
+		uploaderPayout = uploaderPayout.Sub(voterPayout)
 
-		k.SetFunder(ctx, lowestFunder)
+		// Calculate the delegation rewards for the uploader.
+		uploader, foundUploader := k.GetStaker(ctx, pool.BundleProposal.Uploader, pool.Id)
+		uploaderDelegation, foundUploaderDelegation := k.GetDelegationPoolData(ctx, pool.Id, pool.BundleProposal.Uploader)
+
+		if foundUploader && foundUploaderDelegation {
+			// If the uploader has no delegators, it keeps the delegation reward.
+
+			if uploaderDelegation.DelegatorCount > 0 {
+				// Calculate the reward, factoring in the node commission, and subtract from the uploader payout.
+				commission, _ := sdk.NewDecFromStr(uploader.Commission)
+				delegationReward := sdk.NewDecFromInt(uploaderPayout).Mul(sdk.NewDec(1).Sub(commission)).RoundInt()
+
+				uploaderPayout = uploaderPayout.Sub(delegationReward)
+				uploaderDelegation.CurrentRewards = uploaderDelegation.CurrentRewards.Add(delegationReward)
+
+				k.SetDelegationPoolData(ctx, uploaderDelegation)
+			}
+		}
 
-		// Subtract bundle reward from the pool's total funds.
-		pool.TotalFunds -= bundleReward
+		// Charge the funding streams after the funders were able to pay.
+		if err := k.chargeFundingStreams(ctx, streamCharges); err != nil {
+			return nil, err
+		}
 
 		// Partially slash all nodes who voted incorrectly.
 		for _, voter := range pool.BundleProposal.VotersInvalid {
@@ -360,8 +495,15 @@ func (k msgServer) SubmitBundleProposal(
 			return nil, errTreasury
 		}
 
-		// Send payout to uploader.
-		errTransfer := k.TransferToAddress(ctx, pool.BundleProposal.Uploader, uploaderPayout)
+		// Distribute the voter payout among the valid voters. The uploader receives the remainder.
+		voterReward, errVoters := k.distributeVoterRewards(ctx, &pool, voterPayout)
+		if errVoters != nil {
+			return nil, errVoters
+		}
+		uploaderPayout = uploaderPayout.Add(voterPayout.Sub(voterReward))
+
+		// Send payout including the commission to the withdraw address of the uploader.
+		errTransfer := k.transferRewardToAddress(ctx, pool.BundleProposal.Uploader, uploaderPayout)
 		if errTransfer != nil {
 			return nil, errTransfer
 		}
@@ -378,6 +520,11 @@ func (k msgServer) SubmitBundleProposal(
 			Key:         pool.BundleProposal.ToKey,
 			Value:       pool.BundleProposal.ToValue,
 			BundleHash: pool.BundleProposal.BundleHash,
+			ByteSize:    pool.BundleProposal.ByteSize,
+			StorageProviderId: pool.BundleProposal.StorageProviderId,
+			Compression: pool.BundleProposal.Compression,
+			DataItemCount: pool.BundleProposal.DataItemCount,
+			UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
 		})
 
 		// Finalise the proposal, saving useful information.
@@ -385,7 +532,7 @@ func (k msgServer) SubmitBundleProposal(
 		pool.CurrentHeight = pool.BundleProposal.ToHeight
 		pool.TotalBytes = pool.TotalBytes + pool.BundleProposal.ByteSize
 		pool.TotalBundles = pool.TotalBundles + 1
-		pool.TotalBundleRewards = pool.TotalBundleRewards + bundleReward
+		pool.TotalBundleRewards = pool.TotalBundleRewards.Add(bundleReward)
 		pool.CurrentKey = pool.BundleProposal.ToKey
 		pool.CurrentValue = pool.BundleProposal.ToValue
 
@@ -397,6 +544,7 @@ func (k msgServer) SubmitBundleProposal(
 			Uploader:     pool.BundleProposal.Uploader,
 			NextUploader: pool.BundleProposal.NextUploader,
 			Reward:       bundleReward,
+			VoterReward: voterReward,
 			Valid:        valid,
 			Invalid:      invalid,
 			FromHeight:   eventFromHeight,
@@ -408,15 +556,30 @@ func (k msgServer) SubmitBundleProposal(
 			BundleHash: pool.BundleProposal.BundleHash,
 			Abstain: abstain,
 			Total: total,
+			Compression: pool.BundleProposal.Compression,
+			DataItemCount: pool.BundleProposal.DataItemCount,
+			UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
 		})
 		if errEmit != nil {
 			return nil, errEmit
 		}
 
-		// Set submitted bundle as new bundle proposal and select new next_uploader
-		pool.BundleProposal = &types.BundleProposal{
+		// Complete the pool once the finalized bundle reached its end. The submitted bundle is not needed anymore.
+		if isPoolEndReached(&pool) {
+			if err := k.completePool(ctx, &pool); err != nil {
+				return nil, err
+			}
+
+			k.SetPool(ctx, pool)
+
+			return &types.MsgSubmitBundleProposalResponse{}, nil
+		}
+
+		// Set submitted bundle as new bundle proposal and select new next_uploader.
+		// If bundle proposals are pipelined, the oldest one becomes the new bundle proposal
+		// and the submitted bundle is chained after the remaining ones.
+		setNextBundleProposal(&pool, &types.BundleProposal{
 			Uploader:     msg.Creator,
-			NextUploader: nextUploader,
 			StorageId:     msg.StorageId,
 			ByteSize:     msg.ByteSize,
 			ToHeight:     msg.ToHeight,
@@ -424,7 +587,11 @@ func (k msgServer) SubmitBundleProposal(
 			ToKey:        msg.ToKey,
 			ToValue:      msg.ToValue,
 			BundleHash: msg.BundleHash,
-		}
+			StorageProviderId: pool.StorageProviderId,
+			Compression: compression,
+			DataItemCount: msg.DataItemCount,
+			UncompressedByteSize: uncompressedByteSize,
+		}, nextUploader)
 
 		k.SetPool(ctx, pool)
 
@@ -469,7 +636,8 @@ func (k msgServer) SubmitBundleProposal(
 			ByteSize:     pool.BundleProposal.ByteSize,
 			Uploader:     pool.BundleProposal.Uploader,
 			NextUploader: pool.BundleProposal.NextUploader,
-			Reward:       0,
+			Reward:       sdk.ZeroInt(),
+			VoterReward:  sdk.ZeroInt(),
 			Valid:        valid,
 			Invalid:      invalid,
 			FromHeight:   pool.CurrentHeight,
@@ -481,11 +649,19 @@ func (k msgServer) SubmitBundleProposal(
 			BundleHash: pool.BundleProposal.BundleHash,
 			Abstain: abstain,
 			Total: total,
+			Compression: pool.BundleProposal.Compression,
+			DataItemCount: pool.BundleProposal.DataItemCount,
+			UncompressedByteSize: pool.BundleProposal.UncompressedByteSize,
 		})
 		if errEmit != nil {
 			return nil, errEmit
 		}
 
+		// Drop all bundle proposals which chained after the invalid bundle.
+		if err := k.dropPipelinedProposals(ctx, &pool); err != nil {
+			return nil, err
+		}
+
 		// Update and return.
 		pool.BundleProposal = &types.BundleProposal{
 			NextUploader: pool.BundleProposal.NextUploader,
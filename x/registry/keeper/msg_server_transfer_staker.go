@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferStaker moves the staking position of the sender, including all delegations, to a new account.
+func (k msgServer) TransferStaker(
+	goCtx context.Context, msg *types.MsgTransferStaker,
+) (*types.MsgTransferStakerResponse, error) {
+	// Unwrap context and attempt to fetch the pool.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := k.GetPool(ctx, msg.PoolId)
+
+	// Error if the pool isn't found.
+	if !found {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.PoolId)
+	}
+
+	// Check if the sender is a protocol node (aka has staked into this pool).
+	staker, isStaker := k.GetStaker(ctx, msg.Creator, msg.PoolId)
+	if !isStaker {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
+	}
+
+	// A staking position can only be moved once per cooldown period.
+	cooldownEnd := staker.LastTransfer + k.StakerTransferCooldown(ctx)
+	if staker.LastTransfer > 0 && uint64(ctx.BlockTime().Unix()) < cooldownEnd {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrStakerTransferOnCooldown.Error(), cooldownEnd)
+	}
+
+	// Locked tokens of a vesting account must not be moved to another account. The stake stops being
+	// delegated by the old account, which is only possible if its delegated vesting tokens stay locked.
+	if !k.canReleaseDelegation(ctx, msg.Creator, staker.Amount) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrStakerHasVestingTokens.Error(), msg.Creator)
+	}
+
+	// The stake arrives as free tokens, which can not be tracked for a vesting account, as undelegating
+	// them later on would release its own delegated vesting tokens.
+	if k.isVestingAccount(ctx, msg.NewStaker) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrStakerHasVestingTokens.Error(), msg.NewStaker)
+	}
+
+	// The new account must not hold a position in this pool.
+	if _, exists := k.GetStaker(ctx, msg.NewStaker, msg.PoolId); exists {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrStakerAlreadyExists.Error(), msg.NewStaker, msg.PoolId)
+	}
+
+	if _, exists := k.GetUnbondingStaker(ctx, msg.PoolId, msg.NewStaker); exists {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrStakerAlreadyExists.Error(), msg.NewStaker, msg.PoolId)
+	}
+
+	if _, exists := k.GetDelegationPoolData(ctx, msg.PoolId, msg.NewStaker); exists {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrStakerAlreadyExists.Error(), msg.NewStaker, msg.PoolId)
+	}
+
+	// The new account would end up delegating to itself.
+	if _, isDelegator := k.GetDelegator(ctx, msg.PoolId, msg.Creator, msg.NewStaker); isDelegator {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrSelfDelegation.Error())
+	}
+
+	k.transferStaker(ctx, &pool, &staker, msg.NewStaker)
+	k.SetPool(ctx, pool)
+
+	// Emit a transfer event.
+	if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventTransferStaker{
+		PoolId: msg.PoolId,
+		From:   msg.Creator,
+		To:     msg.NewStaker,
+		Amount: staker.Amount,
+	}); errEmit != nil {
+		return nil, errEmit
+	}
+
+	return &types.MsgTransferStakerResponse{}, nil
+}
@@ -12,13 +12,29 @@ import (
 func (k msgServer) UnstakePool(
 	goCtx context.Context, msg *types.MsgUnstakePool,
 ) (*types.MsgUnstakePoolResponse, error) {
+	// Error if the amount is not positive.
+	if err := types.ValidatePositiveAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Unwrap context and attempt to fetch the pool.
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	_, foundPool := k.GetPool(ctx, msg.Id)
+	pool, foundPool := k.GetPool(ctx, msg.Id)
 	if !foundPool {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrNotFound, types.ErrPoolNotFound.Error(), msg.Id)
 	}
 
+	// Stakers of completed pools can withdraw without unbonding time.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		if err := k.unstakeFromCompletedPool(ctx, &pool, msg.Creator, msg.Amount); err != nil {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrUnstakeTooHigh.Error(), msg.Id)
+		}
+
+		k.SetPool(ctx, pool)
+
+		return &types.MsgUnstakePoolResponse{}, nil
+	}
+
 	if err := k.StartUnbondingStaker(ctx, msg.Id, msg.Creator, msg.Amount); err != nil {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrLogic, types.ErrUnstakeTooHigh.Error(), msg.Id)
 	}
@@ -28,12 +28,12 @@ func (k msgServer) VoteProposal(
 	}
 
 	// Check if minimum stake is reached
-	if pool.TotalStake < pool.MinStake {
+	if pool.TotalStake.LT(pool.MinStake) {
 		return nil, types.ErrNotEnoughStake
 	}
 
 	// Error if the pool has no funds.
-	if len(pool.Funders) == 0 {
+	if len(pool.Funders) == 0 && !k.hasActiveFundingStreams(ctx, pool.Id) {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrInsufficientFunds, types.ErrFundsTooLow.Error())
 	}
 
@@ -42,6 +42,11 @@ func (k msgServer) VoteProposal(
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolPaused.Error())
 	}
 
+	// Error if the pool is completed.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
 	// Error if the pool is upgrading.
 	if pool.UpgradePlan.ScheduledAt > 0 && uint64(ctx.BlockTime().Unix()) >= pool.UpgradePlan.ScheduledAt {
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrPoolCurrentlyUpgrading.Error())
@@ -53,41 +58,47 @@ func (k msgServer) VoteProposal(
 		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrNoStaker.Error())
 	}
 
-	// Check if the sender is also the bundle's uploader.
-	if pool.BundleProposal.Uploader == msg.Creator {
-		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrVoterIsUploader.Error())
+	// Check if the sender runs the required protocol version.
+	if err := validateProtocolVersion(&pool, &staker); err != nil {
+		return nil, err
 	}
 
-	// Check if bundle is not dropped or NO_DATA_BUNDLE
-	if pool.BundleProposal.StorageId == "" || strings.HasPrefix(pool.BundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
+	// Check if the sender is voting on an open bundle proposal.
+	bundleProposal, found := getOpenBundleProposal(&pool, msg.StorageId)
+	if !found {
 		return nil, sdkErrors.Wrapf(
-			sdkErrors.ErrNotFound, types.ErrInvalidStorageId.Error(), pool.BundleProposal.StorageId,
+			sdkErrors.ErrNotFound, types.ErrInvalidStorageId.Error(), msg.StorageId,
 		)
 	}
 
-	// Check if the sender is voting on the same bundle.
-	if msg.StorageId != pool.BundleProposal.StorageId {
+	// Check if the sender is also the bundle's uploader.
+	if bundleProposal.Uploader == msg.Creator {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnauthorized, types.ErrVoterIsUploader.Error())
+	}
+
+	// Check if bundle is not dropped or NO_DATA_BUNDLE
+	if bundleProposal.StorageId == "" || strings.HasPrefix(bundleProposal.StorageId, types.KYVE_NO_DATA_BUNDLE) {
 		return nil, sdkErrors.Wrapf(
-			sdkErrors.ErrNotFound, types.ErrInvalidStorageId.Error(), pool.BundleProposal.StorageId,
+			sdkErrors.ErrNotFound, types.ErrInvalidStorageId.Error(), bundleProposal.StorageId,
 		)
 	}
 
 	// Check if the sender has already voted on the bundle.
 	hasVotedValid, hasVotedInvalid, hasVotedAbstain := false, false, false
 
-	for _, voter := range pool.BundleProposal.VotersValid {
+	for _, voter := range bundleProposal.VotersValid {
 		if voter == msg.Creator {
 			hasVotedValid = true
 		}
 	}
 
-	for _, voter := range pool.BundleProposal.VotersInvalid {
+	for _, voter := range bundleProposal.VotersInvalid {
 		if voter == msg.Creator {
 			hasVotedInvalid = true
 		}
 	}
 
-	for _, voter := range pool.BundleProposal.VotersAbstain {
+	for _, voter := range bundleProposal.VotersAbstain {
 		if voter == msg.Creator {
 			hasVotedAbstain = true
 		}
@@ -95,28 +106,28 @@ func (k msgServer) VoteProposal(
 
 	if hasVotedValid || hasVotedInvalid {
 		return nil, sdkErrors.Wrapf(
-			sdkErrors.ErrUnauthorized, types.ErrAlreadyVoted.Error(), pool.BundleProposal.StorageId,
+			sdkErrors.ErrUnauthorized, types.ErrAlreadyVoted.Error(), bundleProposal.StorageId,
 		)
 	}
 
 	if hasVotedAbstain {
 		if msg.Vote == types.VOTE_TYPE_ABSTAIN {
 			return nil, sdkErrors.Wrapf(
-				sdkErrors.ErrUnauthorized, types.ErrAlreadyVoted.Error(), pool.BundleProposal.StorageId,
+				sdkErrors.ErrUnauthorized, types.ErrAlreadyVoted.Error(), bundleProposal.StorageId,
 			)
 		}
 
 		// remove voter from abstain votes
-		pool.BundleProposal.VotersAbstain = removeStringFromList(pool.BundleProposal.VotersAbstain, msg.Creator)
+		bundleProposal.VotersAbstain = removeStringFromList(bundleProposal.VotersAbstain, msg.Creator)
 	}
 
 	// Update and return.
 	if msg.Vote == types.VOTE_TYPE_YES {
-		pool.BundleProposal.VotersValid = append(pool.BundleProposal.VotersValid, msg.Creator)
+		bundleProposal.VotersValid = append(bundleProposal.VotersValid, msg.Creator)
 	} else if msg.Vote == types.VOTE_TYPE_NO {
-		pool.BundleProposal.VotersInvalid = append(pool.BundleProposal.VotersInvalid, msg.Creator)
+		bundleProposal.VotersInvalid = append(bundleProposal.VotersInvalid, msg.Creator)
 	} else if msg.Vote == types.VOTE_TYPE_ABSTAIN {
-		pool.BundleProposal.VotersAbstain = append(pool.BundleProposal.VotersAbstain, msg.Creator)
+		bundleProposal.VotersAbstain = append(bundleProposal.VotersAbstain, msg.Creator)
 	} else {
 		return nil, sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, types.ErrInvalidVote.Error(), msg.Vote)
 	}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/KYVENetwork/chain/x/registry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawAllRewards handles the logic of an SDK message that allows delegators to collect the rewards
+// of all their delegations at once. To keep the gas usage bounded, at most MaxWithdrawAllPositions
+// delegations are withdrawn per message. The returned next key continues with the remaining delegations.
+func (k msgServer) WithdrawAllRewards(
+	goCtx context.Context, msg *types.MsgWithdrawAllRewards,
+) (*types.MsgWithdrawAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit := k.MaxWithdrawAllPositions(ctx)
+	if msg.Limit > 0 && msg.Limit < limit {
+		limit = msg.Limit
+	}
+
+	total := sdk.ZeroInt()
+	delegations, nextKey := k.GetDelegationsOfDelegator(ctx, msg.Creator, msg.StartKey, limit)
+
+	for _, delegator := range delegations {
+		// Create a new F1Distribution struct for interacting with delegations.
+		f1Distribution := F1Distribution{
+			k:                k.Keeper,
+			ctx:              ctx,
+			poolId:           delegator.Id,
+			stakerAddress:    delegator.Staker,
+			delegatorAddress: msg.Creator,
+		}
+
+		reward := f1Distribution.Withdraw()
+		total = total.Add(reward)
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRewards{
+			PoolId:  delegator.Id,
+			Address: msg.Creator,
+			Node:    delegator.Staker,
+			Amount:  reward,
+		}); errEmit != nil {
+			return nil, errEmit
+		}
+	}
+
+	// Transfer the sum of all rewards from this module to the withdraw address of the sender.
+	if err := k.transferRewardToAddress(ctx, msg.Creator, total); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawAllRewardsResponse{Amount: total, NextKey: nextKey}, nil
+}
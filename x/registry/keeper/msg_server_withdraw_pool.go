@@ -39,8 +39,8 @@ func (k msgServer) WithdrawPool(
 	// Withdraw all rewards for the sender.
 	reward := f1Distribution.Withdraw()
 
-	// Transfer tokens from this module to sender.
-	if err := k.TransferToAddress(ctx, msg.Creator, reward); err != nil {
+	// Transfer tokens from this module to the withdraw address of the sender.
+	if err := k.transferRewardToAddress(ctx, msg.Creator, reward); err != nil {
 		return nil, err
 	}
 
@@ -169,7 +169,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.HandleProtocolFunding(ctx)
+}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
@@ -177,7 +179,9 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	am.keeper.HandleUploadTimeout(sdk.WrapSDKContext(ctx))
 	am.keeper.ProcessStakerUnbondingQueue(ctx)
 	am.keeper.ProcessDelegatorUnbondingQueue(ctx)
+	am.keeper.ProcessRedelegationQueue(ctx)
 	am.keeper.ProcessCommissionChangeUnbondingQueue(ctx)
+	am.keeper.ProcessAutoCompounding(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -27,6 +27,20 @@ func NewRegistryProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return handleCancelPoolUpgradeProposal(ctx, k, c)
 		case *types.ResetPoolProposal:
 			return handleResetPoolProposal(ctx, k, c)
+		case *types.CreateStorageProviderProposal:
+			return handleCreateStorageProviderProposal(ctx, k, c)
+		case *types.UpdateStorageProviderProposal:
+			return handleUpdateStorageProviderProposal(ctx, k, c)
+		case *types.RetirePoolProposal:
+			return handleRetirePoolProposal(ctx, k, c)
+		case *types.CreateRuntimeProposal:
+			return handleCreateRuntimeProposal(ctx, k, c)
+		case *types.UpdateRuntimeProposal:
+			return handleUpdateRuntimeProposal(ctx, k, c)
+		case *types.AddRuntimeVersionProposal:
+			return handleAddRuntimeVersionProposal(ctx, k, c)
+		case *types.SetProtocolFundingProposal:
+			return handleSetProtocolFundingProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized registry proposal content type: %T", c)
@@ -35,6 +49,18 @@ func NewRegistryProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleCreatePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreatePoolProposal) error {
+	// The default storage provider with id zero always exists.
+	if p.StorageProviderId > 0 {
+		if _, found := k.GetStorageProvider(ctx, p.StorageProviderId); !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrStorageProviderNotFound.Error(), p.StorageProviderId)
+		}
+	}
+
+	// Gov executes the proposal on submission as well, so invalid configs are rejected before voting starts.
+	if err := k.ValidatePoolConfig(ctx, p.Runtime, p.Config); err != nil {
+		return err
+	}
+
 	pool := types.Pool{
 		Creator:        govtypes.ModuleName,
 		Name:           p.Name,
@@ -42,7 +68,7 @@ func handleCreatePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreateP
 		Logo:           p.Logo,
 		Config:         p.Config,
 		UploadInterval: p.UploadInterval,
-		OperatingCost:  p.OperatingCost,
+		OperatingCost:  sdk.NewIntFromUint64(p.OperatingCost),
 		BundleProposal: &types.BundleProposal{},
 		MaxBundleSize:  p.MaxBundleSize,
 		Protocol: &types.Protocol{
@@ -53,7 +79,13 @@ func handleCreatePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreateP
 		UpgradePlan: &types.UpgradePlan{},
 		StartKey:    p.StartKey,
 		Status: types.POOL_STATUS_NOT_ENOUGH_VALIDATORS,
-		MinStake: p.MinStake,
+		MinStake: sdk.NewIntFromUint64(p.MinStake),
+		PipelineDepth: p.PipelineDepth,
+		StorageProviderId: p.StorageProviderId,
+		AllowedCompressions: p.AllowedCompressions,
+		ChargeUncompressedSize: p.ChargeUncompressedSize,
+		EndKey: p.EndKey,
+		EndHeight: p.EndHeight,
 	}
 
 	k.AppendPool(ctx, pool)
@@ -67,14 +99,47 @@ func handleUpdatePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateP
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
 	}
 
+	// Completed pools can not be updated anymore.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	// Retired pools can not be updated anymore.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
+	// The pool has to be able to reach its end height.
+	if p.EndHeight > 0 && p.EndHeight <= pool.CurrentHeight {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, types.ErrEndHeightPassed.Error(), p.EndHeight, pool.Id)
+	}
+
+	// The default storage provider with id zero always exists.
+	if p.StorageProviderId > 0 {
+		if _, found := k.GetStorageProvider(ctx, p.StorageProviderId); !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrStorageProviderNotFound.Error(), p.StorageProviderId)
+		}
+	}
+
+	// Gov executes the proposal on submission as well, so invalid configs are rejected before voting starts.
+	if err := k.ValidatePoolConfig(ctx, p.Runtime, p.Config); err != nil {
+		return err
+	}
+
 	pool.Name = p.Name
 	pool.Runtime = p.Runtime
 	pool.Logo = p.Logo
 	pool.Config = p.Config
 	pool.UploadInterval = p.UploadInterval
-	pool.OperatingCost = p.OperatingCost
+	pool.OperatingCost = sdk.NewIntFromUint64(p.OperatingCost)
 	pool.MaxBundleSize = p.MaxBundleSize
-	pool.MinStake = p.MinStake
+	pool.MinStake = sdk.NewIntFromUint64(p.MinStake)
+	pool.PipelineDepth = p.PipelineDepth
+	pool.StorageProviderId = p.StorageProviderId
+	pool.AllowedCompressions = p.AllowedCompressions
+	pool.ChargeUncompressedSize = p.ChargeUncompressedSize
+	pool.EndKey = p.EndKey
+	pool.EndHeight = p.EndHeight
 
 	k.SetPool(ctx, pool)
 
@@ -107,6 +172,11 @@ func handleUnpausePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.Unpaus
 		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
 	}
 
+	// Retired pools can never be unpaused again.
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
 	// Throw an error if the pool is already unpaused.
 	if !pool.Paused {
 		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "Pool is already unpaused.")
@@ -120,8 +190,24 @@ func handleUnpausePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.Unpaus
 }
 
 func handleSchedulePoolUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.SchedulePoolUpgradeProposal) error {
+	binaries := p.Binaries
+
+	// Upgrades of registered runtimes have to target a registered version and use its verified binaries.
+	if runtime, found := k.GetRuntime(ctx, p.Runtime); found {
+		version, foundVersion := runtime.GetVersion(p.Version)
+		if !foundVersion {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrRuntimeVersionNotFound.Error(), p.Version, p.Runtime)
+		}
+
+		if binaries == "" {
+			binaries = version.EncodeBinaries()
+		} else if binaries != version.EncodeBinaries() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, types.ErrRuntimeBinariesMismatch.Error(), p.Version, p.Runtime)
+		}
+	}
+
 	// Check if upgrade version and binaries are not empty
-	if p.Version == "" || p.Binaries == "" {
+	if p.Version == "" || binaries == "" {
 		return types.ErrInvalidArgs
 	}
 
@@ -134,6 +220,20 @@ func handleSchedulePoolUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *type
 		scheduledAt = p.ScheduledAt
 	}
 
+	canaryPoolIds := make(map[uint64]bool)
+	for _, poolId := range p.CanaryPoolIds {
+		pool, found := k.GetPool(ctx, poolId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrPoolNotFound.Error(), poolId)
+		}
+
+		if pool.Runtime != p.Runtime {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "canary pool %v does not run runtime %v", poolId, p.Runtime)
+		}
+
+		canaryPoolIds[poolId] = true
+	}
+
 	// go through every pool and schedule the upgrade
 	for _, pool := range k.GetAllPool(ctx) {
 		// Skip if runtime does not match
@@ -146,16 +246,33 @@ func handleSchedulePoolUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *type
 			continue
 		}
 
+		// Canary pools upgrade first, all other pools follow after the canary delay.
+		poolScheduledAt := scheduledAt
+		if len(canaryPoolIds) > 0 && !canaryPoolIds[pool.Id] {
+			poolScheduledAt = scheduledAt + p.CanaryDelay
+		}
+
 		// register upgrade plan
 		pool.UpgradePlan = &types.UpgradePlan{
-			Version:     p.Version,
-			Binaries:    p.Binaries,
-			ScheduledAt: scheduledAt,
-			Duration:    p.Duration,
+			Version:        p.Version,
+			Binaries:       binaries,
+			ScheduledAt:    poolScheduledAt,
+			Duration:       p.Duration,
+			RollbackWindow: p.RollbackWindow,
+			Canary:         canaryPoolIds[pool.Id],
 		}
 
 		// Update the pool
 		k.SetPool(ctx, pool)
+
+		if errEmit := ctx.EventManager().EmitTypedEvent(&types.EventPoolUpgradeScheduled{
+			PoolId:      pool.Id,
+			Version:     p.Version,
+			ScheduledAt: poolScheduledAt,
+			Canary:      canaryPoolIds[pool.Id],
+		}); errEmit != nil {
+			return errEmit
+		}
 	}
 
 	return nil
@@ -234,8 +351,136 @@ func handleResetPoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.ResetPoo
 		}
 	}
 
+	// Drop all open bundle proposals, as they chain after the previous bundle proposal
+	pool.PipelinedProposals = nil
+
 	// Update the pool
 	k.SetPool(ctx, pool)
 
 	return nil
 }
+
+func handleCreateStorageProviderProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreateStorageProviderProposal) error {
+	k.AppendStorageProvider(ctx, types.StorageProvider{
+		Name:            p.Name,
+		StorageCost:     p.StorageCost,
+		StorageIdFormat: p.StorageIdFormat,
+	})
+
+	return nil
+}
+
+func handleUpdateStorageProviderProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateStorageProviderProposal) error {
+	storageProvider, found := k.GetStorageProvider(ctx, p.Id)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrStorageProviderNotFound.Error(), p.Id)
+	}
+
+	storageProvider.Name = p.Name
+	storageProvider.StorageCost = p.StorageCost
+	storageProvider.StorageIdFormat = p.StorageIdFormat
+
+	k.SetStorageProvider(ctx, storageProvider)
+
+	return nil
+}
+
+func handleRetirePoolProposal(ctx sdk.Context, k keeper.Keeper, p *types.RetirePoolProposal) error {
+	return k.RetirePool(ctx, p.Id)
+}
+
+func handleCreateRuntimeProposal(ctx sdk.Context, k keeper.Keeper, p *types.CreateRuntimeProposal) error {
+	if _, found := k.GetRuntime(ctx, p.Name); found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, types.ErrRuntimeAlreadyExists.Error(), p.Name)
+	}
+
+	k.SetRuntime(ctx, types.Runtime{
+		Name:         p.Name,
+		ConfigSchema: p.ConfigSchema,
+	})
+
+	return nil
+}
+
+func handleUpdateRuntimeProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateRuntimeProposal) error {
+	runtime, found := k.GetRuntime(ctx, p.Name)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), p.Name)
+	}
+
+	runtime.ConfigSchema = p.ConfigSchema
+
+	k.SetRuntime(ctx, runtime)
+
+	return nil
+}
+
+func handleAddRuntimeVersionProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddRuntimeVersionProposal) error {
+	runtime, found := k.GetRuntime(ctx, p.Runtime)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrRuntimeNotFound.Error(), p.Runtime)
+	}
+
+	// Released versions are immutable, so node launchers can rely on the checksums.
+	if _, foundVersion := runtime.GetVersion(p.Version); foundVersion {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, types.ErrRuntimeVersionAlreadyExists.Error(), p.Version, p.Runtime)
+	}
+
+	runtime.Versions = append(runtime.Versions, types.RuntimeVersion{
+		Version:   p.Version,
+		Binaries:  p.Binaries,
+		CreatedAt: uint64(ctx.BlockTime().Unix()),
+	})
+
+	k.SetRuntime(ctx, runtime)
+
+	return nil
+}
+
+func handleSetProtocolFundingProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetProtocolFundingProposal) error {
+	pool, found := k.GetPool(ctx, p.Id)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, types.ErrPoolNotFound.Error(), p.Id)
+	}
+
+	// Completed and retired pools do not spend funds anymore.
+	if pool.Status == types.POOL_STATUS_COMPLETED {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrPoolCompleted.Error())
+	}
+
+	if pool.Status == types.POOL_STATUS_RETIRED {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrPoolRetired.Error())
+	}
+
+	inflationShare, err := types.ParseInflationShare(p.InflationShare)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Without any allocation the pool is no public-good pool anymore. Already credited funds stay in the pool.
+	if inflationShare.IsZero() && p.CommunityPoolAmount == 0 {
+		k.RemoveProtocolFunding(ctx, p.Id)
+		return nil
+	}
+
+	// All public-good pools together can at most receive the entire inflation.
+	totalInflationShare := inflationShare
+	for _, funding := range k.GetAllProtocolFundings(ctx) {
+		if funding.PoolId != p.Id {
+			share, _ := types.ParseInflationShare(funding.InflationShare)
+			totalInflationShare = totalInflationShare.Add(share)
+		}
+	}
+
+	if totalInflationShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, types.ErrInflationSharesTooHigh.Error(), totalInflationShare)
+	}
+
+	k.SetProtocolFunding(ctx, types.ProtocolFunding{
+		PoolId:              p.Id,
+		InflationShare:      inflationShare.String(),
+		CommunityPoolAmount: sdk.NewIntFromUint64(p.CommunityPoolAmount),
+	})
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &PoolAuthorization{}
+	_ authz.Authorization = &DelegationAuthorization{}
+)
+
+// poolAuthorizationMsgs are the protocol node messages which can be granted with a PoolAuthorization
+var poolAuthorizationMsgs = []string{
+	sdk.MsgTypeURL(&MsgSubmitBundleProposal{}),
+	sdk.MsgTypeURL(&MsgVoteProposal{}),
+	sdk.MsgTypeURL(&MsgClaimUploaderRole{}),
+	sdk.MsgTypeURL(&MsgSkipUploaderRole{}),
+	sdk.MsgTypeURL(&MsgAttestVersion{}),
+	sdk.MsgTypeURL(&MsgUpdateMetadata{}),
+	sdk.MsgTypeURL(&MsgUpdateCommission{}),
+	sdk.MsgTypeURL(&MsgReactivateStaker{}),
+}
+
+// delegationAuthorizationMsgs are the delegation messages which can be granted with a DelegationAuthorization
+var delegationAuthorizationMsgs = []string{
+	sdk.MsgTypeURL(&MsgDelegatePool{}),
+	sdk.MsgTypeURL(&MsgWithdrawPool{}),
+	sdk.MsgTypeURL(&MsgUndelegatePool{}),
+	sdk.MsgTypeURL(&MsgRedelegatePool{}),
+	sdk.MsgTypeURL(&MsgSetAutoCompound{}),
+}
+
+// ===========================
+// === POOL AUTHORIZATION ===
+// ===========================
+
+// NewPoolAuthorization creates a new PoolAuthorization object.
+func NewPoolAuthorization(msgTypeURL string, poolIds []uint64) *PoolAuthorization {
+	return &PoolAuthorization{
+		Msg:     msgTypeURL,
+		PoolIds: poolIds,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PoolAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a PoolAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	var poolId uint64
+
+	switch msg := msg.(type) {
+	case *MsgSubmitBundleProposal:
+		poolId = msg.Id
+	case *MsgVoteProposal:
+		poolId = msg.Id
+	case *MsgClaimUploaderRole:
+		poolId = msg.Id
+	case *MsgSkipUploaderRole:
+		poolId = msg.Id
+	case *MsgAttestVersion:
+		poolId = msg.Id
+	case *MsgUpdateMetadata:
+		poolId = msg.Id
+	case *MsgUpdateCommission:
+		poolId = msg.Id
+	case *MsgReactivateStaker:
+		poolId = msg.PoolId
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("unknown msg type")
+	}
+
+	if !isAllowedPool(a.PoolIds, poolId) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("pool %v is not allowed", poolId)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PoolAuthorization) ValidateBasic() error {
+	if !containsString(poolAuthorizationMsgs, a.Msg) {
+		return sdkerrors.ErrInvalidType.Wrapf("%s can not be granted with a pool authorization", a.Msg)
+	}
+
+	return nil
+}
+
+// =================================
+// === DELEGATION AUTHORIZATION ===
+// =================================
+
+// NewDelegationAuthorization creates a new DelegationAuthorization object.
+func NewDelegationAuthorization(msgTypeURL string, poolIds []uint64, stakers []string, maxAmount sdk.Int) *DelegationAuthorization {
+	return &DelegationAuthorization{
+		Msg:       msgTypeURL,
+		PoolIds:   poolIds,
+		Stakers:   stakers,
+		MaxAmount: maxAmount,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a DelegationAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a DelegationAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	var poolIds []uint64
+	var stakers []string
+	var amount *sdk.Int
+
+	switch msg := msg.(type) {
+	case *MsgDelegatePool:
+		poolIds, stakers, amount = []uint64{msg.Id}, []string{msg.Staker}, &msg.Amount
+	case *MsgWithdrawPool:
+		poolIds, stakers = []uint64{msg.Id}, []string{msg.Staker}
+	case *MsgUndelegatePool:
+		poolIds, stakers, amount = []uint64{msg.Id}, []string{msg.Staker}, &msg.Amount
+	case *MsgRedelegatePool:
+		poolIds = []uint64{msg.FromPoolId, msg.ToPoolId}
+		stakers = []string{msg.FromStaker, msg.ToStaker}
+		amount = &msg.Amount
+	case *MsgSetAutoCompound:
+		poolIds, stakers = []uint64{msg.PoolId}, []string{msg.Staker}
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("unknown msg type")
+	}
+
+	for _, poolId := range poolIds {
+		if !isAllowedPool(a.PoolIds, poolId) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("pool %v is not allowed", poolId)
+		}
+	}
+
+	for _, staker := range stakers {
+		if len(a.Stakers) > 0 && !containsString(a.Stakers, staker) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("staker %v is not allowed", staker)
+		}
+	}
+
+	// Messages without an amount do not count against the limit.
+	if amount == nil {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	// A negative amount would raise the remaining limit.
+	if err := ValidatePositiveAmount(*amount); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	// No limit configured
+	if a.MaxAmount.IsNil() || a.MaxAmount.IsZero() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	if amount.GT(a.MaxAmount) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("amount %v exceeds remaining limit %v", amount, a.MaxAmount)
+	}
+
+	remaining := a.MaxAmount.Sub(*amount)
+	if remaining.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewDelegationAuthorization(a.Msg, a.PoolIds, a.Stakers, remaining),
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a DelegationAuthorization) ValidateBasic() error {
+	if !containsString(delegationAuthorizationMsgs, a.Msg) {
+		return sdkerrors.ErrInvalidType.Wrapf("%s can not be granted with a delegation authorization", a.Msg)
+	}
+
+	for _, staker := range a.Stakers {
+		if _, err := sdk.AccAddressFromBech32(staker); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid staker address (%s)", err)
+		}
+	}
+
+	if !a.MaxAmount.IsNil() && a.MaxAmount.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid max amount (%v)", a.MaxAmount)
+	}
+
+	return nil
+}
+
+func isAllowedPool(poolIds []uint64, poolId uint64) bool {
+	if len(poolIds) == 0 {
+		return true
+	}
+
+	for _, id := range poolIds {
+		if id == poolId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(list []string, el string) bool {
+	for _, other := range list {
+		if other == el {
+			return true
+		}
+	}
+
+	return false
+}
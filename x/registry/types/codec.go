@@ -5,22 +5,31 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFundPool{}, "registry/FundPool", nil)
 	cdc.RegisterConcrete(&MsgDefundPool{}, "registry/DefundPool", nil)
+	cdc.RegisterConcrete(&MsgCreateFundingStream{}, "registry/CreateFundingStream", nil)
+	cdc.RegisterConcrete(&MsgCloseFundingStream{}, "registry/CloseFundingStream", nil)
 	cdc.RegisterConcrete(&MsgStakePool{}, "registry/StakePool", nil)
 	cdc.RegisterConcrete(&MsgUnstakePool{}, "registry/UnstakePool", nil)
 	cdc.RegisterConcrete(&MsgSubmitBundleProposal{}, "registry/SubmitBundleProposal", nil)
 	cdc.RegisterConcrete(&MsgVoteProposal{}, "registry/VoteProposal", nil)
 	cdc.RegisterConcrete(&MsgClaimUploaderRole{}, "registry/ClaimUploaderRole", nil)
+	cdc.RegisterConcrete(&MsgSkipUploaderRole{}, "registry/SkipUploaderRole", nil)
+	cdc.RegisterConcrete(&MsgAttestVersion{}, "registry/AttestVersion", nil)
 	cdc.RegisterConcrete(&MsgDelegatePool{}, "registry/DelegatePool", nil)
 	cdc.RegisterConcrete(&MsgWithdrawPool{}, "registry/WithdrawPool", nil)
 	cdc.RegisterConcrete(&MsgUndelegatePool{}, "registry/UndelegatePool", nil)
 	cdc.RegisterConcrete(&MsgRedelegatePool{}, "registry/RedelegatePool", nil)
 	cdc.RegisterConcrete(&MsgUpdateMetadata{}, "registry/UpdateMetadata", nil)
+	cdc.RegisterConcrete(&MsgTransferStaker{}, "registry/TransferStaker", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "registry/SetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "registry/SetWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllRewards{}, "registry/WithdrawAllRewards", nil)
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&CreatePoolProposal{}, "kyve/CreatePoolProposal", nil)
 	cdc.RegisterConcrete(&UpdatePoolProposal{}, "kyve/UpdatePoolProposal", nil)
@@ -29,6 +38,15 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&SchedulePoolUpgradeProposal{}, "kyve/SchedulePoolUpgradeProposal", nil)
 	cdc.RegisterConcrete(&CancelPoolUpgradeProposal{}, "kyve/CancelPoolUpgradeProposal", nil)
 	cdc.RegisterConcrete(&ResetPoolProposal{}, "kyve/ResetPoolProposal", nil)
+	cdc.RegisterConcrete(&CreateStorageProviderProposal{}, "kyve/CreateStorageProviderProposal", nil)
+	cdc.RegisterConcrete(&UpdateStorageProviderProposal{}, "kyve/UpdateStorageProviderProposal", nil)
+	cdc.RegisterConcrete(&RetirePoolProposal{}, "kyve/RetirePoolProposal", nil)
+	cdc.RegisterConcrete(&CreateRuntimeProposal{}, "kyve/CreateRuntimeProposal", nil)
+	cdc.RegisterConcrete(&UpdateRuntimeProposal{}, "kyve/UpdateRuntimeProposal", nil)
+	cdc.RegisterConcrete(&AddRuntimeVersionProposal{}, "kyve/AddRuntimeVersionProposal", nil)
+	cdc.RegisterConcrete(&SetProtocolFundingProposal{}, "kyve/SetProtocolFundingProposal", nil)
+	cdc.RegisterConcrete(&PoolAuthorization{}, "registry/PoolAuthorization", nil)
+	cdc.RegisterConcrete(&DelegationAuthorization{}, "registry/DelegationAuthorization", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +56,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDefundPool{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateFundingStream{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCloseFundingStream{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgStakePool{},
 	)
@@ -53,6 +77,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimUploaderRole{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSkipUploaderRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAttestVersion{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDelegatePool{},
 	)
@@ -68,6 +98,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateMetadata{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferStaker{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetAutoCompound{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetWithdrawAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawAllRewards{},
+	)
 	// this line is used by starport scaffolding # 3
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -78,6 +120,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&SchedulePoolUpgradeProposal{},
 		&CancelPoolUpgradeProposal{},
 		&ResetPoolProposal{},
+		&CreateStorageProviderProposal{},
+		&UpdateStorageProviderProposal{},
+		&RetirePoolProposal{},
+		&CreateRuntimeProposal{},
+		&UpdateRuntimeProposal{},
+		&AddRuntimeVersionProposal{},
+		&SetProtocolFundingProposal{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&PoolAuthorization{},
+		&DelegationAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
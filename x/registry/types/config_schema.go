@@ -0,0 +1,423 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// The config schema of a runtime is a JSON schema which is evaluated during the state transition.
+// Therefore, only a deterministic subset of JSON schema is supported. References and formats are not
+// supported and every unknown keyword is rejected, so that a schema never silently accepts a config.
+var configSchemaKeywords = map[string]bool{
+	"$schema":              true,
+	"$id":                  true,
+	"title":                true,
+	"description":          true,
+	"default":              true,
+	"examples":             true,
+	"type":                 true,
+	"properties":           true,
+	"required":             true,
+	"additionalProperties": true,
+	"items":                true,
+	"enum":                 true,
+	"minimum":              true,
+	"maximum":              true,
+	"minLength":            true,
+	"maxLength":            true,
+	"pattern":              true,
+	"minItems":             true,
+	"maxItems":             true,
+}
+
+var configSchemaTypes = map[string]bool{
+	"object":  true,
+	"array":   true,
+	"string":  true,
+	"number":  true,
+	"integer": true,
+	"boolean": true,
+	"null":    true,
+}
+
+// ValidateConfigSchema checks if a config schema is a valid JSON schema which only uses supported keywords.
+func ValidateConfigSchema(schema string) error {
+	node, err := parseJSON(schema)
+	if err != nil {
+		return fmt.Errorf("invalid config schema: %v", err)
+	}
+
+	return checkSchema(node, "schema")
+}
+
+// ValidateConfig checks if a pool config validates against the given config schema.
+func ValidateConfig(schema string, config string) error {
+	node, err := parseJSON(schema)
+	if err != nil {
+		return fmt.Errorf("invalid config schema: %v", err)
+	}
+
+	if err := checkSchema(node, "schema"); err != nil {
+		return err
+	}
+
+	value, err := parseJSON(config)
+	if err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
+	return validateValue(node.(map[string]interface{}), value, "config")
+}
+
+// parseJSON decodes exactly one JSON value. Numbers are kept as json.Number to compare them without precision loss.
+func parseJSON(data string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return value, nil
+}
+
+func checkSchema(node interface{}, path string) error {
+	schema, ok := node.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%v has to be an object", path)
+	}
+
+	for _, keyword := range sortedKeys(schema) {
+		value := schema[keyword]
+
+		if !configSchemaKeywords[keyword] {
+			return fmt.Errorf("%v uses unsupported keyword %v", path, keyword)
+		}
+
+		switch keyword {
+		case "type":
+			types, err := schemaTypes(value)
+			if err != nil {
+				return fmt.Errorf("%v.type %v", path, err)
+			}
+			for _, t := range types {
+				if !configSchemaTypes[t] {
+					return fmt.Errorf("%v.type has unknown type %v", path, t)
+				}
+			}
+		case "properties":
+			properties, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%v.properties has to be an object", path)
+			}
+			for _, property := range sortedKeys(properties) {
+				if err := checkSchema(properties[property], path+".properties."+property); err != nil {
+					return err
+				}
+			}
+		case "required":
+			required, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("%v.required has to be an array", path)
+			}
+			for _, property := range required {
+				if _, ok := property.(string); !ok {
+					return fmt.Errorf("%v.required has to contain strings", path)
+				}
+			}
+		case "additionalProperties":
+			if _, ok := value.(bool); ok {
+				continue
+			}
+			if err := checkSchema(value, path+".additionalProperties"); err != nil {
+				return err
+			}
+		case "items":
+			if err := checkSchema(value, path+".items"); err != nil {
+				return err
+			}
+		case "enum":
+			enum, ok := value.([]interface{})
+			if !ok || len(enum) == 0 {
+				return fmt.Errorf("%v.enum has to be a non empty array", path)
+			}
+		case "minimum", "maximum":
+			if _, err := parseNumber(value); err != nil {
+				return fmt.Errorf("%v.%v %v", path, keyword, err)
+			}
+		case "minLength", "maxLength", "minItems", "maxItems":
+			if _, err := parseLength(value); err != nil {
+				return fmt.Errorf("%v.%v %v", path, keyword, err)
+			}
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("%v.pattern has to be a string", path)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("%v.pattern is invalid: %v", path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateValue validates a value against a schema which has already been checked by checkSchema.
+func validateValue(schema map[string]interface{}, value interface{}, path string) error {
+	if rawType, ok := schema["type"]; ok {
+		types, _ := schemaTypes(rawType)
+
+		matches := false
+		for _, t := range types {
+			if matchesType(t, value) {
+				matches = true
+				break
+			}
+		}
+
+		if !matches {
+			return fmt.Errorf("%v has to be of type %v", path, types)
+		}
+	}
+
+	if rawEnum, ok := schema["enum"]; ok {
+		matches := false
+		for _, option := range rawEnum.([]interface{}) {
+			if equalJSON(option, value) {
+				matches = true
+				break
+			}
+		}
+
+		if !matches {
+			return fmt.Errorf("%v has to be one of the enum values", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+
+		if rawMin, ok := schema["minLength"]; ok {
+			if min, _ := parseLength(rawMin); length < min {
+				return fmt.Errorf("%v has to be at least %v characters long", path, min)
+			}
+		}
+
+		if rawMax, ok := schema["maxLength"]; ok {
+			if max, _ := parseLength(rawMax); length > max {
+				return fmt.Errorf("%v has to be at most %v characters long", path, max)
+			}
+		}
+
+		if rawPattern, ok := schema["pattern"]; ok {
+			if !regexp.MustCompile(rawPattern.(string)).MatchString(v) {
+				return fmt.Errorf("%v does not match pattern %v", path, rawPattern)
+			}
+		}
+	case json.Number:
+		number, err := parseNumber(v)
+		if err != nil {
+			return fmt.Errorf("%v %v", path, err)
+		}
+
+		if rawMin, ok := schema["minimum"]; ok {
+			if min, _ := parseNumber(rawMin); number.Cmp(min) < 0 {
+				return fmt.Errorf("%v has to be at least %v", path, rawMin)
+			}
+		}
+
+		if rawMax, ok := schema["maximum"]; ok {
+			if max, _ := parseNumber(rawMax); number.Cmp(max) > 0 {
+				return fmt.Errorf("%v has to be at most %v", path, rawMax)
+			}
+		}
+	case []interface{}:
+		length := uint64(len(v))
+
+		if rawMin, ok := schema["minItems"]; ok {
+			if min, _ := parseLength(rawMin); length < min {
+				return fmt.Errorf("%v has to contain at least %v items", path, min)
+			}
+		}
+
+		if rawMax, ok := schema["maxItems"]; ok {
+			if max, _ := parseLength(rawMax); length > max {
+				return fmt.Errorf("%v has to contain at most %v items", path, max)
+			}
+		}
+
+		if rawItems, ok := schema["items"]; ok {
+			for i, item := range v {
+				if err := validateValue(rawItems.(map[string]interface{}), item, fmt.Sprintf("%v[%v]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		if rawRequired, ok := schema["required"]; ok {
+			for _, property := range rawRequired.([]interface{}) {
+				if _, ok := v[property.(string)]; !ok {
+					return fmt.Errorf("%v is missing required property %v", path, property)
+				}
+			}
+		}
+
+		properties := map[string]interface{}{}
+		if rawProperties, ok := schema["properties"]; ok {
+			properties = rawProperties.(map[string]interface{})
+		}
+
+		for _, property := range sortedKeys(v) {
+			propertyPath := path + "." + property
+
+			if propertySchema, ok := properties[property]; ok {
+				if err := validateValue(propertySchema.(map[string]interface{}), v[property], propertyPath); err != nil {
+					return err
+				}
+				continue
+			}
+
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					return fmt.Errorf("%v is not allowed", propertyPath)
+				}
+			case map[string]interface{}:
+				if err := validateValue(additional, v[property], propertyPath); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func schemaTypes(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		types := make([]string, 0, len(v))
+		for _, t := range v {
+			s, ok := t.(string)
+			if !ok {
+				return nil, fmt.Errorf("has to contain strings")
+			}
+			types = append(types, s)
+		}
+		return types, nil
+	default:
+		return nil, fmt.Errorf("has to be a string or an array of strings")
+	}
+}
+
+func matchesType(t string, value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return t == "object"
+	case []interface{}:
+		return t == "array"
+	case string:
+		return t == "string"
+	case bool:
+		return t == "boolean"
+	case nil:
+		return t == "null"
+	case json.Number:
+		if t == "number" {
+			return true
+		}
+		if t == "integer" {
+			number, err := parseNumber(v)
+			return err == nil && number.IsInt()
+		}
+	}
+
+	return false
+}
+
+func parseNumber(value interface{}) (*big.Rat, error) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("has to be a number")
+	}
+
+	number, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return nil, fmt.Errorf("is not a valid number")
+	}
+
+	return number, nil
+}
+
+func parseLength(value interface{}) (uint64, error) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("has to be a non negative integer")
+	}
+
+	length, err := strconv.ParseUint(n.String(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("has to be a non negative integer")
+	}
+
+	return length, nil
+}
+
+func equalJSON(a interface{}, b interface{}) bool {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			if other, ok := y[key]; !ok || !equalJSON(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equalJSON(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		numberA, errA := parseNumber(x)
+		numberB, errB := parseNumber(b)
+		return errA == nil && errB == nil && numberA.Cmp(numberB) == 0
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -49,10 +49,63 @@ var (
 	ErrNotEnoughStake         = sdkerrors.Register(ModuleName, 1126, "not enough stake in pool")
 
 	// delegation errors
-	ErrNotADelegator                   = sdkerrors.Register(ModuleName, 1127, "not a delegator")
-	ErrNotEnoughDelegation             = sdkerrors.Register(ModuleName, 1128, "undelegate-amount is larger than current delegation")
-	ErrRedelegationOnCooldown          = sdkerrors.Register(ModuleName, 1129, "all redelegation slots are on cooldown")
-	ErrMultipleRedelegationInSameBlock = sdkerrors.Register(ModuleName, 1130, "only one redelegation per delegator per block")
+	ErrNotADelegator          = sdkerrors.Register(ModuleName, 1127, "not a delegator")
+	ErrNotEnoughDelegation    = sdkerrors.Register(ModuleName, 1128, "undelegate-amount is larger than current delegation")
+	ErrRedelegationOnCooldown = sdkerrors.Register(ModuleName, 1129, "all redelegation slots are on cooldown")
 
 	ErrStakerNotInactive = sdkerrors.Register(ModuleName, 1131, "staker is not an inactive staker")
+
+	// staker transfer errors
+	ErrStakerTransferOnCooldown = sdkerrors.Register(ModuleName, 1132, "staker transfer on cooldown until %v")
+	ErrStakerAlreadyExists      = sdkerrors.Register(ModuleName, 1133, "account %v is already a staker in pool %v")
+	ErrStakerHasVestingTokens   = sdkerrors.Register(ModuleName, 1134, "locked vesting tokens of %v can not be transferred")
+
+	// reward errors
+	ErrWithdrawAddressBlocked = sdkerrors.Register(ModuleName, 1135, "%v is not allowed to receive funds")
+
+	// delegation cap errors
+	ErrDelegationSelfStakeCap = sdkerrors.Register(ModuleName, 1136, "delegation exceeds %v times the self-stake of the staker, remaining capacity is %v")
+	ErrDelegationPoolShareCap = sdkerrors.Register(ModuleName, 1137, "delegation exceeds a share of %v of the pool, remaining capacity is %v")
+
+	// uploader role errors
+	ErrUploaderRoleSkipOnCooldown = sdkerrors.Register(ModuleName, 1138, "uploader role can only be skipped again after %v")
+	ErrUploadTimeoutReached       = sdkerrors.Register(ModuleName, 1139, "upload timeout has already been reached")
+
+	// storage provider errors
+	ErrStorageProviderNotFound = sdkerrors.Register(ModuleName, 1140, "storage provider with id %v does not exist")
+	ErrInvalidStorageIdFormat  = sdkerrors.Register(ModuleName, 1141, "storage id %v does not match the format of storage provider %v")
+
+	// compression errors
+	ErrCompressionNotAllowed = sdkerrors.Register(ModuleName, 1142, "compression %v is not allowed in pool %v")
+
+	// bounded pool errors
+	ErrPoolCompleted   = sdkerrors.Register(ModuleName, 1143, "pool is completed")
+	ErrEndHeight       = sdkerrors.Register(ModuleName, 1144, "to height exceeds the end height %v of the pool")
+	ErrEndHeightPassed = sdkerrors.Register(ModuleName, 1145, "end height %v has already been reached by pool %v")
+	ErrEndKey          = sdkerrors.Register(ModuleName, 1159, "to key %v exceeds the end key %v of the pool")
+
+	// retired pool errors
+	ErrPoolRetired = sdkerrors.Register(ModuleName, 1146, "pool is retired")
+
+	// runtime errors
+	ErrRuntimeNotFound             = sdkerrors.Register(ModuleName, 1147, "runtime %v does not exist")
+	ErrRuntimeAlreadyExists        = sdkerrors.Register(ModuleName, 1148, "runtime %v already exists")
+	ErrInvalidPoolConfig           = sdkerrors.Register(ModuleName, 1149, "config does not match the schema of runtime %v: %v")
+	ErrRuntimeVersionNotFound      = sdkerrors.Register(ModuleName, 1150, "version %v of runtime %v does not exist")
+	ErrRuntimeVersionAlreadyExists = sdkerrors.Register(ModuleName, 1151, "version %v of runtime %v already exists")
+	ErrRuntimeBinariesMismatch     = sdkerrors.Register(ModuleName, 1152, "binaries do not match version %v of runtime %v")
+
+	// protocol version errors
+	ErrProtocolVersionOutdated = sdkerrors.Register(ModuleName, 1153, "attested protocol version %v is below the required version %v")
+
+	// funding stream errors
+	ErrFundingStreamNotFound = sdkerrors.Register(ModuleName, 1154, "funding stream %v does not exist in pool %v")
+	ErrFundingStreamEndTime  = sdkerrors.Register(ModuleName, 1155, "end time %v of funding stream has already passed")
+	ErrFundingStreamTooLow   = sdkerrors.Register(ModuleName, 1158, "minimum funding stream amount of %vtkyve not reached")
+
+	// protocol funding errors
+	ErrInflationSharesTooHigh = sdkerrors.Register(ModuleName, 1156, "inflation shares of all protocol fundings would be %v, but can be at most 1")
+
+	// amount errors
+	ErrInvalidAmount = sdkerrors.Register(ModuleName, 1157, "amount %v has to be positive")
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -37,6 +38,9 @@ const (
 	BUNDLE_STATUS_NO_FUNDS BundleStatus = 3
 	// BUNDLE_STATUS_NO_QUORUM ...
 	BUNDLE_STATUS_NO_QUORUM BundleStatus = 4
+	// BUNDLE_STATUS_DROPPED is used for pipelined bundle proposals which were dropped
+	// because a previous bundle proposal was not finalized as valid.
+	BUNDLE_STATUS_DROPPED BundleStatus = 5
 )
 
 var BundleStatus_name = map[int32]string{
@@ -45,6 +49,7 @@ var BundleStatus_name = map[int32]string{
 	2: "BUNDLE_STATUS_INVALID",
 	3: "BUNDLE_STATUS_NO_FUNDS",
 	4: "BUNDLE_STATUS_NO_QUORUM",
+	5: "BUNDLE_STATUS_DROPPED",
 }
 
 var BundleStatus_value = map[string]int32{
@@ -53,6 +58,7 @@ var BundleStatus_value = map[string]int32{
 	"BUNDLE_STATUS_INVALID":     2,
 	"BUNDLE_STATUS_NO_FUNDS":    3,
 	"BUNDLE_STATUS_NO_QUORUM":   4,
+	"BUNDLE_STATUS_DROPPED":     5,
 }
 
 func (x BundleStatus) String() string {
@@ -112,11 +118,11 @@ type EventBundleFinalised struct {
 	// next_uploader ...
 	NextUploader string `protobuf:"bytes,5,opt,name=next_uploader,json=nextUploader,proto3" json:"next_uploader,omitempty"`
 	// reward ...
-	Reward uint64 `protobuf:"varint,6,opt,name=reward,proto3" json:"reward,omitempty"`
+	Reward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=reward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward"`
 	// valid ...
-	Valid uint64 `protobuf:"varint,7,opt,name=valid,proto3" json:"valid,omitempty"`
+	Valid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=valid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"valid"`
 	// invalid ...
-	Invalid uint64 `protobuf:"varint,8,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Invalid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=invalid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"invalid"`
 	// from_height ...
 	FromHeight uint64 `protobuf:"varint,9,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 	// to_height ...
@@ -132,9 +138,17 @@ type EventBundleFinalised struct {
 	// bundle_hash ...
 	BundleHash string `protobuf:"bytes,15,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
 	// abstain ...
-	Abstain uint64 `protobuf:"varint,16,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	Abstain github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=abstain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"abstain"`
 	// total ...
-	Total uint64 `protobuf:"varint,17,opt,name=total,proto3" json:"total,omitempty"`
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// compression is the compression algorithm of the bundle, e.g. none or gzip.
+	Compression string `protobuf:"bytes,18,opt,name=compression,proto3" json:"compression,omitempty"`
+	// data_item_count is the amount of data items in the bundle.
+	DataItemCount uint64 `protobuf:"varint,19,opt,name=data_item_count,json=dataItemCount,proto3" json:"data_item_count,omitempty"`
+	// uncompressed_byte_size is the size of the bundle in bytes before compression.
+	UncompressedByteSize uint64 `protobuf:"varint,20,opt,name=uncompressed_byte_size,json=uncompressedByteSize,proto3" json:"uncompressed_byte_size,omitempty"`
+	// voter_reward is the part of the reward which was distributed among the valid voters.
+	VoterReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=voter_reward,json=voterReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voter_reward"`
 }
 
 func (m *EventBundleFinalised) Reset()         { *m = EventBundleFinalised{} }
@@ -205,27 +219,6 @@ func (m *EventBundleFinalised) GetNextUploader() string {
 	return ""
 }
 
-func (m *EventBundleFinalised) GetReward() uint64 {
-	if m != nil {
-		return m.Reward
-	}
-	return 0
-}
-
-func (m *EventBundleFinalised) GetValid() uint64 {
-	if m != nil {
-		return m.Valid
-	}
-	return 0
-}
-
-func (m *EventBundleFinalised) GetInvalid() uint64 {
-	if m != nil {
-		return m.Invalid
-	}
-	return 0
-}
-
 func (m *EventBundleFinalised) GetFromHeight() uint64 {
 	if m != nil {
 		return m.FromHeight
@@ -275,16 +268,23 @@ func (m *EventBundleFinalised) GetBundleHash() string {
 	return ""
 }
 
-func (m *EventBundleFinalised) GetAbstain() uint64 {
+func (m *EventBundleFinalised) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *EventBundleFinalised) GetDataItemCount() uint64 {
 	if m != nil {
-		return m.Abstain
+		return m.DataItemCount
 	}
 	return 0
 }
 
-func (m *EventBundleFinalised) GetTotal() uint64 {
+func (m *EventBundleFinalised) GetUncompressedByteSize() uint64 {
 	if m != nil {
-		return m.Total
+		return m.UncompressedByteSize
 	}
 	return 0
 }
@@ -371,7 +371,7 @@ type EventDelegatePool struct {
 	// node is the account address of the protocol node.
 	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *EventDelegatePool) Reset()         { *m = EventDelegatePool{} }
@@ -428,13 +428,6 @@ func (m *EventDelegatePool) GetNode() string {
 	return ""
 }
 
-func (m *EventDelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // EventUndelegatePool is an event emitted when someone undelegates from a protocol node.
 type EventUndelegatePool struct {
 	// pool_id is the unique ID of the pool.
@@ -444,7 +437,7 @@ type EventUndelegatePool struct {
 	// node is the account address of the protocol node.
 	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *EventUndelegatePool) Reset()         { *m = EventUndelegatePool{} }
@@ -501,13 +494,6 @@ func (m *EventUndelegatePool) GetNode() string {
 	return ""
 }
 
-func (m *EventUndelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // EventRedelegatePool is an event emitted when someone redelegates from one protocol node to another.
 type EventRedelegatePool struct {
 	// address is the account address of the delegator.
@@ -521,7 +507,7 @@ type EventRedelegatePool struct {
 	// address is the account address of the new staker in the the pool
 	ToNode string `protobuf:"bytes,5,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *EventRedelegatePool) Reset()         { *m = EventRedelegatePool{} }
@@ -592,35 +578,30 @@ func (m *EventRedelegatePool) GetToNode() string {
 	return ""
 }
 
-func (m *EventRedelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-// EventFundPool is an event emitted when a pool is funded.
-type EventFundPool struct {
+// EventAutoCompound is an event emitted when the rewards of a delegation get compounded automatically.
+type EventAutoCompound struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the pool funder.
+	// address is the account address of the delegator.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// node is the account address of the protocol node.
+	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// amount is the compounded reward.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventFundPool) Reset()         { *m = EventFundPool{} }
-func (m *EventFundPool) String() string { return proto.CompactTextString(m) }
-func (*EventFundPool) ProtoMessage()    {}
-func (*EventFundPool) Descriptor() ([]byte, []int) {
+func (m *EventAutoCompound) Reset()         { *m = EventAutoCompound{} }
+func (m *EventAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*EventAutoCompound) ProtoMessage()    {}
+func (*EventAutoCompound) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{5}
 }
-func (m *EventFundPool) XXX_Unmarshal(b []byte) error {
+func (m *EventAutoCompound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventFundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventFundPool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAutoCompound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -630,61 +611,63 @@ func (m *EventFundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *EventFundPool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventFundPool.Merge(m, src)
+func (m *EventAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoCompound.Merge(m, src)
 }
-func (m *EventFundPool) XXX_Size() int {
+func (m *EventAutoCompound) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventFundPool) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventFundPool.DiscardUnknown(m)
+func (m *EventAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoCompound.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventFundPool proto.InternalMessageInfo
+var xxx_messageInfo_EventAutoCompound proto.InternalMessageInfo
 
-func (m *EventFundPool) GetPoolId() uint64 {
+func (m *EventAutoCompound) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventFundPool) GetAddress() string {
+func (m *EventAutoCompound) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventFundPool) GetAmount() uint64 {
+func (m *EventAutoCompound) GetNode() string {
 	if m != nil {
-		return m.Amount
+		return m.Node
 	}
-	return 0
+	return ""
 }
 
-// EventDefundPool is an event emitted when a pool is defunded.
-type EventDefundPool struct {
+// EventWithdrawRewards is an event emitted when a delegator withdraws the rewards of a delegation.
+type EventWithdrawRewards struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the pool funder.
+	// address is the account address of the delegator.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// node is the account address of the protocol node.
+	Node string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// amount is the withdrawn reward.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventDefundPool) Reset()         { *m = EventDefundPool{} }
-func (m *EventDefundPool) String() string { return proto.CompactTextString(m) }
-func (*EventDefundPool) ProtoMessage()    {}
-func (*EventDefundPool) Descriptor() ([]byte, []int) {
+func (m *EventWithdrawRewards) Reset()         { *m = EventWithdrawRewards{} }
+func (m *EventWithdrawRewards) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawRewards) ProtoMessage()    {}
+func (*EventWithdrawRewards) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{6}
 }
-func (m *EventDefundPool) XXX_Unmarshal(b []byte) error {
+func (m *EventWithdrawRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDefundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventWithdrawRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDefundPool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventWithdrawRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -694,63 +677,67 @@ func (m *EventDefundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventDefundPool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDefundPool.Merge(m, src)
+func (m *EventWithdrawRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawRewards.Merge(m, src)
 }
-func (m *EventDefundPool) XXX_Size() int {
+func (m *EventWithdrawRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDefundPool) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDefundPool.DiscardUnknown(m)
+func (m *EventWithdrawRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDefundPool proto.InternalMessageInfo
+var xxx_messageInfo_EventWithdrawRewards proto.InternalMessageInfo
 
-func (m *EventDefundPool) GetPoolId() uint64 {
+func (m *EventWithdrawRewards) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventDefundPool) GetAddress() string {
+func (m *EventWithdrawRewards) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventDefundPool) GetAmount() uint64 {
+func (m *EventWithdrawRewards) GetNode() string {
 	if m != nil {
-		return m.Amount
+		return m.Node
 	}
-	return 0
+	return ""
 }
 
-// EventSlash is an event emitted when a protocol node is slashed.
-type EventSlash struct {
+// EventSlashDelegation is an event emitted when the delegation pool of a protocol node gets slashed.
+type EventSlashDelegation struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the protocol node.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// slash_type
-	SlashType SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.registry.v1beta1.SlashType" json:"slash_type,omitempty"`
-}
-
-func (m *EventSlash) Reset()         { *m = EventSlash{} }
-func (m *EventSlash) String() string { return proto.CompactTextString(m) }
-func (*EventSlash) ProtoMessage()    {}
-func (*EventSlash) Descriptor() ([]byte, []int) {
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// fraction is the share of the delegation which got slashed.
+	Fraction string `protobuf:"bytes,3,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	// amount is the amount slashed from the active delegation.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unbonding_amount is the amount slashed from pending unbonding entries.
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
+	// redelegation_amount is the amount slashed from pending redelegation entries.
+	RedelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=redelegation_amount,json=redelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redelegation_amount"`
+}
+
+func (m *EventSlashDelegation) Reset()         { *m = EventSlashDelegation{} }
+func (m *EventSlashDelegation) String() string { return proto.CompactTextString(m) }
+func (*EventSlashDelegation) ProtoMessage()    {}
+func (*EventSlashDelegation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{7}
 }
-func (m *EventSlash) XXX_Unmarshal(b []byte) error {
+func (m *EventSlashDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSlashDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSlash.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSlashDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -760,72 +747,61 @@ func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventSlash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSlash.Merge(m, src)
+func (m *EventSlashDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashDelegation.Merge(m, src)
 }
-func (m *EventSlash) XXX_Size() int {
+func (m *EventSlashDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSlash) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventSlash.DiscardUnknown(m)
+func (m *EventSlashDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventSlash proto.InternalMessageInfo
+var xxx_messageInfo_EventSlashDelegation proto.InternalMessageInfo
 
-func (m *EventSlash) GetPoolId() uint64 {
+func (m *EventSlashDelegation) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventSlash) GetAddress() string {
+func (m *EventSlashDelegation) GetStaker() string {
 	if m != nil {
-		return m.Address
+		return m.Staker
 	}
 	return ""
 }
 
-func (m *EventSlash) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-func (m *EventSlash) GetSlashType() SlashType {
+func (m *EventSlashDelegation) GetFraction() string {
 	if m != nil {
-		return m.SlashType
+		return m.Fraction
 	}
-	return SLASH_TYPE_UNSPECIFIED
+	return ""
 }
 
-// EventUpdateMetadata is an event emitted when a protocol node updates their metadata.
-type EventUpdateMetadata struct {
+// EventFundPool is an event emitted when a pool is funded.
+type EventFundPool struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the protocol node.
+	// address is the account address of the pool funder.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// moniker ...
-	Moniker string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// website ...
-	Website string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	// logo ...
-	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventUpdateMetadata) Reset()         { *m = EventUpdateMetadata{} }
-func (m *EventUpdateMetadata) String() string { return proto.CompactTextString(m) }
-func (*EventUpdateMetadata) ProtoMessage()    {}
-func (*EventUpdateMetadata) Descriptor() ([]byte, []int) {
+func (m *EventFundPool) Reset()         { *m = EventFundPool{} }
+func (m *EventFundPool) String() string { return proto.CompactTextString(m) }
+func (*EventFundPool) ProtoMessage()    {}
+func (*EventFundPool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{8}
 }
-func (m *EventUpdateMetadata) XXX_Unmarshal(b []byte) error {
+func (m *EventFundPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventFundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventUpdateMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventFundPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -835,75 +811,54 @@ func (m *EventUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventUpdateMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventUpdateMetadata.Merge(m, src)
+func (m *EventFundPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundPool.Merge(m, src)
 }
-func (m *EventUpdateMetadata) XXX_Size() int {
+func (m *EventFundPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventUpdateMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventUpdateMetadata.DiscardUnknown(m)
+func (m *EventFundPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventUpdateMetadata proto.InternalMessageInfo
+var xxx_messageInfo_EventFundPool proto.InternalMessageInfo
 
-func (m *EventUpdateMetadata) GetPoolId() uint64 {
+func (m *EventFundPool) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventUpdateMetadata) GetAddress() string {
+func (m *EventFundPool) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventUpdateMetadata) GetMoniker() string {
-	if m != nil {
-		return m.Moniker
-	}
-	return ""
-}
-
-func (m *EventUpdateMetadata) GetWebsite() string {
-	if m != nil {
-		return m.Website
-	}
-	return ""
-}
-
-func (m *EventUpdateMetadata) GetLogo() string {
-	if m != nil {
-		return m.Logo
-	}
-	return ""
-}
-
-// EventUpdateCommission ...
-type EventUpdateCommission struct {
+// EventDefundPool is an event emitted when a pool is defunded.
+type EventDefundPool struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the protocol node.
+	// address is the account address of the pool funder.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// commission ...
-	Commission string `protobuf:"bytes,3,opt,name=commission,proto3" json:"commission,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventUpdateCommission) Reset()         { *m = EventUpdateCommission{} }
-func (m *EventUpdateCommission) String() string { return proto.CompactTextString(m) }
-func (*EventUpdateCommission) ProtoMessage()    {}
-func (*EventUpdateCommission) Descriptor() ([]byte, []int) {
+func (m *EventDefundPool) Reset()         { *m = EventDefundPool{} }
+func (m *EventDefundPool) String() string { return proto.CompactTextString(m) }
+func (*EventDefundPool) ProtoMessage()    {}
+func (*EventDefundPool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{9}
 }
-func (m *EventUpdateCommission) XXX_Unmarshal(b []byte) error {
+func (m *EventDefundPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventUpdateCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventDefundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventUpdateCommission.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventDefundPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -913,61 +868,56 @@ func (m *EventUpdateCommission) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventUpdateCommission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventUpdateCommission.Merge(m, src)
+func (m *EventDefundPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDefundPool.Merge(m, src)
 }
-func (m *EventUpdateCommission) XXX_Size() int {
+func (m *EventDefundPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventUpdateCommission) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventUpdateCommission.DiscardUnknown(m)
+func (m *EventDefundPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDefundPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventUpdateCommission proto.InternalMessageInfo
+var xxx_messageInfo_EventDefundPool proto.InternalMessageInfo
 
-func (m *EventUpdateCommission) GetPoolId() uint64 {
+func (m *EventDefundPool) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventUpdateCommission) GetAddress() string {
+func (m *EventDefundPool) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventUpdateCommission) GetCommission() string {
-	if m != nil {
-		return m.Commission
-	}
-	return ""
-}
-
-// EventStakePool is an event emitted when a protocol node stakes in a pool.
-type EventStakePool struct {
+// EventCreateFundingStream is an event emitted when a funding stream is created.
+type EventCreateFundingStream struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the protocol node.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// stream_id is the unique ID of the funding stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// address is the account address of the sponsor.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventStakePool) Reset()         { *m = EventStakePool{} }
-func (m *EventStakePool) String() string { return proto.CompactTextString(m) }
-func (*EventStakePool) ProtoMessage()    {}
-func (*EventStakePool) Descriptor() ([]byte, []int) {
+func (m *EventCreateFundingStream) Reset()         { *m = EventCreateFundingStream{} }
+func (m *EventCreateFundingStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreateFundingStream) ProtoMessage()    {}
+func (*EventCreateFundingStream) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{10}
 }
-func (m *EventStakePool) XXX_Unmarshal(b []byte) error {
+func (m *EventCreateFundingStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventStakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCreateFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventStakePool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCreateFundingStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -977,61 +927,63 @@ func (m *EventStakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *EventStakePool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStakePool.Merge(m, src)
+func (m *EventCreateFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateFundingStream.Merge(m, src)
 }
-func (m *EventStakePool) XXX_Size() int {
+func (m *EventCreateFundingStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventStakePool) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStakePool.DiscardUnknown(m)
+func (m *EventCreateFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateFundingStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventStakePool proto.InternalMessageInfo
+var xxx_messageInfo_EventCreateFundingStream proto.InternalMessageInfo
 
-func (m *EventStakePool) GetPoolId() uint64 {
+func (m *EventCreateFundingStream) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventStakePool) GetAddress() string {
+func (m *EventCreateFundingStream) GetStreamId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventStakePool) GetAmount() uint64 {
+func (m *EventCreateFundingStream) GetAddress() string {
 	if m != nil {
-		return m.Amount
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-// EventUnstakePool is an event emitted when a protocol node unstakes from a pool.
-type EventUnstakePool struct {
+// EventCloseFundingStream is an event emitted when a funding stream is closed, expired or used up.
+type EventCloseFundingStream struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// address is the account address of the protocol node.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (m *EventUnstakePool) Reset()         { *m = EventUnstakePool{} }
-func (m *EventUnstakePool) String() string { return proto.CompactTextString(m) }
-func (*EventUnstakePool) ProtoMessage()    {}
-func (*EventUnstakePool) Descriptor() ([]byte, []int) {
+	// stream_id is the unique ID of the funding stream.
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// address is the account address of the sponsor.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// refund is the unspent budget returned to the sponsor.
+	Refund github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=refund,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refund"`
+}
+
+func (m *EventCloseFundingStream) Reset()         { *m = EventCloseFundingStream{} }
+func (m *EventCloseFundingStream) String() string { return proto.CompactTextString(m) }
+func (*EventCloseFundingStream) ProtoMessage()    {}
+func (*EventCloseFundingStream) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ee28090fc83eabd8, []int{11}
 }
-func (m *EventUnstakePool) XXX_Unmarshal(b []byte) error {
+func (m *EventCloseFundingStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventUnstakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventCloseFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventUnstakePool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventCloseFundingStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1041,61 +993,131 @@ func (m *EventUnstakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *EventUnstakePool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventUnstakePool.Merge(m, src)
+func (m *EventCloseFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCloseFundingStream.Merge(m, src)
 }
-func (m *EventUnstakePool) XXX_Size() int {
+func (m *EventCloseFundingStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventUnstakePool) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventUnstakePool.DiscardUnknown(m)
+func (m *EventCloseFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCloseFundingStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventUnstakePool proto.InternalMessageInfo
+var xxx_messageInfo_EventCloseFundingStream proto.InternalMessageInfo
 
-func (m *EventUnstakePool) GetPoolId() uint64 {
+func (m *EventCloseFundingStream) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventUnstakePool) GetAddress() string {
+func (m *EventCloseFundingStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventCloseFundingStream) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventUnstakePool) GetAmount() uint64 {
+// EventPoolRunwayAlert is an event emitted when the runway of a pool falls below a runway alert threshold.
+type EventPoolRunwayAlert struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// runway is the projected number of seconds until the pool runs out of funds.
+	Runway uint64 `protobuf:"varint,2,opt,name=runway,proto3" json:"runway,omitempty"`
+	// threshold is the runway alert threshold which was crossed.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// total_funds ...
+	TotalFunds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_funds,json=totalFunds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_funds"`
+	// burn_rate is the projected amount of funds spent per day.
+	BurnRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burn_rate"`
+}
+
+func (m *EventPoolRunwayAlert) Reset()         { *m = EventPoolRunwayAlert{} }
+func (m *EventPoolRunwayAlert) String() string { return proto.CompactTextString(m) }
+func (*EventPoolRunwayAlert) ProtoMessage()    {}
+func (*EventPoolRunwayAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{12}
+}
+func (m *EventPoolRunwayAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolRunwayAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolRunwayAlert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolRunwayAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolRunwayAlert.Merge(m, src)
+}
+func (m *EventPoolRunwayAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolRunwayAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolRunwayAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolRunwayAlert proto.InternalMessageInfo
+
+func (m *EventPoolRunwayAlert) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolRunwayAlert) GetRunway() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.Runway
 	}
 	return 0
 }
 
-// EventStakerStatusChanged ...
-type EventStakerStatusChanged struct {
+func (m *EventPoolRunwayAlert) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// EventSlash is an event emitted when a protocol node is slashed.
+type EventSlash struct {
 	// pool_id is the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// address is the account address of the protocol node.
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// amount ...
-	Status StakerStatus `protobuf:"varint,3,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// slash_type
+	SlashType SlashType `protobuf:"varint,4,opt,name=slash_type,json=slashType,proto3,enum=kyve.registry.v1beta1.SlashType" json:"slash_type,omitempty"`
 }
 
-func (m *EventStakerStatusChanged) Reset()         { *m = EventStakerStatusChanged{} }
-func (m *EventStakerStatusChanged) String() string { return proto.CompactTextString(m) }
-func (*EventStakerStatusChanged) ProtoMessage()    {}
-func (*EventStakerStatusChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee28090fc83eabd8, []int{12}
+func (m *EventSlash) Reset()         { *m = EventSlash{} }
+func (m *EventSlash) String() string { return proto.CompactTextString(m) }
+func (*EventSlash) ProtoMessage()    {}
+func (*EventSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{13}
 }
-func (m *EventStakerStatusChanged) XXX_Unmarshal(b []byte) error {
+func (m *EventSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventStakerStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventStakerStatusChanged.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventSlash.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1105,1142 +1127,5871 @@ func (m *EventStakerStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *EventStakerStatusChanged) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStakerStatusChanged.Merge(m, src)
+func (m *EventSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlash.Merge(m, src)
 }
-func (m *EventStakerStatusChanged) XXX_Size() int {
+func (m *EventSlash) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventStakerStatusChanged) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStakerStatusChanged.DiscardUnknown(m)
+func (m *EventSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventStakerStatusChanged proto.InternalMessageInfo
+var xxx_messageInfo_EventSlash proto.InternalMessageInfo
 
-func (m *EventStakerStatusChanged) GetPoolId() uint64 {
+func (m *EventSlash) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *EventStakerStatusChanged) GetAddress() string {
+func (m *EventSlash) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *EventStakerStatusChanged) GetStatus() StakerStatus {
+func (m *EventSlash) GetSlashType() SlashType {
 	if m != nil {
-		return m.Status
+		return m.SlashType
 	}
-	return STAKER_STATUS_UNSPECIFIED
+	return SLASH_TYPE_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterEnum("kyve.registry.v1beta1.BundleStatus", BundleStatus_name, BundleStatus_value)
-	proto.RegisterEnum("kyve.registry.v1beta1.SlashType", SlashType_name, SlashType_value)
-	proto.RegisterType((*EventBundleFinalised)(nil), "kyve.registry.v1beta1.EventBundleFinalised")
-	proto.RegisterType((*EventBundleVote)(nil), "kyve.registry.v1beta1.EventBundleVote")
-	proto.RegisterType((*EventDelegatePool)(nil), "kyve.registry.v1beta1.EventDelegatePool")
-	proto.RegisterType((*EventUndelegatePool)(nil), "kyve.registry.v1beta1.EventUndelegatePool")
-	proto.RegisterType((*EventRedelegatePool)(nil), "kyve.registry.v1beta1.EventRedelegatePool")
-	proto.RegisterType((*EventFundPool)(nil), "kyve.registry.v1beta1.EventFundPool")
-	proto.RegisterType((*EventDefundPool)(nil), "kyve.registry.v1beta1.EventDefundPool")
-	proto.RegisterType((*EventSlash)(nil), "kyve.registry.v1beta1.EventSlash")
-	proto.RegisterType((*EventUpdateMetadata)(nil), "kyve.registry.v1beta1.EventUpdateMetadata")
-	proto.RegisterType((*EventUpdateCommission)(nil), "kyve.registry.v1beta1.EventUpdateCommission")
-	proto.RegisterType((*EventStakePool)(nil), "kyve.registry.v1beta1.EventStakePool")
-	proto.RegisterType((*EventUnstakePool)(nil), "kyve.registry.v1beta1.EventUnstakePool")
-	proto.RegisterType((*EventStakerStatusChanged)(nil), "kyve.registry.v1beta1.EventStakerStatusChanged")
+// EventUpdateMetadata is an event emitted when a protocol node updates their metadata.
+type EventUpdateMetadata struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the protocol node.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// moniker ...
+	Moniker string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// website ...
+	Website string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	// logo ...
+	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kyve/registry/v1beta1/events.proto", fileDescriptor_ee28090fc83eabd8)
+func (m *EventUpdateMetadata) Reset()         { *m = EventUpdateMetadata{} }
+func (m *EventUpdateMetadata) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateMetadata) ProtoMessage()    {}
+func (*EventUpdateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{14}
 }
-
-var fileDescriptor_ee28090fc83eabd8 = []byte{
-	// 978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1a, 0xc7,
-	0x1b, 0x66, 0x01, 0xf3, 0xe7, 0x8d, 0x8d, 0xf1, 0xd8, 0xd8, 0x6b, 0xac, 0x10, 0x8b, 0xfc, 0x0e,
-	0x56, 0xa4, 0x1f, 0x28, 0xc9, 0xb1, 0x87, 0xca, 0x36, 0xb8, 0x46, 0xb1, 0xc1, 0x05, 0x16, 0x29,
-	0x69, 0xab, 0xd5, 0xe0, 0x9d, 0x2c, 0x5b, 0x96, 0x1d, 0xba, 0x33, 0x60, 0x93, 0x63, 0x4f, 0x55,
-	0x4f, 0x39, 0xe6, 0x58, 0xa9, 0x1f, 0xa1, 0x5f, 0xa2, 0xc7, 0x1c, 0x7b, 0xac, 0xec, 0x2f, 0x52,
-	0xcd, 0xcc, 0x2e, 0x5e, 0x5c, 0x3b, 0x07, 0x2b, 0xe9, 0x6d, 0xdf, 0xf7, 0x79, 0xdf, 0xe7, 0x7d,
-	0x66, 0xe6, 0x19, 0x06, 0x28, 0x0f, 0x67, 0x53, 0x52, 0xf5, 0x89, 0xed, 0x30, 0xee, 0xcf, 0xaa,
-	0xd3, 0xe7, 0x7d, 0xc2, 0xf1, 0xf3, 0x2a, 0x99, 0x12, 0x8f, 0xb3, 0xca, 0xd8, 0xa7, 0x9c, 0xa2,
-	0x82, 0xa8, 0xa9, 0x84, 0x35, 0x95, 0xa0, 0xa6, 0xb8, 0x61, 0x53, 0x9b, 0xca, 0x8a, 0xaa, 0xf8,
-	0x52, 0xc5, 0xc5, 0xff, 0xdd, 0x4d, 0x38, 0xef, 0x56, 0x55, 0xa5, 0xbb, 0xab, 0xf8, 0xa5, 0xc2,
-	0xcb, 0x3f, 0x27, 0x61, 0xa3, 0x2e, 0x34, 0x1c, 0x4c, 0x3c, 0xcb, 0x25, 0x47, 0x8e, 0x87, 0x5d,
-	0x87, 0x11, 0x0b, 0x6d, 0x41, 0x7a, 0x4c, 0xa9, 0x6b, 0x3a, 0x96, 0xae, 0xed, 0x6a, 0x7b, 0xc9,
-	0x76, 0x4a, 0x84, 0x0d, 0x0b, 0x3d, 0x06, 0x60, 0x9c, 0xfa, 0xd8, 0x26, 0x02, 0x8b, 0xef, 0x6a,
-	0x7b, 0xd9, 0x76, 0x36, 0xc8, 0x34, 0x2c, 0xb4, 0x03, 0xd9, 0xfe, 0x8c, 0x13, 0x93, 0x39, 0xef,
-	0x88, 0x9e, 0x90, 0x9d, 0x19, 0x91, 0xe8, 0x38, 0xef, 0x08, 0x2a, 0x42, 0x66, 0x32, 0x76, 0x29,
-	0xb6, 0x88, 0xaf, 0x27, 0x65, 0xe7, 0x3c, 0x46, 0x4f, 0x61, 0xc5, 0x23, 0x97, 0xdc, 0x9c, 0x17,
-	0x2c, 0xc9, 0x82, 0x65, 0x91, 0x34, 0xc2, 0xa2, 0x4d, 0x48, 0xf9, 0xe4, 0x02, 0xfb, 0x96, 0x9e,
-	0x52, 0xa2, 0x54, 0x84, 0x36, 0x60, 0x69, 0x8a, 0x5d, 0xc7, 0xd2, 0xd3, 0x32, 0xad, 0x02, 0xa4,
-	0x43, 0xda, 0xf1, 0x54, 0x3e, 0x23, 0xf3, 0x61, 0x88, 0x9e, 0xc0, 0xa3, 0xb7, 0x3e, 0x1d, 0x99,
-	0x03, 0xe2, 0xd8, 0x03, 0xae, 0x67, 0x25, 0x0a, 0x22, 0x75, 0x2c, 0x33, 0x62, 0x19, 0x9c, 0x86,
-	0x30, 0xa8, 0x65, 0x70, 0x1a, 0x80, 0x5f, 0x41, 0x8a, 0x71, 0xcc, 0x27, 0x4c, 0x7f, 0xb4, 0xab,
-	0xed, 0xe5, 0x5e, 0x3c, 0xad, 0xdc, 0x79, 0x70, 0x15, 0xb5, 0xa7, 0x1d, 0x59, 0xda, 0x0e, 0x5a,
-	0x50, 0x01, 0x52, 0x9c, 0x9a, 0x43, 0x32, 0xd3, 0x97, 0xe5, 0x02, 0x97, 0x38, 0x7d, 0x45, 0x66,
-	0x68, 0x1b, 0x32, 0x9c, 0x9a, 0x53, 0xec, 0x4e, 0x88, 0xbe, 0x22, 0x81, 0x34, 0xa7, 0x3d, 0x11,
-	0xa2, 0x1c, 0xc4, 0x1d, 0x4b, 0xcf, 0x49, 0x11, 0x71, 0x25, 0xbe, 0x2f, 0x99, 0xcd, 0x01, 0x66,
-	0x03, 0x7d, 0x55, 0x56, 0x83, 0x4a, 0x1d, 0x63, 0x36, 0x10, 0xeb, 0xc6, 0x7d, 0xc6, 0xb1, 0xe3,
-	0xe9, 0x79, 0xb5, 0xee, 0x20, 0x14, 0xfb, 0xc4, 0x29, 0xc7, 0xae, 0xbe, 0xa6, 0xf6, 0x49, 0x06,
-	0xe5, 0x0f, 0x1a, 0xac, 0x46, 0x4c, 0xd0, 0xa3, 0x9c, 0xdc, 0x7f, 0xfe, 0x82, 0xdc, 0xb2, 0x7c,
-	0xc2, 0x58, 0x70, 0xf8, 0x61, 0x78, 0xcb, 0x19, 0x89, 0xdb, 0xce, 0x78, 0x09, 0xc9, 0x29, 0xe5,
-	0x44, 0x1e, 0x7c, 0xee, 0xc5, 0x93, 0x7b, 0xf6, 0x4c, 0x0c, 0xef, 0xce, 0xc6, 0xa4, 0x2d, 0x8b,
-	0xcb, 0x3e, 0xac, 0x49, 0x65, 0x35, 0xe2, 0x12, 0x1b, 0x73, 0x72, 0x46, 0xa9, 0xfb, 0x10, 0x6d,
-	0x08, 0x92, 0x1e, 0xb5, 0x48, 0xa0, 0x4a, 0x7e, 0x0b, 0x33, 0xe1, 0x11, 0x9d, 0x78, 0x5c, 0x4a,
-	0x4a, 0xb6, 0x83, 0xa8, 0xcc, 0x61, 0x5d, 0xce, 0x34, 0x3c, 0xeb, 0x3f, 0x9c, 0xfa, 0x87, 0x16,
-	0x8c, 0x6d, 0x93, 0x85, 0xb1, 0x11, 0x76, 0x6d, 0x91, 0x7d, 0x07, 0xb2, 0xd2, 0xc4, 0x42, 0x86,
-	0x9c, 0x9c, 0x6c, 0x67, 0x44, 0x42, 0xb6, 0x85, 0x60, 0x64, 0xbe, 0x04, 0x9b, 0x42, 0xc3, 0x16,
-	0xa4, 0x39, 0x55, 0x7d, 0x81, 0x08, 0x4e, 0xc3, 0x35, 0x72, 0xaa, 0x7a, 0xd4, 0xf5, 0x4b, 0x71,
-	0xda, 0x5c, 0x54, 0x9d, 0x5a, 0x50, 0xfd, 0x06, 0x56, 0xa4, 0xe8, 0xa3, 0x89, 0x67, 0x3d, 0x74,
-	0x97, 0x6e, 0xb8, 0x13, 0x0b, 0xdc, 0xdf, 0x07, 0xae, 0xac, 0x91, 0xb7, 0x5f, 0x80, 0xfd, 0x83,
-	0x06, 0x20, 0xe9, 0x3b, 0xae, 0xb8, 0x33, 0x9f, 0x8f, 0x19, 0x7d, 0x0d, 0xc0, 0x04, 0xa7, 0xc9,
-	0x67, 0xe3, 0xd0, 0xee, 0xbb, 0xf7, 0xd8, 0x5d, 0x0e, 0x97, 0x7e, 0xcf, 0xb2, 0xf0, 0xb3, 0xfc,
-	0x3e, 0xb4, 0x82, 0x31, 0xb6, 0x30, 0x27, 0xa7, 0x84, 0x63, 0x0b, 0x73, 0xfc, 0x10, 0x8d, 0x3a,
-	0xa4, 0x47, 0xd4, 0x73, 0x86, 0xc4, 0x0f, 0x4c, 0x10, 0x86, 0x02, 0xb9, 0x20, 0x7d, 0xe6, 0x04,
-	0x37, 0x32, 0xdb, 0x0e, 0x43, 0xe1, 0x5a, 0x97, 0xda, 0x34, 0x70, 0x80, 0xfc, 0x2e, 0xff, 0x08,
-	0x85, 0x88, 0xa2, 0x43, 0x3a, 0x1a, 0x39, 0x8c, 0x39, 0xd4, 0x7b, 0x88, 0xa6, 0x12, 0xc0, 0xf9,
-	0x9c, 0x20, 0x90, 0x15, 0xc9, 0x94, 0xbf, 0x83, 0x9c, 0x3a, 0x18, 0x8e, 0x87, 0xe4, 0x73, 0x1f,
-	0xfb, 0x0f, 0x90, 0x0f, 0x2e, 0x37, 0xfb, 0x12, 0xf4, 0xbf, 0x6a, 0xa0, 0xdf, 0x88, 0xf7, 0xd5,
-	0x6f, 0xff, 0xe1, 0x00, 0x7b, 0xf6, 0xa7, 0xde, 0xd4, 0xfb, 0xe7, 0xdc, 0x3c, 0x35, 0x89, 0x4f,
-	0x3e, 0x35, 0xd1, 0x71, 0xe1, 0x53, 0xf3, 0xec, 0x37, 0x0d, 0x96, 0xa3, 0x6f, 0x10, 0x7a, 0x0c,
-	0xdb, 0x07, 0x46, 0xb3, 0x76, 0x52, 0x37, 0x3b, 0xdd, 0xfd, 0xae, 0xd1, 0x31, 0x8d, 0x66, 0xe7,
-	0xac, 0x7e, 0xd8, 0x38, 0x6a, 0xd4, 0x6b, 0xf9, 0x18, 0xda, 0x82, 0xf5, 0x45, 0xb8, 0xb7, 0x7f,
-	0xd2, 0xa8, 0xe5, 0x35, 0xb4, 0x0d, 0x85, 0x45, 0xa0, 0xd1, 0x54, 0x50, 0x1c, 0x15, 0x61, 0x73,
-	0x11, 0x6a, 0xb6, 0xcc, 0x23, 0xa3, 0x59, 0xeb, 0xe4, 0x13, 0x68, 0x07, 0xb6, 0xfe, 0x85, 0x7d,
-	0x6b, 0xb4, 0xda, 0xc6, 0x69, 0x3e, 0x59, 0x4c, 0xfe, 0xf2, 0x7b, 0x29, 0xf6, 0xec, 0x27, 0xc8,
-	0xce, 0xaf, 0x80, 0xe0, 0xea, 0x9c, 0xec, 0x77, 0x8e, 0xcd, 0xee, 0xeb, 0xb3, 0xfa, 0x2d, 0x6d,
-	0x9b, 0x80, 0x22, 0x58, 0xb7, 0x71, 0x5a, 0x6f, 0x19, 0xdd, 0xbc, 0x86, 0xd6, 0x61, 0x35, 0x92,
-	0xef, 0xb5, 0xba, 0xf5, 0x7c, 0x1c, 0x15, 0x60, 0x2d, 0x4a, 0x74, 0x76, 0xd2, 0xda, 0xaf, 0xe5,
-	0x13, 0x6a, 0xe4, 0xc1, 0x37, 0x7f, 0x5e, 0x95, 0xb4, 0x8f, 0x57, 0x25, 0xed, 0xef, 0xab, 0x92,
-	0xf6, 0xfe, 0xba, 0x14, 0xfb, 0x78, 0x5d, 0x8a, 0xfd, 0x75, 0x5d, 0x8a, 0xbd, 0xf9, 0xbf, 0xed,
-	0xf0, 0xc1, 0xa4, 0x5f, 0x39, 0xa7, 0xa3, 0xea, 0xab, 0xd7, 0xbd, 0x7a, 0x93, 0xf0, 0x0b, 0xea,
-	0x0f, 0xab, 0xe7, 0x03, 0xec, 0x78, 0xd5, 0xcb, 0x9b, 0xbf, 0x51, 0xe2, 0x62, 0xb3, 0x7e, 0x4a,
-	0xfe, 0x85, 0x7a, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xfe, 0xa8, 0x51, 0xdb, 0x09,
-	0x00, 0x00,
+func (m *EventUpdateMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventBundleFinalised) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventUpdateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateMetadata.Merge(m, src)
+}
+func (m *EventUpdateMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateMetadata.DiscardUnknown(m)
 }
 
-func (m *EventBundleFinalised) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventUpdateMetadata proto.InternalMessageInfo
+
+func (m *EventUpdateMetadata) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventBundleFinalised) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Total != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+func (m *EventUpdateMetadata) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.Abstain != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Abstain))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return ""
+}
+
+func (m *EventUpdateMetadata) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	if len(m.BundleHash) > 0 {
-		i -= len(m.BundleHash)
-		copy(dAtA[i:], m.BundleHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BundleHash)))
-		i--
-		dAtA[i] = 0x7a
+	return ""
+}
+
+func (m *EventUpdateMetadata) GetWebsite() string {
+	if m != nil {
+		return m.Website
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *EventUpdateMetadata) GetLogo() string {
+	if m != nil {
+		return m.Logo
 	}
-	if len(m.ToValue) > 0 {
-		i -= len(m.ToValue)
-		copy(dAtA[i:], m.ToValue)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToValue)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+// EventUpdateCommission ...
+type EventUpdateCommission struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the protocol node.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// commission ...
+	Commission string `protobuf:"bytes,3,opt,name=commission,proto3" json:"commission,omitempty"`
+}
+
+func (m *EventUpdateCommission) Reset()         { *m = EventUpdateCommission{} }
+func (m *EventUpdateCommission) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateCommission) ProtoMessage()    {}
+func (*EventUpdateCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{15}
+}
+func (m *EventUpdateCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ToKey) > 0 {
-		i -= len(m.ToKey)
-		copy(dAtA[i:], m.ToKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToKey)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.ToHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ToHeight))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.FromHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FromHeight))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Invalid != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Invalid))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Valid != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Valid))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Reward != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Reward))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.NextUploader) > 0 {
-		i -= len(m.NextUploader)
-		copy(dAtA[i:], m.NextUploader)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NextUploader)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Uploader) > 0 {
-		i -= len(m.Uploader)
-		copy(dAtA[i:], m.Uploader)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ByteSize != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ByteSize))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *EventBundleVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventUpdateCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateCommission.Merge(m, src)
 }
-
-func (m *EventBundleVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpdateCommission) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Vote != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Vote))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventUpdateCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateCommission.DiscardUnknown(m)
 }
 
-func (m *EventDelegatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_EventUpdateCommission proto.InternalMessageInfo
 
-func (m *EventDelegatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUpdateCommission) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *EventDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventUpdateCommission) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUndelegatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUpdateCommission) GetCommission() string {
+	if m != nil {
+		return m.Commission
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventStakePool is an event emitted when a protocol node stakes in a pool.
+type EventStakePool struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the protocol node.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventStakePool) Reset()         { *m = EventStakePool{} }
+func (m *EventStakePool) String() string { return proto.CompactTextString(m) }
+func (*EventStakePool) ProtoMessage()    {}
+func (*EventStakePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{16}
+}
+func (m *EventStakePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventStakePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakePool.Merge(m, src)
+}
+func (m *EventStakePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakePool.DiscardUnknown(m)
 }
 
-func (m *EventRedelegatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventStakePool proto.InternalMessageInfo
+
+func (m *EventStakePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventRedelegatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventStakePool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *EventRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ToNode) > 0 {
-		i -= len(m.ToNode)
-		copy(dAtA[i:], m.ToNode)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToNode)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ToPool != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ToPool))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.FromNode) > 0 {
-		i -= len(m.FromNode)
-		copy(dAtA[i:], m.FromNode)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromNode)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FromPool != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.FromPool))
-		i--
-		dAtA[i] = 0x10
+// EventUnstakePool is an event emitted when a protocol node unstakes from a pool.
+type EventUnstakePool struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the protocol node.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EventUnstakePool) Reset()         { *m = EventUnstakePool{} }
+func (m *EventUnstakePool) String() string { return proto.CompactTextString(m) }
+func (*EventUnstakePool) ProtoMessage()    {}
+func (*EventUnstakePool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{17}
+}
+func (m *EventUnstakePool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnstakePool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnstakePool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventUnstakePool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnstakePool.Merge(m, src)
+}
+func (m *EventUnstakePool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnstakePool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnstakePool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnstakePool proto.InternalMessageInfo
+
+func (m *EventUnstakePool) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventFundPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUnstakePool) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventFundPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventStakerStatusChanged ...
+type EventStakerStatusChanged struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// address is the account address of the protocol node.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount ...
+	Status StakerStatus `protobuf:"varint,3,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
 }
 
-func (m *EventFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+func (m *EventStakerStatusChanged) Reset()         { *m = EventStakerStatusChanged{} }
+func (m *EventStakerStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventStakerStatusChanged) ProtoMessage()    {}
+func (*EventStakerStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{18}
+}
+func (m *EventStakerStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStakerStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStakerStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventStakerStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStakerStatusChanged.Merge(m, src)
+}
+func (m *EventStakerStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStakerStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStakerStatusChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStakerStatusChanged proto.InternalMessageInfo
+
+func (m *EventStakerStatusChanged) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventStakerStatusChanged) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDefundPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventStakerStatusChanged) GetStatus() StakerStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return STAKER_STATUS_UNSPECIFIED
 }
 
-func (m *EventDefundPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventTransferStaker is an event emitted when a protocol node moves its staking position to a new account.
+type EventTransferStaker struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// from is the previous account address of the protocol node.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the new account address of the protocol node.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+func (m *EventTransferStaker) Reset()         { *m = EventTransferStaker{} }
+func (m *EventTransferStaker) String() string { return proto.CompactTextString(m) }
+func (*EventTransferStaker) ProtoMessage()    {}
+func (*EventTransferStaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{19}
+}
+func (m *EventTransferStaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTransferStaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTransferStaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventTransferStaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTransferStaker.Merge(m, src)
+}
+func (m *EventTransferStaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTransferStaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTransferStaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTransferStaker proto.InternalMessageInfo
+
+func (m *EventTransferStaker) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTransferStaker) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSlash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTransferStaker) GetTo() string {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSetWithdrawAddress is an event emitted when an account changes the recipient of its rewards.
+type EventSetWithdrawAddress struct {
+	// address is the account address whose rewards and unbonded tokens are redirected.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the account address which receives the rewards and unbonded tokens.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SlashType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
-		i--
-		dAtA[i] = 0x20
+func (m *EventSetWithdrawAddress) Reset()         { *m = EventSetWithdrawAddress{} }
+func (m *EventSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*EventSetWithdrawAddress) ProtoMessage()    {}
+func (*EventSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{20}
+}
+func (m *EventSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetWithdrawAddress.Merge(m, src)
+}
+func (m *EventSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetWithdrawAddress proto.InternalMessageInfo
+
+func (m *EventSetWithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUpdateMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSetWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventSkippedUploaderRole is an event emitted when the next uploader hands the uploader role to another staker.
+type EventSkippedUploaderRole struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// id is the id of the current bundle proposal.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// previous_uploader is the account address of the protocol node which skipped the uploader role.
+	PreviousUploader string `protobuf:"bytes,3,opt,name=previous_uploader,json=previousUploader,proto3" json:"previous_uploader,omitempty"`
+	// new_uploader is the account address of the newly selected protocol node.
+	NewUploader string `protobuf:"bytes,4,opt,name=new_uploader,json=newUploader,proto3" json:"new_uploader,omitempty"`
 }
 
-func (m *EventUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventSkippedUploaderRole) Reset()         { *m = EventSkippedUploaderRole{} }
+func (m *EventSkippedUploaderRole) String() string { return proto.CompactTextString(m) }
+func (*EventSkippedUploaderRole) ProtoMessage()    {}
+func (*EventSkippedUploaderRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{21}
+}
+func (m *EventSkippedUploaderRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSkippedUploaderRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSkippedUploaderRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSkippedUploaderRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSkippedUploaderRole.Merge(m, src)
+}
+func (m *EventSkippedUploaderRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSkippedUploaderRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSkippedUploaderRole.DiscardUnknown(m)
 }
 
-func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSkippedUploaderRole proto.InternalMessageInfo
+
+func (m *EventSkippedUploaderRole) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSkippedUploaderRole) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *EventUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		i -= len(m.Commission)
-		copy(dAtA[i:], m.Commission)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Commission)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventSkippedUploaderRole) GetPreviousUploader() string {
+	if m != nil {
+		return m.PreviousUploader
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventStakePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSkippedUploaderRole) GetNewUploader() string {
+	if m != nil {
+		return m.NewUploader
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventStakePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttestVersion is an event emitted when a protocol node attests the version it is running.
+type EventAttestVersion struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the account address of the protocol node.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// version is the attested version of the protocol node.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *EventStakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+func (m *EventAttestVersion) Reset()         { *m = EventAttestVersion{} }
+func (m *EventAttestVersion) String() string { return proto.CompactTextString(m) }
+func (*EventAttestVersion) ProtoMessage()    {}
+func (*EventAttestVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{22}
+}
+func (m *EventAttestVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttestVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttestVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventAttestVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttestVersion.Merge(m, src)
+}
+func (m *EventAttestVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttestVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttestVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttestVersion proto.InternalMessageInfo
+
+func (m *EventAttestVersion) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventAttestVersion) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUnstakePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttestVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUnstakePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPoolUpgradeScheduled is an event emitted when an upgrade is scheduled for a pool.
+type EventPoolUpgradeScheduled struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the version the pool upgrades to.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// scheduled_at is the unix time the pool upgrades.
+	ScheduledAt uint64 `protobuf:"varint,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	// canary is true if the pool upgrades before the other pools of the runtime.
+	Canary bool `protobuf:"varint,4,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
-func (m *EventUnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventPoolUpgradeScheduled) Reset()         { *m = EventPoolUpgradeScheduled{} }
+func (m *EventPoolUpgradeScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgradeScheduled) ProtoMessage()    {}
+func (*EventPoolUpgradeScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{23}
+}
+func (m *EventPoolUpgradeScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgradeScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgradeScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventPoolUpgradeScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgradeScheduled.Merge(m, src)
+}
+func (m *EventPoolUpgradeScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgradeScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgradeScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUpgradeScheduled proto.InternalMessageInfo
+
+func (m *EventPoolUpgradeScheduled) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventStakerStatusChanged) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPoolUpgradeScheduled) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventStakerStatusChanged) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPoolUpgradeScheduled) GetScheduledAt() uint64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
 }
 
-func (m *EventStakerStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPoolUpgradeScheduled) GetCanary() bool {
+	if m != nil {
+		return m.Canary
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// EventPoolUpgraded is an event emitted when a pool switches to a new version.
+type EventPoolUpgraded struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the new version of the pool.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// previous_version is the version before the upgrade.
+	PreviousVersion string `protobuf:"bytes,3,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
 }
-func (m *EventBundleFinalised) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ByteSize != 0 {
-		n += 1 + sovEvents(uint64(m.ByteSize))
-	}
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.NextUploader)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Reward != 0 {
-		n += 1 + sovEvents(uint64(m.Reward))
-	}
-	if m.Valid != 0 {
-		n += 1 + sovEvents(uint64(m.Valid))
-	}
-	if m.Invalid != 0 {
-		n += 1 + sovEvents(uint64(m.Invalid))
-	}
-	if m.FromHeight != 0 {
-		n += 1 + sovEvents(uint64(m.FromHeight))
-	}
-	if m.ToHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ToHeight))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
-	}
-	l = len(m.ToKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ToValue)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
-	}
-	l = len(m.BundleHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Abstain != 0 {
-		n += 2 + sovEvents(uint64(m.Abstain))
-	}
-	if m.Total != 0 {
-		n += 2 + sovEvents(uint64(m.Total))
+
+func (m *EventPoolUpgraded) Reset()         { *m = EventPoolUpgraded{} }
+func (m *EventPoolUpgraded) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgraded) ProtoMessage()    {}
+func (*EventPoolUpgraded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{24}
+}
+func (m *EventPoolUpgraded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgraded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgraded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventPoolUpgraded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgraded.Merge(m, src)
+}
+func (m *EventPoolUpgraded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgraded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgraded.DiscardUnknown(m)
 }
 
-func (m *EventBundleVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Vote != 0 {
-		n += 1 + sovEvents(uint64(m.Vote))
+var xxx_messageInfo_EventPoolUpgraded proto.InternalMessageInfo
+
+func (m *EventPoolUpgraded) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return n
+	return 0
 }
 
-func (m *EventDelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+func (m *EventPoolUpgraded) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return ""
 }
 
-func (m *EventUndelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+func (m *EventPoolUpgraded) GetPreviousVersion() string {
+	if m != nil {
+		return m.PreviousVersion
 	}
-	return n
+	return ""
 }
 
-func (m *EventRedelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.FromPool != 0 {
-		n += 1 + sovEvents(uint64(m.FromPool))
-	}
-	l = len(m.FromNode)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ToPool != 0 {
-		n += 1 + sovEvents(uint64(m.ToPool))
-	}
-	l = len(m.ToNode)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
-	}
-	return n
+// EventPoolUpgradeConfirmed is an event emitted when a pool finalized a bundle after an upgrade.
+type EventPoolUpgradeConfirmed struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the confirmed version of the pool.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *EventFundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+func (m *EventPoolUpgradeConfirmed) Reset()         { *m = EventPoolUpgradeConfirmed{} }
+func (m *EventPoolUpgradeConfirmed) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgradeConfirmed) ProtoMessage()    {}
+func (*EventPoolUpgradeConfirmed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{25}
+}
+func (m *EventPoolUpgradeConfirmed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgradeConfirmed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgradeConfirmed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventPoolUpgradeConfirmed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgradeConfirmed.Merge(m, src)
+}
+func (m *EventPoolUpgradeConfirmed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgradeConfirmed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgradeConfirmed.DiscardUnknown(m)
 }
 
-func (m *EventDefundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+var xxx_messageInfo_EventPoolUpgradeConfirmed proto.InternalMessageInfo
+
+func (m *EventPoolUpgradeConfirmed) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return n
+	return 0
 }
 
-func (m *EventSlash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
+func (m *EventPoolUpgradeConfirmed) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+// EventPoolUpgradeRolledBack is an event emitted when a pool did not finalize a bundle within the rollback window.
+type EventPoolUpgradeRolledBack struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the failed version.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// restored_version is the version the pool was rolled back to.
+	RestoredVersion string `protobuf:"bytes,3,opt,name=restored_version,json=restoredVersion,proto3" json:"restored_version,omitempty"`
+}
+
+func (m *EventPoolUpgradeRolledBack) Reset()         { *m = EventPoolUpgradeRolledBack{} }
+func (m *EventPoolUpgradeRolledBack) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgradeRolledBack) ProtoMessage()    {}
+func (*EventPoolUpgradeRolledBack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{26}
+}
+func (m *EventPoolUpgradeRolledBack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgradeRolledBack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgradeRolledBack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+}
+func (m *EventPoolUpgradeRolledBack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgradeRolledBack.Merge(m, src)
+}
+func (m *EventPoolUpgradeRolledBack) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgradeRolledBack) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgradeRolledBack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUpgradeRolledBack proto.InternalMessageInfo
+
+func (m *EventPoolUpgradeRolledBack) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.SlashType != 0 {
-		n += 1 + sovEvents(uint64(m.SlashType))
+	return 0
+}
+
+func (m *EventPoolUpgradeRolledBack) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	return n
+	return ""
 }
 
-func (m *EventUpdateMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPoolUpgradeRolledBack) GetRestoredVersion() string {
+	if m != nil {
+		return m.RestoredVersion
+	}
+	return ""
+}
+
+// EventPoolUpgradeCancelled is an event emitted when a pending upgrade of a pool is cancelled because a canary pool failed.
+type EventPoolUpgradeCancelled struct {
+	// pool_id is the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// version is the version of the cancelled upgrade.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *EventPoolUpgradeCancelled) Reset()         { *m = EventPoolUpgradeCancelled{} }
+func (m *EventPoolUpgradeCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventPoolUpgradeCancelled) ProtoMessage()    {}
+func (*EventPoolUpgradeCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee28090fc83eabd8, []int{27}
+}
+func (m *EventPoolUpgradeCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolUpgradeCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolUpgradeCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolUpgradeCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolUpgradeCancelled.Merge(m, src)
+}
+func (m *EventPoolUpgradeCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolUpgradeCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolUpgradeCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolUpgradeCancelled proto.InternalMessageInfo
+
+func (m *EventPoolUpgradeCancelled) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolUpgradeCancelled) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("kyve.registry.v1beta1.BundleStatus", BundleStatus_name, BundleStatus_value)
+	proto.RegisterEnum("kyve.registry.v1beta1.SlashType", SlashType_name, SlashType_value)
+	proto.RegisterType((*EventBundleFinalised)(nil), "kyve.registry.v1beta1.EventBundleFinalised")
+	proto.RegisterType((*EventBundleVote)(nil), "kyve.registry.v1beta1.EventBundleVote")
+	proto.RegisterType((*EventDelegatePool)(nil), "kyve.registry.v1beta1.EventDelegatePool")
+	proto.RegisterType((*EventUndelegatePool)(nil), "kyve.registry.v1beta1.EventUndelegatePool")
+	proto.RegisterType((*EventRedelegatePool)(nil), "kyve.registry.v1beta1.EventRedelegatePool")
+	proto.RegisterType((*EventAutoCompound)(nil), "kyve.registry.v1beta1.EventAutoCompound")
+	proto.RegisterType((*EventWithdrawRewards)(nil), "kyve.registry.v1beta1.EventWithdrawRewards")
+	proto.RegisterType((*EventSlashDelegation)(nil), "kyve.registry.v1beta1.EventSlashDelegation")
+	proto.RegisterType((*EventFundPool)(nil), "kyve.registry.v1beta1.EventFundPool")
+	proto.RegisterType((*EventDefundPool)(nil), "kyve.registry.v1beta1.EventDefundPool")
+	proto.RegisterType((*EventCreateFundingStream)(nil), "kyve.registry.v1beta1.EventCreateFundingStream")
+	proto.RegisterType((*EventCloseFundingStream)(nil), "kyve.registry.v1beta1.EventCloseFundingStream")
+	proto.RegisterType((*EventPoolRunwayAlert)(nil), "kyve.registry.v1beta1.EventPoolRunwayAlert")
+	proto.RegisterType((*EventSlash)(nil), "kyve.registry.v1beta1.EventSlash")
+	proto.RegisterType((*EventUpdateMetadata)(nil), "kyve.registry.v1beta1.EventUpdateMetadata")
+	proto.RegisterType((*EventUpdateCommission)(nil), "kyve.registry.v1beta1.EventUpdateCommission")
+	proto.RegisterType((*EventStakePool)(nil), "kyve.registry.v1beta1.EventStakePool")
+	proto.RegisterType((*EventUnstakePool)(nil), "kyve.registry.v1beta1.EventUnstakePool")
+	proto.RegisterType((*EventStakerStatusChanged)(nil), "kyve.registry.v1beta1.EventStakerStatusChanged")
+	proto.RegisterType((*EventTransferStaker)(nil), "kyve.registry.v1beta1.EventTransferStaker")
+	proto.RegisterType((*EventSetWithdrawAddress)(nil), "kyve.registry.v1beta1.EventSetWithdrawAddress")
+	proto.RegisterType((*EventSkippedUploaderRole)(nil), "kyve.registry.v1beta1.EventSkippedUploaderRole")
+	proto.RegisterType((*EventAttestVersion)(nil), "kyve.registry.v1beta1.EventAttestVersion")
+	proto.RegisterType((*EventPoolUpgradeScheduled)(nil), "kyve.registry.v1beta1.EventPoolUpgradeScheduled")
+	proto.RegisterType((*EventPoolUpgraded)(nil), "kyve.registry.v1beta1.EventPoolUpgraded")
+	proto.RegisterType((*EventPoolUpgradeConfirmed)(nil), "kyve.registry.v1beta1.EventPoolUpgradeConfirmed")
+	proto.RegisterType((*EventPoolUpgradeRolledBack)(nil), "kyve.registry.v1beta1.EventPoolUpgradeRolledBack")
+	proto.RegisterType((*EventPoolUpgradeCancelled)(nil), "kyve.registry.v1beta1.EventPoolUpgradeCancelled")
+}
+
+func init() {
+	proto.RegisterFile("kyve/registry/v1beta1/events.proto", fileDescriptor_ee28090fc83eabd8)
+}
+
+var fileDescriptor_ee28090fc83eabd8 = []byte{
+	// 1619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0x3b, 0x8e, 0x3f, 0x5e, 0x32, 0x89, 0x53, 0x99, 0x4c, 0x7a, 0x12, 0xd6, 0x09, 0xbd,
+	0x68, 0x35, 0xbb, 0x68, 0x6d, 0xed, 0x2e, 0x37, 0x0e, 0xc8, 0x89, 0x1d, 0x62, 0x4d, 0xc6, 0xc9,
+	0xb6, 0xed, 0xa0, 0xe1, 0x40, 0xab, 0xec, 0xae, 0xd8, 0x4d, 0xda, 0x5d, 0xde, 0xaa, 0xb2, 0x3d,
+	0x9e, 0x2b, 0x17, 0x58, 0x40, 0x5a, 0x89, 0x0b, 0xe2, 0xc8, 0xc7, 0x1f, 0x00, 0x57, 0xce, 0x48,
+	0x73, 0xdc, 0x23, 0xe2, 0xb0, 0x42, 0x93, 0x03, 0xff, 0x06, 0xaa, 0xea, 0x6a, 0x7f, 0x64, 0xc6,
+	0x41, 0xea, 0x2c, 0x9a, 0x9c, 0xec, 0x7a, 0x5f, 0xf5, 0x7b, 0xaf, 0xde, 0x7b, 0xf5, 0xaa, 0xc1,
+	0xba, 0x1a, 0x0f, 0x49, 0x91, 0x91, 0x8e, 0xc7, 0x05, 0x1b, 0x17, 0x87, 0x9f, 0xb4, 0x88, 0xc0,
+	0x9f, 0x14, 0xc9, 0x90, 0x04, 0x82, 0x17, 0xfa, 0x8c, 0x0a, 0x8a, 0xb6, 0xa5, 0x4c, 0x21, 0x92,
+	0x29, 0x68, 0x99, 0xdd, 0x87, 0x1d, 0xda, 0xa1, 0x4a, 0xa2, 0x28, 0xff, 0x85, 0xc2, 0xbb, 0xdf,
+	0x7b, 0xbb, 0xc1, 0x89, 0x76, 0x28, 0x95, 0x7f, 0xbb, 0x94, 0x78, 0x11, 0xf2, 0xad, 0x7f, 0xa4,
+	0xe1, 0x61, 0x45, 0x62, 0x38, 0x1c, 0x04, 0xae, 0x4f, 0x8e, 0xbd, 0x00, 0xfb, 0x1e, 0x27, 0x2e,
+	0xda, 0x81, 0x74, 0x9f, 0x52, 0xdf, 0xf1, 0x5c, 0xd3, 0x38, 0x30, 0x9e, 0x24, 0xed, 0x94, 0x5c,
+	0x56, 0x5d, 0xf4, 0x1e, 0x00, 0x17, 0x94, 0xe1, 0x0e, 0x91, 0xbc, 0xc4, 0x81, 0xf1, 0x24, 0x6b,
+	0x67, 0x35, 0xa5, 0xea, 0xa2, 0x3d, 0xc8, 0xb6, 0xc6, 0x82, 0x38, 0xdc, 0x7b, 0x49, 0xcc, 0x65,
+	0xa5, 0x99, 0x91, 0x84, 0xba, 0xf7, 0x92, 0xa0, 0x5d, 0xc8, 0x0c, 0xfa, 0x3e, 0xc5, 0x2e, 0x61,
+	0x66, 0x52, 0x69, 0x4e, 0xd6, 0xe8, 0x7d, 0x78, 0x10, 0x90, 0x17, 0xc2, 0x99, 0x08, 0xac, 0x28,
+	0x81, 0x35, 0x49, 0x6c, 0x46, 0x42, 0xc7, 0x90, 0x62, 0x64, 0x84, 0x99, 0x6b, 0xa6, 0x24, 0xf7,
+	0xb0, 0xf0, 0xea, 0x9b, 0xfd, 0xa5, 0x7f, 0x7d, 0xb3, 0xff, 0x41, 0xc7, 0x13, 0xdd, 0x41, 0xab,
+	0xd0, 0xa6, 0xbd, 0x62, 0x9b, 0xf2, 0x1e, 0xe5, 0xfa, 0xe7, 0x63, 0xee, 0x5e, 0x15, 0xc5, 0xb8,
+	0x4f, 0x78, 0xa1, 0x1a, 0x08, 0x5b, 0x6b, 0xa3, 0x32, 0xac, 0x0c, 0xb1, 0xef, 0xb9, 0x66, 0x3a,
+	0x96, 0x99, 0x50, 0x19, 0x9d, 0x40, 0xda, 0x0b, 0x42, 0x3b, 0x99, 0x58, 0x76, 0x22, 0x75, 0xb4,
+	0x0f, 0xab, 0x97, 0x8c, 0xf6, 0x9c, 0x2e, 0xf1, 0x3a, 0x5d, 0x61, 0x66, 0x55, 0xdc, 0x40, 0x92,
+	0x4e, 0x14, 0x45, 0x86, 0x55, 0xd0, 0x88, 0x0d, 0x61, 0x58, 0x05, 0xd5, 0xcc, 0x1f, 0x42, 0x8a,
+	0x0b, 0x2c, 0x06, 0xdc, 0x5c, 0x3d, 0x30, 0x9e, 0xac, 0x7f, 0xfa, 0x7e, 0xe1, 0xad, 0x89, 0x54,
+	0x08, 0xcf, 0xb8, 0xae, 0x44, 0x6d, 0xad, 0x82, 0xb6, 0x21, 0x25, 0xa8, 0x73, 0x45, 0xc6, 0xe6,
+	0x9a, 0x0a, 0xf8, 0x8a, 0xa0, 0x4f, 0xc9, 0x18, 0x3d, 0x86, 0x8c, 0xa0, 0xce, 0x10, 0xfb, 0x03,
+	0x62, 0x3e, 0x50, 0x8c, 0xb4, 0xa0, 0x17, 0x72, 0x89, 0xd6, 0x21, 0xe1, 0xb9, 0xe6, 0xba, 0x02,
+	0x91, 0x08, 0xc1, 0xb7, 0x94, 0x65, 0xa7, 0x8b, 0x79, 0xd7, 0xdc, 0x50, 0xd2, 0x10, 0x92, 0x4e,
+	0x30, 0xef, 0xca, 0x38, 0xe1, 0x16, 0x17, 0xd8, 0x0b, 0xcc, 0x5c, 0xbc, 0x38, 0x69, 0x75, 0x79,
+	0x6e, 0x82, 0x0a, 0xec, 0x9b, 0x9b, 0xf1, 0xce, 0x4d, 0x29, 0xa3, 0x03, 0x58, 0x6d, 0xd3, 0x5e,
+	0x9f, 0x11, 0xce, 0x3d, 0x1a, 0x98, 0x48, 0x01, 0x9e, 0x25, 0xa1, 0x0f, 0x60, 0xc3, 0xc5, 0x02,
+	0x3b, 0x9e, 0x20, 0x3d, 0xa7, 0x4d, 0x07, 0x81, 0x30, 0xb7, 0x94, 0xbf, 0x0f, 0x24, 0xb9, 0x2a,
+	0x48, 0xef, 0x48, 0x12, 0xd1, 0x0f, 0xe0, 0xd1, 0x20, 0x88, 0x14, 0x89, 0xeb, 0x4c, 0x53, 0xff,
+	0xa1, 0x12, 0x7f, 0x38, 0xcb, 0x3d, 0x8c, 0xca, 0xe0, 0x73, 0x58, 0x1b, 0x52, 0x41, 0x98, 0xa3,
+	0x73, 0x79, 0x3b, 0x96, 0x33, 0xab, 0xca, 0x86, 0xad, 0x4c, 0x58, 0xbf, 0x37, 0x60, 0x63, 0xa6,
+	0x8e, 0x2f, 0xa8, 0x20, 0x8b, 0x4b, 0xd8, 0x84, 0x34, 0x76, 0x5d, 0x09, 0x4a, 0xd7, 0x6f, 0xb4,
+	0xbc, 0x51, 0xdc, 0xcb, 0x37, 0x8b, 0xfb, 0x33, 0x48, 0xca, 0x4d, 0x55, 0xed, 0xae, 0x7f, 0xba,
+	0xbf, 0x20, 0xcd, 0xe4, 0xe6, 0x8d, 0x71, 0x9f, 0xd8, 0x4a, 0xd8, 0xfa, 0xa3, 0x01, 0x9b, 0x0a,
+	0x5a, 0x99, 0xf8, 0xa4, 0x83, 0x05, 0x39, 0xa7, 0xd4, 0x8f, 0x03, 0x0e, 0x41, 0x32, 0xa0, 0x2e,
+	0xd1, 0xb0, 0xd4, 0x7f, 0xd9, 0x10, 0x70, 0x4f, 0x9d, 0x4f, 0x32, 0x5e, 0x43, 0x08, 0xb5, 0xad,
+	0x3f, 0x1b, 0xb0, 0xa5, 0x40, 0x36, 0x03, 0xf7, 0x1e, 0xc3, 0xbc, 0x8e, 0x60, 0xda, 0x64, 0x0e,
+	0xe6, 0x0c, 0x1a, 0x63, 0x1e, 0xcd, 0x1e, 0x64, 0x55, 0x67, 0x91, 0xb0, 0x15, 0xd2, 0xa4, 0x9d,
+	0x91, 0x04, 0xa5, 0x16, 0x31, 0x67, 0xf0, 0x2a, 0x66, 0x4d, 0x62, 0xde, 0x81, 0xb4, 0xa0, 0xa1,
+	0x5e, 0x32, 0x74, 0x5d, 0xd0, 0x28, 0x26, 0x82, 0x86, 0x3a, 0x61, 0x8f, 0x4e, 0x09, 0x5a, 0x9b,
+	0xf7, 0x32, 0x75, 0x27, 0x2f, 0x27, 0x19, 0x53, 0x1a, 0x08, 0x7a, 0x44, 0x7b, 0x7d, 0x3a, 0x08,
+	0xdc, 0xfb, 0x76, 0x14, 0x7f, 0x31, 0xf4, 0xcd, 0xf9, 0x13, 0x4f, 0x74, 0x5d, 0x86, 0x47, 0x61,
+	0x25, 0xf2, 0xfb, 0x86, 0xf3, 0x3f, 0x09, 0x8d, 0xb3, 0xee, 0x63, 0xde, 0xd5, 0x35, 0x28, 0x7b,
+	0xdc, 0x42, 0x9c, 0x8f, 0xd4, 0x75, 0x72, 0x45, 0x98, 0x86, 0xa9, 0x57, 0xf2, 0xf6, 0xbe, 0x64,
+	0xb8, 0x2d, 0x95, 0xa7, 0xc9, 0x12, 0xae, 0xbf, 0x2d, 0xb4, 0xe8, 0x39, 0xe4, 0x06, 0x41, 0x8b,
+	0x06, 0xae, 0x17, 0x74, 0x1c, 0x6d, 0x71, 0x25, 0x96, 0xc5, 0x8d, 0x89, 0x9d, 0x52, 0x68, 0xda,
+	0x81, 0x2d, 0x16, 0x55, 0x8d, 0x47, 0x03, 0xe7, 0x4e, 0xa9, 0x8a, 0x66, 0x4d, 0x85, 0x1b, 0x58,
+	0x5f, 0x1a, 0xf0, 0x40, 0x45, 0xfa, 0x78, 0x10, 0xb8, 0x71, 0xbb, 0xc7, 0x34, 0x90, 0xcb, 0x77,
+	0x3a, 0xf6, 0xdf, 0x44, 0x17, 0x42, 0x99, 0x5c, 0xde, 0x03, 0x38, 0x7f, 0x33, 0xc0, 0x54, 0x70,
+	0x8e, 0x18, 0xc1, 0x82, 0xc8, 0x08, 0x79, 0x41, 0xa7, 0x2e, 0x18, 0xc1, 0xbd, 0xc5, 0xb8, 0xf6,
+	0x20, 0xcb, 0x95, 0x48, 0x34, 0x6a, 0x26, 0xed, 0x4c, 0x48, 0x98, 0x07, 0xbd, 0xbc, 0x08, 0xf4,
+	0xdd, 0x4a, 0xe7, 0xaf, 0x06, 0xec, 0x84, 0xa0, 0x7d, 0xca, 0xff, 0xff, 0x98, 0x99, 0x3a, 0xa9,
+	0xb8, 0x98, 0x43, 0x6d, 0xeb, 0x17, 0x51, 0xb9, 0xcb, 0x13, 0xb7, 0x07, 0xc1, 0x08, 0x8f, 0x4b,
+	0x3e, 0x61, 0xe2, 0xd6, 0x72, 0x67, 0x4a, 0x4e, 0xa3, 0xd5, 0x2b, 0xf4, 0x1d, 0xc8, 0x8a, 0x2e,
+	0x23, 0xbc, 0x4b, 0x7d, 0x57, 0x4f, 0xf2, 0x53, 0x02, 0x3a, 0x83, 0x55, 0x35, 0x4c, 0x39, 0x72,
+	0x57, 0x1e, 0x13, 0x34, 0x28, 0x13, 0x32, 0xb0, 0x1c, 0x3d, 0x85, 0x6c, 0x6b, 0xc0, 0x02, 0x87,
+	0x61, 0x41, 0x62, 0x96, 0x7c, 0x46, 0x1a, 0xb0, 0xb1, 0x20, 0xd6, 0x2b, 0x03, 0x60, 0xda, 0xf4,
+	0xde, 0x61, 0xe2, 0xa3, 0x1f, 0x01, 0x70, 0x89, 0xc1, 0x91, 0x2c, 0x3d, 0x38, 0x1d, 0x2c, 0x18,
+	0x9c, 0x14, 0x58, 0x35, 0x39, 0x65, 0x79, 0xf4, 0xd7, 0xfa, 0x6a, 0x32, 0x99, 0xf4, 0x5d, 0x2c,
+	0xc8, 0x33, 0x22, 0xb0, 0x9c, 0x41, 0xe3, 0xf8, 0x64, 0x42, 0xba, 0x47, 0x03, 0x4f, 0x76, 0x76,
+	0x9d, 0x7d, 0x7a, 0x29, 0x39, 0x23, 0xd2, 0xe2, 0x9e, 0x9e, 0xed, 0xb2, 0x76, 0xb4, 0x94, 0x57,
+	0x93, 0x4f, 0x3b, 0x54, 0xdf, 0xf4, 0xea, 0xbf, 0xf5, 0x73, 0xd8, 0x9e, 0x41, 0x74, 0x44, 0x7b,
+	0x3d, 0x2f, 0x1c, 0x9b, 0x63, 0x60, 0xca, 0x03, 0xb4, 0x27, 0x06, 0x34, 0xac, 0x19, 0x8a, 0xf5,
+	0x6b, 0x03, 0xd6, 0xc3, 0x93, 0x94, 0x97, 0xd0, 0xbb, 0x6e, 0x63, 0xbf, 0x35, 0x20, 0xa7, 0xc7,
+	0x44, 0x7e, 0x1f, 0xf0, 0x7c, 0x19, 0xb5, 0x55, 0x15, 0x1d, 0x16, 0x3e, 0xed, 0x8e, 0xba, 0x38,
+	0xe8, 0x90, 0x58, 0x03, 0xd3, 0xf4, 0x25, 0xb9, 0x7c, 0xeb, 0x4b, 0x72, 0x76, 0xbb, 0xe8, 0x25,
+	0x69, 0xfd, 0x21, 0xca, 0xd4, 0x06, 0xc3, 0x01, 0xbf, 0x54, 0x7c, 0x99, 0x5c, 0x0b, 0x71, 0x20,
+	0x48, 0xca, 0x69, 0x53, 0x83, 0x50, 0xff, 0xe5, 0xe3, 0x52, 0x50, 0x9d, 0x07, 0x09, 0x41, 0xbf,
+	0xb5, 0x5e, 0xfe, 0x33, 0xdd, 0xca, 0xeb, 0x64, 0x32, 0xb0, 0x95, 0xa6, 0x65, 0xb1, 0x60, 0x78,
+	0xfe, 0x10, 0x72, 0x23, 0x2d, 0xec, 0xcc, 0x47, 0x6c, 0x63, 0x34, 0x6f, 0xc4, 0xfa, 0xdd, 0xe4,
+	0x24, 0xae, 0xbc, 0x7e, 0x9f, 0xb8, 0xd1, 0x27, 0x0b, 0x9b, 0xfa, 0xb7, 0xbc, 0xc4, 0xc2, 0xa7,
+	0x74, 0x62, 0xf2, 0x94, 0xfe, 0x3e, 0x6c, 0xf6, 0x19, 0x19, 0x7a, 0x74, 0xc0, 0xa7, 0x1f, 0x42,
+	0xc2, 0x60, 0xe4, 0x22, 0x46, 0x64, 0x19, 0x7d, 0x17, 0xd6, 0x02, 0x32, 0x72, 0x6e, 0x7c, 0x51,
+	0x59, 0x0d, 0xc8, 0x28, 0x12, 0xb1, 0x1c, 0x40, 0xe1, 0x20, 0x2d, 0x04, 0xe1, 0xe2, 0x82, 0x30,
+	0x1e, 0x6b, 0xf2, 0x33, 0x21, 0x3d, 0x0c, 0x75, 0xa3, 0xc6, 0xa1, 0x97, 0xd6, 0xaf, 0x0c, 0x78,
+	0x3c, 0xb9, 0x6e, 0x9a, 0xfd, 0x0e, 0xc3, 0x2e, 0xa9, 0xb7, 0xbb, 0xc4, 0x1d, 0xf8, 0xff, 0x23,
+	0x03, 0x23, 0x83, 0x89, 0x39, 0x83, 0xd2, 0x29, 0x1e, 0xe9, 0x3b, 0x58, 0xe8, 0x8b, 0x67, 0x75,
+	0x42, 0x2b, 0x09, 0x89, 0xb2, 0x8d, 0x03, 0xcc, 0xc6, 0xca, 0xe3, 0x8c, 0xad, 0x57, 0xd6, 0x17,
+	0xb0, 0x79, 0x13, 0x4a, 0x2c, 0x08, 0x1f, 0xc2, 0x24, 0xd6, 0xce, 0xbc, 0xdb, 0x1b, 0x11, 0x5d,
+	0x47, 0xd2, 0xaa, 0xbd, 0xe9, 0xfd, 0x11, 0x0d, 0x2e, 0x3d, 0xd6, 0x8b, 0xb5, 0xb5, 0xf5, 0x12,
+	0x76, 0x6f, 0xda, 0xb3, 0xa9, 0xef, 0x13, 0xf7, 0x10, 0xb7, 0xaf, 0x62, 0xfa, 0xc2, 0x08, 0x17,
+	0x94, 0x11, 0xf7, 0xa6, 0x2f, 0x11, 0xfd, 0x36, 0x5f, 0x70, 0xd0, 0x26, 0x7e, 0xbc, 0x93, 0xfc,
+	0xe8, 0xef, 0x06, 0xac, 0xcd, 0x7e, 0x71, 0x42, 0xef, 0xc1, 0xe3, 0xc3, 0x66, 0xad, 0x7c, 0x5a,
+	0x71, 0xea, 0x8d, 0x52, 0xa3, 0x59, 0x77, 0x9a, 0xb5, 0xfa, 0x79, 0xe5, 0xa8, 0x7a, 0x5c, 0xad,
+	0x94, 0x73, 0x4b, 0x68, 0x07, 0xb6, 0xe6, 0xd9, 0x17, 0xa5, 0xd3, 0x6a, 0x39, 0x67, 0xa0, 0xc7,
+	0xb0, 0x3d, 0xcf, 0xa8, 0xd6, 0x42, 0x56, 0x02, 0xed, 0xc2, 0xa3, 0x79, 0x56, 0xed, 0xcc, 0x39,
+	0x6e, 0xd6, 0xca, 0xf5, 0xdc, 0x32, 0xda, 0x83, 0x9d, 0x37, 0x78, 0x9f, 0x37, 0xcf, 0xec, 0xe6,
+	0xb3, 0x5c, 0xf2, 0x4d, 0x9b, 0x65, 0xfb, 0xec, 0xfc, 0xbc, 0x52, 0xce, 0xad, 0xec, 0x26, 0x7f,
+	0xf9, 0xa7, 0xfc, 0xd2, 0x47, 0x5f, 0x40, 0x76, 0x72, 0x1d, 0xcb, 0x6d, 0xea, 0xa7, 0xa5, 0xfa,
+	0x89, 0xd3, 0x78, 0x7e, 0x5e, 0xb9, 0x01, 0xfb, 0x11, 0xa0, 0x19, 0x5e, 0xa3, 0xfa, 0xac, 0x72,
+	0xd6, 0x6c, 0xe4, 0x0c, 0xb4, 0x05, 0x1b, 0x33, 0xf4, 0x8b, 0xb3, 0x46, 0x25, 0x97, 0x40, 0xdb,
+	0xb0, 0x39, 0x6b, 0xe8, 0xfc, 0xf4, 0xac, 0x54, 0xce, 0x2d, 0x87, 0x5b, 0x1e, 0xfe, 0xf8, 0xd5,
+	0xeb, 0xbc, 0xf1, 0xf5, 0xeb, 0xbc, 0xf1, 0xef, 0xd7, 0x79, 0xe3, 0xab, 0xeb, 0xfc, 0xd2, 0xd7,
+	0xd7, 0xf9, 0xa5, 0x7f, 0x5e, 0xe7, 0x97, 0x7e, 0xfa, 0xf1, 0x4c, 0xb3, 0x7b, 0xfa, 0xfc, 0xa2,
+	0x52, 0x23, 0x62, 0x44, 0xd9, 0x55, 0xb1, 0xdd, 0xc5, 0x5e, 0x50, 0x7c, 0x31, 0xfd, 0xbe, 0xab,
+	0xfa, 0x5e, 0x2b, 0xa5, 0xbe, 0xed, 0x7e, 0xf6, 0xdf, 0x01, 0x00, 0x85, 0xda, 0x5e, 0x91, 0x74,
+	0x16, 0x00, 0x00,
+}
+
+func (m *EventBundleFinalised) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleFinalised) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleFinalised) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
+	{
+		size := m.VoterReward.Size()
+		i -= size
+		if _, err := m.VoterReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.UncompressedByteSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UncompressedByteSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.DataItemCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DataItemCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ToValue) > 0 {
+		i -= len(m.ToValue)
+		copy(dAtA[i:], m.ToValue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToValue)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ToKey) > 0 {
+		i -= len(m.ToKey)
+		copy(dAtA[i:], m.ToKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.Invalid.Size()
+		i -= size
+		if _, err := m.Invalid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Valid.Size()
+		i -= size
+		if _, err := m.Valid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Reward.Size()
+		i -= size
+		if _, err := m.Reward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.NextUploader) > 0 {
+		i -= len(m.NextUploader)
+		copy(dAtA[i:], m.NextUploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NextUploader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ByteSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventUpdateCommission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventBundleVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBundleVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBundleVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Vote != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Commission)
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRedelegatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRedelegatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ToNode) > 0 {
+		i -= len(m.ToNode)
+		copy(dAtA[i:], m.ToNode)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToNode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToPool != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ToPool))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FromNode) > 0 {
+		i -= len(m.FromNode)
+		copy(dAtA[i:], m.FromNode)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FromNode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromPool != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromPool))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedelegationAmount.Size()
+		i -= size
+		if _, err := m.RedelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDefundPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDefundPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateFundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateFundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCloseFundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCloseFundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCloseFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refund.Size()
+		i -= size
+		if _, err := m.Refund.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolRunwayAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolRunwayAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolRunwayAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalFunds.Size()
+		i -= size
+		if _, err := m.TotalFunds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Threshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Runway != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Runway))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SlashType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		i -= len(m.Commission)
+		copy(dAtA[i:], m.Commission)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Commission)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnstakePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnstakePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStakerStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStakerStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStakerStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTransferStaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTransferStaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTransferStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSkippedUploaderRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSkippedUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSkippedUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewUploader) > 0 {
+		i -= len(m.NewUploader)
+		copy(dAtA[i:], m.NewUploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewUploader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousUploader) > 0 {
+		i -= len(m.PreviousUploader)
+		copy(dAtA[i:], m.PreviousUploader)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousUploader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttestVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttestVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgradeScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgradeScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgradeScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Canary {
+		i--
+		if m.Canary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgraded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgraded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgraded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousVersion) > 0 {
+		i -= len(m.PreviousVersion)
+		copy(dAtA[i:], m.PreviousVersion)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgradeConfirmed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgradeConfirmed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgradeConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgradeRolledBack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgradeRolledBack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgradeRolledBack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RestoredVersion) > 0 {
+		i -= len(m.RestoredVersion)
+		copy(dAtA[i:], m.RestoredVersion)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RestoredVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolUpgradeCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolUpgradeCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolUpgradeCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBundleFinalised) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovEvents(uint64(m.ByteSize))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NextUploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Valid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Invalid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.FromHeight != 0 {
+		n += 1 + sovEvents(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ToHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.ToKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ToValue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Abstain.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = m.Total.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	l = len(m.Compression)
+	if l > 0 {
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	if m.DataItemCount != 0 {
+		n += 2 + sovEvents(uint64(m.DataItemCount))
+	}
+	if m.UncompressedByteSize != 0 {
+		n += 2 + sovEvents(uint64(m.UncompressedByteSize))
+	}
+	l = m.VoterReward.Size()
+	n += 2 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBundleVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovEvents(uint64(m.Vote))
+	}
+	return n
+}
+
+func (m *EventDelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUndelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRedelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FromPool != 0 {
+		n += 1 + sovEvents(uint64(m.FromPool))
+	}
+	l = len(m.FromNode)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ToPool != 0 {
+		n += 1 + sovEvents(uint64(m.ToPool))
+	}
+	l = len(m.ToNode)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlashDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fraction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.RedelegationAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDefundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCreateFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovEvents(uint64(m.StreamId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCloseFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovEvents(uint64(m.StreamId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPoolRunwayAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Runway != 0 {
+		n += 1 + sovEvents(uint64(m.Runway))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvents(uint64(m.Threshold))
+	}
+	l = m.TotalFunds.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.BurnRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.SlashType != 0 {
+		n += 1 + sovEvents(uint64(m.SlashType))
+	}
+	return n
+}
+
+func (m *EventUpdateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Commission)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventStakePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnstakePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventStakerStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *EventTransferStaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSkippedUploaderRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.PreviousUploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewUploader)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttestVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUpgradeScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduledAt))
+	}
+	if m.Canary {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventPoolUpgraded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUpgradeConfirmed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUpgradeRolledBack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RestoredVersion)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPoolUpgradeCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvents(uint64(m.PoolId))
+	}
+	l = len(m.Version)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBundleFinalised: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBundleFinalised: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Invalid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BundleStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataItemCount", wireType)
+			}
+			m.DataItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataItemCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedByteSize", wireType)
+			}
+			m.UncompressedByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoterReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBundleVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			m.Vote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vote |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUndelegatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRedelegatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRedelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPool", wireType)
+			}
+			m.FromPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromPool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromNode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPool", wireType)
+			}
+			m.ToPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToPool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToNode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToNode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCloseFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCloseFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCloseFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventStakePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *EventUnstakePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovEvents(uint64(m.Amount))
+func (m *EventPoolRunwayAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolRunwayAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolRunwayAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runway", wireType)
+			}
+			m.Runway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFunds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventStakerStatusChanged) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovEvents(uint64(m.PoolId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovEvents(uint64(m.Status))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
+func (m *EventSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2263,10 +7014,10 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBundleFinalised: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBundleFinalised: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2290,7 +7041,7 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2318,30 +7069,11 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
-			}
-			m.ByteSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ByteSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2369,13 +7101,15 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
 			}
-			var stringLen uint64
+			m.SlashType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2385,48 +7119,66 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashType |= SlashType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextUploader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			m.Reward = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reward |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Valid = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2436,16 +7188,16 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Valid |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Invalid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2455,35 +7207,29 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Invalid |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.FromHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ToHeight = 0
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2493,33 +7239,27 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BundleStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 12:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,11 +7287,11 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
+			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2579,13 +7319,63 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToValue = string(dAtA[iNdEx:postIndex])
+			m.Logo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2595,14 +7385,14 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2630,13 +7420,13 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			m.Abstain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2646,30 +7436,24 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Abstain |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Commission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2691,7 +7475,7 @@ func (m *EventBundleFinalised) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
+func (m *EventStakePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2714,10 +7498,10 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBundleVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStakePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBundleVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStakePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2773,7 +7557,7 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2801,27 +7585,10 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
-			}
-			m.Vote = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Vote |= VoteType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2843,7 +7610,7 @@ func (m *EventBundleVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
+func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2866,10 +7633,10 @@ func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnstakePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnstakePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2925,7 +7692,7 @@ func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2953,27 +7720,10 @@ func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Node = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2995,7 +7745,7 @@ func (m *EventDelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
+func (m *EventStakerStatusChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3018,10 +7768,10 @@ func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUndelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventStakerStatusChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventStakerStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3076,42 +7826,10 @@ func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Node = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Amount = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3121,7 +7839,7 @@ func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Status |= StakerStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3147,7 +7865,7 @@ func (m *EventUndelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
+func (m *EventTransferStaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3170,17 +7888,17 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRedelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransferStaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRedelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransferStaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3190,29 +7908,16 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromPool", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			m.FromPool = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3222,14 +7927,27 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromPool |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3257,30 +7975,11 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromNode = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPool", wireType)
-			}
-			m.ToPool = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToPool |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3308,27 +8007,10 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToNode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3350,7 +8032,7 @@ func (m *EventRedelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+func (m *EventSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3369,36 +8051,17 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -3430,11 +8093,11 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3444,11 +8107,24 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3470,7 +8146,7 @@ func (m *EventFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
+func (m *EventSkippedUploaderRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3493,10 +8169,10 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDefundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSkippedUploaderRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSkippedUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3519,8 +8195,27 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousUploader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3548,13 +8243,13 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PreviousUploader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewUploader", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3564,11 +8259,24 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3590,7 +8298,7 @@ func (m *EventDefundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSlash) Unmarshal(dAtA []byte) error {
+func (m *EventAttestVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3613,10 +8321,10 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlash: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttestVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttestVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3640,7 +8348,7 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3668,13 +8376,13 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3684,30 +8392,24 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashType", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.SlashType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashType |= SlashType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3729,7 +8431,7 @@ func (m *EventSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgradeScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3752,10 +8454,10 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgradeScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgradeScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3779,7 +8481,7 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3807,13 +8509,13 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
 			}
-			var stringLen uint64
+			m.ScheduledAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3823,61 +8525,16 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ScheduledAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3887,24 +8544,12 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Canary = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3926,7 +8571,7 @@ func (m *EventUpdateMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgraded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3949,10 +8594,10 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgraded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgraded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3976,7 +8621,7 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4004,11 +8649,11 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4036,7 +8681,7 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = string(dAtA[iNdEx:postIndex])
+			m.PreviousVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4059,7 +8704,7 @@ func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStakePool) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgradeConfirmed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4082,10 +8727,10 @@ func (m *EventStakePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStakePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgradeConfirmed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgradeConfirmed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4109,7 +8754,7 @@ func (m *EventStakePool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4137,27 +8782,8 @@ func (m *EventStakePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4179,7 +8805,7 @@ func (m *EventStakePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgradeRolledBack) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,10 +8828,10 @@ func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUnstakePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgradeRolledBack: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUnstakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgradeRolledBack: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4229,7 +8855,7 @@ func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4257,13 +8883,13 @@ func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoredVersion", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4273,11 +8899,24 @@ func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestoredVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4299,7 +8938,7 @@ func (m *EventUnstakePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStakerStatusChanged) Unmarshal(dAtA []byte) error {
+func (m *EventPoolUpgradeCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,10 +8961,10 @@ func (m *EventStakerStatusChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStakerStatusChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolUpgradeCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStakerStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolUpgradeCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4349,7 +8988,7 @@ func (m *EventStakerStatusChanged) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4377,27 +9016,8 @@ func (m *EventStakerStatusChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StakerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
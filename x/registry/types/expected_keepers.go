@@ -3,23 +3,37 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	upgradeTypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	SetAccount(ctx sdk.Context, acc types.AccountI)
 }
 
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetFeePool(ctx sdk.Context) (feePool distrTypes.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distrTypes.FeePool)
+}
+
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) (minter mintTypes.Minter)
+	GetParams(ctx sdk.Context) (params mintTypes.Params)
 }
 
 type UpgradeKeeper interface {
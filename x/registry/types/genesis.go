@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default capability global index
@@ -96,6 +98,95 @@ func (gs GenesisState) Validate() error {
 		}
 		proposalIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated ID in storage provider
+	storageProviderIdMap := make(map[uint64]bool)
+	storageProviderCount := gs.GetStorageProviderCount()
+	for _, elem := range gs.StorageProviderList {
+		if _, ok := storageProviderIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for storage provider")
+		}
+		if elem.Id == 0 || elem.Id > storageProviderCount {
+			return fmt.Errorf("storage provider id should be between one and the storage provider count")
+		}
+		if elem.StorageCost.IsNil() || elem.StorageCost.IsNegative() {
+			return fmt.Errorf("invalid storage cost for storage provider %d", elem.Id)
+		}
+		storageProviderIdMap[elem.Id] = true
+	}
+	// Check for duplicated index in archived proposal
+	archivedProposalIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ArchivedProposalList {
+		index := string(ArchivedProposalKey(elem.PoolId, elem.Id))
+		if _, ok := archivedProposalIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for archived proposal")
+		}
+		archivedProposalIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index and valid config schema in runtime
+	runtimeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RuntimeList {
+		index := string(RuntimeKey(elem.Name))
+		if _, ok := runtimeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for runtime")
+		}
+		if elem.ConfigSchema != "" {
+			if err := ValidateConfigSchema(elem.ConfigSchema); err != nil {
+				return err
+			}
+		}
+		versionMap := make(map[string]struct{})
+		for _, version := range elem.Versions {
+			if _, ok := versionMap[version.Version]; ok {
+				return fmt.Errorf("duplicated version for runtime %v", elem.Name)
+			}
+			if err := ValidateRuntimeVersion(version.Version, version.Binaries); err != nil {
+				return err
+			}
+			versionMap[version.Version] = struct{}{}
+		}
+		runtimeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated ID and valid amounts in funding stream
+	fundingStreamIdMap := make(map[uint64]bool)
+	fundingStreamCount := gs.GetFundingStreamCount()
+	for _, elem := range gs.FundingStreamList {
+		if _, ok := fundingStreamIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for funding stream")
+		}
+		if elem.Id == 0 || elem.Id > fundingStreamCount {
+			return fmt.Errorf("funding stream id should be between one and the funding stream count")
+		}
+		for _, amount := range []sdk.Int{elem.Amount, elem.AmountPerBundle, elem.AmountPerDay, elem.SpentToday} {
+			if err := validateAmount(amount); err != nil {
+				return err
+			}
+		}
+		fundingStreamIdMap[elem.Id] = true
+	}
+	// Check for duplicated index and valid allocations in protocol funding
+	protocolFundingIndexMap := make(map[string]struct{})
+	inflationShares := sdk.ZeroDec()
+
+	for _, elem := range gs.ProtocolFundingList {
+		index := string(ProtocolFundingKey(elem.PoolId))
+		if _, ok := protocolFundingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for protocol funding")
+		}
+		inflationShare, err := ParseInflationShare(elem.InflationShare)
+		if err != nil {
+			return err
+		}
+		inflationShares = inflationShares.Add(inflationShare)
+		if err := validateAmount(elem.CommunityPoolAmount); err != nil {
+			return err
+		}
+		protocolFundingIndexMap[index] = struct{}{}
+	}
+	if inflationShares.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation shares of protocol fundings exceed one")
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -57,6 +57,32 @@ type GenesisState struct {
 	UnbondingDelegationQueueEntries []UnbondingDelegationQueueEntry `protobuf:"bytes,14,rep,name=unbonding_delegation_queue_entries,json=unbondingDelegationQueueEntries,proto3" json:"unbonding_delegation_queue_entries"`
 	// redelegation_cooldown_list ...
 	RedelegationCooldownList []RedelegationCooldown `protobuf:"bytes,15,rep,name=redelegation_cooldown_list,json=redelegationCooldownList,proto3" json:"redelegation_cooldown_list"`
+	// delegation_slash_list ...
+	DelegationSlashList []DelegationSlash `protobuf:"bytes,18,rep,name=delegation_slash_list,json=delegationSlashList,proto3" json:"delegation_slash_list"`
+	// redelegation_queue_state ...
+	RedelegationQueueState RedelegationQueueState `protobuf:"bytes,19,opt,name=redelegation_queue_state,json=redelegationQueueState,proto3" json:"redelegation_queue_state"`
+	// redelegation_queue_entries ...
+	RedelegationQueueEntries []RedelegationQueueEntry `protobuf:"bytes,20,rep,name=redelegation_queue_entries,json=redelegationQueueEntries,proto3" json:"redelegation_queue_entries"`
+	// auto_compound_list ...
+	AutoCompoundList []AutoCompound `protobuf:"bytes,21,rep,name=auto_compound_list,json=autoCompoundList,proto3" json:"auto_compound_list"`
+	// withdraw_address_list ...
+	WithdrawAddressList []WithdrawAddress `protobuf:"bytes,22,rep,name=withdraw_address_list,json=withdrawAddressList,proto3" json:"withdraw_address_list"`
+	// storage_provider_list ...
+	StorageProviderList []StorageProvider `protobuf:"bytes,23,rep,name=storage_provider_list,json=storageProviderList,proto3" json:"storage_provider_list"`
+	// storage_provider_count ...
+	StorageProviderCount uint64 `protobuf:"varint,24,opt,name=storage_provider_count,json=storageProviderCount,proto3" json:"storage_provider_count,omitempty"`
+	// archived_proposal_list ...
+	ArchivedProposalList []Proposal `protobuf:"bytes,25,rep,name=archived_proposal_list,json=archivedProposalList,proto3" json:"archived_proposal_list"`
+	// runtime_list ...
+	RuntimeList []Runtime `protobuf:"bytes,26,rep,name=runtime_list,json=runtimeList,proto3" json:"runtime_list"`
+	// funding_stream_list ...
+	FundingStreamList []FundingStream `protobuf:"bytes,27,rep,name=funding_stream_list,json=fundingStreamList,proto3" json:"funding_stream_list"`
+	// funding_stream_count ...
+	FundingStreamCount uint64 `protobuf:"varint,28,opt,name=funding_stream_count,json=fundingStreamCount,proto3" json:"funding_stream_count,omitempty"`
+	// protocol_funding_list ...
+	ProtocolFundingList []ProtocolFunding `protobuf:"bytes,29,rep,name=protocol_funding_list,json=protocolFundingList,proto3" json:"protocol_funding_list"`
+	// auto_compound_cursor ...
+	AutoCompoundCursor []byte `protobuf:"bytes,30,opt,name=auto_compound_cursor,json=autoCompoundCursor,proto3" json:"auto_compound_cursor,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -204,6 +230,97 @@ func (m *GenesisState) GetRedelegationCooldownList() []RedelegationCooldown {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationSlashList() []DelegationSlash {
+	if m != nil {
+		return m.DelegationSlashList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationQueueState() RedelegationQueueState {
+	if m != nil {
+		return m.RedelegationQueueState
+	}
+	return RedelegationQueueState{}
+}
+
+func (m *GenesisState) GetRedelegationQueueEntries() []RedelegationQueueEntry {
+	if m != nil {
+		return m.RedelegationQueueEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundList() []AutoCompound {
+	if m != nil {
+		return m.AutoCompoundList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawAddressList() []WithdrawAddress {
+	if m != nil {
+		return m.WithdrawAddressList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageProviderList() []StorageProvider {
+	if m != nil {
+		return m.StorageProviderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageProviderCount() uint64 {
+	if m != nil {
+		return m.StorageProviderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetArchivedProposalList() []Proposal {
+	if m != nil {
+		return m.ArchivedProposalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRuntimeList() []Runtime {
+	if m != nil {
+		return m.RuntimeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingStreamList() []FundingStream {
+	if m != nil {
+		return m.FundingStreamList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingStreamCount() uint64 {
+	if m != nil {
+		return m.FundingStreamCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProtocolFundingList() []ProtocolFunding {
+	if m != nil {
+		return m.ProtocolFundingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundCursor() []byte {
+	if m != nil {
+		return m.AutoCompoundCursor
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kyve.registry.v1beta1.GenesisState")
 }
@@ -213,47 +330,66 @@ func init() {
 }
 
 var fileDescriptor_99000362002b89f1 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x73, 0xd2, 0x4e,
-	0x18, 0xc6, 0xc9, 0xb7, 0xfd, 0xd6, 0x76, 0xa1, 0x55, 0xa3, 0x68, 0x44, 0x09, 0x4c, 0x75, 0x1c,
-	0x1c, 0xc7, 0x64, 0xa8, 0x9e, 0x3d, 0x14, 0x6a, 0x67, 0xfc, 0x35, 0x4a, 0x47, 0x67, 0xf4, 0x82,
-	0x21, 0xd9, 0x86, 0x15, 0xd8, 0xc5, 0xec, 0xa6, 0x15, 0x0f, 0x5e, 0x3c, 0x78, 0xf5, 0x3f, 0xf2,
-	0xda, 0x63, 0x8f, 0x9e, 0x1c, 0x07, 0xfe, 0x11, 0x67, 0xdf, 0x6c, 0x20, 0x08, 0x49, 0xa7, 0x27,
-	0x98, 0xe4, 0x79, 0x9e, 0xcf, 0x9b, 0x27, 0x6f, 0x12, 0x74, 0xbb, 0x37, 0x3a, 0xc2, 0x76, 0x80,
-	0x7d, 0xc2, 0x45, 0x30, 0xb2, 0x8f, 0xea, 0x1d, 0x2c, 0x9c, 0xba, 0xed, 0x63, 0x8a, 0x39, 0xe1,
-	0xd6, 0x30, 0x60, 0x82, 0xe9, 0x45, 0x29, 0xb2, 0x62, 0x91, 0xa5, 0x44, 0xa5, 0xab, 0x3e, 0xf3,
-	0x19, 0x28, 0x6c, 0xf9, 0x2f, 0x12, 0x97, 0xee, 0x2c, 0x4f, 0x9c, 0xba, 0x41, 0xb5, 0xfd, 0xb3,
-	0x80, 0x0a, 0xfb, 0x11, 0xe4, 0x40, 0x38, 0x02, 0xeb, 0x8f, 0xd1, 0xc6, 0x90, 0xb1, 0x7e, 0xbb,
-	0x4f, 0xb8, 0x30, 0xfe, 0xab, 0xae, 0xd4, 0xf2, 0x3b, 0x37, 0xad, 0xa5, 0x5c, 0xeb, 0x15, 0x63,
-	0xfd, 0xdd, 0xd5, 0x93, 0xdf, 0x95, 0x5c, 0x6b, 0x5d, 0x7a, 0x9e, 0x13, 0x2e, 0xf4, 0x32, 0x42,
-	0xe0, 0x77, 0x59, 0x48, 0x85, 0xb1, 0x52, 0xd5, 0x6a, 0xab, 0x2d, 0x48, 0x6c, 0xc8, 0x03, 0x7a,
-	0x13, 0xe5, 0x0f, 0x43, 0xea, 0xe1, 0x20, 0x02, 0xac, 0x02, 0xa0, 0x9c, 0x02, 0x78, 0x02, 0x4a,
-	0x85, 0x40, 0x91, 0x0f, 0x20, 0x4d, 0x94, 0xe7, 0xc2, 0xe9, 0xc5, 0x29, 0xff, 0x67, 0xa6, 0x1c,
-	0x80, 0x32, 0x4e, 0x89, 0x7c, 0x90, 0xf2, 0x05, 0x95, 0x5d, 0x36, 0x18, 0x10, 0xce, 0x09, 0xa3,
-	0x6d, 0xb7, 0xeb, 0x50, 0x1f, 0xb7, 0x3f, 0x85, 0x38, 0xc4, 0x6d, 0x2e, 0xbb, 0x30, 0x2e, 0x55,
-	0xb5, 0x5a, 0x7e, 0xa7, 0x9e, 0x92, 0xdb, 0x98, 0x7a, 0x1b, 0x60, 0x7d, 0x2d, 0x9d, 0x50, 0xa2,
-	0x62, 0x95, 0xdc, 0x54, 0x45, 0x16, 0x1b, 0x53, 0x11, 0x8c, 0x8c, 0xcb, 0x70, 0x4d, 0xe7, 0x62,
-	0xef, 0x49, 0x63, 0x26, 0x1b, 0x14, 0xfa, 0x07, 0x54, 0x0c, 0x69, 0x87, 0x51, 0x8f, 0x50, 0xbf,
-	0x9d, 0xec, 0xb1, 0x00, 0xcc, 0xbb, 0x29, 0xcc, 0x37, 0xb1, 0x67, 0xae, 0xd0, 0x2b, 0xe1, 0xfc,
-	0xe1, 0xb8, 0xd9, 0x79, 0x82, 0xfc, 0x4d, 0x36, 0x8b, 0x32, 0x9b, 0x9d, 0x23, 0x11, 0xea, 0x2f,
-	0x36, 0x1b, 0xa6, 0x2a, 0xf4, 0xaf, 0xa8, 0x92, 0xc6, 0x96, 0xcd, 0x12, 0xcc, 0x8d, 0x7c, 0x66,
-	0xb7, 0x4b, 0xe9, 0xc9, 0x6e, 0x6f, 0x85, 0x69, 0x0a, 0x82, 0xb9, 0xfe, 0x02, 0x6d, 0x79, 0xb8,
-	0x8f, 0x7d, 0x47, 0x30, 0x55, 0xeb, 0x1a, 0xe0, 0xaa, 0x29, 0xb8, 0x66, 0x2c, 0x56, 0xe9, 0x9b,
-	0x53, 0x37, 0x54, 0xf9, 0x11, 0xdd, 0x50, 0x07, 0xe4, 0xa2, 0xc0, 0xa3, 0xe5, 0x39, 0xc2, 0x89,
-	0x92, 0x2f, 0x40, 0xf2, 0xbd, 0xec, 0x64, 0xc2, 0xa8, 0x7c, 0x52, 0x9b, 0x8e, 0x70, 0x14, 0xe2,
-	0x9a, 0xb7, 0x70, 0x06, 0x58, 0x87, 0xe8, 0x7a, 0x82, 0xa5, 0xda, 0x8a, 0x48, 0xeb, 0x40, 0xaa,
-	0x9d, 0x49, 0x52, 0x2d, 0x28, 0x50, 0xd1, 0xfb, 0xf7, 0x04, 0x70, 0x9e, 0xa2, 0xcd, 0x61, 0xc0,
-	0x86, 0x8c, 0x3b, 0xea, 0x3d, 0xb3, 0x01, 0xe9, 0x95, 0xb4, 0xf7, 0x8c, 0xd2, 0xaa, 0xd0, 0x42,
-	0xec, 0x85, 0xac, 0x6f, 0x1a, 0xaa, 0xce, 0xee, 0x77, 0x62, 0xfc, 0xe4, 0xba, 0x6d, 0xc2, 0xba,
-	0x3d, 0x3a, 0xeb, 0x86, 0xcf, 0x2e, 0x63, 0x61, 0xe3, 0x66, 0xfb, 0xbc, 0x4c, 0xa4, 0x7f, 0xd7,
-	0xd0, 0x76, 0xc6, 0x14, 0xf1, 0xe2, 0x6d, 0xc1, 0x75, 0x9e, 0x77, 0x8e, 0xe4, 0xee, 0x55, 0xc2,
-	0x0c, 0x91, 0x5c, 0x3f, 0x86, 0x4a, 0x01, 0x4e, 0x0c, 0xe0, 0x32, 0xd6, 0xf7, 0xd8, 0x31, 0x8d,
-	0x8a, 0xbe, 0x08, 0x03, 0xdc, 0x4f, 0x19, 0xa0, 0x95, 0x30, 0x36, 0x94, 0x4f, 0x71, 0x8d, 0x60,
-	0xc9, 0x39, 0x79, 0x03, 0x76, 0xf7, 0x4f, 0xc6, 0xa6, 0x76, 0x3a, 0x36, 0xb5, 0x3f, 0x63, 0x53,
-	0xfb, 0x31, 0x31, 0x73, 0xa7, 0x13, 0x33, 0xf7, 0x6b, 0x62, 0xe6, 0xde, 0x3f, 0xf0, 0x89, 0xe8,
-	0x86, 0x1d, 0xcb, 0x65, 0x03, 0xfb, 0xd9, 0xbb, 0xb7, 0x7b, 0x2f, 0xb1, 0x38, 0x66, 0x41, 0xcf,
-	0x76, 0xbb, 0x0e, 0xa1, 0xf6, 0xe7, 0xd9, 0xb7, 0x49, 0x8c, 0x86, 0x98, 0x77, 0xd6, 0xe0, 0x8b,
-	0xf4, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x9d, 0x0d, 0x13, 0x0b, 0x07, 0x00, 0x00,
+	// 943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x63, 0x1c, 0x4a, 0x3b, 0x76, 0x4a, 0xbb, 0xb1, 0xdd, 0xad, 0x5b, 0x6f, 0x56, 0x6d,
+	0x85, 0x8c, 0x50, 0x6d, 0x52, 0x7a, 0x46, 0x6a, 0x9d, 0x12, 0x89, 0x7f, 0x0a, 0x89, 0xa0, 0xa2,
+	0x1c, 0x36, 0x93, 0xdd, 0xc9, 0x7a, 0x88, 0xbd, 0xb3, 0x9d, 0x3f, 0x31, 0xe1, 0xc0, 0x85, 0x03,
+	0x57, 0x3e, 0x01, 0x9f, 0xa7, 0xc7, 0x1e, 0x39, 0x21, 0x94, 0x7c, 0x11, 0xb4, 0xef, 0xcc, 0x24,
+	0xbb, 0xb1, 0x77, 0x83, 0x7b, 0x4a, 0x34, 0xf3, 0x3e, 0xcf, 0xef, 0xf5, 0xf3, 0x8e, 0xc7, 0x83,
+	0x1e, 0x1e, 0x9d, 0x1c, 0x93, 0x21, 0x27, 0x31, 0x15, 0x92, 0x9f, 0x0c, 0x8f, 0x37, 0x0f, 0x88,
+	0xc4, 0x9b, 0xc3, 0x98, 0x24, 0x44, 0x50, 0x31, 0x48, 0x39, 0x93, 0xcc, 0x69, 0x67, 0x45, 0x03,
+	0x5b, 0x34, 0x30, 0x45, 0xdd, 0x56, 0xcc, 0x62, 0x06, 0x15, 0xc3, 0xec, 0x3f, 0x5d, 0xdc, 0x7d,
+	0xb4, 0xd8, 0xf1, 0x5c, 0x0d, 0x55, 0x0f, 0xfe, 0x6a, 0xa3, 0xe6, 0xb6, 0x86, 0xec, 0x49, 0x2c,
+	0x89, 0xf3, 0x39, 0xba, 0x91, 0x32, 0x36, 0x09, 0x26, 0x54, 0x48, 0xf7, 0x3d, 0xbf, 0xde, 0x6f,
+	0x3c, 0xb9, 0x37, 0x58, 0xc8, 0x1d, 0xec, 0x30, 0x36, 0x79, 0xbe, 0xfa, 0xe6, 0x9f, 0x8d, 0x95,
+	0xdd, 0xeb, 0x99, 0xe6, 0x6b, 0x2a, 0xa4, 0xd3, 0x43, 0x08, 0xf4, 0x21, 0x53, 0x89, 0x74, 0xeb,
+	0x7e, 0xad, 0xbf, 0xba, 0x0b, 0x8e, 0xa3, 0x6c, 0xc1, 0xd9, 0x42, 0x8d, 0x43, 0x95, 0x44, 0x84,
+	0x6b, 0xc0, 0x2a, 0x00, 0x7a, 0x25, 0x80, 0x2f, 0xa0, 0xd2, 0x20, 0x90, 0xd6, 0x01, 0x64, 0x0b,
+	0x35, 0x84, 0xc4, 0x47, 0xd6, 0xe5, 0xfd, 0x4a, 0x97, 0x3d, 0xa8, 0xb4, 0x2e, 0x5a, 0x07, 0x2e,
+	0xbf, 0xa2, 0x5e, 0xc8, 0xa6, 0x53, 0x2a, 0x04, 0x65, 0x49, 0x10, 0x8e, 0x71, 0x12, 0x93, 0xe0,
+	0xb5, 0x22, 0x8a, 0x04, 0x22, 0xcb, 0xc2, 0xbd, 0xe5, 0xd7, 0xfa, 0x8d, 0x27, 0x9b, 0x25, 0xbe,
+	0xa3, 0x73, 0xed, 0x08, 0xa4, 0xdf, 0x65, 0x4a, 0x08, 0xd1, 0xb0, 0xba, 0x61, 0x69, 0x45, 0x15,
+	0x9b, 0x24, 0x92, 0x9f, 0xb8, 0xb7, 0xfd, 0xfa, 0xb2, 0xec, 0x17, 0x99, 0xb0, 0x92, 0x0d, 0x15,
+	0xce, 0x3e, 0x6a, 0xab, 0xe4, 0x80, 0x25, 0x11, 0x4d, 0xe2, 0x20, 0x9f, 0x63, 0x13, 0x98, 0x1f,
+	0x95, 0x30, 0xbf, 0xb7, 0x9a, 0x42, 0xa0, 0xeb, 0xaa, 0xb8, 0x6c, 0x93, 0x2d, 0x12, 0xb2, 0xbf,
+	0xf9, 0x64, 0x51, 0x65, 0xb2, 0x05, 0x12, 0x4d, 0xe2, 0xf9, 0x64, 0x55, 0x69, 0x85, 0xf3, 0x1b,
+	0xda, 0x28, 0x63, 0x67, 0xc9, 0x52, 0x22, 0xdc, 0x86, 0x5f, 0x5f, 0x96, 0x9e, 0xcf, 0xf6, 0xbe,
+	0x2a, 0xab, 0xa0, 0x44, 0x38, 0xdf, 0xa0, 0x9b, 0x11, 0x99, 0x90, 0x18, 0x4b, 0x66, 0x62, 0xbd,
+	0x06, 0x38, 0xbf, 0x04, 0xb7, 0x65, 0x8b, 0x8d, 0xfb, 0xda, 0xb9, 0x1a, 0xa2, 0xfc, 0x19, 0xdd,
+	0x35, 0x0b, 0xd9, 0x41, 0x81, 0xaf, 0x56, 0x84, 0x25, 0xd6, 0xce, 0x1f, 0x80, 0xf3, 0xc7, 0xd5,
+	0xce, 0x94, 0x25, 0xd9, 0x37, 0x75, 0x0b, 0x4b, 0x6c, 0x10, 0x9d, 0x68, 0x6e, 0x07, 0x58, 0x87,
+	0xe8, 0x4e, 0x8e, 0x65, 0xd2, 0xd2, 0xa4, 0xeb, 0x40, 0xea, 0x5f, 0x49, 0x32, 0x29, 0x18, 0x50,
+	0x3b, 0xba, 0xbc, 0x01, 0x9c, 0x2f, 0xd1, 0x5a, 0xca, 0x59, 0xca, 0x04, 0x36, 0xf7, 0xcc, 0x0d,
+	0x70, 0xdf, 0x28, 0xbb, 0x67, 0x4c, 0xad, 0x31, 0x6d, 0x5a, 0x2d, 0x78, 0xfd, 0x5e, 0x43, 0xfe,
+	0xc5, 0xbc, 0x73, 0xed, 0xe7, 0x8f, 0xdb, 0x1a, 0x1c, 0xb7, 0xa7, 0x57, 0x0d, 0xfc, 0xe2, 0x63,
+	0xcc, 0x9d, 0xb8, 0x9e, 0xaa, 0x2a, 0x72, 0xfe, 0xa8, 0xa1, 0x07, 0x15, 0x5d, 0xd8, 0x83, 0x77,
+	0xd3, 0xaf, 0xbf, 0x43, 0x1f, 0xf9, 0xb3, 0xb7, 0xa1, 0x2a, 0x8a, 0xb2, 0xe3, 0xc7, 0x50, 0x97,
+	0x93, 0x5c, 0x03, 0x21, 0x63, 0x93, 0x88, 0xcd, 0x12, 0x1d, 0xf4, 0x87, 0xd0, 0xc0, 0x27, 0x25,
+	0x0d, 0xec, 0xe6, 0x84, 0x23, 0xa3, 0x33, 0x5c, 0x97, 0x2f, 0xd8, 0x83, 0x01, 0xec, 0xa3, 0xdc,
+	0x94, 0x03, 0x31, 0xc1, 0x62, 0xac, 0x59, 0x4e, 0xe5, 0x6d, 0x72, 0xd1, 0xfe, 0x5e, 0x26, 0xb1,
+	0xb7, 0x49, 0x54, 0x5c, 0x06, 0xc2, 0x14, 0x15, 0xe8, 0x85, 0xc9, 0xae, 0xc3, 0x64, 0x1f, 0xff,
+	0x8f, 0x0f, 0x34, 0x37, 0xd2, 0x0e, 0x5f, 0xb8, 0xeb, 0xbc, 0x46, 0xdd, 0x05, 0x38, 0x3b, 0xc2,
+	0x96, 0x5f, 0x5f, 0x06, 0x98, 0x9f, 0x9d, 0xcb, 0x49, 0xb4, 0x78, 0x68, 0x2f, 0x91, 0x83, 0x95,
+	0x64, 0x41, 0xc8, 0xa6, 0x29, 0x53, 0x49, 0xa4, 0x03, 0x6c, 0x03, 0xea, 0x61, 0x09, 0xea, 0x99,
+	0x92, 0x6c, 0x64, 0xea, 0x0d, 0xe0, 0x16, 0xce, 0xad, 0xd9, 0xe1, 0xcc, 0xa8, 0x1c, 0x47, 0x1c,
+	0xcf, 0x02, 0x1c, 0x45, 0x9c, 0x08, 0xf3, 0x7d, 0xee, 0x54, 0x0e, 0xe7, 0xa5, 0xd1, 0x3c, 0xd3,
+	0x12, 0x3b, 0x9c, 0x59, 0x71, 0xd9, 0x12, 0x84, 0x64, 0x1c, 0xc7, 0x24, 0x48, 0x39, 0x3b, 0xa6,
+	0xe7, 0x3f, 0xed, 0x77, 0x2a, 0x09, 0x7b, 0x5a, 0xb3, 0x63, 0x24, 0x96, 0x20, 0x8a, 0xcb, 0x40,
+	0x78, 0x8a, 0x3a, 0x73, 0x04, 0xfd, 0xba, 0x70, 0xe1, 0x75, 0xd1, 0xba, 0x24, 0xd2, 0x0f, 0x8d,
+	0x9f, 0x50, 0x07, 0xf3, 0x70, 0x4c, 0x8f, 0x49, 0x14, 0x14, 0x2f, 0x9b, 0xbb, 0xcb, 0x5c, 0x36,
+	0x2d, 0x6b, 0xb2, 0x93, 0xbf, 0x74, 0xb6, 0x51, 0x93, 0xab, 0x44, 0xd2, 0x29, 0xd1, 0x96, 0x5d,
+	0xb0, 0xf4, 0xca, 0x0e, 0x85, 0x2e, 0x35, 0x8e, 0x0d, 0xa3, 0x04, 0xa3, 0x57, 0x68, 0xfd, 0x50,
+	0xe9, 0x4b, 0x43, 0x48, 0x4e, 0xf0, 0x54, 0xfb, 0xdd, 0x03, 0xbf, 0x47, 0x15, 0xcf, 0x22, 0xf8,
+	0xf1, 0xc9, 0x04, 0xc6, 0xf5, 0xf6, 0x61, 0x7e, 0x11, 0xbc, 0x3f, 0x45, 0xad, 0x4b, 0xde, 0x3a,
+	0xb5, 0xfb, 0x90, 0x9a, 0x53, 0x10, 0xe8, 0xcc, 0xf6, 0x51, 0x1b, 0x5e, 0x85, 0x21, 0x9b, 0x04,
+	0x56, 0x0a, 0xfd, 0xf4, 0x2a, 0x67, 0xb9, 0x63, 0x34, 0xa6, 0x2f, 0x3b, 0xcb, 0xb4, 0xb8, 0x6c,
+	0x7b, 0x2a, 0x1e, 0xf4, 0x50, 0x71, 0xc1, 0xb8, 0xeb, 0xf9, 0xb5, 0x7e, 0x73, 0xd7, 0xc9, 0x9f,
+	0xdf, 0x11, 0xec, 0x3c, 0xdf, 0x7e, 0x73, 0xea, 0xd5, 0xde, 0x9e, 0x7a, 0xb5, 0x7f, 0x4f, 0xbd,
+	0xda, 0x9f, 0x67, 0xde, 0xca, 0xdb, 0x33, 0x6f, 0xe5, 0xef, 0x33, 0x6f, 0xe5, 0xd5, 0xe3, 0x98,
+	0xca, 0xb1, 0x3a, 0x18, 0x84, 0x6c, 0x3a, 0xfc, 0xea, 0xc7, 0x1f, 0x5e, 0x7c, 0x4b, 0xe4, 0x8c,
+	0xf1, 0xa3, 0x61, 0x38, 0xc6, 0x34, 0x19, 0xfe, 0x72, 0xf1, 0xf4, 0x95, 0x27, 0x29, 0x11, 0x07,
+	0xd7, 0xa0, 0x9f, 0xcf, 0xfe, 0x1b, 0x00, 0x20, 0x62, 0x0d, 0xc5, 0x6a, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +412,185 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundCursor) > 0 {
+		i -= len(m.AutoCompoundCursor)
+		copy(dAtA[i:], m.AutoCompoundCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.ProtocolFundingList) > 0 {
+		for iNdEx := len(m.ProtocolFundingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFundingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.FundingStreamCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FundingStreamCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.FundingStreamList) > 0 {
+		for iNdEx := len(m.FundingStreamList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreamList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.RuntimeList) > 0 {
+		for iNdEx := len(m.RuntimeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuntimeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.ArchivedProposalList) > 0 {
+		for iNdEx := len(m.ArchivedProposalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedProposalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.StorageProviderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StorageProviderCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.StorageProviderList) > 0 {
+		for iNdEx := len(m.StorageProviderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageProviderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.WithdrawAddressList) > 0 {
+		for iNdEx := len(m.WithdrawAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.AutoCompoundList) > 0 {
+		for iNdEx := len(m.AutoCompoundList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.RedelegationQueueEntries) > 0 {
+		for iNdEx := len(m.RedelegationQueueEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationQueueEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.RedelegationQueueState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.DelegationSlashList) > 0 {
+		for iNdEx := len(m.DelegationSlashList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationSlashList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.CommissionChangeQueueEntry) > 0 {
 		for iNdEx := len(m.CommissionChangeQueueEntry) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -584,6 +899,72 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationSlashList) > 0 {
+		for _, e := range m.DelegationSlashList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RedelegationQueueState.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.RedelegationQueueEntries) > 0 {
+		for _, e := range m.RedelegationQueueEntries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundList) > 0 {
+		for _, e := range m.AutoCompoundList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddressList) > 0 {
+		for _, e := range m.WithdrawAddressList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageProviderList) > 0 {
+		for _, e := range m.StorageProviderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StorageProviderCount != 0 {
+		n += 2 + sovGenesis(uint64(m.StorageProviderCount))
+	}
+	if len(m.ArchivedProposalList) > 0 {
+		for _, e := range m.ArchivedProposalList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RuntimeList) > 0 {
+		for _, e := range m.RuntimeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingStreamList) > 0 {
+		for _, e := range m.FundingStreamList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FundingStreamCount != 0 {
+		n += 2 + sovGenesis(uint64(m.FundingStreamCount))
+	}
+	if len(m.ProtocolFundingList) > 0 {
+		for _, e := range m.ProtocolFundingList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.AutoCompoundCursor)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1148,6 +1529,417 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationSlashList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationSlashList = append(m.DelegationSlashList, DelegationSlash{})
+			if err := m.DelegationSlashList[len(m.DelegationSlashList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationQueueState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedelegationQueueState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationQueueEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationQueueEntries = append(m.RedelegationQueueEntries, RedelegationQueueEntry{})
+			if err := m.RedelegationQueueEntries[len(m.RedelegationQueueEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundList = append(m.AutoCompoundList, AutoCompound{})
+			if err := m.AutoCompoundList[len(m.AutoCompoundList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddressList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddressList = append(m.WithdrawAddressList, WithdrawAddress{})
+			if err := m.WithdrawAddressList[len(m.WithdrawAddressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProviderList = append(m.StorageProviderList, StorageProvider{})
+			if err := m.StorageProviderList[len(m.StorageProviderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderCount", wireType)
+			}
+			m.StorageProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedProposalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedProposalList = append(m.ArchivedProposalList, Proposal{})
+			if err := m.ArchivedProposalList[len(m.ArchivedProposalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeList = append(m.RuntimeList, Runtime{})
+			if err := m.RuntimeList[len(m.RuntimeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreamList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreamList = append(m.FundingStreamList, FundingStream{})
+			if err := m.FundingStreamList[len(m.FundingStreamList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreamCount", wireType)
+			}
+			m.FundingStreamCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingStreamCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFundingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFundingList = append(m.ProtocolFundingList, ProtocolFunding{})
+			if err := m.ProtocolFundingList[len(m.ProtocolFundingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundCursor = append(m.AutoCompoundCursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.AutoCompoundCursor == nil {
+				m.AutoCompoundCursor = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -12,6 +14,13 @@ const (
 	ProposalTypeSchedulePoolUpgrade = "SchedulePoolUpgrade"
 	ProposalTypeCancelPoolUpgrade = "CancelPoolUpgrade"
 	ProposalTypeResetPool = "ResetPool"
+	ProposalTypeCreateStorageProvider = "CreateStorageProvider"
+	ProposalTypeUpdateStorageProvider = "UpdateStorageProvider"
+	ProposalTypeRetirePool = "RetirePool"
+	ProposalTypeCreateRuntime = "CreateRuntime"
+	ProposalTypeUpdateRuntime = "UpdateRuntime"
+	ProposalTypeAddRuntimeVersion = "AddRuntimeVersion"
+	ProposalTypeSetProtocolFunding = "SetProtocolFunding"
 )
 
 func init() {
@@ -29,6 +38,20 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&CancelPoolUpgradeProposal{}, "kyve/CancelPoolUpgradeProposal")
 	govtypes.RegisterProposalType(ProposalTypeResetPool)
 	govtypes.RegisterProposalTypeCodec(&ResetPoolProposal{}, "kyve/ResetPoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateStorageProvider)
+	govtypes.RegisterProposalTypeCodec(&CreateStorageProviderProposal{}, "kyve/CreateStorageProviderProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateStorageProvider)
+	govtypes.RegisterProposalTypeCodec(&UpdateStorageProviderProposal{}, "kyve/UpdateStorageProviderProposal")
+	govtypes.RegisterProposalType(ProposalTypeRetirePool)
+	govtypes.RegisterProposalTypeCodec(&RetirePoolProposal{}, "kyve/RetirePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeCreateRuntime)
+	govtypes.RegisterProposalTypeCodec(&CreateRuntimeProposal{}, "kyve/CreateRuntimeProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateRuntime)
+	govtypes.RegisterProposalTypeCodec(&UpdateRuntimeProposal{}, "kyve/UpdateRuntimeProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddRuntimeVersion)
+	govtypes.RegisterProposalTypeCodec(&AddRuntimeVersionProposal{}, "kyve/AddRuntimeVersionProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetProtocolFunding)
+	govtypes.RegisterProposalTypeCodec(&SetProtocolFundingProposal{}, "kyve/SetProtocolFundingProposal")
 }
 
 var (
@@ -39,9 +62,16 @@ var (
 	_ govtypes.Content = &SchedulePoolUpgradeProposal{}
 	_ govtypes.Content = &CancelPoolUpgradeProposal{}
 	_ govtypes.Content = &ResetPoolProposal{}
+	_ govtypes.Content = &CreateStorageProviderProposal{}
+	_ govtypes.Content = &UpdateStorageProviderProposal{}
+	_ govtypes.Content = &RetirePoolProposal{}
+	_ govtypes.Content = &CreateRuntimeProposal{}
+	_ govtypes.Content = &UpdateRuntimeProposal{}
+	_ govtypes.Content = &AddRuntimeVersionProposal{}
+	_ govtypes.Content = &SetProtocolFundingProposal{}
 )
 
-func NewCreatePoolProposal(title string, description string, name string, runtime string, logo string, config string, uploadInterval uint64, operatingCost uint64, maxBundleSize uint64, version string, binaries string, startKey string, minStake uint64) govtypes.Content {
+func NewCreatePoolProposal(title string, description string, name string, runtime string, logo string, config string, uploadInterval uint64, operatingCost uint64, maxBundleSize uint64, version string, binaries string, startKey string, minStake uint64, pipelineDepth uint64, storageProviderId uint64, allowedCompressions []string, chargeUncompressedSize bool, endKey string, endHeight uint64) govtypes.Content {
 	return &CreatePoolProposal{
 		Title:         title,
 		Description:   description,
@@ -56,6 +86,12 @@ func NewCreatePoolProposal(title string, description string, name string, runtim
 		Binaries: binaries,
 		StartKey: startKey,
 		MinStake: minStake,
+		PipelineDepth: pipelineDepth,
+		StorageProviderId: storageProviderId,
+		AllowedCompressions: allowedCompressions,
+		ChargeUncompressedSize: chargeUncompressedSize,
+		EndKey: endKey,
+		EndHeight: endHeight,
 	}
 }
 
@@ -71,10 +107,14 @@ func (p *CreatePoolProposal) ValidateBasic() error {
 		return err
 	}
 
-	return nil
+	if err := validatePipelineDepth(p.PipelineDepth); err != nil {
+		return err
+	}
+
+	return validateAllowedCompressions(p.AllowedCompressions)
 }
 
-func NewUpdatePoolProposal(title string, description string, id uint64, name string, runtime string, logo string, config string, uploadInterval uint64, operatingCost uint64, maxBundleSize uint64, minStake uint64) govtypes.Content {
+func NewUpdatePoolProposal(title string, description string, id uint64, name string, runtime string, logo string, config string, uploadInterval uint64, operatingCost uint64, maxBundleSize uint64, minStake uint64, pipelineDepth uint64, storageProviderId uint64, allowedCompressions []string, chargeUncompressedSize bool, endKey string, endHeight uint64) govtypes.Content {
 	return &UpdatePoolProposal{
 		Title:         title,
 		Description:   description,
@@ -87,6 +127,12 @@ func NewUpdatePoolProposal(title string, description string, id uint64, name str
 		OperatingCost: operatingCost,
 		MaxBundleSize: maxBundleSize,
 		MinStake: minStake,
+		PipelineDepth: pipelineDepth,
+		StorageProviderId: storageProviderId,
+		AllowedCompressions: allowedCompressions,
+		ChargeUncompressedSize: chargeUncompressedSize,
+		EndKey: endKey,
+		EndHeight: endHeight,
 	}
 }
 
@@ -102,7 +148,11 @@ func (p *UpdatePoolProposal) ValidateBasic() error {
 		return err
 	}
 
-	return nil
+	if err := validatePipelineDepth(p.PipelineDepth); err != nil {
+		return err
+	}
+
+	return validateAllowedCompressions(p.AllowedCompressions)
 }
 
 func NewPausePoolProposal(title string, description string, id uint64) govtypes.Content {
@@ -151,7 +201,7 @@ func (p *UnpausePoolProposal) ValidateBasic() error {
 	return nil
 }
 
-func NewSchedulePoolUpgradeProposal(title string, description string, runtime string, version string, scheduled_at uint64, duration uint64, binaries string) govtypes.Content {
+func NewSchedulePoolUpgradeProposal(title string, description string, runtime string, version string, scheduled_at uint64, duration uint64, binaries string, canaryPoolIds []uint64, canaryDelay uint64, rollbackWindow uint64) govtypes.Content {
 	return &SchedulePoolUpgradeProposal{
 		Title:       title,
 		Description: description,
@@ -160,6 +210,9 @@ func NewSchedulePoolUpgradeProposal(title string, description string, runtime st
 		ScheduledAt: scheduled_at,
 		Duration: duration,
 		Binaries: binaries,
+		CanaryPoolIds: canaryPoolIds,
+		CanaryDelay: canaryDelay,
+		RollbackWindow: rollbackWindow,
 	}
 }
 
@@ -175,6 +228,19 @@ func (p *SchedulePoolUpgradeProposal) ValidateBasic() error {
 		return err
 	}
 
+	canaryPoolIds := make(map[uint64]bool)
+	for _, poolId := range p.CanaryPoolIds {
+		if canaryPoolIds[poolId] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated canary pool %v", poolId)
+		}
+		canaryPoolIds[poolId] = true
+	}
+
+	// A failing canary has to be rolled back before the remaining pools start to upgrade.
+	if len(p.CanaryPoolIds) > 0 && p.RollbackWindow > 0 && p.CanaryDelay <= p.Duration+p.RollbackWindow {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "canary delay %v has to exceed the upgrade duration and rollback window of %v", p.CanaryDelay, p.Duration+p.RollbackWindow)
+	}
+
 	return nil
 }
 
@@ -224,3 +290,233 @@ func (p *ResetPoolProposal) ValidateBasic() error {
 
 	return nil
 }
+
+func NewCreateStorageProviderProposal(title string, description string, name string, storageCost sdk.Int, storageIdFormat string) govtypes.Content {
+	return &CreateStorageProviderProposal{
+		Title:           title,
+		Description:     description,
+		Name:            name,
+		StorageCost:     storageCost,
+		StorageIdFormat: storageIdFormat,
+	}
+}
+
+func (p *CreateStorageProviderProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CreateStorageProviderProposal) ProposalType() string {
+	return ProposalTypeCreateStorageProvider
+}
+
+func (p *CreateStorageProviderProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateStorageProvider(p.Name, p.StorageCost, p.StorageIdFormat)
+}
+
+func NewUpdateStorageProviderProposal(title string, description string, id uint64, name string, storageCost sdk.Int, storageIdFormat string) govtypes.Content {
+	return &UpdateStorageProviderProposal{
+		Title:           title,
+		Description:     description,
+		Id:              id,
+		Name:            name,
+		StorageCost:     storageCost,
+		StorageIdFormat: storageIdFormat,
+	}
+}
+
+func (p *UpdateStorageProviderProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateStorageProviderProposal) ProposalType() string {
+	return ProposalTypeUpdateStorageProvider
+}
+
+func (p *UpdateStorageProviderProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateStorageProvider(p.Name, p.StorageCost, p.StorageIdFormat)
+}
+
+func NewRetirePoolProposal(title string, description string, id uint64) govtypes.Content {
+	return &RetirePoolProposal{
+		Title:       title,
+		Description: description,
+		Id:          id,
+	}
+}
+
+func (p *RetirePoolProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RetirePoolProposal) ProposalType() string {
+	return ProposalTypeRetirePool
+}
+
+func (p *RetirePoolProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewCreateRuntimeProposal(title string, description string, name string, configSchema string) govtypes.Content {
+	return &CreateRuntimeProposal{
+		Title:        title,
+		Description:  description,
+		Name:         name,
+		ConfigSchema: configSchema,
+	}
+}
+
+func (p *CreateRuntimeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CreateRuntimeProposal) ProposalType() string {
+	return ProposalTypeCreateRuntime
+}
+
+func (p *CreateRuntimeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateRuntime(p.Name, p.ConfigSchema)
+}
+
+func NewUpdateRuntimeProposal(title string, description string, name string, configSchema string) govtypes.Content {
+	return &UpdateRuntimeProposal{
+		Title:        title,
+		Description:  description,
+		Name:         name,
+		ConfigSchema: configSchema,
+	}
+}
+
+func (p *UpdateRuntimeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateRuntimeProposal) ProposalType() string {
+	return ProposalTypeUpdateRuntime
+}
+
+func (p *UpdateRuntimeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return validateRuntime(p.Name, p.ConfigSchema)
+}
+
+func NewAddRuntimeVersionProposal(title string, description string, runtime string, version string, binaries []RuntimeBinary) govtypes.Content {
+	return &AddRuntimeVersionProposal{
+		Title:       title,
+		Description: description,
+		Runtime:     runtime,
+		Version:     version,
+		Binaries:    binaries,
+	}
+}
+
+func (p *AddRuntimeVersionProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddRuntimeVersionProposal) ProposalType() string {
+	return ProposalTypeAddRuntimeVersion
+}
+
+func (p *AddRuntimeVersionProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Runtime == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "runtime name can not be empty")
+	}
+
+	if err := ValidateRuntimeVersion(p.Version, p.Binaries); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func NewSetProtocolFundingProposal(title string, description string, id uint64, inflationShare string, communityPoolAmount uint64) govtypes.Content {
+	return &SetProtocolFundingProposal{
+		Title:               title,
+		Description:         description,
+		Id:                  id,
+		InflationShare:      inflationShare,
+		CommunityPoolAmount: communityPoolAmount,
+	}
+}
+
+func (p *SetProtocolFundingProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetProtocolFundingProposal) ProposalType() string {
+	return ProposalTypeSetProtocolFunding
+}
+
+func (p *SetProtocolFundingProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ParseInflationShare(p.InflationShare); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func validateRuntime(name string, configSchema string) error {
+	if name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "runtime name can not be empty")
+	}
+
+	if err := ValidateConfigSchema(configSchema); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+func validateStorageProvider(name string, storageCost sdk.Int, storageIdFormat string) error {
+	if name == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "storage provider name can not be empty")
+	}
+
+	if storageCost.IsNil() || storageCost.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid storage cost (%v)", storageCost)
+	}
+
+	if _, err := CompileStorageIdFormat(storageIdFormat); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid storage id format: %v", err)
+	}
+
+	return nil
+}
+
+func validateAllowedCompressions(allowedCompressions []string) error {
+	for _, compression := range allowedCompressions {
+		if compression == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "allowed compression can not be empty")
+		}
+	}
+
+	return nil
+}
+
+func validatePipelineDepth(pipelineDepth uint64) error {
+	if pipelineDepth > MaxPipelineDepth {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pipeline depth %v exceeds the maximum of %v", pipelineDepth, MaxPipelineDepth)
+	}
+
+	return nil
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -52,6 +54,18 @@ type CreatePoolProposal struct {
 	StartKey string `protobuf:"bytes,13,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
 	// min_stake ...
 	MinStake uint64 `protobuf:"varint,14,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	// pipeline_depth ...
+	PipelineDepth uint64 `protobuf:"varint,15,opt,name=pipeline_depth,json=pipelineDepth,proto3" json:"pipeline_depth,omitempty"`
+	// storage_provider_id ...
+	StorageProviderId uint64 `protobuf:"varint,16,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// allowed_compressions ...
+	AllowedCompressions []string `protobuf:"bytes,17,rep,name=allowed_compressions,json=allowedCompressions,proto3" json:"allowed_compressions,omitempty"`
+	// charge_uncompressed_size ...
+	ChargeUncompressedSize bool `protobuf:"varint,18,opt,name=charge_uncompressed_size,json=chargeUncompressedSize,proto3" json:"charge_uncompressed_size,omitempty"`
+	// end_key ...
+	EndKey string `protobuf:"bytes,19,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// end_height ...
+	EndHeight uint64 `protobuf:"varint,20,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
 func (m *CreatePoolProposal) Reset()         { *m = CreatePoolProposal{} }
@@ -186,6 +200,48 @@ func (m *CreatePoolProposal) GetMinStake() uint64 {
 	return 0
 }
 
+func (m *CreatePoolProposal) GetPipelineDepth() uint64 {
+	if m != nil {
+		return m.PipelineDepth
+	}
+	return 0
+}
+
+func (m *CreatePoolProposal) GetStorageProviderId() uint64 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *CreatePoolProposal) GetAllowedCompressions() []string {
+	if m != nil {
+		return m.AllowedCompressions
+	}
+	return nil
+}
+
+func (m *CreatePoolProposal) GetChargeUncompressedSize() bool {
+	if m != nil {
+		return m.ChargeUncompressedSize
+	}
+	return false
+}
+
+func (m *CreatePoolProposal) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+func (m *CreatePoolProposal) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 // UpdatePoolProposal is a gov Content type for updating a pool.
 type UpdatePoolProposal struct {
 	// title ...
@@ -212,6 +268,18 @@ type UpdatePoolProposal struct {
 	MaxBundleSize uint64 `protobuf:"varint,11,opt,name=max_bundle_size,json=maxBundleSize,proto3" json:"max_bundle_size,omitempty"`
 	// min_stake ...
 	MinStake uint64 `protobuf:"varint,12,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	// pipeline_depth ...
+	PipelineDepth uint64 `protobuf:"varint,13,opt,name=pipeline_depth,json=pipelineDepth,proto3" json:"pipeline_depth,omitempty"`
+	// storage_provider_id ...
+	StorageProviderId uint64 `protobuf:"varint,14,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// allowed_compressions ...
+	AllowedCompressions []string `protobuf:"bytes,15,rep,name=allowed_compressions,json=allowedCompressions,proto3" json:"allowed_compressions,omitempty"`
+	// charge_uncompressed_size ...
+	ChargeUncompressedSize bool `protobuf:"varint,16,opt,name=charge_uncompressed_size,json=chargeUncompressedSize,proto3" json:"charge_uncompressed_size,omitempty"`
+	// end_key ...
+	EndKey string `protobuf:"bytes,17,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// end_height ...
+	EndHeight uint64 `protobuf:"varint,18,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
 func (m *UpdatePoolProposal) Reset()         { *m = UpdatePoolProposal{} }
@@ -332,6 +400,48 @@ func (m *UpdatePoolProposal) GetMinStake() uint64 {
 	return 0
 }
 
+func (m *UpdatePoolProposal) GetPipelineDepth() uint64 {
+	if m != nil {
+		return m.PipelineDepth
+	}
+	return 0
+}
+
+func (m *UpdatePoolProposal) GetStorageProviderId() uint64 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *UpdatePoolProposal) GetAllowedCompressions() []string {
+	if m != nil {
+		return m.AllowedCompressions
+	}
+	return nil
+}
+
+func (m *UpdatePoolProposal) GetChargeUncompressedSize() bool {
+	if m != nil {
+		return m.ChargeUncompressedSize
+	}
+	return false
+}
+
+func (m *UpdatePoolProposal) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+func (m *UpdatePoolProposal) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 // PausePoolProposal is a gov Content type for pausing a pool.
 type PausePoolProposal struct {
 	// title ...
@@ -476,6 +586,14 @@ type SchedulePoolUpgradeProposal struct {
 	Duration uint64 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
 	// binaries ...
 	Binaries string `protobuf:"bytes,7,opt,name=binaries,proto3" json:"binaries,omitempty"`
+	// canary_pool_ids are the pools which upgrade first, all other pools of the runtime upgrade after the canary delay.
+	CanaryPoolIds []uint64 `protobuf:"varint,8,rep,packed,name=canary_pool_ids,json=canaryPoolIds,proto3" json:"canary_pool_ids,omitempty"`
+	// canary_delay is the time in seconds the remaining pools upgrade after the canary pools.
+	// It has to exceed the duration plus the rollback_window, so that a failing canary is rolled back first.
+	CanaryDelay uint64 `protobuf:"varint,9,opt,name=canary_delay,json=canaryDelay,proto3" json:"canary_delay,omitempty"`
+	// rollback_window is the time in seconds after the upgrade in which a pool has to finalize a bundle,
+	// otherwise the pool is rolled back to its previous version. Zero disables the rollback.
+	RollbackWindow uint64 `protobuf:"varint,10,opt,name=rollback_window,json=rollbackWindow,proto3" json:"rollback_window,omitempty"`
 }
 
 func (m *SchedulePoolUpgradeProposal) Reset()         { *m = SchedulePoolUpgradeProposal{} }
@@ -560,6 +678,27 @@ func (m *SchedulePoolUpgradeProposal) GetBinaries() string {
 	return ""
 }
 
+func (m *SchedulePoolUpgradeProposal) GetCanaryPoolIds() []uint64 {
+	if m != nil {
+		return m.CanaryPoolIds
+	}
+	return nil
+}
+
+func (m *SchedulePoolUpgradeProposal) GetCanaryDelay() uint64 {
+	if m != nil {
+		return m.CanaryDelay
+	}
+	return 0
+}
+
+func (m *SchedulePoolUpgradeProposal) GetRollbackWindow() uint64 {
+	if m != nil {
+		return m.RollbackWindow
+	}
+	return 0
+}
+
 // CancelPoolUpgradeProposal is a gov Content type for cancelling a scheduled pool upgrade by the runtime.
 type CancelPoolUpgradeProposal struct {
 	// title ...
@@ -697,353 +836,634 @@ func (m *ResetPoolProposal) GetBundleId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*CreatePoolProposal)(nil), "kyve.registry.v1beta1.CreatePoolProposal")
-	proto.RegisterType((*UpdatePoolProposal)(nil), "kyve.registry.v1beta1.UpdatePoolProposal")
-	proto.RegisterType((*PausePoolProposal)(nil), "kyve.registry.v1beta1.PausePoolProposal")
-	proto.RegisterType((*UnpausePoolProposal)(nil), "kyve.registry.v1beta1.UnpausePoolProposal")
-	proto.RegisterType((*SchedulePoolUpgradeProposal)(nil), "kyve.registry.v1beta1.SchedulePoolUpgradeProposal")
-	proto.RegisterType((*CancelPoolUpgradeProposal)(nil), "kyve.registry.v1beta1.CancelPoolUpgradeProposal")
-	proto.RegisterType((*ResetPoolProposal)(nil), "kyve.registry.v1beta1.ResetPoolProposal")
+// CreateStorageProviderProposal is a gov Content type for registering a new storage provider.
+type CreateStorageProviderProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// storage_cost ...
+	StorageCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=storage_cost,json=storageCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"storage_cost"`
+	// storage_id_format ...
+	StorageIdFormat string `protobuf:"bytes,5,opt,name=storage_id_format,json=storageIdFormat,proto3" json:"storage_id_format,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/registry/v1beta1/gov.proto", fileDescriptor_fd0b5a4cb85a3285) }
-
-var fileDescriptor_fd0b5a4cb85a3285 = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x97, 0x2e, 0xeb, 0xda, 0xd3, 0xae, 0xd3, 0xcc, 0x1f, 0x19, 0x26, 0x85, 0x51, 0x69,
-	0xb0, 0x1b, 0x5a, 0x4d, 0x3c, 0x01, 0x9b, 0x10, 0x4c, 0x93, 0xd0, 0x94, 0x69, 0x48, 0x80, 0x50,
-	0xe4, 0x26, 0x87, 0xd4, 0x6a, 0x62, 0x47, 0xb6, 0x53, 0xda, 0x3d, 0x05, 0x2f, 0xc3, 0x3b, 0x70,
-	0xb9, 0x4b, 0x2e, 0xd1, 0xc6, 0x1d, 0x2f, 0x81, 0xe2, 0x64, 0xa5, 0x45, 0xbb, 0x83, 0xc2, 0x9d,
-	0xcf, 0x77, 0x3e, 0xd9, 0xce, 0xf9, 0x9d, 0x13, 0xc3, 0x83, 0xd1, 0x74, 0x8c, 0x7d, 0x85, 0x31,
-	0xd7, 0x46, 0x4d, 0xfb, 0xe3, 0xfd, 0x01, 0x1a, 0xb6, 0xdf, 0x8f, 0xe5, 0xb8, 0x97, 0x29, 0x69,
-	0x24, 0xb9, 0x53, 0x18, 0x7a, 0xd7, 0x86, 0x5e, 0x65, 0xe8, 0x7e, 0x5e, 0x05, 0x72, 0xa8, 0x90,
-	0x19, 0x3c, 0x91, 0x32, 0x39, 0x51, 0x32, 0x93, 0x9a, 0x25, 0xe4, 0x36, 0xac, 0x19, 0x6e, 0x12,
-	0xa4, 0xce, 0x8e, 0xb3, 0xd7, 0xf4, 0xcb, 0x80, 0xec, 0x40, 0x2b, 0x42, 0x1d, 0x2a, 0x9e, 0x19,
-	0x2e, 0x05, 0xad, 0xd9, 0xdc, 0xbc, 0x44, 0x08, 0xb8, 0x82, 0xa5, 0x48, 0x57, 0x6d, 0xca, 0xae,
-	0x09, 0x85, 0x75, 0x95, 0x0b, 0xc3, 0x53, 0xa4, 0xae, 0x95, 0xaf, 0xc3, 0xc2, 0x9d, 0xc8, 0x58,
-	0xd2, 0xb5, 0xd2, 0x5d, 0xac, 0x0b, 0xf7, 0x18, 0x95, 0x2e, 0xf6, 0xaf, 0x97, 0xee, 0x2a, 0x24,
-	0x77, 0xa1, 0x1e, 0x4a, 0xf1, 0x81, 0xc7, 0x74, 0xdd, 0x26, 0xaa, 0x88, 0xec, 0x42, 0x5b, 0x1b,
-	0xa6, 0x4c, 0x30, 0x44, 0x1e, 0x0f, 0x0d, 0x6d, 0xec, 0x38, 0x7b, 0xee, 0x41, 0x8d, 0x3a, 0x7e,
-	0xcb, 0xea, 0x2f, 0xad, 0x4c, 0x1e, 0xc3, 0x66, 0x9e, 0x25, 0x92, 0x45, 0x01, 0x17, 0x06, 0xd5,
-	0x98, 0x25, 0xb4, 0x59, 0x38, 0xfd, 0x4e, 0x29, 0x1f, 0x55, 0x2a, 0xd9, 0x85, 0x8e, 0xcc, 0x50,
-	0x31, 0xc3, 0x45, 0x1c, 0x84, 0x52, 0x1b, 0x0a, 0xd6, 0xb7, 0x31, 0x53, 0x0f, 0xa5, 0x36, 0xe4,
-	0x11, 0x6c, 0xa6, 0x6c, 0x12, 0x0c, 0x72, 0x11, 0x25, 0x18, 0x68, 0x7e, 0x8e, 0xb4, 0x55, 0xfa,
-	0x52, 0x36, 0x39, 0xb0, 0xea, 0x29, 0x3f, 0x47, 0x72, 0x1f, 0x1a, 0x03, 0x2e, 0x98, 0xe2, 0xa8,
-	0x69, 0xdb, 0x5e, 0x7c, 0x16, 0x93, 0x6d, 0x68, 0x96, 0x57, 0x1f, 0xe1, 0x94, 0x6e, 0x94, 0x49,
-	0x2b, 0x1c, 0xe3, 0xb4, 0x48, 0xa6, 0x5c, 0x04, 0xda, 0xb0, 0x11, 0xd2, 0x8e, 0xdd, 0xba, 0x91,
-	0x72, 0x71, 0x5a, 0xc4, 0xdd, 0x1f, 0x35, 0x20, 0x67, 0x59, 0xf4, 0xb7, 0xb8, 0x75, 0xa0, 0xc6,
-	0x23, 0x4b, 0xcd, 0xf5, 0x6b, 0x3c, 0x9a, 0x71, 0x74, 0x6f, 0xe6, 0xb8, 0x76, 0x33, 0xc7, 0xfa,
-	0x1c, 0x47, 0x0f, 0x1a, 0x15, 0x38, 0x5d, 0xf2, 0xb2, 0x44, 0x66, 0xda, 0x1c, 0xcd, 0xc6, 0x02,
-	0xcd, 0xff, 0x85, 0x69, 0xa1, 0xda, 0xed, 0xdf, 0xaa, 0xfd, 0x0e, 0xb6, 0x4e, 0x58, 0xae, 0x97,
-	0x52, 0xeb, 0xee, 0x7b, 0xb8, 0x75, 0x26, 0xb2, 0xa5, 0x6d, 0xff, 0xdd, 0x81, 0xed, 0xd3, 0x70,
-	0x88, 0x51, 0x9e, 0xd8, 0x03, 0xce, 0xb2, 0x58, 0xb1, 0x08, 0xff, 0xf8, 0x9c, 0xb9, 0x76, 0x58,
-	0x5d, 0x6c, 0x87, 0xb9, 0x11, 0x76, 0x17, 0x47, 0xf8, 0x21, 0xb4, 0x75, 0x75, 0x95, 0x28, 0x60,
-	0xc6, 0xf6, 0x91, 0xeb, 0xb7, 0x66, 0xda, 0x33, 0x53, 0x8c, 0x4b, 0x94, 0x17, 0xfc, 0xaa, 0x1f,
-	0x80, 0xeb, 0xcf, 0xe2, 0x85, 0x51, 0x5a, 0x5f, 0x1c, 0xa5, 0x6e, 0x0a, 0xf7, 0x0e, 0x99, 0x08,
-	0x31, 0xf9, 0x27, 0xdf, 0xd8, 0x9d, 0xc0, 0x96, 0x8f, 0x1a, 0xcd, 0x52, 0xa6, 0x6f, 0x1b, 0x9a,
-	0x55, 0xbf, 0xf2, 0xc8, 0x96, 0xd0, 0xf5, 0x1b, 0xa5, 0x70, 0x14, 0x1d, 0xbc, 0xf8, 0x72, 0xe9,
-	0x39, 0x17, 0x97, 0x9e, 0xf3, 0xed, 0xd2, 0x73, 0x3e, 0x5d, 0x79, 0x2b, 0x17, 0x57, 0xde, 0xca,
-	0xd7, 0x2b, 0x6f, 0xe5, 0xed, 0x93, 0x98, 0x9b, 0x61, 0x3e, 0xe8, 0x85, 0x32, 0xed, 0x1f, 0xbf,
-	0x79, 0xfd, 0xfc, 0x15, 0x9a, 0x8f, 0x52, 0x8d, 0xfa, 0xe1, 0x90, 0x71, 0xd1, 0x9f, 0xfc, 0x7a,
-	0x1d, 0xcc, 0x34, 0x43, 0x3d, 0xa8, 0xdb, 0x87, 0xe1, 0xe9, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x00, 0xc8, 0xa0, 0x0c, 0x3b, 0x06, 0x00, 0x00,
+func (m *CreateStorageProviderProposal) Reset()         { *m = CreateStorageProviderProposal{} }
+func (m *CreateStorageProviderProposal) String() string { return proto.CompactTextString(m) }
+func (*CreateStorageProviderProposal) ProtoMessage()    {}
+func (*CreateStorageProviderProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{7}
+}
+func (m *CreateStorageProviderProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateStorageProviderProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateStorageProviderProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateStorageProviderProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStorageProviderProposal.Merge(m, src)
+}
+func (m *CreateStorageProviderProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateStorageProviderProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStorageProviderProposal.DiscardUnknown(m)
 }
 
-func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateStorageProviderProposal proto.InternalMessageInfo
+
+func (m *CreateStorageProviderProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CreateStorageProviderProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinStake != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x70
+func (m *CreateStorageProviderProposal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.StartKey) > 0 {
-		i -= len(m.StartKey)
-		copy(dAtA[i:], m.StartKey)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.StartKey)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *CreateStorageProviderProposal) GetStorageIdFormat() string {
+	if m != nil {
+		return m.StorageIdFormat
 	}
-	if len(m.Binaries) > 0 {
-		i -= len(m.Binaries)
-		copy(dAtA[i:], m.Binaries)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Binaries)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+// UpdateStorageProviderProposal is a gov Content type for updating a storage provider.
+type UpdateStorageProviderProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// storage_cost ...
+	StorageCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=storage_cost,json=storageCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"storage_cost"`
+	// storage_id_format ...
+	StorageIdFormat string `protobuf:"bytes,6,opt,name=storage_id_format,json=storageIdFormat,proto3" json:"storage_id_format,omitempty"`
+}
+
+func (m *UpdateStorageProviderProposal) Reset()         { *m = UpdateStorageProviderProposal{} }
+func (m *UpdateStorageProviderProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateStorageProviderProposal) ProtoMessage()    {}
+func (*UpdateStorageProviderProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{8}
+}
+func (m *UpdateStorageProviderProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStorageProviderProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStorageProviderProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *UpdateStorageProviderProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStorageProviderProposal.Merge(m, src)
+}
+func (m *UpdateStorageProviderProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStorageProviderProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStorageProviderProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStorageProviderProposal proto.InternalMessageInfo
+
+func (m *UpdateStorageProviderProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.OperatingCost != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.OperatingCost))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *UpdateStorageProviderProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if m.UploadInterval != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *UpdateStorageProviderProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *UpdateStorageProviderProposal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *UpdateStorageProviderProposal) GetStorageIdFormat() string {
+	if m != nil {
+		return m.StorageIdFormat
 	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+// RetirePoolProposal is a gov Content type for retiring a pool. All funders are refunded
+// and all stakers and delegators start unbonding.
+type RetirePoolProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *RetirePoolProposal) Reset()         { *m = RetirePoolProposal{} }
+func (m *RetirePoolProposal) String() string { return proto.CompactTextString(m) }
+func (*RetirePoolProposal) ProtoMessage()    {}
+func (*RetirePoolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{9}
+}
+func (m *RetirePoolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetirePoolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetirePoolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *RetirePoolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetirePoolProposal.Merge(m, src)
+}
+func (m *RetirePoolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetirePoolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetirePoolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetirePoolProposal proto.InternalMessageInfo
+
+func (m *RetirePoolProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RetirePoolProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UpdatePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RetirePoolProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UpdatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CreateRuntimeProposal is a gov Content type for registering a new runtime.
+type CreateRuntimeProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// config_schema ...
+	ConfigSchema string `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
 }
 
-func (m *UpdatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinStake != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.MaxBundleSize != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.MaxBundleSize))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.OperatingCost != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.OperatingCost))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.UploadInterval != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.UploadInterval))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Config) > 0 {
-		i -= len(m.Config)
-		copy(dAtA[i:], m.Config)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Config)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Versions) > 0 {
-		i -= len(m.Versions)
-		copy(dAtA[i:], m.Versions)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Versions)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
+func (m *CreateRuntimeProposal) Reset()         { *m = CreateRuntimeProposal{} }
+func (m *CreateRuntimeProposal) String() string { return proto.CompactTextString(m) }
+func (*CreateRuntimeProposal) ProtoMessage()    {}
+func (*CreateRuntimeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{10}
+}
+func (m *CreateRuntimeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRuntimeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRuntimeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *CreateRuntimeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuntimeProposal.Merge(m, src)
+}
+func (m *CreateRuntimeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRuntimeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuntimeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuntimeProposal proto.InternalMessageInfo
+
+func (m *CreateRuntimeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *CreateRuntimeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *CreateRuntimeProposal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PausePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CreateRuntimeProposal) GetConfigSchema() string {
+	if m != nil {
+		return m.ConfigSchema
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateRuntimeProposal is a gov Content type for updating the config schema of a runtime.
+type UpdateRuntimeProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// config_schema ...
+	ConfigSchema string `protobuf:"bytes,4,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
 }
 
-func (m *PausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+func (m *UpdateRuntimeProposal) Reset()         { *m = UpdateRuntimeProposal{} }
+func (m *UpdateRuntimeProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateRuntimeProposal) ProtoMessage()    {}
+func (*UpdateRuntimeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{11}
+}
+func (m *UpdateRuntimeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRuntimeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRuntimeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *UpdateRuntimeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRuntimeProposal.Merge(m, src)
+}
+func (m *UpdateRuntimeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRuntimeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRuntimeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRuntimeProposal proto.InternalMessageInfo
+
+func (m *UpdateRuntimeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UpdateRuntimeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UnpausePoolProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateRuntimeProposal) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UnpausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateRuntimeProposal) GetConfigSchema() string {
+	if m != nil {
+		return m.ConfigSchema
+	}
+	return ""
 }
 
-func (m *UnpausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
+// AddRuntimeVersionProposal is a gov Content type for registering a new version of a runtime.
+type AddRuntimeVersionProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// runtime ...
+	Runtime string `protobuf:"bytes,3,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// version ...
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// binaries ...
+	Binaries []RuntimeBinary `protobuf:"bytes,5,rep,name=binaries,proto3" json:"binaries"`
+}
+
+func (m *AddRuntimeVersionProposal) Reset()         { *m = AddRuntimeVersionProposal{} }
+func (m *AddRuntimeVersionProposal) String() string { return proto.CompactTextString(m) }
+func (*AddRuntimeVersionProposal) ProtoMessage()    {}
+func (*AddRuntimeVersionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{12}
+}
+func (m *AddRuntimeVersionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRuntimeVersionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRuntimeVersionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *AddRuntimeVersionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRuntimeVersionProposal.Merge(m, src)
+}
+func (m *AddRuntimeVersionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRuntimeVersionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRuntimeVersionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRuntimeVersionProposal proto.InternalMessageInfo
+
+func (m *AddRuntimeVersionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *AddRuntimeVersionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SchedulePoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
+func (m *AddRuntimeVersionProposal) GetRuntime() string {
+	if m != nil {
+		return m.Runtime
+	}
+	return ""
+}
+
+func (m *AddRuntimeVersionProposal) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *AddRuntimeVersionProposal) GetBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.Binaries
+	}
+	return nil
+}
+
+// SetProtocolFundingProposal is a gov Content type for assigning a continuous allocation
+// from inflation or the community pool to a public-good pool. Zero for both removes the allocation.
+type SetProtocolFundingProposal struct {
+	// title ...
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description ...
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	// inflation_share is the share of the tokens minted in every block the pool receives.
+	InflationShare string `protobuf:"bytes,4,opt,name=inflation_share,json=inflationShare,proto3" json:"inflation_share,omitempty"`
+	// community_pool_amount is the amount drawn from the community pool in every block.
+	CommunityPoolAmount uint64 `protobuf:"varint,5,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount,omitempty"`
+}
+
+func (m *SetProtocolFundingProposal) Reset()         { *m = SetProtocolFundingProposal{} }
+func (m *SetProtocolFundingProposal) String() string { return proto.CompactTextString(m) }
+func (*SetProtocolFundingProposal) ProtoMessage()    {}
+func (*SetProtocolFundingProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd0b5a4cb85a3285, []int{13}
+}
+func (m *SetProtocolFundingProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProtocolFundingProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProtocolFundingProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProtocolFundingProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProtocolFundingProposal.Merge(m, src)
+}
+func (m *SetProtocolFundingProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProtocolFundingProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProtocolFundingProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProtocolFundingProposal proto.InternalMessageInfo
+
+func (m *SetProtocolFundingProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SetProtocolFundingProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *SetProtocolFundingProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SetProtocolFundingProposal) GetInflationShare() string {
+	if m != nil {
+		return m.InflationShare
+	}
+	return ""
+}
+
+func (m *SetProtocolFundingProposal) GetCommunityPoolAmount() uint64 {
+	if m != nil {
+		return m.CommunityPoolAmount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CreatePoolProposal)(nil), "kyve.registry.v1beta1.CreatePoolProposal")
+	proto.RegisterType((*UpdatePoolProposal)(nil), "kyve.registry.v1beta1.UpdatePoolProposal")
+	proto.RegisterType((*PausePoolProposal)(nil), "kyve.registry.v1beta1.PausePoolProposal")
+	proto.RegisterType((*UnpausePoolProposal)(nil), "kyve.registry.v1beta1.UnpausePoolProposal")
+	proto.RegisterType((*SchedulePoolUpgradeProposal)(nil), "kyve.registry.v1beta1.SchedulePoolUpgradeProposal")
+	proto.RegisterType((*CancelPoolUpgradeProposal)(nil), "kyve.registry.v1beta1.CancelPoolUpgradeProposal")
+	proto.RegisterType((*ResetPoolProposal)(nil), "kyve.registry.v1beta1.ResetPoolProposal")
+	proto.RegisterType((*CreateStorageProviderProposal)(nil), "kyve.registry.v1beta1.CreateStorageProviderProposal")
+	proto.RegisterType((*UpdateStorageProviderProposal)(nil), "kyve.registry.v1beta1.UpdateStorageProviderProposal")
+	proto.RegisterType((*RetirePoolProposal)(nil), "kyve.registry.v1beta1.RetirePoolProposal")
+	proto.RegisterType((*CreateRuntimeProposal)(nil), "kyve.registry.v1beta1.CreateRuntimeProposal")
+	proto.RegisterType((*UpdateRuntimeProposal)(nil), "kyve.registry.v1beta1.UpdateRuntimeProposal")
+	proto.RegisterType((*AddRuntimeVersionProposal)(nil), "kyve.registry.v1beta1.AddRuntimeVersionProposal")
+	proto.RegisterType((*SetProtocolFundingProposal)(nil), "kyve.registry.v1beta1.SetProtocolFundingProposal")
+}
+
+func init() { proto.RegisterFile("kyve/registry/v1beta1/gov.proto", fileDescriptor_fd0b5a4cb85a3285) }
+
+var fileDescriptor_fd0b5a4cb85a3285 = []byte{
+	// 1096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
+	0x1c, 0x65, 0xc1, 0x06, 0x7b, 0x0c, 0x76, 0x58, 0x20, 0xdd, 0x80, 0x30, 0xae, 0x9b, 0x10, 0x54,
+	0x29, 0xb6, 0x48, 0x2f, 0xbd, 0x62, 0x52, 0x5a, 0x2b, 0x52, 0x45, 0xd7, 0x22, 0x55, 0xff, 0x69,
+	0x35, 0xde, 0x19, 0xd6, 0x23, 0xef, 0xce, 0xac, 0x66, 0x66, 0x0d, 0xce, 0x27, 0xe8, 0xb1, 0x5f,
+	0xa5, 0x1f, 0xa1, 0xb7, 0xdc, 0x9a, 0x63, 0xd4, 0x43, 0x54, 0xc1, 0xa1, 0x52, 0x3f, 0x42, 0x4f,
+	0xd5, 0xfc, 0xb1, 0x63, 0x23, 0xd4, 0x26, 0x2d, 0x0e, 0x27, 0x76, 0xde, 0x7b, 0xcc, 0xfe, 0x66,
+	0xe6, 0xfd, 0xde, 0x78, 0xc1, 0x4e, 0x7f, 0x38, 0xc0, 0x4d, 0x8e, 0x23, 0x22, 0x24, 0x1f, 0x36,
+	0x07, 0xfb, 0x5d, 0x2c, 0xe1, 0x7e, 0x33, 0x62, 0x83, 0x46, 0xca, 0x99, 0x64, 0xee, 0x86, 0x12,
+	0x34, 0x46, 0x82, 0x86, 0x15, 0x6c, 0xae, 0x47, 0x2c, 0x62, 0x5a, 0xd1, 0x54, 0x4f, 0x46, 0xbc,
+	0x79, 0xff, 0xfa, 0xd9, 0xc6, 0xff, 0xad, 0x55, 0xf5, 0x9f, 0xf3, 0xc0, 0x3d, 0xe4, 0x18, 0x4a,
+	0x7c, 0xcc, 0x58, 0x7c, 0xcc, 0x59, 0xca, 0x04, 0x8c, 0xdd, 0x75, 0x90, 0x97, 0x44, 0xc6, 0xd8,
+	0x73, 0x6a, 0xce, 0x5e, 0xd1, 0x37, 0x03, 0xb7, 0x06, 0x4a, 0x08, 0x8b, 0x90, 0x93, 0x54, 0x12,
+	0x46, 0xbd, 0x79, 0xcd, 0x4d, 0x42, 0xae, 0x0b, 0x72, 0x14, 0x26, 0xd8, 0x5b, 0xd0, 0x94, 0x7e,
+	0x76, 0x3d, 0xb0, 0xc4, 0x33, 0x2a, 0x49, 0x82, 0xbd, 0x9c, 0x86, 0x47, 0x43, 0xa5, 0x8e, 0x59,
+	0xc4, 0xbc, 0xbc, 0x51, 0xab, 0x67, 0xa5, 0x1e, 0x60, 0x2e, 0xd4, 0xfc, 0x8b, 0x46, 0x6d, 0x87,
+	0xee, 0x5d, 0xb0, 0x18, 0x32, 0x7a, 0x4a, 0x22, 0x6f, 0x49, 0x13, 0x76, 0xe4, 0x3e, 0x00, 0xcb,
+	0x42, 0x42, 0x2e, 0x83, 0x1e, 0x26, 0x51, 0x4f, 0x7a, 0x85, 0x9a, 0xb3, 0x97, 0x6b, 0xcd, 0x7b,
+	0x8e, 0x5f, 0xd2, 0xf8, 0x17, 0x1a, 0x76, 0x1f, 0x82, 0x4a, 0x96, 0xc6, 0x0c, 0xa2, 0x80, 0x50,
+	0x89, 0xf9, 0x00, 0xc6, 0x5e, 0x51, 0x29, 0xfd, 0xb2, 0x81, 0xdb, 0x16, 0x75, 0x1f, 0x80, 0x32,
+	0x4b, 0x31, 0x87, 0x92, 0xd0, 0x28, 0x08, 0x99, 0x90, 0x1e, 0xd0, 0xba, 0x95, 0x31, 0x7a, 0xc8,
+	0x84, 0x74, 0x77, 0x41, 0x25, 0x81, 0xe7, 0x41, 0x37, 0xa3, 0x28, 0xc6, 0x81, 0x20, 0xcf, 0xb1,
+	0x57, 0x32, 0xba, 0x04, 0x9e, 0xb7, 0x34, 0xda, 0x21, 0xcf, 0xb1, 0xbb, 0x09, 0x0a, 0x5d, 0x42,
+	0x21, 0x27, 0x58, 0x78, 0xcb, 0xba, 0xf0, 0xf1, 0xd8, 0xdd, 0x02, 0x45, 0x53, 0x7a, 0x1f, 0x0f,
+	0xbd, 0x15, 0x43, 0x6a, 0xe0, 0x29, 0x1e, 0x2a, 0x32, 0x21, 0x34, 0x10, 0x12, 0xf6, 0xb1, 0x57,
+	0xd6, 0x53, 0x17, 0x12, 0x42, 0x3b, 0x6a, 0xac, 0x8a, 0x4c, 0x49, 0x8a, 0x63, 0x42, 0x71, 0x80,
+	0x70, 0x2a, 0x7b, 0x5e, 0xc5, 0xbc, 0x7c, 0x84, 0x3e, 0x51, 0xa0, 0xdb, 0x00, 0x6b, 0x42, 0x32,
+	0x0e, 0x23, 0x1c, 0xa4, 0x9c, 0x0d, 0x08, 0xc2, 0x3c, 0x20, 0xc8, 0xbb, 0xa3, 0xb5, 0xab, 0x96,
+	0x3a, 0xb6, 0x4c, 0x1b, 0xb9, 0xfb, 0x60, 0x1d, 0xc6, 0x31, 0x3b, 0xc3, 0x28, 0x08, 0x59, 0x92,
+	0x72, 0x2c, 0xd4, 0xd6, 0x0b, 0x6f, 0xb5, 0xb6, 0xb0, 0x57, 0xf4, 0xd7, 0x2c, 0x77, 0x38, 0x41,
+	0xb9, 0x9f, 0x02, 0x2f, 0xec, 0x41, 0x1e, 0xe1, 0x20, 0xa3, 0xa3, 0xff, 0xc1, 0xc8, 0x6c, 0x88,
+	0x5b, 0x73, 0xf6, 0x0a, 0xfe, 0x5d, 0xc3, 0x9f, 0x4c, 0xd0, 0x7a, 0x67, 0x3e, 0x00, 0x4b, 0x98,
+	0x22, 0xbd, 0xf6, 0x35, 0x73, 0xa2, 0x98, 0x22, 0xb5, 0xf2, 0x6d, 0x00, 0x14, 0x61, 0xcf, 0x73,
+	0x5d, 0x17, 0x5b, 0xc4, 0x14, 0x99, 0x93, 0xac, 0xff, 0x91, 0x03, 0xee, 0x49, 0x8a, 0x6e, 0xca,
+	0xb3, 0x65, 0x30, 0x4f, 0x90, 0x76, 0x6c, 0xce, 0x9f, 0x27, 0x68, 0xec, 0xe1, 0xdc, 0xf5, 0x1e,
+	0xce, 0x5f, 0xef, 0xe1, 0xc5, 0x09, 0x0f, 0x57, 0x41, 0xc1, 0x9a, 0x56, 0x18, 0xaf, 0x6a, 0x37,
+	0x8e, 0xb1, 0x09, 0x27, 0x17, 0xa6, 0x9c, 0x7c, 0x5b, 0x16, 0x9d, 0x72, 0xda, 0xf2, 0xbf, 0x3a,
+	0x6d, 0xe5, 0x1d, 0x9c, 0x56, 0x7e, 0x57, 0xa7, 0x55, 0xfe, 0x9b, 0xd3, 0xee, 0xbc, 0xad, 0xd3,
+	0x56, 0xff, 0xc1, 0x69, 0xee, 0x55, 0xa7, 0x7d, 0x07, 0x56, 0x8f, 0x61, 0x26, 0x66, 0xe2, 0xb3,
+	0xfa, 0x0f, 0x60, 0xed, 0x84, 0xa6, 0x33, 0x9b, 0xfe, 0xd5, 0x3c, 0xd8, 0xea, 0x84, 0x3d, 0x8c,
+	0xb2, 0x58, 0xbf, 0xe0, 0x24, 0x8d, 0x38, 0x44, 0xf8, 0x7f, 0xbf, 0x67, 0xa2, 0x15, 0x16, 0xa6,
+	0x5b, 0x61, 0x22, 0xba, 0x73, 0xd3, 0xd1, 0xfd, 0x21, 0x58, 0x16, 0xb6, 0x14, 0x14, 0x40, 0xa9,
+	0x7b, 0x28, 0xe7, 0x97, 0xc6, 0xd8, 0x81, 0x54, 0x31, 0x89, 0x32, 0xe5, 0x5d, 0x1b, 0xfc, 0x39,
+	0x7f, 0x3c, 0x9e, 0x8a, 0xd0, 0xa5, 0x2b, 0x11, 0xba, 0x0b, 0x2a, 0x21, 0xa4, 0x90, 0x0f, 0x83,
+	0x94, 0xb1, 0x38, 0x20, 0x48, 0x78, 0x85, 0xda, 0x82, 0xf2, 0xa7, 0x81, 0xd5, 0xd2, 0xdb, 0x48,
+	0xa8, 0x12, 0xac, 0x0e, 0xe1, 0x18, 0x0e, 0x6d, 0x63, 0x95, 0x0c, 0xf6, 0x44, 0x41, 0xaa, 0xfd,
+	0x38, 0x8b, 0xe3, 0x2e, 0x0c, 0xfb, 0xc1, 0x19, 0xa1, 0x88, 0x9d, 0xd9, 0xb6, 0x2a, 0x8f, 0xe0,
+	0xaf, 0x35, 0x5a, 0x4f, 0xc0, 0xbd, 0x43, 0x48, 0x43, 0x1c, 0xbf, 0x97, 0x7d, 0xad, 0x9f, 0x83,
+	0x55, 0x1f, 0x0b, 0x2c, 0x67, 0x92, 0x76, 0x5b, 0xa0, 0x68, 0xf3, 0x81, 0x20, 0x7d, 0x6c, 0x39,
+	0xbf, 0x60, 0x80, 0x36, 0xaa, 0xff, 0xe9, 0x80, 0x6d, 0xf3, 0xeb, 0xa0, 0x33, 0xdd, 0xc0, 0x33,
+	0xf9, 0xa1, 0xf0, 0x95, 0xba, 0xc8, 0x4d, 0x84, 0xe8, 0x4c, 0xd3, 0x26, 0x6a, 0x35, 0x5e, 0xbc,
+	0xde, 0x99, 0xfb, 0xed, 0xf5, 0xce, 0x6e, 0x44, 0x64, 0x2f, 0xeb, 0x36, 0x42, 0x96, 0x34, 0x43,
+	0x26, 0x12, 0x26, 0xec, 0x9f, 0x47, 0x02, 0xf5, 0x9b, 0x72, 0x98, 0x62, 0xd1, 0x68, 0x53, 0xe9,
+	0x97, 0xec, 0x1c, 0x3a, 0x01, 0x3f, 0x06, 0xa3, 0xe8, 0x09, 0x08, 0x0a, 0x4e, 0x19, 0x4f, 0xac,
+	0xfb, 0x8a, 0x7e, 0xc5, 0x12, 0x6d, 0x74, 0xa4, 0xe1, 0xfa, 0x5f, 0x0e, 0xd8, 0x36, 0xd7, 0xca,
+	0x4d, 0x2f, 0xf6, 0x6d, 0x6e, 0x98, 0xab, 0x8b, 0xcf, 0xcf, 0x68, 0xf1, 0x8b, 0xd7, 0x2f, 0xfe,
+	0x7b, 0xe0, 0xfa, 0x58, 0x12, 0x3e, 0x9b, 0x2c, 0xfa, 0xd1, 0x01, 0x1b, 0xc6, 0x47, 0xbe, 0xf1,
+	0xf4, 0x4c, 0xfc, 0xf3, 0x11, 0x58, 0x31, 0x17, 0x69, 0xa0, 0x82, 0x25, 0x81, 0x76, 0x7f, 0x97,
+	0x0d, 0xd8, 0xd1, 0x98, 0x2e, 0xc5, 0x9c, 0xf2, 0xad, 0x97, 0xf2, 0xab, 0x03, 0xee, 0x1d, 0x20,
+	0x64, 0xeb, 0x78, 0x66, 0xb2, 0xf2, 0x56, 0xf2, 0xf9, 0x68, 0x22, 0x60, 0xf3, 0xb5, 0x85, 0xbd,
+	0xd2, 0xe3, 0xfb, 0x8d, 0x6b, 0xbf, 0x35, 0x1a, 0xb6, 0xd8, 0x96, 0x52, 0x0f, 0x5b, 0x39, 0x65,
+	0xcf, 0x37, 0x61, 0x5c, 0xff, 0xc5, 0x01, 0x9b, 0x1d, 0x2c, 0x8f, 0x39, 0x93, 0x2c, 0x64, 0xf1,
+	0x51, 0x46, 0x11, 0xa1, 0xd1, 0x8d, 0xf7, 0xcf, 0x43, 0x50, 0x21, 0xf4, 0x34, 0xd6, 0x97, 0x43,
+	0x20, 0x7a, 0x90, 0x8f, 0x5a, 0xa9, 0x3c, 0x86, 0x3b, 0x0a, 0x75, 0x1f, 0x83, 0x8d, 0x90, 0x25,
+	0x49, 0x46, 0x89, 0xb4, 0xf7, 0x03, 0x4c, 0x58, 0x46, 0x47, 0x17, 0xd0, 0xda, 0x98, 0x54, 0xae,
+	0x3f, 0xd0, 0x54, 0xeb, 0xf3, 0x17, 0x17, 0x55, 0xe7, 0xe5, 0x45, 0xd5, 0xf9, 0xfd, 0xa2, 0xea,
+	0xfc, 0x74, 0x59, 0x9d, 0x7b, 0x79, 0x59, 0x9d, 0x7b, 0x75, 0x59, 0x9d, 0xfb, 0xf6, 0xd1, 0x44,
+	0x13, 0x3e, 0xfd, 0xe6, 0xd9, 0x67, 0x5f, 0x62, 0x79, 0xc6, 0x78, 0xbf, 0x19, 0xf6, 0x20, 0xa1,
+	0xcd, 0xf3, 0x37, 0xdf, 0x5a, 0xba, 0x1f, 0xbb, 0x8b, 0xfa, 0x0b, 0xeb, 0x93, 0xbf, 0x07, 0x00,
+	0x52, 0x86, 0x8a, 0x3e, 0xd7, 0x0d, 0x00, 0x00,
+}
+
+func (m *CreatePoolProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1053,45 +1473,139 @@ func (m *SchedulePoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchedulePoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchedulePoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ChargeUncompressedSize {
+		i--
+		if m.ChargeUncompressedSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for iNdEx := len(m.AllowedCompressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCompressions[iNdEx])
+			copy(dAtA[i:], m.AllowedCompressions[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedCompressions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.PipelineDepth != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PipelineDepth))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Binaries) > 0 {
 		i -= len(m.Binaries)
 		copy(dAtA[i:], m.Binaries)
 		i = encodeVarintGov(dAtA, i, uint64(len(m.Binaries)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x62
 	}
-	if m.Duration != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Duration))
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxBundleSize))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x58
 	}
-	if m.ScheduledAt != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ScheduledAt))
+	if m.OperatingCost != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.OperatingCost))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x50
+	}
+	if m.UploadInterval != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
 		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Runtime) > 0 {
 		i -= len(m.Runtime)
 		copy(dAtA[i:], m.Runtime)
 		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
@@ -1111,7 +1625,7 @@ func (m *SchedulePoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelPoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
+func (m *UpdatePoolProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1121,41 +1635,141 @@ func (m *CancelPoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CancelPoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdatePoolProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelPoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdatePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+	if m.EndHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EndHeight))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.EndKey)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+	if m.ChargeUncompressedSize {
 		i--
-		dAtA[i] = 0xa
+		if m.ChargeUncompressedSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for iNdEx := len(m.AllowedCompressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCompressions[iNdEx])
+			copy(dAtA[i:], m.AllowedCompressions[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedCompressions[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	return len(dAtA) - i, nil
-}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PipelineDepth != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PipelineDepth))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxBundleSize != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxBundleSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OperatingCost != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.OperatingCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UploadInterval != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UploadInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Versions) > 0 {
+		i -= len(m.Versions)
+		copy(dAtA[i:], m.Versions)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Versions)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *ResetPoolProposal) Marshal() (dAtA []byte, err error) {
+func (m *PausePoolProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1165,21 +1779,16 @@ func (m *ResetPoolProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetPoolProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *PausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BundleId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.BundleId))
-		i--
-		dAtA[i] = 0x20
-	}
 	if m.Id != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Id))
 		i--
@@ -1202,255 +1811,2333 @@ func (m *ResetPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnpausePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreatePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnpausePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpausePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RollbackWindow != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RollbackWindow))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.CanaryDelay != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CanaryDelay))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.CanaryPoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.CanaryPoolIds)*10)
+		var j1 int
+		for _, num := range m.CanaryPoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovGov(uint64(m.StartHeight))
+	if len(m.Binaries) > 0 {
+		i -= len(m.Binaries)
+		copy(dAtA[i:], m.Binaries)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Binaries)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovGov(uint64(m.UploadInterval))
+	if m.Duration != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.OperatingCost != 0 {
-		n += 1 + sovGov(uint64(m.OperatingCost))
+	if m.ScheduledAt != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovGov(uint64(m.MaxBundleSize))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MinStake != 0 {
-		n += 1 + sovGov(uint64(m.MinStake))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdatePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CancelPoolUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Versions)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Config)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.UploadInterval != 0 {
-		n += 1 + sovGov(uint64(m.UploadInterval))
-	}
-	if m.OperatingCost != 0 {
-		n += 1 + sovGov(uint64(m.OperatingCost))
-	}
-	if m.MaxBundleSize != 0 {
-		n += 1 + sovGov(uint64(m.MaxBundleSize))
-	}
-	if m.MinStake != 0 {
-		n += 1 + sovGov(uint64(m.MinStake))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PausePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CancelPoolUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelPoolUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnpausePoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResetPoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetPoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetPoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.BundleId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.BundleId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SchedulePoolUpgradeProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateStorageProviderProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateStorageProviderProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateStorageProviderProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.StorageIdFormat) > 0 {
+		i -= len(m.StorageIdFormat)
+		copy(dAtA[i:], m.StorageIdFormat)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.StorageIdFormat)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size := m.StorageCost.Size()
+		i -= size
+		if _, err := m.StorageCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	if m.ScheduledAt != 0 {
-		n += 1 + sovGov(uint64(m.ScheduledAt))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Duration != 0 {
-		n += 1 + sovGov(uint64(m.Duration))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Binaries)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CancelPoolUpgradeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+func (m *UpdateStorageProviderProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetPoolProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateStorageProviderProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStorageProviderProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.StorageIdFormat) > 0 {
+		i -= len(m.StorageIdFormat)
+		copy(dAtA[i:], m.StorageIdFormat)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.StorageIdFormat)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	{
+		size := m.StorageCost.Size()
+		i -= size
+		if _, err := m.StorageCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BundleId != 0 {
-		n += 1 + sovGov(uint64(m.BundleId))
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetirePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetirePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetirePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateRuntimeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRuntimeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRuntimeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRuntimeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRuntimeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRuntimeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddRuntimeVersionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRuntimeVersionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRuntimeVersionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetProtocolFundingProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProtocolFundingProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProtocolFundingProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommunityPoolAmount != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CommunityPoolAmount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InflationShare) > 0 {
+		i -= len(m.InflationShare)
+		copy(dAtA[i:], m.InflationShare)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InflationShare)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreatePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGov(uint64(m.StartHeight))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovGov(uint64(m.UploadInterval))
+	}
+	if m.OperatingCost != 0 {
+		n += 1 + sovGov(uint64(m.OperatingCost))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovGov(uint64(m.MaxBundleSize))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovGov(uint64(m.MinStake))
+	}
+	if m.PipelineDepth != 0 {
+		n += 1 + sovGov(uint64(m.PipelineDepth))
+	}
+	if m.StorageProviderId != 0 {
+		n += 2 + sovGov(uint64(m.StorageProviderId))
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for _, s := range m.AllowedCompressions {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ChargeUncompressedSize {
+		n += 3
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 2 + sovGov(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *UpdatePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Versions)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.UploadInterval != 0 {
+		n += 1 + sovGov(uint64(m.UploadInterval))
+	}
+	if m.OperatingCost != 0 {
+		n += 1 + sovGov(uint64(m.OperatingCost))
+	}
+	if m.MaxBundleSize != 0 {
+		n += 1 + sovGov(uint64(m.MaxBundleSize))
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovGov(uint64(m.MinStake))
+	}
+	if m.PipelineDepth != 0 {
+		n += 1 + sovGov(uint64(m.PipelineDepth))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovGov(uint64(m.StorageProviderId))
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for _, s := range m.AllowedCompressions {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ChargeUncompressedSize {
+		n += 3
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 2 + sovGov(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *PausePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *UnpausePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *SchedulePoolUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovGov(uint64(m.ScheduledAt))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovGov(uint64(m.Duration))
+	}
+	l = len(m.Binaries)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.CanaryPoolIds) > 0 {
+		l = 0
+		for _, e := range m.CanaryPoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	if m.CanaryDelay != 0 {
+		n += 1 + sovGov(uint64(m.CanaryDelay))
+	}
+	if m.RollbackWindow != 0 {
+		n += 1 + sovGov(uint64(m.RollbackWindow))
+	}
+	return n
+}
+
+func (m *CancelPoolUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetPoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.BundleId != 0 {
+		n += 1 + sovGov(uint64(m.BundleId))
+	}
+	return n
+}
+
+func (m *CreateStorageProviderProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.StorageCost.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.StorageIdFormat)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateStorageProviderProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.StorageCost.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = len(m.StorageIdFormat)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *RetirePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *CreateRuntimeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRuntimeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *AddRuntimeVersionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetProtocolFundingProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.InflationShare)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.CommunityPoolAmount != 0 {
+		n += 1 + sovGov(uint64(m.CommunityPoolAmount))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineDepth", wireType)
+			}
+			m.PipelineDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCompressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCompressions = append(m.AllowedCompressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeUncompressedSize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChargeUncompressedSize = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			m.OperatingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			}
+			m.MaxBundleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineDepth", wireType)
+			}
+			m.PipelineDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCompressions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCompressions = append(m.AllowedCompressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeUncompressedSize", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChargeUncompressedSize = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1473,10 +4160,10 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpausePoolProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1544,8 +4231,77 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1573,11 +4329,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1605,11 +4361,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1637,9 +4393,9 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.Runtime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -1671,9 +4427,47 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1701,13 +4495,89 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Binaries = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CanaryPoolIds = append(m.CanaryPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CanaryPoolIds) == 0 {
+					m.CanaryPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CanaryPoolIds = append(m.CanaryPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPoolIds", wireType)
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryDelay", wireType)
 			}
-			m.StartHeight = 0
+			m.CanaryDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1717,16 +4587,16 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				m.CanaryDelay |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
 			}
-			m.UploadInterval = 0
+			m.RollbackWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1736,16 +4606,66 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
+				m.RollbackWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OperatingCost = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelPoolUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelPoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1755,16 +4675,61 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatingCost |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
 			}
-			m.MaxBundleSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1774,14 +4739,77 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetPoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1809,11 +4837,11 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1841,13 +4869,13 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.MinStake = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1857,7 +4885,26 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+			}
+			m.BundleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BundleId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1883,7 +4930,7 @@ func (m *CreatePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *CreateStorageProviderProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1906,10 +4953,10 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateStorageProviderProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateStorageProviderProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1977,25 +5024,6 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -2027,9 +5055,9 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageCost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,11 +5085,13 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			if err := m.StorageCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageIdFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2089,11 +5119,61 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.StorageIdFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStorageProviderProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStorageProviderProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStorageProviderProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2121,11 +5201,11 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2153,13 +5233,13 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.UploadInterval = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2169,16 +5249,16 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.OperatingCost = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2188,16 +5268,29 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OperatingCost |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			m.MaxBundleSize = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageCost", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2207,16 +5300,31 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MinStake = 0
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageIdFormat", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2226,11 +5334,24 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageIdFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2252,7 +5373,7 @@ func (m *UpdatePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *RetirePoolProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2275,10 +5396,10 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PausePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetirePoolProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetirePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2385,7 +5506,7 @@ func (m *PausePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
+func (m *CreateRuntimeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2408,10 +5529,10 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpausePoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRuntimeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpausePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRuntimeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2479,10 +5600,10 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2492,11 +5613,56 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2518,7 +5684,7 @@ func (m *UnpausePoolProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateRuntimeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,10 +5707,10 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRuntimeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchedulePoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRuntimeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2613,7 +5779,7 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2641,81 +5807,11 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
-			}
-			m.ScheduledAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2743,7 +5839,7 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2766,7 +5862,7 @@ func (m *SchedulePoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
+func (m *AddRuntimeVersionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2789,10 +5885,10 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelPoolUpgradeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddRuntimeVersionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelPoolUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddRuntimeVersionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2891,6 +5987,72 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Runtime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, RuntimeBinary{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2912,7 +6074,7 @@ func (m *CancelPoolUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
+func (m *SetProtocolFundingProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2935,10 +6097,10 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetPoolProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetProtocolFundingProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetPoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetProtocolFundingProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3025,10 +6187,42 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
 			}
-			m.BundleId = 0
+			m.CommunityPoolAmount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3038,7 +6232,7 @@ func (m *ResetPoolProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BundleId |= uint64(b&0x7F) << shift
+				m.CommunityPoolAmount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
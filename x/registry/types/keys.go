@@ -25,8 +25,16 @@ const (
 const (
 	MaxFunders          = 50 // maximum amount of funders which are allowed
 	MaxStakers          = 50 // maximum amount of stakers which are allowed
+	MaxPipelineDepth    = 10 // maximum amount of bundle proposals which can be open at the same time
 	DefaultCommission   = "0.9"
 	KYVE_NO_DATA_BUNDLE = "KYVE_NO_DATA_BUNDLE"
+	CompressionNone     = "none" // compression of uncompressed bundles, always allowed
+)
+
+// funding stream limits
+const (
+	MaxFundingStreams = 50            // maximum amount of funding streams per pool which are allowed
+	MinFundingStream  = 1_000_000_000 // minimum budget of a funding stream in tkyve
 )
 
 // ============ KV-STORE ===============
@@ -47,6 +55,18 @@ var (
 
 	// CommissionChangeQueueStateKey ...
 	CommissionChangeQueueStateKey = []byte{0, 5}
+
+	// RedelegationQueueStateKey ...
+	RedelegationQueueStateKey = []byte{0, 6}
+
+	// AutoCompoundCursorKey ...
+	AutoCompoundCursorKey = []byte{0, 7}
+
+	// StorageProviderCountKey ...
+	StorageProviderCountKey = []byte{0, 8}
+
+	// FundingStreamCountKey ...
+	FundingStreamCountKey = []byte{0, 9}
 )
 
 var (
@@ -85,6 +105,8 @@ var (
 	UnbondingDelegationQueueEntryKeyPrefix = []byte{12}
 	// UnbondingDelegationQueueEntryKeyPrefixIndex2 ...
 	UnbondingDelegationQueueEntryKeyPrefixIndex2 = []byte{13}
+	// UnbondingDelegationQueueEntryKeyPrefixIndex3 ...
+	UnbondingDelegationQueueEntryKeyPrefixIndex3 = []byte{20}
 
 	// RedelegationCooldownPrefix ...
 	RedelegationCooldownPrefix = []byte{14}
@@ -93,8 +115,44 @@ var (
 	CommissionChangeQueueEntryKeyPrefix = []byte{15}
 	// CommissionChangeQueueEntryKeyPrefixIndex2 ...
 	CommissionChangeQueueEntryKeyPrefixIndex2 = []byte{16}
+
+	// DelegationSlashKeyPrefix ...
+	DelegationSlashKeyPrefix = []byte{17}
+
+	// RedelegationQueueEntryKeyPrefix ...
+	RedelegationQueueEntryKeyPrefix = []byte{18}
+	// RedelegationQueueEntryKeyPrefixIndex2 ...
+	RedelegationQueueEntryKeyPrefixIndex2 = []byte{19}
+
+	// AutoCompoundKeyPrefix ...
+	AutoCompoundKeyPrefix = []byte{21}
+
+	// WithdrawAddressKeyPrefix ...
+	WithdrawAddressKeyPrefix = []byte{22}
+
+	// StorageProviderKeyPrefix ...
+	StorageProviderKeyPrefix = []byte{23}
+
+	// ArchivedProposalKeyPrefix ...
+	ArchivedProposalKeyPrefix = []byte{24}
+
+	// RuntimeKeyPrefix ...
+	RuntimeKeyPrefix = []byte{25}
+
+	// FundingStreamKeyPrefix ...
+	FundingStreamKeyPrefix = []byte{26}
+	// FundingStreamKeyPrefixIndex2 ...
+	FundingStreamKeyPrefixIndex2 = []byte{28}
+
+	// ProtocolFundingKeyPrefix ...
+	ProtocolFundingKeyPrefix = []byte{27}
 )
 
+// ArchivedProposalKey returns the store Key to retrieve an archived Proposal from the index fields
+func ArchivedProposalKey(poolId uint64, bundleId uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).AInt(bundleId).Key
+}
+
 // StakerKey returns the store Key to retrieve a Staker from the index fields
 func StakerKey(staker string, poolId uint64) []byte {
 	return KeyPrefixBuilder{}.AString(staker).AInt(poolId).Key
@@ -122,6 +180,46 @@ func DelegationEntriesKey(poolId uint64, stakerAddress string, kIndex uint64) []
 	return KeyPrefixBuilder{}.AInt(poolId).AString(stakerAddress).AInt(kIndex).Key
 }
 
+// DelegationSlashKey returns the store Key to retrieve a DelegationSlash from the index fields
+func DelegationSlashKey(poolId uint64, stakerAddress string, kIndex uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).AString(stakerAddress).AInt(kIndex).Key
+}
+
+// AutoCompoundKey returns the store Key to retrieve an AutoCompound from the index fields
+func AutoCompoundKey(poolId uint64, stakerAddress string, delegatorAddress string) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).AString(stakerAddress).AString(delegatorAddress).Key
+}
+
+// WithdrawAddressKey returns the store Key to retrieve a WithdrawAddress from the index fields
+func WithdrawAddressKey(address string) []byte {
+	return KeyPrefixBuilder{}.AString(address).Key
+}
+
+// StorageProviderKey returns the store Key to retrieve a StorageProvider from the index fields
+func StorageProviderKey(id uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(id).Key
+}
+
+// RuntimeKey returns the store Key to retrieve a Runtime from the index fields
+func RuntimeKey(name string) []byte {
+	return KeyPrefixBuilder{}.AString(name).Key
+}
+
+// FundingStreamKey returns the store Key to retrieve a FundingStream from the index fields
+func FundingStreamKey(poolId uint64, id uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).AInt(id).Key
+}
+
+// FundingStreamKeyIndex2 returns the store Key to retrieve a FundingStream by its end time
+func FundingStreamKeyIndex2(endTime uint64, poolId uint64, id uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(endTime).AInt(poolId).AInt(id).Key
+}
+
+// ProtocolFundingKey returns the store Key to retrieve a ProtocolFunding from the index fields
+func ProtocolFundingKey(poolId uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).Key
+}
+
 // DelegationPoolDataKey returns the store Key to retrieve a DelegationPoolData from the index fields
 func DelegationPoolDataKey(poolId uint64, stakerAddress string) []byte {
 	return KeyPrefixBuilder{}.AInt(poolId).AString(stakerAddress).Key
@@ -163,9 +261,19 @@ func UnbondingDelegationQueueEntryKey(index uint64) []byte {
 func UnbondingDelegationQueueEntryKeyIndex2(delegator string, index uint64) []byte {
 	return KeyPrefixBuilder{}.AString(delegator).AInt(index).Key
 }
+func UnbondingDelegationQueueEntryKeyIndex3(poolId uint64, staker string, index uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(poolId).AString(staker).AInt(index).Key
+}
 
-func RedelegationCooldownKey(delegator string, block uint64) []byte {
-	return KeyPrefixBuilder{}.AString(delegator).AInt(block).Key
+func RedelegationQueueEntryKey(index uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(index).Key
+}
+func RedelegationQueueEntryKeyIndex2(fromPoolId uint64, fromStaker string, index uint64) []byte {
+	return KeyPrefixBuilder{}.AInt(fromPoolId).AString(fromStaker).AInt(index).Key
+}
+
+func RedelegationCooldownKey(delegator string, index uint64) []byte {
+	return KeyPrefixBuilder{}.AString(delegator).AInt(index).Key
 }
 
 func CommissionChangeQueueEntryKey(index uint64) []byte {
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/mod/semver"
+)
+
+const TypeMsgAttestVersion = "attest_version"
+
+var _ sdk.Msg = &MsgAttestVersion{}
+
+func NewMsgAttestVersion(creator string, id uint64, version string) *MsgAttestVersion {
+	return &MsgAttestVersion{
+		Creator: creator,
+		Id:      id,
+		Version: version,
+	}
+}
+
+func (msg *MsgAttestVersion) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAttestVersion) Type() string {
+	return TypeMsgAttestVersion
+}
+
+func (msg *MsgAttestVersion) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAttestVersion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAttestVersion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !semver.IsValid(CanonicalVersion(msg.Version)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid semantic version %v", msg.Version)
+	}
+
+	return nil
+}
+
+// CanonicalVersion prefixes a protocol version with "v" as expected by the semver package.
+func CanonicalVersion(version string) string {
+	if version == "" || version[0] == 'v' {
+		return version
+	}
+
+	return "v" + version
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCloseFundingStream = "close_funding_stream"
+
+var _ sdk.Msg = &MsgCloseFundingStream{}
+
+func NewMsgCloseFundingStream(creator string, id uint64, streamId uint64) *MsgCloseFundingStream {
+	return &MsgCloseFundingStream{
+		Creator:  creator,
+		Id:       id,
+		StreamId: streamId,
+	}
+}
+
+func (msg *MsgCloseFundingStream) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCloseFundingStream) Type() string {
+	return TypeMsgCloseFundingStream
+}
+
+func (msg *MsgCloseFundingStream) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCloseFundingStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCloseFundingStream) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
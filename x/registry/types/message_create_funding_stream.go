@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateFundingStream = "create_funding_stream"
+
+var _ sdk.Msg = &MsgCreateFundingStream{}
+
+func NewMsgCreateFundingStream(creator string, id uint64, amount sdk.Int, amountPerBundle sdk.Int, amountPerDay sdk.Int, endTime uint64) *MsgCreateFundingStream {
+	return &MsgCreateFundingStream{
+		Creator:         creator,
+		Id:              id,
+		Amount:          amount,
+		AmountPerBundle: amountPerBundle,
+		AmountPerDay:    amountPerDay,
+		EndTime:         endTime,
+	}
+}
+
+func (msg *MsgCreateFundingStream) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateFundingStream) Type() string {
+	return TypeMsgCreateFundingStream
+}
+
+func (msg *MsgCreateFundingStream) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateFundingStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateFundingStream) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePositiveAmount(msg.Amount); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.AmountPerBundle); err != nil {
+		return err
+	}
+	return validateAmount(msg.AmountPerDay)
+}
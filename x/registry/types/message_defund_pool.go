@@ -9,7 +9,7 @@ const TypeMsgDefundPool = "defund_pool"
 
 var _ sdk.Msg = &MsgDefundPool{}
 
-func NewMsgDefundPool(creator string, id uint64, amount uint64) *MsgDefundPool {
+func NewMsgDefundPool(creator string, id uint64, amount sdk.Int) *MsgDefundPool {
 	return &MsgDefundPool{
 		Creator: creator,
 		Id:      id,
@@ -43,5 +43,5 @@ func (msg *MsgDefundPool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
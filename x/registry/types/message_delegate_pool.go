@@ -9,7 +9,7 @@ const TypeMsgDelegatePool = "delegate_pool"
 
 var _ sdk.Msg = &MsgDelegatePool{}
 
-func NewMsgDelegatePool(creator string, id uint64, staker string, amount uint64) *MsgDelegatePool {
+func NewMsgDelegatePool(creator string, id uint64, staker string, amount sdk.Int) *MsgDelegatePool {
 	return &MsgDelegatePool{
 		Creator: creator,
 		Id:      id,
@@ -49,5 +49,5 @@ func (msg *MsgDelegatePool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid staker address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
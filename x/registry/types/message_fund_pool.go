@@ -9,7 +9,7 @@ const TypeMsgFundPool = "fund_pool"
 
 var _ sdk.Msg = &MsgFundPool{}
 
-func NewMsgFundPool(creator string, id uint64, amount uint64) *MsgFundPool {
+func NewMsgFundPool(creator string, id uint64, amount sdk.Int) *MsgFundPool {
 	return &MsgFundPool{
 		Creator: creator,
 		Id:      id,
@@ -43,5 +43,5 @@ func (msg *MsgFundPool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
@@ -35,5 +35,5 @@ func (msg *MsgRedelegatePool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
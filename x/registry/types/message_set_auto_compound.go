@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetAutoCompound = "set_auto_compound"
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+func NewMsgSetAutoCompound(creator string, poolId uint64, staker string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Creator: creator,
+		PoolId:  poolId,
+		Staker:  staker,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetAutoCompound) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoCompound) Type() string {
+	return TypeMsgSetAutoCompound
+}
+
+func (msg *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Staker)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid staker address (%s)", err)
+	}
+	return nil
+}
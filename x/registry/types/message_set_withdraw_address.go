@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetWithdrawAddress = "set_withdraw_address"
+
+var _ sdk.Msg = &MsgSetWithdrawAddress{}
+
+func NewMsgSetWithdrawAddress(creator string, withdrawAddress string) *MsgSetWithdrawAddress {
+	return &MsgSetWithdrawAddress{
+		Creator:         creator,
+		WithdrawAddress: withdrawAddress,
+	}
+}
+
+func (msg *MsgSetWithdrawAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetWithdrawAddress) Type() string {
+	return TypeMsgSetWithdrawAddress
+}
+
+func (msg *MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetWithdrawAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address (%s)", err)
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ const TypeMsgStakePool = "stake_pool"
 
 var _ sdk.Msg = &MsgStakePool{}
 
-func NewMsgStakePool(creator string, id uint64, amount uint64) *MsgStakePool {
+func NewMsgStakePool(creator string, id uint64, amount sdk.Int) *MsgStakePool {
 	return &MsgStakePool{
 		Creator: creator,
 		Id:      id,
@@ -43,5 +43,5 @@ func (msg *MsgStakePool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
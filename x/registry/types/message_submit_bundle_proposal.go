@@ -9,7 +9,7 @@ const TypeMsgSubmitBundleProposal = "submit_bundle_proposal"
 
 var _ sdk.Msg = &MsgSubmitBundleProposal{}
 
-func NewMsgSubmitBundleProposal(creator string, id uint64, storageId string, byteSize uint64, fromHeight uint64, toHeight uint64, fromKey string, toKey string, toValue string) *MsgSubmitBundleProposal {
+func NewMsgSubmitBundleProposal(creator string, id uint64, storageId string, byteSize uint64, fromHeight uint64, toHeight uint64, fromKey string, toKey string, toValue string, bundleHash string, compression string, dataItemCount uint64, uncompressedByteSize uint64) *MsgSubmitBundleProposal {
 	return &MsgSubmitBundleProposal{
 		Creator:    creator,
 		Id:         id,
@@ -20,6 +20,10 @@ func NewMsgSubmitBundleProposal(creator string, id uint64, storageId string, byt
 		FromKey: fromKey,
 		ToKey: toKey,
 		ToValue: toValue,
+		BundleHash: bundleHash,
+		Compression: compression,
+		DataItemCount: dataItemCount,
+		UncompressedByteSize: uncompressedByteSize,
 	}
 }
 
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferStaker = "transfer_staker"
+
+var _ sdk.Msg = &MsgTransferStaker{}
+
+func NewMsgTransferStaker(creator string, poolId uint64, newStaker string) *MsgTransferStaker {
+	return &MsgTransferStaker{
+		Creator:   creator,
+		PoolId:    poolId,
+		NewStaker: newStaker,
+	}
+}
+
+func (msg *MsgTransferStaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferStaker) Type() string {
+	return TypeMsgTransferStaker
+}
+
+func (msg *MsgTransferStaker) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferStaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferStaker) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.NewStaker)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new staker address (%s)", err)
+	}
+
+	if msg.Creator == msg.NewStaker {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "new staker must differ from creator")
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ const TypeMsgUndelegatePool = "undelegate_pool"
 
 var _ sdk.Msg = &MsgUndelegatePool{}
 
-func NewMsgUndelegatePool(creator string, id uint64, staker string, amount uint64) *MsgUndelegatePool {
+func NewMsgUndelegatePool(creator string, id uint64, staker string, amount sdk.Int) *MsgUndelegatePool {
 	return &MsgUndelegatePool{
 		Creator: creator,
 		Id:      id,
@@ -50,5 +50,5 @@ func (msg *MsgUndelegatePool) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid staker address (%s)", err)
 	}
 
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
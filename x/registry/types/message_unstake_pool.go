@@ -9,7 +9,7 @@ const TypeMsgUnstakePool = "unstake_pool"
 
 var _ sdk.Msg = &MsgUnstakePool{}
 
-func NewMsgUnstakePool(creator string, id uint64, amount uint64) *MsgUnstakePool {
+func NewMsgUnstakePool(creator string, id uint64, amount sdk.Int) *MsgUnstakePool {
 	return &MsgUnstakePool{
 		Creator: creator,
 		Id:      id,
@@ -43,5 +43,5 @@ func (msg *MsgUnstakePool) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	return ValidatePositiveAmount(msg.Amount)
 }
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawAllRewards = "withdraw_all_rewards"
+
+var _ sdk.Msg = &MsgWithdrawAllRewards{}
+
+func NewMsgWithdrawAllRewards(creator string, limit uint64, startKey []byte) *MsgWithdrawAllRewards {
+	return &MsgWithdrawAllRewards{
+		Creator:  creator,
+		Limit:    limit,
+		StartKey: startKey,
+	}
+}
+
+func (msg *MsgWithdrawAllRewards) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawAllRewards) Type() string {
+	return TypeMsgWithdrawAllRewards
+}
+
+func (msg *MsgWithdrawAllRewards) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawAllRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -71,6 +71,46 @@ var (
 	DefaultCommissionChangeTime uint64 = 60 * 60 * 24 * 5
 )
 
+var (
+	KeyStakerTransferCooldown            = []byte("StakerTransferCooldown")
+	DefaultStakerTransferCooldown uint64 = 60 * 60 * 24 * 5
+)
+
+var (
+	KeyMaxAutoCompoundsPerBlock            = []byte("MaxAutoCompoundsPerBlock")
+	DefaultMaxAutoCompoundsPerBlock uint64 = 100
+)
+
+var (
+	KeyMaxWithdrawAllPositions            = []byte("MaxWithdrawAllPositions")
+	DefaultMaxWithdrawAllPositions uint64 = 50
+)
+
+var (
+	KeyMaxDelegationSelfStakeMultiple            = []byte("MaxDelegationSelfStakeMultiple")
+	DefaultMaxDelegationSelfStakeMultiple uint64 = 0
+)
+
+var (
+	KeyMaxDelegationPoolShare            = []byte("MaxDelegationPoolShare")
+	DefaultMaxDelegationPoolShare string = "1"
+)
+
+var (
+	KeyUploaderRoleSkipCooldown            = []byte("UploaderRoleSkipCooldown")
+	DefaultUploaderRoleSkipCooldown uint64 = 60 * 60
+)
+
+var (
+	KeyVoterRewardShare            = []byte("VoterRewardShare")
+	DefaultVoterRewardShare string = "0"
+)
+
+var (
+	KeyRunwayAlertThresholds              = []byte("RunwayAlertThresholds")
+	DefaultRunwayAlertThresholds []uint64 = []uint64{60 * 60 * 24 * 7, 60 * 60 * 24 * 3, 60 * 60 * 24}
+)
+
 // ParamKeyTable the param Key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -90,20 +130,36 @@ func NewParams(
 	redelegationCooldown uint64,
 	redelegationMaxAmount uint64,
 	commissionChangeTime uint64,
+	stakerTransferCooldown uint64,
+	maxAutoCompoundsPerBlock uint64,
+	maxWithdrawAllPositions uint64,
+	maxDelegationSelfStakeMultiple uint64,
+	maxDelegationPoolShare string,
+	uploaderRoleSkipCooldown uint64,
+	voterRewardShare string,
+	runwayAlertThresholds []uint64,
 ) Params {
 	return Params{
-		VoteSlash:               voteSlash,
-		UploadSlash:             uploadSlash,
-		TimeoutSlash:            timeoutSlash,
-		UploadTimeout:           uploadTimeout,
-		StorageCost:             storageCost,
-		NetworkFee:              networkFee,
-		MaxPoints:               maxPoints,
-		UnbondingStakingTime:    unbondingStakingTime,
-		UnbondingDelegationTime: unbondingDelegationTime,
-		RedelegationCooldown:    redelegationCooldown,
-		RedelegationMaxAmount:   redelegationMaxAmount,
-		CommissionChangeTime:    commissionChangeTime,
+		VoteSlash:                      voteSlash,
+		UploadSlash:                    uploadSlash,
+		TimeoutSlash:                   timeoutSlash,
+		UploadTimeout:                  uploadTimeout,
+		StorageCost:                    storageCost,
+		NetworkFee:                     networkFee,
+		MaxPoints:                      maxPoints,
+		UnbondingStakingTime:           unbondingStakingTime,
+		UnbondingDelegationTime:        unbondingDelegationTime,
+		RedelegationCooldown:           redelegationCooldown,
+		RedelegationMaxAmount:          redelegationMaxAmount,
+		CommissionChangeTime:           commissionChangeTime,
+		StakerTransferCooldown:         stakerTransferCooldown,
+		MaxAutoCompoundsPerBlock:       maxAutoCompoundsPerBlock,
+		MaxWithdrawAllPositions:        maxWithdrawAllPositions,
+		MaxDelegationSelfStakeMultiple: maxDelegationSelfStakeMultiple,
+		MaxDelegationPoolShare:         maxDelegationPoolShare,
+		UploaderRoleSkipCooldown:       uploaderRoleSkipCooldown,
+		VoterRewardShare:               voterRewardShare,
+		RunwayAlertThresholds:          runwayAlertThresholds,
 	}
 }
 
@@ -122,6 +178,14 @@ func DefaultParams() Params {
 		DefaultRedelegationCooldown,
 		DefaultRedelegationMaxAmount,
 		DefaultCommissionChangeTime,
+		DefaultStakerTransferCooldown,
+		DefaultMaxAutoCompoundsPerBlock,
+		DefaultMaxWithdrawAllPositions,
+		DefaultMaxDelegationSelfStakeMultiple,
+		DefaultMaxDelegationPoolShare,
+		DefaultUploaderRoleSkipCooldown,
+		DefaultVoterRewardShare,
+		DefaultRunwayAlertThresholds,
 	)
 }
 
@@ -140,6 +204,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyRedelegationCooldown, &p.RedelegationCooldown, validateTrue),
 		paramtypes.NewParamSetPair(KeyRedelegationMaxAmount, &p.RedelegationMaxAmount, validateTrue),
 		paramtypes.NewParamSetPair(KeyCommissionChangeTime, &p.CommissionChangeTime, validateTrue),
+		paramtypes.NewParamSetPair(KeyStakerTransferCooldown, &p.StakerTransferCooldown, validateTrue),
+		paramtypes.NewParamSetPair(KeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxWithdrawAllPositions, &p.MaxWithdrawAllPositions, validateMaxWithdrawAllPositions),
+		paramtypes.NewParamSetPair(KeyMaxDelegationSelfStakeMultiple, &p.MaxDelegationSelfStakeMultiple, validateTrue),
+		paramtypes.NewParamSetPair(KeyMaxDelegationPoolShare, &p.MaxDelegationPoolShare, validateMaxDelegationPoolShare),
+		paramtypes.NewParamSetPair(KeyUploaderRoleSkipCooldown, &p.UploaderRoleSkipCooldown, validateTrue),
+		paramtypes.NewParamSetPair(KeyVoterRewardShare, &p.VoterRewardShare, validateVoterRewardShare),
+		paramtypes.NewParamSetPair(KeyRunwayAlertThresholds, &p.RunwayAlertThresholds, validateRunwayAlertThresholds),
 	}
 }
 
@@ -181,6 +253,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxDelegationPoolShare(p.MaxDelegationPoolShare); err != nil {
+		return err
+	}
+
+	if err := validateVoterRewardShare(p.VoterRewardShare); err != nil {
+		return err
+	}
+
+	if err := validateRunwayAlertThresholds(p.RunwayAlertThresholds); err != nil {
+		return err
+	}
+
+	if err := validateMaxAutoCompoundsPerBlock(p.MaxAutoCompoundsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxWithdrawAllPositions(p.MaxWithdrawAllPositions); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -273,6 +365,63 @@ func validateUnbondingDelegationTime(v interface{}) error {
 	return nil
 }
 
+// validateMaxDelegationPoolShare validates the MaxDelegationPoolShare param
+func validateMaxDelegationPoolShare(v interface{}) error {
+	return validatePercentage(v)
+}
+
+// validateVoterRewardShare validates the VoterRewardShare param
+func validateVoterRewardShare(v interface{}) error {
+	return validatePercentage(v)
+}
+
+// validateRunwayAlertThresholds validates the RunwayAlertThresholds param
+func validateRunwayAlertThresholds(v interface{}) error {
+	thresholds, ok := v.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, threshold := range thresholds {
+		if threshold == 0 {
+			return fmt.Errorf("runway alert thresholds should be greater than 0")
+		}
+		if i > 0 && threshold >= thresholds[i-1] {
+			return fmt.Errorf("runway alert thresholds should be in descending order")
+		}
+	}
+
+	return nil
+}
+
+// validateMaxAutoCompoundsPerBlock validates the MaxAutoCompoundsPerBlock param
+func validateMaxAutoCompoundsPerBlock(v interface{}) error {
+	maxAutoCompounds, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxAutoCompounds == 0 {
+		return fmt.Errorf("max auto compounds per block should be greater than 0")
+	}
+
+	return nil
+}
+
+// validateMaxWithdrawAllPositions validates the MaxWithdrawAllPositions param
+func validateMaxWithdrawAllPositions(v interface{}) error {
+	maxPositions, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxPositions == 0 {
+		return fmt.Errorf("max withdraw all positions should be greater than 0")
+	}
+
+	return nil
+}
+
 // validatePercentage ...
 func validatePercentage(v interface{}) error {
 	val, ok := v.(string)
@@ -49,6 +49,28 @@ type Params struct {
 	RedelegationMaxAmount uint64 `protobuf:"varint,13,opt,name=redelegation_max_amount,json=redelegationMaxAmount,proto3" json:"redelegation_max_amount,omitempty"`
 	// commission_change_time ...
 	CommissionChangeTime uint64 `protobuf:"varint,14,opt,name=commission_change_time,json=commissionChangeTime,proto3" json:"commission_change_time,omitempty"`
+	// staker_transfer_cooldown ...
+	StakerTransferCooldown uint64 `protobuf:"varint,15,opt,name=staker_transfer_cooldown,json=stakerTransferCooldown,proto3" json:"staker_transfer_cooldown,omitempty"`
+	// max_auto_compounds_per_block ...
+	MaxAutoCompoundsPerBlock uint64 `protobuf:"varint,16,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
+	// max_withdraw_all_positions ...
+	MaxWithdrawAllPositions uint64 `protobuf:"varint,17,opt,name=max_withdraw_all_positions,json=maxWithdrawAllPositions,proto3" json:"max_withdraw_all_positions,omitempty"`
+	// max_delegation_self_stake_multiple is the maximum delegation of a staker as a multiple of its self-stake.
+	// Zero disables the limit.
+	MaxDelegationSelfStakeMultiple uint64 `protobuf:"varint,18,opt,name=max_delegation_self_stake_multiple,json=maxDelegationSelfStakeMultiple,proto3" json:"max_delegation_self_stake_multiple,omitempty"`
+	// max_delegation_pool_share is the maximum share the delegation of a staker may have of the
+	// total delegation of a pool. It does not apply to the first delegation of a pool.
+	// One disables the limit.
+	MaxDelegationPoolShare string `protobuf:"bytes,19,opt,name=max_delegation_pool_share,json=maxDelegationPoolShare,proto3" json:"max_delegation_pool_share,omitempty"`
+	// uploader_role_skip_cooldown is the time in seconds a staker has to wait
+	// before it can skip the uploader role again.
+	UploaderRoleSkipCooldown uint64 `protobuf:"varint,20,opt,name=uploader_role_skip_cooldown,json=uploaderRoleSkipCooldown,proto3" json:"uploader_role_skip_cooldown,omitempty"`
+	// voter_reward_share is the share of the bundle reward (after the network fee)
+	// which is distributed among the stakers who voted valid on a finalized bundle.
+	VoterRewardShare string `protobuf:"bytes,21,opt,name=voter_reward_share,json=voterRewardShare,proto3" json:"voter_reward_share,omitempty"`
+	// runway_alert_thresholds are the runways in seconds, in descending order, below which
+	// a pool emits a low funds alert.
+	RunwayAlertThresholds []uint64 `protobuf:"varint,22,rep,packed,name=runway_alert_thresholds,json=runwayAlertThresholds,proto3" json:"runway_alert_thresholds,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -167,6 +189,62 @@ func (m *Params) GetCommissionChangeTime() uint64 {
 	return 0
 }
 
+func (m *Params) GetStakerTransferCooldown() uint64 {
+	if m != nil {
+		return m.StakerTransferCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWithdrawAllPositions() uint64 {
+	if m != nil {
+		return m.MaxWithdrawAllPositions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDelegationSelfStakeMultiple() uint64 {
+	if m != nil {
+		return m.MaxDelegationSelfStakeMultiple
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDelegationPoolShare() string {
+	if m != nil {
+		return m.MaxDelegationPoolShare
+	}
+	return ""
+}
+
+func (m *Params) GetUploaderRoleSkipCooldown() uint64 {
+	if m != nil {
+		return m.UploaderRoleSkipCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetVoterRewardShare() string {
+	if m != nil {
+		return m.VoterRewardShare
+	}
+	return ""
+}
+
+func (m *Params) GetRunwayAlertThresholds() []uint64 {
+	if m != nil {
+		return m.RunwayAlertThresholds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kyve.registry.v1beta1.Params")
 }
@@ -176,35 +254,50 @@ func init() {
 }
 
 var fileDescriptor_ca08e39f277f4aef = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x3f, 0x6f, 0x13, 0x41,
-	0x10, 0xc5, 0x7d, 0xc2, 0x18, 0xbc, 0xb6, 0x53, 0x9c, 0x12, 0x72, 0x20, 0x71, 0x09, 0x41, 0x48,
-	0x69, 0xf0, 0x29, 0x0a, 0xa2, 0x48, 0x07, 0xe6, 0x4f, 0x81, 0x40, 0x51, 0x82, 0x90, 0xa0, 0x39,
-	0xad, 0xcf, 0xc3, 0xf9, 0x64, 0xef, 0xce, 0xe9, 0x76, 0xce, 0xb1, 0xbf, 0x05, 0x05, 0x05, 0x25,
-	0x1f, 0x87, 0x32, 0x25, 0x25, 0xb2, 0xbf, 0x08, 0xda, 0xd9, 0x8d, 0x1d, 0x57, 0xb6, 0xde, 0xfb,
-	0xbd, 0x79, 0x33, 0xa7, 0x15, 0x47, 0x93, 0xc5, 0x0c, 0x92, 0x0a, 0xf2, 0xc2, 0x50, 0xb5, 0x48,
-	0x66, 0x27, 0x43, 0x20, 0x79, 0x92, 0x94, 0xb2, 0x92, 0xca, 0xf4, 0xcb, 0x0a, 0x09, 0xc3, 0x3d,
-	0xcb, 0xf4, 0x6f, 0x98, 0xbe, 0x67, 0x1e, 0xed, 0xe6, 0x98, 0x23, 0x13, 0x89, 0xfd, 0xe7, 0xe0,
-	0xa3, 0x9f, 0x4d, 0xd1, 0x3a, 0xe7, 0x74, 0xf8, 0x58, 0x88, 0x19, 0x12, 0xa4, 0x66, 0x2a, 0xcd,
-	0x38, 0xba, 0x73, 0x18, 0x1c, 0xb7, 0x2f, 0xda, 0x56, 0xb9, 0xb4, 0x42, 0xf8, 0x44, 0x74, 0xeb,
-	0x72, 0x8a, 0x72, 0xe4, 0x81, 0x26, 0x03, 0x1d, 0xa7, 0x39, 0xe4, 0xa9, 0xe8, 0x51, 0xa1, 0x00,
-	0x6b, 0xf2, 0xcc, 0x5d, 0x66, 0xba, 0x5e, 0x74, 0xd0, 0x33, 0xb1, 0xe3, 0xe7, 0x78, 0x39, 0x6a,
-	0x1d, 0x06, 0xc7, 0xcd, 0x8b, 0x9e, 0x53, 0x3f, 0x3b, 0xd1, 0xd6, 0x19, 0xc2, 0x4a, 0xe6, 0x90,
-	0x66, 0x68, 0x28, 0xba, 0xc7, 0x50, 0xc7, 0x6b, 0x03, 0x34, 0x14, 0x1e, 0x88, 0x8e, 0x06, 0xba,
-	0xc2, 0x6a, 0x92, 0x7e, 0x07, 0x88, 0xee, 0x73, 0x99, 0xf0, 0xd2, 0x3b, 0x00, 0x7b, 0x91, 0x92,
-	0xf3, 0xb4, 0xc4, 0x42, 0x93, 0x89, 0xda, 0x3c, 0xa1, 0xad, 0xe4, 0xfc, 0x9c, 0x85, 0xf0, 0x85,
-	0x78, 0x50, 0xeb, 0x21, 0xea, 0x51, 0xa1, 0xf3, 0xd4, 0x90, 0x9c, 0xd8, 0x5f, 0xbb, 0x54, 0x24,
-	0x18, 0xdd, 0x5d, 0xbb, 0x97, 0xce, 0xb4, 0xbb, 0x85, 0x67, 0xe2, 0xe1, 0x26, 0x35, 0x82, 0x29,
-	0xe4, 0x92, 0x0a, 0xd4, 0x2e, 0xd8, 0xe1, 0xe0, 0xfe, 0x1a, 0x78, 0xb3, 0xf6, 0x39, 0x7b, 0x2a,
-	0xf6, 0x2a, 0xb8, 0x95, 0xc9, 0x10, 0xa7, 0x23, 0xbc, 0xd2, 0x51, 0xd7, 0x15, 0xde, 0x36, 0x07,
-	0xde, 0x0b, 0x5f, 0x8a, 0xfd, 0xad, 0x90, 0x3d, 0x49, 0x2a, 0xac, 0x35, 0x45, 0x3d, 0x8e, 0x6d,
-	0xcd, 0xfc, 0x28, 0xe7, 0xaf, 0xd8, 0xb4, 0xe7, 0x65, 0xa8, 0x54, 0x61, 0x0c, 0x57, 0x8d, 0xa5,
-	0xce, 0xc1, 0x6d, 0xb9, 0xe3, 0xda, 0x36, 0xee, 0x80, 0x4d, 0xbb, 0xe2, 0x59, 0xf3, 0xd7, 0xef,
-	0x83, 0xc6, 0xeb, 0xf7, 0x7f, 0x96, 0x71, 0x70, 0xbd, 0x8c, 0x83, 0x7f, 0xcb, 0x38, 0xf8, 0xb1,
-	0x8a, 0x1b, 0xd7, 0xab, 0xb8, 0xf1, 0x77, 0x15, 0x37, 0xbe, 0x3d, 0xcf, 0x0b, 0x1a, 0xd7, 0xc3,
-	0x7e, 0x86, 0x2a, 0xf9, 0xf0, 0xf5, 0xcb, 0xdb, 0x4f, 0xee, 0x73, 0x27, 0xd9, 0x58, 0x16, 0x3a,
-	0x99, 0x6f, 0xde, 0x26, 0x2d, 0x4a, 0x30, 0xc3, 0x16, 0x3f, 0xb3, 0xd3, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x32, 0xec, 0x3d, 0xfd, 0xb9, 0x02, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x94, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0x13, 0xb5, 0xb7, 0xbd, 0x99, 0xb4, 0xbd, 0xbd, 0xa6, 0x4d, 0xa7, 0x05, 0xd2, 0x52,
+	0x84, 0xd4, 0x05, 0x24, 0xaa, 0x8a, 0x10, 0x14, 0x81, 0x94, 0x86, 0x3f, 0x12, 0xa8, 0x28, 0x4a,
+	0x2a, 0x10, 0x6c, 0x46, 0x93, 0xf8, 0xc4, 0xb6, 0x32, 0xf6, 0xb1, 0x66, 0xc6, 0x8d, 0xf3, 0x16,
+	0x2c, 0x59, 0xf2, 0x38, 0x2c, 0xbb, 0x64, 0x89, 0xda, 0x87, 0x60, 0x8b, 0x66, 0xc6, 0x49, 0x5a,
+	0x56, 0x89, 0xbe, 0xef, 0xf7, 0x9d, 0x73, 0xe6, 0x78, 0x6c, 0xb2, 0x3f, 0x9a, 0x9c, 0x43, 0x53,
+	0x42, 0x10, 0x29, 0x2d, 0x27, 0xcd, 0xf3, 0xc3, 0x3e, 0x68, 0x7e, 0xd8, 0x4c, 0xb9, 0xe4, 0xb1,
+	0x6a, 0xa4, 0x12, 0x35, 0x7a, 0x9b, 0x86, 0x69, 0x4c, 0x99, 0x46, 0xc1, 0xec, 0x6c, 0x04, 0x18,
+	0xa0, 0x25, 0x9a, 0xe6, 0x9f, 0x83, 0xf7, 0x7f, 0x2f, 0x93, 0xa5, 0x8e, 0x4d, 0x7b, 0x77, 0x09,
+	0x39, 0x47, 0x0d, 0x4c, 0x09, 0xae, 0x42, 0xba, 0xb0, 0x57, 0x3e, 0xa8, 0x74, 0x2b, 0x46, 0xe9,
+	0x19, 0xc1, 0xbb, 0x47, 0x56, 0xb2, 0x54, 0x20, 0xf7, 0x0b, 0x60, 0xd1, 0x02, 0x55, 0xa7, 0x39,
+	0xe4, 0x3e, 0x59, 0xd5, 0x51, 0x0c, 0x98, 0xe9, 0x82, 0xf9, 0xc7, 0x32, 0x2b, 0x85, 0xe8, 0xa0,
+	0x07, 0x64, 0xad, 0xa8, 0x53, 0xc8, 0x74, 0x69, 0xaf, 0x7c, 0xb0, 0xd8, 0x5d, 0x75, 0xea, 0x99,
+	0x13, 0x4d, 0x3b, 0xa5, 0x51, 0xf2, 0x00, 0xd8, 0x00, 0x95, 0xa6, 0xcb, 0x16, 0xaa, 0x16, 0x5a,
+	0x1b, 0x95, 0xf6, 0x76, 0x49, 0x35, 0x01, 0x3d, 0x46, 0x39, 0x62, 0x43, 0x00, 0xfa, 0xaf, 0x6d,
+	0x46, 0x0a, 0xe9, 0x0d, 0x80, 0x39, 0x51, 0xcc, 0x73, 0x96, 0x62, 0x94, 0x68, 0x45, 0x2b, 0xb6,
+	0x42, 0x25, 0xe6, 0x79, 0xc7, 0x0a, 0xde, 0x63, 0x52, 0xcb, 0x92, 0x3e, 0x26, 0x7e, 0x94, 0x04,
+	0x4c, 0x69, 0x3e, 0x32, 0xbf, 0x66, 0x28, 0x4a, 0x2c, 0xba, 0x31, 0x73, 0x7b, 0xce, 0x34, 0xb3,
+	0x79, 0xc7, 0x64, 0x7b, 0x9e, 0xf2, 0x41, 0x40, 0xc0, 0x75, 0x84, 0x89, 0x0b, 0x56, 0x6d, 0x70,
+	0x6b, 0x06, 0xbc, 0x9a, 0xf9, 0x36, 0x7b, 0x44, 0x36, 0x25, 0x5c, 0xcb, 0x0c, 0x10, 0x85, 0x8f,
+	0xe3, 0x84, 0xae, 0xb8, 0x86, 0xd7, 0xcd, 0x76, 0xe1, 0x79, 0x4f, 0xc8, 0xd6, 0x8d, 0x90, 0x39,
+	0x12, 0x8f, 0x31, 0x4b, 0x34, 0x5d, 0xb5, 0xb1, 0x1b, 0x35, 0x4f, 0x79, 0xde, 0xb2, 0xa6, 0x39,
+	0xde, 0x00, 0xe3, 0x38, 0x52, 0xca, 0xb6, 0x0a, 0x79, 0x12, 0x80, 0x9b, 0x72, 0xcd, 0x75, 0x9b,
+	0xbb, 0x6d, 0x6b, 0xda, 0x11, 0x9f, 0x12, 0x6a, 0x56, 0x01, 0x92, 0x69, 0xc9, 0x13, 0x35, 0x04,
+	0x39, 0x9f, 0xf2, 0x3f, 0x9b, 0xab, 0x39, 0xff, 0xac, 0xb0, 0x67, 0x73, 0xbe, 0x24, 0x77, 0xec,
+	0x68, 0x99, 0x46, 0x36, 0xc0, 0x38, 0xc5, 0x2c, 0xf1, 0x15, 0x4b, 0x41, 0xb2, 0xbe, 0xc0, 0xc1,
+	0x88, 0xae, 0xdb, 0x34, 0x8d, 0x79, 0xde, 0xca, 0x34, 0xb6, 0xa7, 0x44, 0x07, 0xe4, 0x89, 0xf1,
+	0xbd, 0xe7, 0x64, 0xc7, 0xe4, 0xc7, 0x91, 0x0e, 0x7d, 0xc9, 0xc7, 0x8c, 0x0b, 0xc1, 0x52, 0x54,
+	0x91, 0x39, 0x95, 0xa2, 0xff, 0xbb, 0xcd, 0xc6, 0x3c, 0xff, 0x54, 0x00, 0x2d, 0x21, 0x3a, 0x53,
+	0xdb, 0x7b, 0x47, 0xf6, 0x4d, 0xf8, 0xda, 0x9a, 0x14, 0x88, 0xa1, 0x7d, 0xaa, 0xc0, 0xe2, 0x4c,
+	0xe8, 0x28, 0x15, 0x40, 0x3d, 0x5b, 0xa4, 0x1e, 0xf3, 0x7c, 0xfe, 0x60, 0x7a, 0x20, 0x86, 0xe6,
+	0xf9, 0xc2, 0x69, 0x41, 0x79, 0xcf, 0xc8, 0xf6, 0x5f, 0xb5, 0x52, 0x44, 0xc1, 0x54, 0xc8, 0x25,
+	0xd0, 0x5b, 0xf6, 0x96, 0xd5, 0x6e, 0x94, 0xe8, 0x20, 0x8a, 0x9e, 0x71, 0xbd, 0x17, 0xe4, 0xb6,
+	0xbb, 0xc6, 0x20, 0x99, 0x44, 0x01, 0x4c, 0x8d, 0xa2, 0x74, 0xbe, 0xc0, 0x0d, 0xb7, 0x82, 0x29,
+	0xd2, 0x45, 0x01, 0xbd, 0x51, 0x94, 0xce, 0x56, 0xf8, 0x90, 0x78, 0xe6, 0x85, 0x93, 0x4c, 0xc2,
+	0x98, 0x4b, 0xbf, 0x68, 0xb9, 0x69, 0x5b, 0xae, 0x5b, 0xa7, 0x6b, 0x0d, 0xd7, 0xcc, 0x5c, 0x8c,
+	0x2c, 0x19, 0xf3, 0x09, 0xe3, 0x02, 0xa4, 0x66, 0x3a, 0x94, 0xa0, 0x42, 0x14, 0xbe, 0xa2, 0xb5,
+	0xbd, 0x05, 0x7b, 0x31, 0xac, 0xdd, 0x32, 0xee, 0xd9, 0xcc, 0x3c, 0x5e, 0xfc, 0xf6, 0x7d, 0xb7,
+	0x74, 0xf2, 0xf6, 0xc7, 0x65, 0xbd, 0x7c, 0x71, 0x59, 0x2f, 0xff, 0xba, 0xac, 0x97, 0xbf, 0x5e,
+	0xd5, 0x4b, 0x17, 0x57, 0xf5, 0xd2, 0xcf, 0xab, 0x7a, 0xe9, 0xcb, 0xa3, 0x20, 0xd2, 0x61, 0xd6,
+	0x6f, 0x0c, 0x30, 0x6e, 0xbe, 0xff, 0xfc, 0xf1, 0xf5, 0x07, 0xf7, 0x46, 0x35, 0x07, 0x21, 0x8f,
+	0x92, 0x66, 0x3e, 0xff, 0xfc, 0xe8, 0x49, 0x0a, 0xaa, 0xbf, 0x64, 0xbf, 0x24, 0x47, 0x7f, 0x06,
+	0x00, 0x27, 0x86, 0x9b, 0xc3, 0x9c, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -227,6 +320,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RunwayAlertThresholds) > 0 {
+		dAtA2 := make([]byte, len(m.RunwayAlertThresholds)*10)
+		var j1 int
+		for _, num := range m.RunwayAlertThresholds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.VoterRewardShare) > 0 {
+		i -= len(m.VoterRewardShare)
+		copy(dAtA[i:], m.VoterRewardShare)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VoterRewardShare)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.UploaderRoleSkipCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UploaderRoleSkipCooldown))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.MaxDelegationPoolShare) > 0 {
+		i -= len(m.MaxDelegationPoolShare)
+		copy(dAtA[i:], m.MaxDelegationPoolShare)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxDelegationPoolShare)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxDelegationSelfStakeMultiple != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDelegationSelfStakeMultiple))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxWithdrawAllPositions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWithdrawAllPositions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.StakerTransferCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StakerTransferCooldown))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.CommissionChangeTime != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.CommissionChangeTime))
 		i--
@@ -355,6 +519,36 @@ func (m *Params) Size() (n int) {
 	if m.CommissionChangeTime != 0 {
 		n += 1 + sovParams(uint64(m.CommissionChangeTime))
 	}
+	if m.StakerTransferCooldown != 0 {
+		n += 1 + sovParams(uint64(m.StakerTransferCooldown))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	if m.MaxWithdrawAllPositions != 0 {
+		n += 2 + sovParams(uint64(m.MaxWithdrawAllPositions))
+	}
+	if m.MaxDelegationSelfStakeMultiple != 0 {
+		n += 2 + sovParams(uint64(m.MaxDelegationSelfStakeMultiple))
+	}
+	l = len(m.MaxDelegationPoolShare)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.UploaderRoleSkipCooldown != 0 {
+		n += 2 + sovParams(uint64(m.UploaderRoleSkipCooldown))
+	}
+	l = len(m.VoterRewardShare)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.RunwayAlertThresholds) > 0 {
+		l = 0
+		for _, e := range m.RunwayAlertThresholds {
+			l += sovParams(uint64(e))
+		}
+		n += 2 + sovParams(uint64(l)) + l
+	}
 	return n
 }
 
@@ -673,6 +867,241 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerTransferCooldown", wireType)
+			}
+			m.StakerTransferCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakerTransferCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawAllPositions", wireType)
+			}
+			m.MaxWithdrawAllPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawAllPositions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationSelfStakeMultiple", wireType)
+			}
+			m.MaxDelegationSelfStakeMultiple = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationSelfStakeMultiple |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationPoolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDelegationPoolShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderRoleSkipCooldown", wireType)
+			}
+			m.UploaderRoleSkipCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderRoleSkipCooldown |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterRewardShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterRewardShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RunwayAlertThresholds = append(m.RunwayAlertThresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RunwayAlertThresholds) == 0 {
+					m.RunwayAlertThresholds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RunwayAlertThresholds = append(m.RunwayAlertThresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayAlertThresholds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ProtocolFunderName is the name the address of the protocol funder is derived from.
+// The protocol funder holds the allocations of public-good pools from inflation and the community pool.
+const ProtocolFunderName = "registry-protocol-funder"
+
+// ProtocolFunderAddress returns the address the protocol funder is listed with in the funders of a pool.
+func ProtocolFunderAddress() string {
+	return authtypes.NewModuleAddress(ProtocolFunderName).String()
+}
+
+// ParseInflationShare parses the inflation share of a protocol funding, an empty share is zero.
+func ParseInflationShare(share string) (sdk.Dec, error) {
+	if share == "" {
+		return sdk.ZeroDec(), nil
+	}
+
+	parsed, err := sdk.NewDecFromStr(share)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid inflation share %v", share)
+	}
+
+	if parsed.IsNegative() || parsed.GT(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("inflation share %v has to be between 0 and 1", share)
+	}
+
+	return parsed, nil
+}
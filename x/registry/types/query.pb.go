@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -333,24 +334,24 @@ func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryFundersListRequest is the request type for the Query/FundersList RPC method.
-type QueryFundersListRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+// QueryStorageProviderRequest is the request type for the Query/StorageProvider RPC method.
+type QueryStorageProviderRequest struct {
+	// id defines the unique ID of the storage provider.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryFundersListRequest) Reset()         { *m = QueryFundersListRequest{} }
-func (m *QueryFundersListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFundersListRequest) ProtoMessage()    {}
-func (*QueryFundersListRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStorageProviderRequest) Reset()         { *m = QueryStorageProviderRequest{} }
+func (m *QueryStorageProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderRequest) ProtoMessage()    {}
+func (*QueryStorageProviderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{6}
 }
-func (m *QueryFundersListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFundersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFundersListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageProviderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -360,43 +361,43 @@ func (m *QueryFundersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryFundersListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFundersListRequest.Merge(m, src)
+func (m *QueryStorageProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderRequest.Merge(m, src)
 }
-func (m *QueryFundersListRequest) XXX_Size() int {
+func (m *QueryStorageProviderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFundersListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFundersListRequest.DiscardUnknown(m)
+func (m *QueryStorageProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFundersListRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageProviderRequest proto.InternalMessageInfo
 
-func (m *QueryFundersListRequest) GetPoolId() uint64 {
+func (m *QueryStorageProviderRequest) GetId() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.Id
 	}
 	return 0
 }
 
-// QueryFundersListResponse is the response type for the Query/FundersList RPC method.
-type QueryFundersListResponse struct {
-	// funders ...
-	Funders []*Funder `protobuf:"bytes,1,rep,name=funders,proto3" json:"funders,omitempty"`
+// QueryStorageProviderResponse is the response type for the Query/StorageProvider RPC method.
+type QueryStorageProviderResponse struct {
+	// storage_provider ...
+	StorageProvider StorageProvider `protobuf:"bytes,1,opt,name=storage_provider,json=storageProvider,proto3" json:"storage_provider"`
 }
 
-func (m *QueryFundersListResponse) Reset()         { *m = QueryFundersListResponse{} }
-func (m *QueryFundersListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFundersListResponse) ProtoMessage()    {}
-func (*QueryFundersListResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStorageProviderResponse) Reset()         { *m = QueryStorageProviderResponse{} }
+func (m *QueryStorageProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProviderResponse) ProtoMessage()    {}
+func (*QueryStorageProviderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{7}
 }
-func (m *QueryFundersListResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFundersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFundersListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageProviderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -406,45 +407,41 @@ func (m *QueryFundersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryFundersListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFundersListResponse.Merge(m, src)
+func (m *QueryStorageProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProviderResponse.Merge(m, src)
 }
-func (m *QueryFundersListResponse) XXX_Size() int {
+func (m *QueryStorageProviderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFundersListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFundersListResponse.DiscardUnknown(m)
+func (m *QueryStorageProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProviderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFundersListResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageProviderResponse proto.InternalMessageInfo
 
-func (m *QueryFundersListResponse) GetFunders() []*Funder {
+func (m *QueryStorageProviderResponse) GetStorageProvider() StorageProvider {
 	if m != nil {
-		return m.Funders
+		return m.StorageProvider
 	}
-	return nil
+	return StorageProvider{}
 }
 
-// QueryFunderRequest is the request type for the Query/Funder RPC method.
-type QueryFunderRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// funder
-	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+// QueryStorageProvidersRequest is the request type for the Query/StorageProviders RPC method.
+type QueryStorageProvidersRequest struct {
 }
 
-func (m *QueryFunderRequest) Reset()         { *m = QueryFunderRequest{} }
-func (m *QueryFunderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFunderRequest) ProtoMessage()    {}
-func (*QueryFunderRequest) Descriptor() ([]byte, []int) {
+func (m *QueryStorageProvidersRequest) Reset()         { *m = QueryStorageProvidersRequest{} }
+func (m *QueryStorageProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProvidersRequest) ProtoMessage()    {}
+func (*QueryStorageProvidersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{8}
 }
-func (m *QueryFunderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFunderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageProvidersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -454,50 +451,36 @@ func (m *QueryFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryFunderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFunderRequest.Merge(m, src)
+func (m *QueryStorageProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProvidersRequest.Merge(m, src)
 }
-func (m *QueryFunderRequest) XXX_Size() int {
+func (m *QueryStorageProvidersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFunderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFunderRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryFunderRequest proto.InternalMessageInfo
-
-func (m *QueryFunderRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+func (m *QueryStorageProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFunderRequest) GetFunder() string {
-	if m != nil {
-		return m.Funder
-	}
-	return ""
-}
+var xxx_messageInfo_QueryStorageProvidersRequest proto.InternalMessageInfo
 
-// QueryFunderResponse is the response type for the Query/Funder RPC method.
-type QueryFunderResponse struct {
-	// funder ...
-	Funder *Funder `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+// QueryStorageProvidersResponse is the response type for the Query/StorageProviders RPC method.
+type QueryStorageProvidersResponse struct {
+	// storage_providers ...
+	StorageProviders []StorageProvider `protobuf:"bytes,1,rep,name=storage_providers,json=storageProviders,proto3" json:"storage_providers"`
 }
 
-func (m *QueryFunderResponse) Reset()         { *m = QueryFunderResponse{} }
-func (m *QueryFunderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFunderResponse) ProtoMessage()    {}
-func (*QueryFunderResponse) Descriptor() ([]byte, []int) {
+func (m *QueryStorageProvidersResponse) Reset()         { *m = QueryStorageProvidersResponse{} }
+func (m *QueryStorageProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageProvidersResponse) ProtoMessage()    {}
+func (*QueryStorageProvidersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{9}
 }
-func (m *QueryFunderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFunderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageProvidersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -507,47 +490,43 @@ func (m *QueryFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryFunderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFunderResponse.Merge(m, src)
+func (m *QueryStorageProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageProvidersResponse.Merge(m, src)
 }
-func (m *QueryFunderResponse) XXX_Size() int {
+func (m *QueryStorageProvidersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFunderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFunderResponse.DiscardUnknown(m)
+func (m *QueryStorageProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageProvidersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFunderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageProvidersResponse proto.InternalMessageInfo
 
-func (m *QueryFunderResponse) GetFunder() *Funder {
+func (m *QueryStorageProvidersResponse) GetStorageProviders() []StorageProvider {
 	if m != nil {
-		return m.Funder
+		return m.StorageProviders
 	}
 	return nil
 }
 
-// QueryStakersListRequest is the request type for the Query/StakersList RPC method.
-type QueryStakersListRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// status ...
-	Status StakerStatus `protobuf:"varint,2,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
-	// pagination ...
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryRuntimeRequest is the request type for the Query/Runtime RPC method.
+type QueryRuntimeRequest struct {
+	// name defines the unique name of the runtime.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueryStakersListRequest) Reset()         { *m = QueryStakersListRequest{} }
-func (m *QueryStakersListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakersListRequest) ProtoMessage()    {}
-func (*QueryStakersListRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimeRequest) Reset()         { *m = QueryRuntimeRequest{} }
+func (m *QueryRuntimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeRequest) ProtoMessage()    {}
+func (*QueryRuntimeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{10}
 }
-func (m *QueryStakersListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakersListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -557,59 +536,43 @@ func (m *QueryStakersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryStakersListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakersListRequest.Merge(m, src)
+func (m *QueryRuntimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeRequest.Merge(m, src)
 }
-func (m *QueryStakersListRequest) XXX_Size() int {
+func (m *QueryRuntimeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakersListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakersListRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryStakersListRequest proto.InternalMessageInfo
-
-func (m *QueryStakersListRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+func (m *QueryRuntimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakersListRequest) GetStatus() StakerStatus {
-	if m != nil {
-		return m.Status
-	}
-	return STAKER_STATUS_UNSPECIFIED
-}
+var xxx_messageInfo_QueryRuntimeRequest proto.InternalMessageInfo
 
-func (m *QueryStakersListRequest) GetPagination() *query.PageRequest {
+func (m *QueryRuntimeRequest) GetName() string {
 	if m != nil {
-		return m.Pagination
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-// QueryStakersListResponse is the response type for the Query/StakersList RPC method.
-type QueryStakersListResponse struct {
-	// stakers ...
-	Stakers []*StakerResponse `protobuf:"bytes,1,rep,name=stakers,proto3" json:"stakers,omitempty"`
-	// pagination ...
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryRuntimeResponse is the response type for the Query/Runtime RPC method.
+type QueryRuntimeResponse struct {
+	// runtime ...
+	Runtime Runtime `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime"`
 }
 
-func (m *QueryStakersListResponse) Reset()         { *m = QueryStakersListResponse{} }
-func (m *QueryStakersListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakersListResponse) ProtoMessage()    {}
-func (*QueryStakersListResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimeResponse) Reset()         { *m = QueryRuntimeResponse{} }
+func (m *QueryRuntimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeResponse) ProtoMessage()    {}
+func (*QueryRuntimeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{11}
 }
-func (m *QueryStakersListResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakersListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -619,52 +582,41 @@ func (m *QueryStakersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryStakersListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakersListResponse.Merge(m, src)
+func (m *QueryRuntimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeResponse.Merge(m, src)
 }
-func (m *QueryStakersListResponse) XXX_Size() int {
+func (m *QueryRuntimeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakersListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakersListResponse.DiscardUnknown(m)
+func (m *QueryRuntimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakersListResponse proto.InternalMessageInfo
-
-func (m *QueryStakersListResponse) GetStakers() []*StakerResponse {
-	if m != nil {
-		return m.Stakers
-	}
-	return nil
-}
+var xxx_messageInfo_QueryRuntimeResponse proto.InternalMessageInfo
 
-func (m *QueryStakersListResponse) GetPagination() *query.PageResponse {
+func (m *QueryRuntimeResponse) GetRuntime() Runtime {
 	if m != nil {
-		return m.Pagination
+		return m.Runtime
 	}
-	return nil
+	return Runtime{}
 }
 
-// QueryStakerRequest is the request type for the Query/Staker RPC method.
-type QueryStakerRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// staker ...
-	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+// QueryRuntimesRequest is the request type for the Query/Runtimes RPC method.
+type QueryRuntimesRequest struct {
 }
 
-func (m *QueryStakerRequest) Reset()         { *m = QueryStakerRequest{} }
-func (m *QueryStakerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakerRequest) ProtoMessage()    {}
-func (*QueryStakerRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimesRequest) Reset()         { *m = QueryRuntimesRequest{} }
+func (m *QueryRuntimesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimesRequest) ProtoMessage()    {}
+func (*QueryRuntimesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{12}
 }
-func (m *QueryStakerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -674,50 +626,36 @@ func (m *QueryStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryStakerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakerRequest.Merge(m, src)
+func (m *QueryRuntimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimesRequest.Merge(m, src)
 }
-func (m *QueryStakerRequest) XXX_Size() int {
+func (m *QueryRuntimesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakerRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryStakerRequest proto.InternalMessageInfo
-
-func (m *QueryStakerRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+func (m *QueryRuntimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakerRequest) GetStaker() string {
-	if m != nil {
-		return m.Staker
-	}
-	return ""
-}
+var xxx_messageInfo_QueryRuntimesRequest proto.InternalMessageInfo
 
-// QueryStakerResponse is the response type for the Query/Staker RPC method.
-type QueryStakerResponse struct {
-	// staker ...
-	Staker *StakerResponse `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+// QueryRuntimesResponse is the response type for the Query/Runtimes RPC method.
+type QueryRuntimesResponse struct {
+	// runtimes ...
+	Runtimes []Runtime `protobuf:"bytes,1,rep,name=runtimes,proto3" json:"runtimes"`
 }
 
-func (m *QueryStakerResponse) Reset()         { *m = QueryStakerResponse{} }
-func (m *QueryStakerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakerResponse) ProtoMessage()    {}
-func (*QueryStakerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimesResponse) Reset()         { *m = QueryRuntimesResponse{} }
+func (m *QueryRuntimesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimesResponse) ProtoMessage()    {}
+func (*QueryRuntimesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{13}
 }
-func (m *QueryStakerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -727,47 +665,45 @@ func (m *QueryStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryStakerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakerResponse.Merge(m, src)
+func (m *QueryRuntimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimesResponse.Merge(m, src)
 }
-func (m *QueryStakerResponse) XXX_Size() int {
+func (m *QueryRuntimesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakerResponse.DiscardUnknown(m)
+func (m *QueryRuntimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRuntimesResponse proto.InternalMessageInfo
 
-func (m *QueryStakerResponse) GetStaker() *StakerResponse {
+func (m *QueryRuntimesResponse) GetRuntimes() []Runtime {
 	if m != nil {
-		return m.Staker
+		return m.Runtimes
 	}
 	return nil
 }
 
-// PendingCommissionChange ...
-type PendingCommissionChange struct {
-	// new_commission ...
-	NewCommission string `protobuf:"bytes,1,opt,name=new_commission,json=newCommission,proto3" json:"new_commission,omitempty"`
-	// creation_date ...
-	CreationDate int64 `protobuf:"varint,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	// finish_date ...
-	FinishDate int64 `protobuf:"varint,3,opt,name=finish_date,json=finishDate,proto3" json:"finish_date,omitempty"`
+// QueryRuntimeVersionRequest is the request type for the Query/RuntimeVersion RPC method.
+type QueryRuntimeVersionRequest struct {
+	// runtime defines the unique name of the runtime.
+	Runtime string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// version ...
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
-func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
-func (*PendingCommissionChange) ProtoMessage()    {}
-func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimeVersionRequest) Reset()         { *m = QueryRuntimeVersionRequest{} }
+func (m *QueryRuntimeVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeVersionRequest) ProtoMessage()    {}
+func (*QueryRuntimeVersionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{14}
 }
-func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimeVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimeVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimeVersionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -777,83 +713,50 @@ func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+func (m *QueryRuntimeVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeVersionRequest.Merge(m, src)
 }
-func (m *PendingCommissionChange) XXX_Size() int {
+func (m *QueryRuntimeVersionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *PendingCommissionChange) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+func (m *QueryRuntimeVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeVersionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+var xxx_messageInfo_QueryRuntimeVersionRequest proto.InternalMessageInfo
 
-func (m *PendingCommissionChange) GetNewCommission() string {
+func (m *QueryRuntimeVersionRequest) GetRuntime() string {
 	if m != nil {
-		return m.NewCommission
+		return m.Runtime
 	}
 	return ""
 }
 
-func (m *PendingCommissionChange) GetCreationDate() int64 {
+func (m *QueryRuntimeVersionRequest) GetVersion() string {
 	if m != nil {
-		return m.CreationDate
+		return m.Version
 	}
-	return 0
+	return ""
 }
 
-func (m *PendingCommissionChange) GetFinishDate() int64 {
-	if m != nil {
-		return m.FinishDate
-	}
-	return 0
+// QueryRuntimeVersionResponse is the response type for the Query/RuntimeVersion RPC method.
+type QueryRuntimeVersionResponse struct {
+	// runtime_version ...
+	RuntimeVersion RuntimeVersion `protobuf:"bytes,1,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version"`
 }
 
-// StakerResponse ...
-type StakerResponse struct {
-	// staker ...
-	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// account ...
-	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// total_delegation ...
-	TotalDelegation uint64 `protobuf:"varint,5,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
-	// commission ...
-	Commission string `protobuf:"bytes,6,opt,name=commission,proto3" json:"commission,omitempty"`
-	// moniker ...
-	Moniker string `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// website ...
-	Website string `protobuf:"bytes,8,opt,name=website,proto3" json:"website,omitempty"`
-	// logo
-	Logo string `protobuf:"bytes,9,opt,name=logo,proto3" json:"logo,omitempty"`
-	// points
-	Points uint64 `protobuf:"varint,10,opt,name=points,proto3" json:"points,omitempty"`
-	// unbonding_amount ...
-	UnbondingAmount uint64 `protobuf:"varint,11,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount,omitempty"`
-	// upload_probability
-	UploadProbability string `protobuf:"bytes,12,opt,name=upload_probability,json=uploadProbability,proto3" json:"upload_probability,omitempty"`
-	// status
-	Status StakerStatus `protobuf:"varint,13,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
-	// pending_commission_change
-	PendingCommissionChange *PendingCommissionChange `protobuf:"bytes,14,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
-}
-
-func (m *StakerResponse) Reset()         { *m = StakerResponse{} }
-func (m *StakerResponse) String() string { return proto.CompactTextString(m) }
-func (*StakerResponse) ProtoMessage()    {}
-func (*StakerResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRuntimeVersionResponse) Reset()         { *m = QueryRuntimeVersionResponse{} }
+func (m *QueryRuntimeVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRuntimeVersionResponse) ProtoMessage()    {}
+func (*QueryRuntimeVersionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5c3b23aaee0836da, []int{15}
 }
-func (m *StakerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRuntimeVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRuntimeVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StakerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRuntimeVersionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -863,134 +766,214 @@ func (m *StakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *StakerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StakerResponse.Merge(m, src)
+func (m *QueryRuntimeVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRuntimeVersionResponse.Merge(m, src)
 }
-func (m *StakerResponse) XXX_Size() int {
+func (m *QueryRuntimeVersionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StakerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StakerResponse.DiscardUnknown(m)
+func (m *QueryRuntimeVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRuntimeVersionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StakerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRuntimeVersionResponse proto.InternalMessageInfo
 
-func (m *StakerResponse) GetStaker() string {
+func (m *QueryRuntimeVersionResponse) GetRuntimeVersion() RuntimeVersion {
 	if m != nil {
-		return m.Staker
+		return m.RuntimeVersion
 	}
-	return ""
+	return RuntimeVersion{}
 }
 
-func (m *StakerResponse) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
+// QueryUpgradeReadinessRequest is the request type for the Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *StakerResponse) GetAccount() string {
-	if m != nil {
-		return m.Account
-	}
-	return ""
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{16}
 }
-
-func (m *StakerResponse) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
 }
 
-func (m *StakerResponse) GetTotalDelegation() uint64 {
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.TotalDelegation
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *StakerResponse) GetCommission() string {
-	if m != nil {
-		return m.Commission
-	}
-	return ""
+// QueryUpgradeReadinessResponse is the response type for the Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// target_version is the version of the scheduled upgrade or the current protocol version otherwise.
+	TargetVersion string `protobuf:"bytes,1,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
+	// stakers ...
+	Stakers []StakerReadiness `protobuf:"bytes,2,rep,name=stakers,proto3" json:"stakers"`
+	// ready_stake is the stake of all stakers running at least the target version.
+	ReadyStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=ready_stake,json=readyStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ready_stake"`
+	// total_stake ...
+	TotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_stake,json=totalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stake"`
 }
 
-func (m *StakerResponse) GetMoniker() string {
-	if m != nil {
-		return m.Moniker
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{17}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
 }
 
-func (m *StakerResponse) GetWebsite() string {
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetTargetVersion() string {
 	if m != nil {
-		return m.Website
+		return m.TargetVersion
 	}
 	return ""
 }
 
-func (m *StakerResponse) GetLogo() string {
+func (m *QueryUpgradeReadinessResponse) GetStakers() []StakerReadiness {
 	if m != nil {
-		return m.Logo
+		return m.Stakers
 	}
-	return ""
+	return nil
 }
 
-func (m *StakerResponse) GetPoints() uint64 {
-	if m != nil {
-		return m.Points
-	}
-	return 0
+// StakerReadiness is the attested version of a single staker.
+type StakerReadiness struct {
+	// account ...
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// version ...
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// ready is true if the staker runs at least the target version.
+	Ready bool `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-func (m *StakerResponse) GetUnbondingAmount() uint64 {
-	if m != nil {
-		return m.UnbondingAmount
+func (m *StakerReadiness) Reset()         { *m = StakerReadiness{} }
+func (m *StakerReadiness) String() string { return proto.CompactTextString(m) }
+func (*StakerReadiness) ProtoMessage()    {}
+func (*StakerReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{18}
+}
+func (m *StakerReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *StakerReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerReadiness.Merge(m, src)
+}
+func (m *StakerReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerReadiness.DiscardUnknown(m)
 }
 
-func (m *StakerResponse) GetUploadProbability() string {
+var xxx_messageInfo_StakerReadiness proto.InternalMessageInfo
+
+func (m *StakerReadiness) GetAccount() string {
 	if m != nil {
-		return m.UploadProbability
+		return m.Account
 	}
 	return ""
 }
 
-func (m *StakerResponse) GetStatus() StakerStatus {
+func (m *StakerReadiness) GetVersion() string {
 	if m != nil {
-		return m.Status
+		return m.Version
 	}
-	return STAKER_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (m *StakerResponse) GetPendingCommissionChange() *PendingCommissionChange {
+func (m *StakerReadiness) GetReady() bool {
 	if m != nil {
-		return m.PendingCommissionChange
+		return m.Ready
 	}
-	return nil
+	return false
 }
 
-// QueryVoteStatusRequest is the request type for the Query/VoteStatus RPC method.
-type QueryVoteStatusRequest struct {
+// QueryFundingStreamsRequest is the request type for the Query/FundingStreams RPC method.
+type QueryFundingStreamsRequest struct {
 	// pool_id defines the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryVoteStatusRequest) Reset()         { *m = QueryVoteStatusRequest{} }
-func (m *QueryVoteStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteStatusRequest) ProtoMessage()    {}
-func (*QueryVoteStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{16}
+func (m *QueryFundingStreamsRequest) Reset()         { *m = QueryFundingStreamsRequest{} }
+func (m *QueryFundingStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsRequest) ProtoMessage()    {}
+func (*QueryFundingStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{19}
 }
-func (m *QueryVoteStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFundingStreamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFundingStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFundingStreamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1000,43 +983,43 @@ func (m *QueryVoteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteStatusRequest.Merge(m, src)
+func (m *QueryFundingStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsRequest.Merge(m, src)
 }
-func (m *QueryVoteStatusRequest) XXX_Size() int {
+func (m *QueryFundingStreamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteStatusRequest.DiscardUnknown(m)
+func (m *QueryFundingStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFundingStreamsRequest proto.InternalMessageInfo
 
-func (m *QueryVoteStatusRequest) GetPoolId() uint64 {
+func (m *QueryFundingStreamsRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-// QueryVoteStatusResponse is the response type for the Query/VoteStatus RPC method.
-type QueryVoteStatusResponse struct {
-	// staker ...
-	VoteStatus *VoteStatusResponse `protobuf:"bytes,1,opt,name=vote_status,json=voteStatus,proto3" json:"vote_status,omitempty"`
+// QueryFundingStreamsResponse is the response type for the Query/FundingStreams RPC method.
+type QueryFundingStreamsResponse struct {
+	// funding_streams ...
+	FundingStreams []FundingStreamStatus `protobuf:"bytes,1,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams"`
 }
 
-func (m *QueryVoteStatusResponse) Reset()         { *m = QueryVoteStatusResponse{} }
-func (m *QueryVoteStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryVoteStatusResponse) ProtoMessage()    {}
-func (*QueryVoteStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{17}
+func (m *QueryFundingStreamsResponse) Reset()         { *m = QueryFundingStreamsResponse{} }
+func (m *QueryFundingStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingStreamsResponse) ProtoMessage()    {}
+func (*QueryFundingStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{20}
 }
-func (m *QueryVoteStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFundingStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryVoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFundingStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryVoteStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFundingStreamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1046,49 +1029,47 @@ func (m *QueryVoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryVoteStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryVoteStatusResponse.Merge(m, src)
+func (m *QueryFundingStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingStreamsResponse.Merge(m, src)
 }
-func (m *QueryVoteStatusResponse) XXX_Size() int {
+func (m *QueryFundingStreamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryVoteStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryVoteStatusResponse.DiscardUnknown(m)
+func (m *QueryFundingStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingStreamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryVoteStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFundingStreamsResponse proto.InternalMessageInfo
 
-func (m *QueryVoteStatusResponse) GetVoteStatus() *VoteStatusResponse {
+func (m *QueryFundingStreamsResponse) GetFundingStreams() []FundingStreamStatus {
 	if m != nil {
-		return m.VoteStatus
+		return m.FundingStreams
 	}
 	return nil
 }
 
-// VoteStatusResponse ...
-type VoteStatusResponse struct {
-	// valid ...
-	Valid uint64 `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	// invalid ...
-	Invalid uint64 `protobuf:"varint,2,opt,name=invalid,proto3" json:"invalid,omitempty"`
-	// abstain ...
-	Abstain uint64 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
-	// total ...
-	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+// FundingStreamStatus is a funding stream with its projected spending.
+type FundingStreamStatus struct {
+	// funding_stream ...
+	FundingStream FundingStream `protobuf:"bytes,1,opt,name=funding_stream,json=fundingStream,proto3" json:"funding_stream"`
+	// spend_per_day is the projected amount spent per day based on the recent bundle rewards of the pool.
+	SpendPerDay github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=spend_per_day,json=spendPerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spend_per_day"`
+	// runway is the projected number of seconds until the budget is used up or the stream ends.
+	Runway uint64 `protobuf:"varint,3,opt,name=runway,proto3" json:"runway,omitempty"`
 }
 
-func (m *VoteStatusResponse) Reset()         { *m = VoteStatusResponse{} }
-func (m *VoteStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*VoteStatusResponse) ProtoMessage()    {}
-func (*VoteStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{18}
+func (m *FundingStreamStatus) Reset()         { *m = FundingStreamStatus{} }
+func (m *FundingStreamStatus) String() string { return proto.CompactTextString(m) }
+func (*FundingStreamStatus) ProtoMessage()    {}
+func (*FundingStreamStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{21}
 }
-func (m *VoteStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *FundingStreamStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FundingStreamStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VoteStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FundingStreamStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1098,64 +1079,48 @@ func (m *VoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *VoteStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VoteStatusResponse.Merge(m, src)
+func (m *FundingStreamStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStreamStatus.Merge(m, src)
 }
-func (m *VoteStatusResponse) XXX_Size() int {
+func (m *FundingStreamStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *VoteStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_VoteStatusResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VoteStatusResponse proto.InternalMessageInfo
-
-func (m *VoteStatusResponse) GetValid() uint64 {
-	if m != nil {
-		return m.Valid
-	}
-	return 0
+func (m *FundingStreamStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStreamStatus.DiscardUnknown(m)
 }
 
-func (m *VoteStatusResponse) GetInvalid() uint64 {
-	if m != nil {
-		return m.Invalid
-	}
-	return 0
-}
+var xxx_messageInfo_FundingStreamStatus proto.InternalMessageInfo
 
-func (m *VoteStatusResponse) GetAbstain() uint64 {
+func (m *FundingStreamStatus) GetFundingStream() FundingStream {
 	if m != nil {
-		return m.Abstain
+		return m.FundingStream
 	}
-	return 0
+	return FundingStream{}
 }
 
-func (m *VoteStatusResponse) GetTotal() uint64 {
+func (m *FundingStreamStatus) GetRunway() uint64 {
 	if m != nil {
-		return m.Total
+		return m.Runway
 	}
 	return 0
 }
 
-// QueryProposalRequest is the request type for the Query/Proposal RPC method.
-type QueryProposalRequest struct {
-	// storage_id ...
-	StorageId string `protobuf:"bytes,1,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+// QueryProtocolFundingsRequest is the request type for the Query/ProtocolFundings RPC method.
+type QueryProtocolFundingsRequest struct {
 }
 
-func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
-func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalRequest) ProtoMessage()    {}
-func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{19}
+func (m *QueryProtocolFundingsRequest) Reset()         { *m = QueryProtocolFundingsRequest{} }
+func (m *QueryProtocolFundingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFundingsRequest) ProtoMessage()    {}
+func (*QueryProtocolFundingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{22}
 }
-func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProtocolFundingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProtocolFundingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProtocolFundingsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1165,43 +1130,36 @@ func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
+func (m *QueryProtocolFundingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFundingsRequest.Merge(m, src)
 }
-func (m *QueryProposalRequest) XXX_Size() int {
+func (m *QueryProtocolFundingsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
+func (m *QueryProtocolFundingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFundingsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
-
-func (m *QueryProposalRequest) GetStorageId() string {
-	if m != nil {
-		return m.StorageId
-	}
-	return ""
-}
+var xxx_messageInfo_QueryProtocolFundingsRequest proto.InternalMessageInfo
 
-// QueryProposalResponse is the response type for the Query/Proposal RPC method.
-type QueryProposalResponse struct {
-	// proposal ...
-	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+// QueryProtocolFundingsResponse is the response type for the Query/ProtocolFundings RPC method.
+type QueryProtocolFundingsResponse struct {
+	// protocol_fundings ...
+	ProtocolFundings []ProtocolFunding `protobuf:"bytes,1,rep,name=protocol_fundings,json=protocolFundings,proto3" json:"protocol_fundings"`
 }
 
-func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
-func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalResponse) ProtoMessage()    {}
-func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{20}
+func (m *QueryProtocolFundingsResponse) Reset()         { *m = QueryProtocolFundingsResponse{} }
+func (m *QueryProtocolFundingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFundingsResponse) ProtoMessage()    {}
+func (*QueryProtocolFundingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{23}
 }
-func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProtocolFundingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProtocolFundingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProtocolFundingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1211,45 +1169,43 @@ func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
+func (m *QueryProtocolFundingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFundingsResponse.Merge(m, src)
 }
-func (m *QueryProposalResponse) XXX_Size() int {
+func (m *QueryProtocolFundingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
+func (m *QueryProtocolFundingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFundingsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProtocolFundingsResponse proto.InternalMessageInfo
 
-func (m *QueryProposalResponse) GetProposal() Proposal {
+func (m *QueryProtocolFundingsResponse) GetProtocolFundings() []ProtocolFunding {
 	if m != nil {
-		return m.Proposal
+		return m.ProtocolFundings
 	}
-	return Proposal{}
+	return nil
 }
 
-// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
-type QueryProposalsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+// QueryFundersListRequest is the request type for the Query/FundersList RPC method.
+type QueryFundersListRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
-func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsRequest) ProtoMessage()    {}
-func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{21}
+func (m *QueryFundersListRequest) Reset()         { *m = QueryFundersListRequest{} }
+func (m *QueryFundersListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundersListRequest) ProtoMessage()    {}
+func (*QueryFundersListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{24}
 }
-func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFundersListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFundersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFundersListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1259,52 +1215,43 @@ func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
+func (m *QueryFundersListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundersListRequest.Merge(m, src)
 }
-func (m *QueryProposalsRequest) XXX_Size() int {
+func (m *QueryFundersListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
+func (m *QueryFundersListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundersListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
-
-func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryFundersListRequest proto.InternalMessageInfo
 
-func (m *QueryProposalsRequest) GetPoolId() uint64 {
+func (m *QueryFundersListRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-// QueryProposalsResponse is the response type for the Query/Proposals RPC method.
-type QueryProposalsResponse struct {
-	// proposals ...
-	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryFundersListResponse is the response type for the Query/FundersList RPC method.
+type QueryFundersListResponse struct {
+	// funders ...
+	Funders []*Funder `protobuf:"bytes,1,rep,name=funders,proto3" json:"funders,omitempty"`
 }
 
-func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
-func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalsResponse) ProtoMessage()    {}
-func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{22}
+func (m *QueryFundersListResponse) Reset()         { *m = QueryFundersListResponse{} }
+func (m *QueryFundersListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundersListResponse) ProtoMessage()    {}
+func (*QueryFundersListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{25}
 }
-func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFundersListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFundersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFundersListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1314,52 +1261,45 @@ func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+func (m *QueryFundersListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundersListResponse.Merge(m, src)
 }
-func (m *QueryProposalsResponse) XXX_Size() int {
+func (m *QueryFundersListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+func (m *QueryFundersListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundersListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
-
-func (m *QueryProposalsResponse) GetProposals() []Proposal {
-	if m != nil {
-		return m.Proposals
-	}
-	return nil
-}
+var xxx_messageInfo_QueryFundersListResponse proto.InternalMessageInfo
 
-func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
+func (m *QueryFundersListResponse) GetFunders() []*Funder {
 	if m != nil {
-		return m.Pagination
+		return m.Funders
 	}
 	return nil
 }
 
-// QueryProposalByHeightRequest is the request type for the Query/ProposalByHeight RPC method.
-type QueryProposalByHeightRequest struct {
-	// pool_id ...
+// QueryFunderRequest is the request type for the Query/Funder RPC method.
+type QueryFunderRequest struct {
+	// pool_id defines the unique ID of the pool.
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// height ...
-	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// funder
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
 }
 
-func (m *QueryProposalByHeightRequest) Reset()         { *m = QueryProposalByHeightRequest{} }
-func (m *QueryProposalByHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalByHeightRequest) ProtoMessage()    {}
-func (*QueryProposalByHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{23}
+func (m *QueryFunderRequest) Reset()         { *m = QueryFunderRequest{} }
+func (m *QueryFunderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderRequest) ProtoMessage()    {}
+func (*QueryFunderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{26}
 }
-func (m *QueryProposalByHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFunderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalByHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFunderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1369,50 +1309,50 @@ func (m *QueryProposalByHeightRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalByHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalByHeightRequest.Merge(m, src)
+func (m *QueryFunderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderRequest.Merge(m, src)
 }
-func (m *QueryProposalByHeightRequest) XXX_Size() int {
+func (m *QueryFunderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalByHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalByHeightRequest.DiscardUnknown(m)
+func (m *QueryFunderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalByHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFunderRequest proto.InternalMessageInfo
 
-func (m *QueryProposalByHeightRequest) GetPoolId() uint64 {
+func (m *QueryFunderRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryProposalByHeightRequest) GetHeight() uint64 {
+func (m *QueryFunderRequest) GetFunder() string {
 	if m != nil {
-		return m.Height
+		return m.Funder
 	}
-	return 0
+	return ""
 }
 
-// QueryProposalResponse is the response type for the Query/ProposalByHeight RPC method.
-type QueryProposalByHeightResponse struct {
-	// proposal ...
-	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+// QueryFunderResponse is the response type for the Query/Funder RPC method.
+type QueryFunderResponse struct {
+	// funder ...
+	Funder *Funder `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
 }
 
-func (m *QueryProposalByHeightResponse) Reset()         { *m = QueryProposalByHeightResponse{} }
-func (m *QueryProposalByHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalByHeightResponse) ProtoMessage()    {}
-func (*QueryProposalByHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{24}
+func (m *QueryFunderResponse) Reset()         { *m = QueryFunderResponse{} }
+func (m *QueryFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderResponse) ProtoMessage()    {}
+func (*QueryFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{27}
 }
-func (m *QueryProposalByHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFunderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalByHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFunderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1422,47 +1362,47 @@ func (m *QueryProposalByHeightResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalByHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalByHeightResponse.Merge(m, src)
+func (m *QueryFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderResponse.Merge(m, src)
 }
-func (m *QueryProposalByHeightResponse) XXX_Size() int {
+func (m *QueryFunderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalByHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalByHeightResponse.DiscardUnknown(m)
+func (m *QueryFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalByHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFunderResponse proto.InternalMessageInfo
 
-func (m *QueryProposalByHeightResponse) GetProposal() Proposal {
+func (m *QueryFunderResponse) GetFunder() *Funder {
 	if m != nil {
-		return m.Proposal
+		return m.Funder
 	}
-	return Proposal{}
+	return nil
 }
 
-// QueryProposalByFinalizedAtRequest ...
-type QueryProposalSinceFinalizedAtRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// height ...
-	FinalizedAt uint64 `protobuf:"varint,3,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
+// QueryStakersListRequest is the request type for the Query/StakersList RPC method.
+type QueryStakersListRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// status ...
+	Status StakerStatus `protobuf:"varint,2,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+	// pagination ...
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) Reset()         { *m = QueryProposalSinceFinalizedAtRequest{} }
-func (m *QueryProposalSinceFinalizedAtRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalSinceFinalizedAtRequest) ProtoMessage()    {}
-func (*QueryProposalSinceFinalizedAtRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{25}
+func (m *QueryStakersListRequest) Reset()         { *m = QueryStakersListRequest{} }
+func (m *QueryStakersListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersListRequest) ProtoMessage()    {}
+func (*QueryStakersListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{28}
 }
-func (m *QueryProposalSinceFinalizedAtRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakersListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalSinceFinalizedAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakersListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakersListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1472,59 +1412,59 @@ func (m *QueryProposalSinceFinalizedAtRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalSinceFinalizedAtRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.Merge(m, src)
+func (m *QueryStakersListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersListRequest.Merge(m, src)
 }
-func (m *QueryProposalSinceFinalizedAtRequest) XXX_Size() int {
+func (m *QueryStakersListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalSinceFinalizedAtRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.DiscardUnknown(m)
+func (m *QueryStakersListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalSinceFinalizedAtRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakersListRequest proto.InternalMessageInfo
 
-func (m *QueryProposalSinceFinalizedAtRequest) GetPagination() *query.PageRequest {
+func (m *QueryStakersListRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) GetPoolId() uint64 {
+func (m *QueryStakersListRequest) GetStatus() StakerStatus {
 	if m != nil {
-		return m.PoolId
+		return m.Status
 	}
-	return 0
+	return STAKER_STATUS_UNSPECIFIED
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) GetFinalizedAt() uint64 {
+func (m *QueryStakersListRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.FinalizedAt
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// QueryProposalByFinalizedAtResponse ...
-type QueryProposalSinceFinalizedAtResponse struct {
-	// proposal ...
-	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
-	// pagination defines the pagination in the response.
+// QueryStakersListResponse is the response type for the Query/StakersList RPC method.
+type QueryStakersListResponse struct {
+	// stakers ...
+	Stakers []*StakerResponse `protobuf:"bytes,1,rep,name=stakers,proto3" json:"stakers,omitempty"`
+	// pagination ...
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) Reset()         { *m = QueryProposalSinceFinalizedAtResponse{} }
-func (m *QueryProposalSinceFinalizedAtResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalSinceFinalizedAtResponse) ProtoMessage()    {}
-func (*QueryProposalSinceFinalizedAtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{26}
+func (m *QueryStakersListResponse) Reset()         { *m = QueryStakersListResponse{} }
+func (m *QueryStakersListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersListResponse) ProtoMessage()    {}
+func (*QueryStakersListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{29}
 }
-func (m *QueryProposalSinceFinalizedAtResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakersListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalSinceFinalizedAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakersListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakersListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1534,54 +1474,52 @@ func (m *QueryProposalSinceFinalizedAtResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalSinceFinalizedAtResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.Merge(m, src)
+func (m *QueryStakersListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersListResponse.Merge(m, src)
 }
-func (m *QueryProposalSinceFinalizedAtResponse) XXX_Size() int {
+func (m *QueryStakersListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalSinceFinalizedAtResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.DiscardUnknown(m)
+func (m *QueryStakersListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalSinceFinalizedAtResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakersListResponse proto.InternalMessageInfo
 
-func (m *QueryProposalSinceFinalizedAtResponse) GetProposals() []Proposal {
+func (m *QueryStakersListResponse) GetStakers() []*StakerResponse {
 	if m != nil {
-		return m.Proposals
+		return m.Stakers
 	}
 	return nil
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) GetPagination() *query.PageResponse {
+func (m *QueryStakersListResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryProposalSinceIdRequest ...
-type QueryProposalSinceIdRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// height ...
-	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+// QueryStakerRequest is the request type for the Query/Staker RPC method.
+type QueryStakerRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
 }
 
-func (m *QueryProposalSinceIdRequest) Reset()         { *m = QueryProposalSinceIdRequest{} }
-func (m *QueryProposalSinceIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalSinceIdRequest) ProtoMessage()    {}
-func (*QueryProposalSinceIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{27}
+func (m *QueryStakerRequest) Reset()         { *m = QueryStakerRequest{} }
+func (m *QueryStakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerRequest) ProtoMessage()    {}
+func (*QueryStakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{30}
 }
-func (m *QueryProposalSinceIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalSinceIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalSinceIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1591,59 +1529,50 @@ func (m *QueryProposalSinceIdRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalSinceIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalSinceIdRequest.Merge(m, src)
+func (m *QueryStakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerRequest.Merge(m, src)
 }
-func (m *QueryProposalSinceIdRequest) XXX_Size() int {
+func (m *QueryStakerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalSinceIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalSinceIdRequest.DiscardUnknown(m)
+func (m *QueryStakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalSinceIdRequest proto.InternalMessageInfo
-
-func (m *QueryProposalSinceIdRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryStakerRequest proto.InternalMessageInfo
 
-func (m *QueryProposalSinceIdRequest) GetPoolId() uint64 {
+func (m *QueryStakerRequest) GetPoolId() uint64 {
 	if m != nil {
 		return m.PoolId
 	}
 	return 0
 }
 
-func (m *QueryProposalSinceIdRequest) GetId() uint64 {
+func (m *QueryStakerRequest) GetStaker() string {
 	if m != nil {
-		return m.Id
+		return m.Staker
 	}
-	return 0
+	return ""
 }
 
-// QueryProposalSinceIdResponse ...
-type QueryProposalSinceIdResponse struct {
-	// proposal ...
-	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryStakerResponse is the response type for the Query/Staker RPC method.
+type QueryStakerResponse struct {
+	// staker ...
+	Staker *StakerResponse `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
 }
 
-func (m *QueryProposalSinceIdResponse) Reset()         { *m = QueryProposalSinceIdResponse{} }
-func (m *QueryProposalSinceIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProposalSinceIdResponse) ProtoMessage()    {}
-func (*QueryProposalSinceIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{28}
+func (m *QueryStakerResponse) Reset()         { *m = QueryStakerResponse{} }
+func (m *QueryStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerResponse) ProtoMessage()    {}
+func (*QueryStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{31}
 }
-func (m *QueryProposalSinceIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProposalSinceIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProposalSinceIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1653,54 +1582,47 @@ func (m *QueryProposalSinceIdResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryProposalSinceIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProposalSinceIdResponse.Merge(m, src)
+func (m *QueryStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerResponse.Merge(m, src)
 }
-func (m *QueryProposalSinceIdResponse) XXX_Size() int {
+func (m *QueryStakerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProposalSinceIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProposalSinceIdResponse.DiscardUnknown(m)
+func (m *QueryStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProposalSinceIdResponse proto.InternalMessageInfo
-
-func (m *QueryProposalSinceIdResponse) GetProposals() []Proposal {
-	if m != nil {
-		return m.Proposals
-	}
-	return nil
-}
+var xxx_messageInfo_QueryStakerResponse proto.InternalMessageInfo
 
-func (m *QueryProposalSinceIdResponse) GetPagination() *query.PageResponse {
+func (m *QueryStakerResponse) GetStaker() *StakerResponse {
 	if m != nil {
-		return m.Pagination
+		return m.Staker
 	}
 	return nil
 }
 
-// QueryCanProposeRequest is the request type for the Query/CanPropose RPC method.
-type QueryCanProposeRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// proposer ...
-	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// from_height ...
-	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+// PendingCommissionChange ...
+type PendingCommissionChange struct {
+	// new_commission ...
+	NewCommission string `protobuf:"bytes,1,opt,name=new_commission,json=newCommission,proto3" json:"new_commission,omitempty"`
+	// creation_date ...
+	CreationDate int64 `protobuf:"varint,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// finish_date ...
+	FinishDate int64 `protobuf:"varint,3,opt,name=finish_date,json=finishDate,proto3" json:"finish_date,omitempty"`
 }
 
-func (m *QueryCanProposeRequest) Reset()         { *m = QueryCanProposeRequest{} }
-func (m *QueryCanProposeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCanProposeRequest) ProtoMessage()    {}
-func (*QueryCanProposeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{29}
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{32}
 }
-func (m *QueryCanProposeRequest) XXX_Unmarshal(b []byte) error {
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCanProposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCanProposeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1710,59 +1632,83 @@ func (m *QueryCanProposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryCanProposeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCanProposeRequest.Merge(m, src)
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
 }
-func (m *QueryCanProposeRequest) XXX_Size() int {
+func (m *PendingCommissionChange) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCanProposeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCanProposeRequest.DiscardUnknown(m)
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCanProposeRequest proto.InternalMessageInfo
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
 
-func (m *QueryCanProposeRequest) GetPoolId() uint64 {
+func (m *PendingCommissionChange) GetNewCommission() string {
 	if m != nil {
-		return m.PoolId
+		return m.NewCommission
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryCanProposeRequest) GetProposer() string {
+func (m *PendingCommissionChange) GetCreationDate() int64 {
 	if m != nil {
-		return m.Proposer
+		return m.CreationDate
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryCanProposeRequest) GetFromHeight() uint64 {
+func (m *PendingCommissionChange) GetFinishDate() int64 {
 	if m != nil {
-		return m.FromHeight
+		return m.FinishDate
 	}
 	return 0
 }
 
-// QueryCanProposeResponse is the response type for the Query/CanPropose RPC method.
-type QueryCanProposeResponse struct {
-	// possible ...
-	Possible bool `protobuf:"varint,1,opt,name=possible,proto3" json:"possible,omitempty"`
-	// reason ...
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+// StakerResponse ...
+type StakerResponse struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// account ...
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// total_delegation ...
+	TotalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_delegation,json=totalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_delegation"`
+	// commission ...
+	Commission string `protobuf:"bytes,6,opt,name=commission,proto3" json:"commission,omitempty"`
+	// moniker ...
+	Moniker string `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// website ...
+	Website string `protobuf:"bytes,8,opt,name=website,proto3" json:"website,omitempty"`
+	// logo
+	Logo string `protobuf:"bytes,9,opt,name=logo,proto3" json:"logo,omitempty"`
+	// points
+	Points uint64 `protobuf:"varint,10,opt,name=points,proto3" json:"points,omitempty"`
+	// unbonding_amount ...
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
+	// upload_probability
+	UploadProbability string `protobuf:"bytes,12,opt,name=upload_probability,json=uploadProbability,proto3" json:"upload_probability,omitempty"`
+	// status
+	Status StakerStatus `protobuf:"varint,13,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+	// pending_commission_change
+	PendingCommissionChange *PendingCommissionChange `protobuf:"bytes,14,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
 }
 
-func (m *QueryCanProposeResponse) Reset()         { *m = QueryCanProposeResponse{} }
-func (m *QueryCanProposeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCanProposeResponse) ProtoMessage()    {}
-func (*QueryCanProposeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{30}
+func (m *StakerResponse) Reset()         { *m = StakerResponse{} }
+func (m *StakerResponse) String() string { return proto.CompactTextString(m) }
+func (*StakerResponse) ProtoMessage()    {}
+func (*StakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{33}
 }
-func (m *QueryCanProposeResponse) XXX_Unmarshal(b []byte) error {
+func (m *StakerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCanProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCanProposeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StakerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1772,116 +1718,115 @@ func (m *QueryCanProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryCanProposeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCanProposeResponse.Merge(m, src)
+func (m *StakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerResponse.Merge(m, src)
 }
-func (m *QueryCanProposeResponse) XXX_Size() int {
+func (m *StakerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCanProposeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCanProposeResponse.DiscardUnknown(m)
+func (m *StakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCanProposeResponse proto.InternalMessageInfo
+var xxx_messageInfo_StakerResponse proto.InternalMessageInfo
 
-func (m *QueryCanProposeResponse) GetPossible() bool {
+func (m *StakerResponse) GetStaker() string {
 	if m != nil {
-		return m.Possible
+		return m.Staker
 	}
-	return false
+	return ""
 }
 
-func (m *QueryCanProposeResponse) GetReason() string {
+func (m *StakerResponse) GetPoolId() uint64 {
 	if m != nil {
-		return m.Reason
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-// QueryCanVoteRequest is the request type for the Query/CanVote RPC method.
-type QueryCanVoteRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// voter ...
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// storage_id ...
-	StorageId string `protobuf:"bytes,3,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
+func (m *StakerResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *QueryCanVoteRequest) Reset()         { *m = QueryCanVoteRequest{} }
-func (m *QueryCanVoteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCanVoteRequest) ProtoMessage()    {}
-func (*QueryCanVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{31}
-}
-func (m *QueryCanVoteRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryCanVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryCanVoteRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StakerResponse) GetCommission() string {
+	if m != nil {
+		return m.Commission
 	}
+	return ""
 }
-func (m *QueryCanVoteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCanVoteRequest.Merge(m, src)
-}
-func (m *QueryCanVoteRequest) XXX_Size() int {
-	return m.Size()
+
+func (m *StakerResponse) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
 }
-func (m *QueryCanVoteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCanVoteRequest.DiscardUnknown(m)
+
+func (m *StakerResponse) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
 }
 
-var xxx_messageInfo_QueryCanVoteRequest proto.InternalMessageInfo
+func (m *StakerResponse) GetLogo() string {
+	if m != nil {
+		return m.Logo
+	}
+	return ""
+}
 
-func (m *QueryCanVoteRequest) GetPoolId() uint64 {
+func (m *StakerResponse) GetPoints() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.Points
 	}
 	return 0
 }
 
-func (m *QueryCanVoteRequest) GetVoter() string {
+func (m *StakerResponse) GetUploadProbability() string {
 	if m != nil {
-		return m.Voter
+		return m.UploadProbability
 	}
 	return ""
 }
 
-func (m *QueryCanVoteRequest) GetStorageId() string {
+func (m *StakerResponse) GetStatus() StakerStatus {
 	if m != nil {
-		return m.StorageId
+		return m.Status
 	}
-	return ""
+	return STAKER_STATUS_UNSPECIFIED
 }
 
-// QueryCanVoteResponse is the response type for the Query/CanVote RPC method.
-type QueryCanVoteResponse struct {
-	// possible ...
-	Possible bool `protobuf:"varint,1,opt,name=possible,proto3" json:"possible,omitempty"`
-	// reason ...
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+func (m *StakerResponse) GetPendingCommissionChange() *PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChange
+	}
+	return nil
 }
 
-func (m *QueryCanVoteResponse) Reset()         { *m = QueryCanVoteResponse{} }
-func (m *QueryCanVoteResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCanVoteResponse) ProtoMessage()    {}
-func (*QueryCanVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{32}
+// QueryVoteStatusRequest is the request type for the Query/VoteStatus RPC method.
+type QueryVoteStatusRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// storage_id selects a pipelined bundle proposal, the current bundle proposal is used if it is empty.
+	StorageId string `protobuf:"bytes,2,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
 }
-func (m *QueryCanVoteResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryVoteStatusRequest) Reset()         { *m = QueryVoteStatusRequest{} }
+func (m *QueryVoteStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteStatusRequest) ProtoMessage()    {}
+func (*QueryVoteStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{34}
+}
+func (m *QueryVoteStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCanVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCanVoteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1891,52 +1836,50 @@ func (m *QueryCanVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryCanVoteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCanVoteResponse.Merge(m, src)
+func (m *QueryVoteStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteStatusRequest.Merge(m, src)
 }
-func (m *QueryCanVoteResponse) XXX_Size() int {
+func (m *QueryVoteStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCanVoteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCanVoteResponse.DiscardUnknown(m)
+func (m *QueryVoteStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCanVoteResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryVoteStatusRequest proto.InternalMessageInfo
 
-func (m *QueryCanVoteResponse) GetPossible() bool {
+func (m *QueryVoteStatusRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Possible
+		return m.PoolId
 	}
-	return false
+	return 0
 }
 
-func (m *QueryCanVoteResponse) GetReason() string {
+func (m *QueryVoteStatusRequest) GetStorageId() string {
 	if m != nil {
-		return m.Reason
+		return m.StorageId
 	}
 	return ""
 }
 
-// QueryStakeInfoRequest is the request type for the Query/StakeInfo RPC method.
-type QueryStakeInfoRequest struct {
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// staker ..
-	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+// QueryVoteStatusResponse is the response type for the Query/VoteStatus RPC method.
+type QueryVoteStatusResponse struct {
+	// staker ...
+	VoteStatus *VoteStatusResponse `protobuf:"bytes,1,opt,name=vote_status,json=voteStatus,proto3" json:"vote_status,omitempty"`
 }
 
-func (m *QueryStakeInfoRequest) Reset()         { *m = QueryStakeInfoRequest{} }
-func (m *QueryStakeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeInfoRequest) ProtoMessage()    {}
-func (*QueryStakeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{33}
+func (m *QueryVoteStatusResponse) Reset()         { *m = QueryVoteStatusResponse{} }
+func (m *QueryVoteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteStatusResponse) ProtoMessage()    {}
+func (*QueryVoteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{35}
 }
-func (m *QueryStakeInfoRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryVoteStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryVoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeInfoRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryVoteStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1946,56 +1889,49 @@ func (m *QueryStakeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeInfoRequest.Merge(m, src)
+func (m *QueryVoteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteStatusResponse.Merge(m, src)
 }
-func (m *QueryStakeInfoRequest) XXX_Size() int {
+func (m *QueryVoteStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeInfoRequest.DiscardUnknown(m)
+func (m *QueryVoteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeInfoRequest proto.InternalMessageInfo
-
-func (m *QueryStakeInfoRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryVoteStatusResponse proto.InternalMessageInfo
 
-func (m *QueryStakeInfoRequest) GetStaker() string {
+func (m *QueryVoteStatusResponse) GetVoteStatus() *VoteStatusResponse {
 	if m != nil {
-		return m.Staker
+		return m.VoteStatus
 	}
-	return ""
+	return nil
 }
 
-// QueryStakeInfoResponse is the response type for the Query/StakeInfo RPC method.
-type QueryStakeInfoResponse struct {
-	// balance ...
-	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// current_stake ...
-	CurrentStake string `protobuf:"bytes,2,opt,name=current_stake,json=currentStake,proto3" json:"current_stake,omitempty"`
-	// minimum_stake ...
-	MinimumStake string `protobuf:"bytes,3,opt,name=minimum_stake,json=minimumStake,proto3" json:"minimum_stake,omitempty"`
-	// status ...
-	Status StakerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+// VoteStatusResponse ...
+type VoteStatusResponse struct {
+	// valid ...
+	Valid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=valid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"valid"`
+	// invalid ...
+	Invalid github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=invalid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"invalid"`
+	// abstain ...
+	Abstain github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=abstain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"abstain"`
+	// total ...
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
 }
 
-func (m *QueryStakeInfoResponse) Reset()         { *m = QueryStakeInfoResponse{} }
-func (m *QueryStakeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakeInfoResponse) ProtoMessage()    {}
-func (*QueryStakeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{34}
+func (m *VoteStatusResponse) Reset()         { *m = VoteStatusResponse{} }
+func (m *VoteStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteStatusResponse) ProtoMessage()    {}
+func (*VoteStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{36}
 }
-func (m *QueryStakeInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *VoteStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VoteStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VoteStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2005,64 +1941,36 @@ func (m *QueryStakeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryStakeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakeInfoResponse.Merge(m, src)
+func (m *VoteStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteStatusResponse.Merge(m, src)
 }
-func (m *QueryStakeInfoResponse) XXX_Size() int {
+func (m *VoteStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakeInfoResponse.DiscardUnknown(m)
+func (m *VoteStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakeInfoResponse proto.InternalMessageInfo
-
-func (m *QueryStakeInfoResponse) GetBalance() string {
-	if m != nil {
-		return m.Balance
-	}
-	return ""
-}
+var xxx_messageInfo_VoteStatusResponse proto.InternalMessageInfo
 
-func (m *QueryStakeInfoResponse) GetCurrentStake() string {
-	if m != nil {
-		return m.CurrentStake
-	}
-	return ""
-}
-
-func (m *QueryStakeInfoResponse) GetMinimumStake() string {
-	if m != nil {
-		return m.MinimumStake
-	}
-	return ""
-}
-
-func (m *QueryStakeInfoResponse) GetStatus() StakerStatus {
-	if m != nil {
-		return m.Status
-	}
-	return STAKER_STATUS_UNSPECIFIED
-}
-
-// QueryAccountAssetsRequest is the request type for the Query/AccountAssets RPC method.
-type QueryAccountAssetsRequest struct {
-	// address ...
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// QueryProposalRequest is the request type for the Query/Proposal RPC method.
+type QueryProposalRequest struct {
+	// storage_id ...
+	StorageId string `protobuf:"bytes,1,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
 }
 
-func (m *QueryAccountAssetsRequest) Reset()         { *m = QueryAccountAssetsRequest{} }
-func (m *QueryAccountAssetsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountAssetsRequest) ProtoMessage()    {}
-func (*QueryAccountAssetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{35}
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+func (*QueryProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{37}
 }
-func (m *QueryAccountAssetsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountAssetsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2072,55 +1980,43 @@ func (m *QueryAccountAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountAssetsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountAssetsRequest.Merge(m, src)
+func (m *QueryProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequest.Merge(m, src)
 }
-func (m *QueryAccountAssetsRequest) XXX_Size() int {
+func (m *QueryProposalRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountAssetsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountAssetsRequest.DiscardUnknown(m)
+func (m *QueryProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountAssetsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalRequest proto.InternalMessageInfo
 
-func (m *QueryAccountAssetsRequest) GetAddress() string {
+func (m *QueryProposalRequest) GetStorageId() string {
 	if m != nil {
-		return m.Address
+		return m.StorageId
 	}
 	return ""
 }
 
-// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
-type QueryAccountAssetsResponse struct {
-	// balance ...
-	Balance uint64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// protocol_staking ...
-	ProtocolStaking uint64 `protobuf:"varint,2,opt,name=protocol_staking,json=protocolStaking,proto3" json:"protocol_staking,omitempty"`
-	// protocol_staking_unbonding
-	ProtocolStakingUnbonding uint64 `protobuf:"varint,3,opt,name=protocol_staking_unbonding,json=protocolStakingUnbonding,proto3" json:"protocol_staking_unbonding,omitempty"`
-	// protocol_delegation ...
-	ProtocolDelegation uint64 `protobuf:"varint,4,opt,name=protocol_delegation,json=protocolDelegation,proto3" json:"protocol_delegation,omitempty"`
-	// protocol_delegation_unbonding
-	ProtocolDelegationUnbonding uint64 `protobuf:"varint,5,opt,name=protocol_delegation_unbonding,json=protocolDelegationUnbonding,proto3" json:"protocol_delegation_unbonding,omitempty"`
-	// protocol_rewards ...
-	ProtocolRewards uint64 `protobuf:"varint,6,opt,name=protocol_rewards,json=protocolRewards,proto3" json:"protocol_rewards,omitempty"`
-	// protocol_funding ...
-	ProtocolFunding uint64 `protobuf:"varint,7,opt,name=protocol_funding,json=protocolFunding,proto3" json:"protocol_funding,omitempty"`
+// QueryProposalResponse is the response type for the Query/Proposal RPC method.
+type QueryProposalResponse struct {
+	// proposal ...
+	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
 
-func (m *QueryAccountAssetsResponse) Reset()         { *m = QueryAccountAssetsResponse{} }
-func (m *QueryAccountAssetsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountAssetsResponse) ProtoMessage()    {}
-func (*QueryAccountAssetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{36}
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{38}
 }
-func (m *QueryAccountAssetsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountAssetsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2130,87 +2026,45 @@ func (m *QueryAccountAssetsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountAssetsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountAssetsResponse.Merge(m, src)
+func (m *QueryProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalResponse.Merge(m, src)
 }
-func (m *QueryAccountAssetsResponse) XXX_Size() int {
+func (m *QueryProposalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountAssetsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountAssetsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryAccountAssetsResponse proto.InternalMessageInfo
-
-func (m *QueryAccountAssetsResponse) GetBalance() uint64 {
-	if m != nil {
-		return m.Balance
-	}
-	return 0
-}
-
-func (m *QueryAccountAssetsResponse) GetProtocolStaking() uint64 {
-	if m != nil {
-		return m.ProtocolStaking
-	}
-	return 0
-}
-
-func (m *QueryAccountAssetsResponse) GetProtocolStakingUnbonding() uint64 {
-	if m != nil {
-		return m.ProtocolStakingUnbonding
-	}
-	return 0
-}
-
-func (m *QueryAccountAssetsResponse) GetProtocolDelegation() uint64 {
-	if m != nil {
-		return m.ProtocolDelegation
-	}
-	return 0
-}
-
-func (m *QueryAccountAssetsResponse) GetProtocolDelegationUnbonding() uint64 {
-	if m != nil {
-		return m.ProtocolDelegationUnbonding
-	}
-	return 0
+func (m *QueryProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountAssetsResponse) GetProtocolRewards() uint64 {
-	if m != nil {
-		return m.ProtocolRewards
-	}
-	return 0
-}
+var xxx_messageInfo_QueryProposalResponse proto.InternalMessageInfo
 
-func (m *QueryAccountAssetsResponse) GetProtocolFunding() uint64 {
+func (m *QueryProposalResponse) GetProposal() Proposal {
 	if m != nil {
-		return m.ProtocolFunding
+		return m.Proposal
 	}
-	return 0
+	return Proposal{}
 }
 
-// QueryAccountFundedListRequest ...
-type QueryAccountStakingUnbondingsRequest struct {
+// QueryProposalsRequest is the request type for the Query/Proposals RPC method.
+type QueryProposalsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// address ...
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) Reset()         { *m = QueryAccountStakingUnbondingsRequest{} }
-func (m *QueryAccountStakingUnbondingsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountStakingUnbondingsRequest) ProtoMessage()    {}
-func (*QueryAccountStakingUnbondingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{37}
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+func (*QueryProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{39}
 }
-func (m *QueryAccountStakingUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountStakingUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountStakingUnbondingsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2220,52 +2074,52 @@ func (m *QueryAccountStakingUnbondingsRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountStakingUnbondingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountStakingUnbondingsRequest.Merge(m, src)
+func (m *QueryProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsRequest.Merge(m, src)
 }
-func (m *QueryAccountStakingUnbondingsRequest) XXX_Size() int {
+func (m *QueryProposalsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountStakingUnbondingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountStakingUnbondingsRequest.DiscardUnknown(m)
+func (m *QueryProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountStakingUnbondingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalsRequest proto.InternalMessageInfo
 
-func (m *QueryAccountStakingUnbondingsRequest) GetPagination() *query.PageRequest {
+func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) GetAddress() string {
+func (m *QueryProposalsRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
-type QueryAccountStakingUnbondingsResponse struct {
-	// balance ...
-	Unbondings []StakingUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+// QueryProposalsResponse is the response type for the Query/Proposals RPC method.
+type QueryProposalsResponse struct {
+	// proposals ...
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountStakingUnbondingsResponse) Reset()         { *m = QueryAccountStakingUnbondingsResponse{} }
-func (m *QueryAccountStakingUnbondingsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountStakingUnbondingsResponse) ProtoMessage()    {}
-func (*QueryAccountStakingUnbondingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{38}
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{40}
 }
-func (m *QueryAccountStakingUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountStakingUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountStakingUnbondingsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2275,54 +2129,52 @@ func (m *QueryAccountStakingUnbondingsResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountStakingUnbondingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountStakingUnbondingsResponse.Merge(m, src)
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
 }
-func (m *QueryAccountStakingUnbondingsResponse) XXX_Size() int {
+func (m *QueryProposalsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountStakingUnbondingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountStakingUnbondingsResponse.DiscardUnknown(m)
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountStakingUnbondingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
 
-func (m *QueryAccountStakingUnbondingsResponse) GetUnbondings() []StakingUnbonding {
+func (m *QueryProposalsResponse) GetProposals() []Proposal {
 	if m != nil {
-		return m.Unbondings
+		return m.Proposals
 	}
 	return nil
 }
 
-func (m *QueryAccountStakingUnbondingsResponse) GetPagination() *query.PageResponse {
+func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
-type StakingUnbonding struct {
-	// amount
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// creation_time
-	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+// QueryArchivedProposalsRequest is the request type for the Query/ArchivedProposals RPC method.
+type QueryArchivedProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *StakingUnbonding) Reset()         { *m = StakingUnbonding{} }
-func (m *StakingUnbonding) String() string { return proto.CompactTextString(m) }
-func (*StakingUnbonding) ProtoMessage()    {}
-func (*StakingUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{39}
+func (m *QueryArchivedProposalsRequest) Reset()         { *m = QueryArchivedProposalsRequest{} }
+func (m *QueryArchivedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedProposalsRequest) ProtoMessage()    {}
+func (*QueryArchivedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{41}
 }
-func (m *StakingUnbonding) XXX_Unmarshal(b []byte) error {
+func (m *QueryArchivedProposalsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StakingUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryArchivedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StakingUnbonding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryArchivedProposalsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2332,61 +2184,52 @@ func (m *StakingUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *StakingUnbonding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StakingUnbonding.Merge(m, src)
+func (m *QueryArchivedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedProposalsRequest.Merge(m, src)
 }
-func (m *StakingUnbonding) XXX_Size() int {
+func (m *QueryArchivedProposalsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StakingUnbonding) XXX_DiscardUnknown() {
-	xxx_messageInfo_StakingUnbonding.DiscardUnknown(m)
+func (m *QueryArchivedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedProposalsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StakingUnbonding proto.InternalMessageInfo
+var xxx_messageInfo_QueryArchivedProposalsRequest proto.InternalMessageInfo
 
-func (m *StakingUnbonding) GetAmount() uint64 {
+func (m *QueryArchivedProposalsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Amount
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *StakingUnbonding) GetCreationTime() uint64 {
+func (m *QueryArchivedProposalsRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.CreationTime
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *StakingUnbonding) GetPool() *Pool {
-	if m != nil {
-		return m.Pool
-	}
-	return nil
-}
-
-// QueryAccountFundedListRequest ...
-type QueryAccountDelegationUnbondingsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// address ...
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+// QueryArchivedProposalsResponse is the response type for the Query/ArchivedProposals RPC method.
+type QueryArchivedProposalsResponse struct {
+	// proposals ...
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) Reset() {
-	*m = QueryAccountDelegationUnbondingsRequest{}
-}
-func (m *QueryAccountDelegationUnbondingsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountDelegationUnbondingsRequest) ProtoMessage()    {}
-func (*QueryAccountDelegationUnbondingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{40}
+func (m *QueryArchivedProposalsResponse) Reset()         { *m = QueryArchivedProposalsResponse{} }
+func (m *QueryArchivedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArchivedProposalsResponse) ProtoMessage()    {}
+func (*QueryArchivedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{42}
 }
-func (m *QueryAccountDelegationUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryArchivedProposalsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountDelegationUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryArchivedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryArchivedProposalsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2396,54 +2239,52 @@ func (m *QueryAccountDelegationUnbondingsRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountDelegationUnbondingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.Merge(m, src)
+func (m *QueryArchivedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArchivedProposalsResponse.Merge(m, src)
 }
-func (m *QueryAccountDelegationUnbondingsRequest) XXX_Size() int {
+func (m *QueryArchivedProposalsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountDelegationUnbondingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.DiscardUnknown(m)
+func (m *QueryArchivedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArchivedProposalsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountDelegationUnbondingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryArchivedProposalsResponse proto.InternalMessageInfo
 
-func (m *QueryAccountDelegationUnbondingsRequest) GetPagination() *query.PageRequest {
+func (m *QueryArchivedProposalsResponse) GetProposals() []Proposal {
 	if m != nil {
-		return m.Pagination
+		return m.Proposals
 	}
 	return nil
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) GetAddress() string {
+func (m *QueryArchivedProposalsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Address
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
-type QueryAccountDelegationUnbondingsResponse struct {
-	// balance ...
-	Unbondings []DelegationUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryProposalByHeightRequest is the request type for the Query/ProposalByHeight RPC method.
+type QueryProposalByHeightRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height ...
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) Reset() {
-	*m = QueryAccountDelegationUnbondingsResponse{}
-}
-func (m *QueryAccountDelegationUnbondingsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountDelegationUnbondingsResponse) ProtoMessage()    {}
-func (*QueryAccountDelegationUnbondingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{41}
+func (m *QueryProposalByHeightRequest) Reset()         { *m = QueryProposalByHeightRequest{} }
+func (m *QueryProposalByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalByHeightRequest) ProtoMessage()    {}
+func (*QueryProposalByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{43}
 }
-func (m *QueryAccountDelegationUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountDelegationUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalByHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2453,56 +2294,50 @@ func (m *QueryAccountDelegationUnbondingsResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountDelegationUnbondingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.Merge(m, src)
+func (m *QueryProposalByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalByHeightRequest.Merge(m, src)
 }
-func (m *QueryAccountDelegationUnbondingsResponse) XXX_Size() int {
+func (m *QueryProposalByHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountDelegationUnbondingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.DiscardUnknown(m)
+func (m *QueryProposalByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalByHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountDelegationUnbondingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalByHeightRequest proto.InternalMessageInfo
 
-func (m *QueryAccountDelegationUnbondingsResponse) GetUnbondings() []DelegationUnbonding {
+func (m *QueryProposalByHeightRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Unbondings
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) GetPagination() *query.PageResponse {
+func (m *QueryProposalByHeightRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
-type DelegationUnbonding struct {
-	// amount
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// creation_time
-	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	// creation_time
-	Staker *StakerResponse `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`
+// QueryProposalResponse is the response type for the Query/ProposalByHeight RPC method.
+type QueryProposalByHeightResponse struct {
+	// proposal ...
+	Proposal Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
 }
 
-func (m *DelegationUnbonding) Reset()         { *m = DelegationUnbonding{} }
-func (m *DelegationUnbonding) String() string { return proto.CompactTextString(m) }
-func (*DelegationUnbonding) ProtoMessage()    {}
-func (*DelegationUnbonding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{42}
+func (m *QueryProposalByHeightResponse) Reset()         { *m = QueryProposalByHeightResponse{} }
+func (m *QueryProposalByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalByHeightResponse) ProtoMessage()    {}
+func (*QueryProposalByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{44}
 }
-func (m *DelegationUnbonding) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalByHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationUnbonding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalByHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2512,66 +2347,47 @@ func (m *DelegationUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelegationUnbonding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationUnbonding.Merge(m, src)
+func (m *QueryProposalByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalByHeightResponse.Merge(m, src)
 }
-func (m *DelegationUnbonding) XXX_Size() int {
+func (m *QueryProposalByHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationUnbonding) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationUnbonding.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DelegationUnbonding proto.InternalMessageInfo
-
-func (m *DelegationUnbonding) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-func (m *DelegationUnbonding) GetCreationTime() uint64 {
-	if m != nil {
-		return m.CreationTime
-	}
-	return 0
+func (m *QueryProposalByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalByHeightResponse.DiscardUnknown(m)
 }
 
-func (m *DelegationUnbonding) GetStaker() *StakerResponse {
-	if m != nil {
-		return m.Staker
-	}
-	return nil
-}
+var xxx_messageInfo_QueryProposalByHeightResponse proto.InternalMessageInfo
 
-func (m *DelegationUnbonding) GetPool() *Pool {
+func (m *QueryProposalByHeightResponse) GetProposal() Proposal {
 	if m != nil {
-		return m.Pool
+		return m.Proposal
 	}
-	return nil
+	return Proposal{}
 }
 
-// QueryAccountFundedListRequest is the request type for the account queries with pagination
-type QueryAccountFundedListRequest struct {
+// QueryProposalByFinalizedAtRequest ...
+type QueryProposalSinceFinalizedAtRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// address ...
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height ...
+	FinalizedAt uint64 `protobuf:"varint,3,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
 }
 
-func (m *QueryAccountFundedListRequest) Reset()         { *m = QueryAccountFundedListRequest{} }
-func (m *QueryAccountFundedListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountFundedListRequest) ProtoMessage()    {}
-func (*QueryAccountFundedListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{43}
+func (m *QueryProposalSinceFinalizedAtRequest) Reset()         { *m = QueryProposalSinceFinalizedAtRequest{} }
+func (m *QueryProposalSinceFinalizedAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalSinceFinalizedAtRequest) ProtoMessage()    {}
+func (*QueryProposalSinceFinalizedAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{45}
 }
-func (m *QueryAccountFundedListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalSinceFinalizedAtRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountFundedListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalSinceFinalizedAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountFundedListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2581,52 +2397,59 @@ func (m *QueryAccountFundedListRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountFundedListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountFundedListRequest.Merge(m, src)
+func (m *QueryProposalSinceFinalizedAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.Merge(m, src)
 }
-func (m *QueryAccountFundedListRequest) XXX_Size() int {
+func (m *QueryProposalSinceFinalizedAtRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountFundedListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountFundedListRequest.DiscardUnknown(m)
+func (m *QueryProposalSinceFinalizedAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalSinceFinalizedAtRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountFundedListRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalSinceFinalizedAtRequest proto.InternalMessageInfo
 
-func (m *QueryAccountFundedListRequest) GetPagination() *query.PageRequest {
+func (m *QueryProposalSinceFinalizedAtRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QueryAccountFundedListRequest) GetAddress() string {
+func (m *QueryProposalSinceFinalizedAtRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.PoolId
 	}
-	return ""
+	return 0
 }
 
-// QueryAccountFundedListResponse is the response type for the Query/AccountFundedList RPC method.
-type QueryAccountFundedListResponse struct {
-	// funded ...
-	Funded []Funded `protobuf:"bytes,1,rep,name=funded,proto3" json:"funded"`
+func (m *QueryProposalSinceFinalizedAtRequest) GetFinalizedAt() uint64 {
+	if m != nil {
+		return m.FinalizedAt
+	}
+	return 0
+}
+
+// QueryProposalByFinalizedAtResponse ...
+type QueryProposalSinceFinalizedAtResponse struct {
+	// proposal ...
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountFundedListResponse) Reset()         { *m = QueryAccountFundedListResponse{} }
-func (m *QueryAccountFundedListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountFundedListResponse) ProtoMessage()    {}
-func (*QueryAccountFundedListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{44}
+func (m *QueryProposalSinceFinalizedAtResponse) Reset()         { *m = QueryProposalSinceFinalizedAtResponse{} }
+func (m *QueryProposalSinceFinalizedAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalSinceFinalizedAtResponse) ProtoMessage()    {}
+func (*QueryProposalSinceFinalizedAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{46}
 }
-func (m *QueryAccountFundedListResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalSinceFinalizedAtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountFundedListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalSinceFinalizedAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountFundedListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2636,54 +2459,54 @@ func (m *QueryAccountFundedListResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountFundedListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountFundedListResponse.Merge(m, src)
+func (m *QueryProposalSinceFinalizedAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.Merge(m, src)
 }
-func (m *QueryAccountFundedListResponse) XXX_Size() int {
+func (m *QueryProposalSinceFinalizedAtResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountFundedListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountFundedListResponse.DiscardUnknown(m)
+func (m *QueryProposalSinceFinalizedAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalSinceFinalizedAtResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountFundedListResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalSinceFinalizedAtResponse proto.InternalMessageInfo
 
-func (m *QueryAccountFundedListResponse) GetFunded() []Funded {
+func (m *QueryProposalSinceFinalizedAtResponse) GetProposals() []Proposal {
 	if m != nil {
-		return m.Funded
+		return m.Proposals
 	}
 	return nil
 }
 
-func (m *QueryAccountFundedListResponse) GetPagination() *query.PageResponse {
+func (m *QueryProposalSinceFinalizedAtResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// Funded ...
-type Funded struct {
-	// account ...
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+// QueryProposalSinceIdRequest ...
+type QueryProposalSinceIdRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// height ...
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *Funded) Reset()         { *m = Funded{} }
-func (m *Funded) String() string { return proto.CompactTextString(m) }
-func (*Funded) ProtoMessage()    {}
-func (*Funded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{45}
+func (m *QueryProposalSinceIdRequest) Reset()         { *m = QueryProposalSinceIdRequest{} }
+func (m *QueryProposalSinceIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalSinceIdRequest) ProtoMessage()    {}
+func (*QueryProposalSinceIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{47}
 }
-func (m *Funded) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalSinceIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Funded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalSinceIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Funded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalSinceIdRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2693,59 +2516,59 @@ func (m *Funded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Funded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Funded.Merge(m, src)
+func (m *QueryProposalSinceIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalSinceIdRequest.Merge(m, src)
 }
-func (m *Funded) XXX_Size() int {
+func (m *QueryProposalSinceIdRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Funded) XXX_DiscardUnknown() {
-	xxx_messageInfo_Funded.DiscardUnknown(m)
+func (m *QueryProposalSinceIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalSinceIdRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Funded proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalSinceIdRequest proto.InternalMessageInfo
 
-func (m *Funded) GetAccount() string {
+func (m *QueryProposalSinceIdRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Account
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *Funded) GetAmount() uint64 {
+func (m *QueryProposalSinceIdRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *Funded) GetPool() *Pool {
+func (m *QueryProposalSinceIdRequest) GetId() uint64 {
 	if m != nil {
-		return m.Pool
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-// QueryAccountStakedListRequest ...
-type QueryAccountStakedListRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// address ...
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+// QueryProposalSinceIdResponse ...
+type QueryProposalSinceIdResponse struct {
+	// proposal ...
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAccountStakedListRequest) Reset()         { *m = QueryAccountStakedListRequest{} }
-func (m *QueryAccountStakedListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountStakedListRequest) ProtoMessage()    {}
-func (*QueryAccountStakedListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{46}
+func (m *QueryProposalSinceIdResponse) Reset()         { *m = QueryProposalSinceIdResponse{} }
+func (m *QueryProposalSinceIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalSinceIdResponse) ProtoMessage()    {}
+func (*QueryProposalSinceIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{48}
 }
-func (m *QueryAccountStakedListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProposalSinceIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountStakedListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProposalSinceIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountStakedListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProposalSinceIdResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2755,52 +2578,54 @@ func (m *QueryAccountStakedListRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountStakedListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountStakedListRequest.Merge(m, src)
+func (m *QueryProposalSinceIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalSinceIdResponse.Merge(m, src)
 }
-func (m *QueryAccountStakedListRequest) XXX_Size() int {
+func (m *QueryProposalSinceIdResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountStakedListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountStakedListRequest.DiscardUnknown(m)
+func (m *QueryProposalSinceIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalSinceIdResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountStakedListRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProposalSinceIdResponse proto.InternalMessageInfo
 
-func (m *QueryAccountStakedListRequest) GetPagination() *query.PageRequest {
+func (m *QueryProposalSinceIdResponse) GetProposals() []Proposal {
 	if m != nil {
-		return m.Pagination
+		return m.Proposals
 	}
 	return nil
 }
 
-func (m *QueryAccountStakedListRequest) GetAddress() string {
+func (m *QueryProposalSinceIdResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Address
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryAccountStakedListResponse is the response type for the Query/StakedList RPC method.
-type QueryAccountStakedListResponse struct {
-	// staked ...
-	Staked []Staked `protobuf:"bytes,1,rep,name=staked,proto3" json:"staked"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryCanProposeRequest is the request type for the Query/CanPropose RPC method.
+type QueryCanProposeRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// proposer ...
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// from_height ...
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
-func (m *QueryAccountStakedListResponse) Reset()         { *m = QueryAccountStakedListResponse{} }
-func (m *QueryAccountStakedListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountStakedListResponse) ProtoMessage()    {}
-func (*QueryAccountStakedListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{47}
+func (m *QueryCanProposeRequest) Reset()         { *m = QueryCanProposeRequest{} }
+func (m *QueryCanProposeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanProposeRequest) ProtoMessage()    {}
+func (*QueryCanProposeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{49}
 }
-func (m *QueryAccountStakedListResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCanProposeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountStakedListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanProposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountStakedListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanProposeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2810,62 +2635,59 @@ func (m *QueryAccountStakedListResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountStakedListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountStakedListResponse.Merge(m, src)
+func (m *QueryCanProposeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanProposeRequest.Merge(m, src)
 }
-func (m *QueryAccountStakedListResponse) XXX_Size() int {
+func (m *QueryCanProposeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountStakedListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountStakedListResponse.DiscardUnknown(m)
+func (m *QueryCanProposeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanProposeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountStakedListResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCanProposeRequest proto.InternalMessageInfo
 
-func (m *QueryAccountStakedListResponse) GetStaked() []Staked {
+func (m *QueryCanProposeRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Staked
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryAccountStakedListResponse) GetPagination() *query.PageResponse {
+func (m *QueryCanProposeRequest) GetProposer() string {
 	if m != nil {
-		return m.Pagination
+		return m.Proposer
 	}
-	return nil
+	return ""
 }
 
-// Staked ...
-type Staked struct {
-	// staker ...
-	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// account ...
-	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
-	// unbonding_amount ...
-	UnbondingAmount uint64 `protobuf:"varint,7,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount,omitempty"`
-	// upload_probability
-	UploadProbability string `protobuf:"bytes,8,opt,name=upload_probability,json=uploadProbability,proto3" json:"upload_probability,omitempty"`
+func (m *QueryCanProposeRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-func (m *Staked) Reset()         { *m = Staked{} }
-func (m *Staked) String() string { return proto.CompactTextString(m) }
-func (*Staked) ProtoMessage()    {}
-func (*Staked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{48}
+// QueryCanProposeResponse is the response type for the Query/CanPropose RPC method.
+type QueryCanProposeResponse struct {
+	// possible ...
+	Possible bool `protobuf:"varint,1,opt,name=possible,proto3" json:"possible,omitempty"`
+	// reason ...
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *Staked) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryCanProposeResponse) Reset()         { *m = QueryCanProposeResponse{} }
+func (m *QueryCanProposeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanProposeResponse) ProtoMessage()    {}
+func (*QueryCanProposeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{50}
+}
+func (m *QueryCanProposeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Staked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Staked.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanProposeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2875,87 +2697,54 @@ func (m *Staked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Staked) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Staked.Merge(m, src)
+func (m *QueryCanProposeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanProposeResponse.Merge(m, src)
 }
-func (m *Staked) XXX_Size() int {
+func (m *QueryCanProposeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Staked) XXX_DiscardUnknown() {
-	xxx_messageInfo_Staked.DiscardUnknown(m)
+func (m *QueryCanProposeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanProposeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Staked proto.InternalMessageInfo
-
-func (m *Staked) GetStaker() string {
-	if m != nil {
-		return m.Staker
-	}
-	return ""
-}
+var xxx_messageInfo_QueryCanProposeResponse proto.InternalMessageInfo
 
-func (m *Staked) GetPoolId() uint64 {
+func (m *QueryCanProposeResponse) GetPossible() bool {
 	if m != nil {
-		return m.PoolId
+		return m.Possible
 	}
-	return 0
+	return false
 }
 
-func (m *Staked) GetAccount() string {
+func (m *QueryCanProposeResponse) GetReason() string {
 	if m != nil {
-		return m.Account
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *Staked) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-func (m *Staked) GetPool() *Pool {
-	if m != nil {
-		return m.Pool
-	}
-	return nil
+// QueryCanVoteRequest is the request type for the Query/CanVote RPC method.
+type QueryCanVoteRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// voter ...
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// storage_id ...
+	StorageId string `protobuf:"bytes,3,opt,name=storage_id,json=storageId,proto3" json:"storage_id,omitempty"`
 }
 
-func (m *Staked) GetUnbondingAmount() uint64 {
-	if m != nil {
-		return m.UnbondingAmount
-	}
-	return 0
-}
-
-func (m *Staked) GetUploadProbability() string {
-	if m != nil {
-		return m.UploadProbability
-	}
-	return ""
-}
-
-// QueryAccountDelegationListRequest ...
-type QueryAccountDelegationListRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// address ...
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-}
-
-func (m *QueryAccountDelegationListRequest) Reset()         { *m = QueryAccountDelegationListRequest{} }
-func (m *QueryAccountDelegationListRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountDelegationListRequest) ProtoMessage()    {}
-func (*QueryAccountDelegationListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{49}
+func (m *QueryCanVoteRequest) Reset()         { *m = QueryCanVoteRequest{} }
+func (m *QueryCanVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanVoteRequest) ProtoMessage()    {}
+func (*QueryCanVoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{51}
 }
-func (m *QueryAccountDelegationListRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCanVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountDelegationListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanVoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountDelegationListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanVoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2965,52 +2754,59 @@ func (m *QueryAccountDelegationListRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountDelegationListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountDelegationListRequest.Merge(m, src)
+func (m *QueryCanVoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanVoteRequest.Merge(m, src)
 }
-func (m *QueryAccountDelegationListRequest) XXX_Size() int {
+func (m *QueryCanVoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountDelegationListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountDelegationListRequest.DiscardUnknown(m)
+func (m *QueryCanVoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanVoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountDelegationListRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCanVoteRequest proto.InternalMessageInfo
 
-func (m *QueryAccountDelegationListRequest) GetPagination() *query.PageRequest {
+func (m *QueryCanVoteRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryAccountDelegationListRequest) GetAddress() string {
+func (m *QueryCanVoteRequest) GetVoter() string {
 	if m != nil {
-		return m.Address
+		return m.Voter
 	}
 	return ""
 }
 
-// QueryAccountDelegationListRequest is the response type for the Query/AccountDelegationList RPC method.
-type QueryAccountDelegationListResponse struct {
-	// delegations ...
-	Delegations []DelegatorResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryCanVoteRequest) GetStorageId() string {
+	if m != nil {
+		return m.StorageId
+	}
+	return ""
 }
 
-func (m *QueryAccountDelegationListResponse) Reset()         { *m = QueryAccountDelegationListResponse{} }
-func (m *QueryAccountDelegationListResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountDelegationListResponse) ProtoMessage()    {}
-func (*QueryAccountDelegationListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{50}
+// QueryCanVoteResponse is the response type for the Query/CanVote RPC method.
+type QueryCanVoteResponse struct {
+	// possible ...
+	Possible bool `protobuf:"varint,1,opt,name=possible,proto3" json:"possible,omitempty"`
+	// reason ...
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *QueryAccountDelegationListResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryCanVoteResponse) Reset()         { *m = QueryCanVoteResponse{} }
+func (m *QueryCanVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanVoteResponse) ProtoMessage()    {}
+func (*QueryCanVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{52}
+}
+func (m *QueryCanVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountDelegationListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCanVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountDelegationListResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCanVoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3020,62 +2816,52 @@ func (m *QueryAccountDelegationListResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountDelegationListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountDelegationListResponse.Merge(m, src)
+func (m *QueryCanVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanVoteResponse.Merge(m, src)
 }
-func (m *QueryAccountDelegationListResponse) XXX_Size() int {
+func (m *QueryCanVoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountDelegationListResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountDelegationListResponse.DiscardUnknown(m)
+func (m *QueryCanVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanVoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountDelegationListResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCanVoteResponse proto.InternalMessageInfo
 
-func (m *QueryAccountDelegationListResponse) GetDelegations() []DelegatorResponse {
+func (m *QueryCanVoteResponse) GetPossible() bool {
 	if m != nil {
-		return m.Delegations
+		return m.Possible
 	}
-	return nil
+	return false
 }
 
-func (m *QueryAccountDelegationListResponse) GetPagination() *query.PageResponse {
+func (m *QueryCanVoteResponse) GetReason() string {
 	if m != nil {
-		return m.Pagination
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-// DelegatorResponse ...
-type DelegatorResponse struct {
-	// account ...
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
-	// current_reward ...
-	CurrentReward uint64 `protobuf:"varint,3,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
-	// delegation_amount ...
-	DelegationAmount uint64 `protobuf:"varint,4,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
-	// staker ...
-	Staker string `protobuf:"bytes,5,opt,name=staker,proto3" json:"staker,omitempty"`
-	// pending_commission_change
-	PendingCommissionChange *PendingCommissionChange `protobuf:"bytes,6,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
-	// delegation_pool_data ...
-	DelegationPoolData *DelegationPoolData `protobuf:"bytes,7,opt,name=delegation_pool_data,json=delegationPoolData,proto3" json:"delegation_pool_data,omitempty"`
+// QueryStakeInfoRequest is the request type for the Query/StakeInfo RPC method.
+type QueryStakeInfoRequest struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ..
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
 }
 
-func (m *DelegatorResponse) Reset()         { *m = DelegatorResponse{} }
-func (m *DelegatorResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegatorResponse) ProtoMessage()    {}
-func (*DelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{51}
+func (m *QueryStakeInfoRequest) Reset()         { *m = QueryStakeInfoRequest{} }
+func (m *QueryStakeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeInfoRequest) ProtoMessage()    {}
+func (*QueryStakeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{53}
 }
-func (m *DelegatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakeInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3085,85 +2871,56 @@ func (m *DelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DelegatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorResponse.Merge(m, src)
+func (m *QueryStakeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeInfoRequest.Merge(m, src)
 }
-func (m *DelegatorResponse) XXX_Size() int {
+func (m *QueryStakeInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DelegatorResponse proto.InternalMessageInfo
-
-func (m *DelegatorResponse) GetAccount() string {
-	if m != nil {
-		return m.Account
-	}
-	return ""
-}
-
-func (m *DelegatorResponse) GetPool() *Pool {
-	if m != nil {
-		return m.Pool
-	}
-	return nil
+func (m *QueryStakeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeInfoRequest.DiscardUnknown(m)
 }
 
-func (m *DelegatorResponse) GetCurrentReward() uint64 {
-	if m != nil {
-		return m.CurrentReward
-	}
-	return 0
-}
+var xxx_messageInfo_QueryStakeInfoRequest proto.InternalMessageInfo
 
-func (m *DelegatorResponse) GetDelegationAmount() uint64 {
+func (m *QueryStakeInfoRequest) GetPoolId() uint64 {
 	if m != nil {
-		return m.DelegationAmount
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *DelegatorResponse) GetStaker() string {
+func (m *QueryStakeInfoRequest) GetStaker() string {
 	if m != nil {
 		return m.Staker
 	}
 	return ""
 }
 
-func (m *DelegatorResponse) GetPendingCommissionChange() *PendingCommissionChange {
-	if m != nil {
-		return m.PendingCommissionChange
-	}
-	return nil
-}
-
-func (m *DelegatorResponse) GetDelegationPoolData() *DelegationPoolData {
-	if m != nil {
-		return m.DelegationPoolData
-	}
-	return nil
-}
-
-// QueryAccountDelegationListRequest ...
-type QueryAccountRedelegationRequest struct {
-	// address ...
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// QueryStakeInfoResponse is the response type for the Query/StakeInfo RPC method.
+type QueryStakeInfoResponse struct {
+	// balance ...
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// current_stake ...
+	CurrentStake string `protobuf:"bytes,2,opt,name=current_stake,json=currentStake,proto3" json:"current_stake,omitempty"`
+	// minimum_stake ...
+	MinimumStake string `protobuf:"bytes,3,opt,name=minimum_stake,json=minimumStake,proto3" json:"minimum_stake,omitempty"`
+	// status ...
+	Status StakerStatus `protobuf:"varint,4,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
 }
 
-func (m *QueryAccountRedelegationRequest) Reset()         { *m = QueryAccountRedelegationRequest{} }
-func (m *QueryAccountRedelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountRedelegationRequest) ProtoMessage()    {}
-func (*QueryAccountRedelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{52}
+func (m *QueryStakeInfoResponse) Reset()         { *m = QueryStakeInfoResponse{} }
+func (m *QueryStakeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeInfoResponse) ProtoMessage()    {}
+func (*QueryStakeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{54}
 }
-func (m *QueryAccountRedelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountRedelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountRedelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakeInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3173,43 +2930,64 @@ func (m *QueryAccountRedelegationRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountRedelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountRedelegationRequest.Merge(m, src)
+func (m *QueryStakeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeInfoResponse.Merge(m, src)
 }
-func (m *QueryAccountRedelegationRequest) XXX_Size() int {
+func (m *QueryStakeInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountRedelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountRedelegationRequest.DiscardUnknown(m)
+func (m *QueryStakeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountRedelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakeInfoResponse proto.InternalMessageInfo
 
-func (m *QueryAccountRedelegationRequest) GetAddress() string {
+func (m *QueryStakeInfoResponse) GetBalance() string {
 	if m != nil {
-		return m.Address
+		return m.Balance
 	}
 	return ""
 }
 
-// QueryAccountDelegationListRequest is the response type for the Query/AccountDelegationList RPC method.
-type QueryAccountRedelegationResponse struct {
-	// redelegation_cooldown_entries ...
-	RedelegationCooldownEntries []uint64 `protobuf:"varint,1,rep,packed,name=redelegation_cooldown_entries,json=redelegationCooldownEntries,proto3" json:"redelegation_cooldown_entries,omitempty"`
+func (m *QueryStakeInfoResponse) GetCurrentStake() string {
+	if m != nil {
+		return m.CurrentStake
+	}
+	return ""
 }
 
-func (m *QueryAccountRedelegationResponse) Reset()         { *m = QueryAccountRedelegationResponse{} }
-func (m *QueryAccountRedelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAccountRedelegationResponse) ProtoMessage()    {}
-func (*QueryAccountRedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{53}
+func (m *QueryStakeInfoResponse) GetMinimumStake() string {
+	if m != nil {
+		return m.MinimumStake
+	}
+	return ""
 }
-func (m *QueryAccountRedelegationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryStakeInfoResponse) GetStatus() StakerStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STAKER_STATUS_UNSPECIFIED
+}
+
+// QueryAccountAssetsRequest is the request type for the Query/AccountAssets RPC method.
+type QueryAccountAssetsRequest struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountAssetsRequest) Reset()         { *m = QueryAccountAssetsRequest{} }
+func (m *QueryAccountAssetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAssetsRequest) ProtoMessage()    {}
+func (*QueryAccountAssetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{55}
+}
+func (m *QueryAccountAssetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAccountRedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountAssetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAccountRedelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountAssetsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3219,47 +2997,55 @@ func (m *QueryAccountRedelegationResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryAccountRedelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAccountRedelegationResponse.Merge(m, src)
+func (m *QueryAccountAssetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAssetsRequest.Merge(m, src)
 }
-func (m *QueryAccountRedelegationResponse) XXX_Size() int {
+func (m *QueryAccountAssetsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAccountRedelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAccountRedelegationResponse.DiscardUnknown(m)
+func (m *QueryAccountAssetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAssetsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAccountRedelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountAssetsRequest proto.InternalMessageInfo
 
-func (m *QueryAccountRedelegationResponse) GetRedelegationCooldownEntries() []uint64 {
+func (m *QueryAccountAssetsRequest) GetAddress() string {
 	if m != nil {
-		return m.RedelegationCooldownEntries
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-// QueryDelegatorRequest is the request type for the Query/Delegator RPC method.
-type QueryDelegatorRequest struct {
-	// pool_id defines the unique ID of the pool.
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// staker ...
-	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
-	// delegator ...
-	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
+type QueryAccountAssetsResponse struct {
+	// balance ...
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// protocol_staking ...
+	ProtocolStaking github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=protocol_staking,json=protocolStaking,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_staking"`
+	// protocol_staking_unbonding
+	ProtocolStakingUnbonding github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=protocol_staking_unbonding,json=protocolStakingUnbonding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_staking_unbonding"`
+	// protocol_delegation ...
+	ProtocolDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=protocol_delegation,json=protocolDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_delegation"`
+	// protocol_delegation_unbonding
+	ProtocolDelegationUnbonding github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=protocol_delegation_unbonding,json=protocolDelegationUnbonding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_delegation_unbonding"`
+	// protocol_rewards ...
+	ProtocolRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=protocol_rewards,json=protocolRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_rewards"`
+	// protocol_funding ...
+	ProtocolFunding github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=protocol_funding,json=protocolFunding,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"protocol_funding"`
 }
 
-func (m *QueryDelegatorRequest) Reset()         { *m = QueryDelegatorRequest{} }
-func (m *QueryDelegatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorRequest) ProtoMessage()    {}
-func (*QueryDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{54}
+func (m *QueryAccountAssetsResponse) Reset()         { *m = QueryAccountAssetsResponse{} }
+func (m *QueryAccountAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAssetsResponse) ProtoMessage()    {}
+func (*QueryAccountAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{56}
 }
-func (m *QueryDelegatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountAssetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountAssetsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3269,57 +3055,93 @@ func (m *QueryDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorRequest.Merge(m, src)
+func (m *QueryAccountAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAssetsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorRequest) XXX_Size() int {
+func (m *QueryAccountAssetsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorRequest.DiscardUnknown(m)
+func (m *QueryAccountAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAssetsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountAssetsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
+// QueryAccountFundedListRequest ...
+type QueryAccountStakingUnbondingsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountStakingUnbondingsRequest) Reset()         { *m = QueryAccountStakingUnbondingsRequest{} }
+func (m *QueryAccountStakingUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakingUnbondingsRequest) ProtoMessage()    {}
+func (*QueryAccountStakingUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{57}
+}
+func (m *QueryAccountStakingUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStakingUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStakingUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryAccountStakingUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakingUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryAccountStakingUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStakingUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakingUnbondingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorRequest) GetStaker() string {
+var xxx_messageInfo_QueryAccountStakingUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountStakingUnbondingsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Staker
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryDelegatorRequest) GetDelegator() string {
+func (m *QueryAccountStakingUnbondingsRequest) GetAddress() string {
 	if m != nil {
-		return m.Delegator
+		return m.Address
 	}
 	return ""
 }
 
-// QueryDelegatorResponse is the response type for the Query/Delegator RPC method.
-type QueryDelegatorResponse struct {
-	// delegator ...
-	Delegator *StakerDelegatorResponse `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
+type QueryAccountStakingUnbondingsResponse struct {
+	// balance ...
+	Unbondings []StakingUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorResponse) Reset()         { *m = QueryDelegatorResponse{} }
-func (m *QueryDelegatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorResponse) ProtoMessage()    {}
-func (*QueryDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{55}
+func (m *QueryAccountStakingUnbondingsResponse) Reset()         { *m = QueryAccountStakingUnbondingsResponse{} }
+func (m *QueryAccountStakingUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakingUnbondingsResponse) ProtoMessage()    {}
+func (*QueryAccountStakingUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{58}
 }
-func (m *QueryDelegatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountStakingUnbondingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountStakingUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountStakingUnbondingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3329,49 +3151,54 @@ func (m *QueryDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorResponse.Merge(m, src)
+func (m *QueryAccountStakingUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakingUnbondingsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorResponse) XXX_Size() int {
+func (m *QueryAccountStakingUnbondingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorResponse.DiscardUnknown(m)
+func (m *QueryAccountStakingUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakingUnbondingsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountStakingUnbondingsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorResponse) GetDelegator() *StakerDelegatorResponse {
+func (m *QueryAccountStakingUnbondingsResponse) GetUnbondings() []StakingUnbonding {
 	if m != nil {
-		return m.Delegator
+		return m.Unbondings
 	}
 	return nil
 }
 
-// StakerDelegatorResponse ...
-type StakerDelegatorResponse struct {
-	// delegator ...
-	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	// current_reward ...
-	CurrentReward uint64 `protobuf:"varint,2,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
-	// delegation_amount ...
-	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
-	// staker ...
-	Staker string `protobuf:"bytes,4,opt,name=staker,proto3" json:"staker,omitempty"`
+func (m *QueryAccountStakingUnbondingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *StakerDelegatorResponse) Reset()         { *m = StakerDelegatorResponse{} }
-func (m *StakerDelegatorResponse) String() string { return proto.CompactTextString(m) }
-func (*StakerDelegatorResponse) ProtoMessage()    {}
-func (*StakerDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{56}
+// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
+type StakingUnbonding struct {
+	// amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// creation_time
+	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
 }
-func (m *StakerDelegatorResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *StakingUnbonding) Reset()         { *m = StakingUnbonding{} }
+func (m *StakingUnbonding) String() string { return proto.CompactTextString(m) }
+func (*StakingUnbonding) ProtoMessage()    {}
+func (*StakingUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{59}
+}
+func (m *StakingUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StakerDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StakingUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StakerDelegatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StakingUnbonding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3381,68 +3208,54 @@ func (m *StakerDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *StakerDelegatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StakerDelegatorResponse.Merge(m, src)
+func (m *StakingUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingUnbonding.Merge(m, src)
 }
-func (m *StakerDelegatorResponse) XXX_Size() int {
+func (m *StakingUnbonding) XXX_Size() int {
 	return m.Size()
 }
-func (m *StakerDelegatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StakerDelegatorResponse.DiscardUnknown(m)
+func (m *StakingUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingUnbonding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StakerDelegatorResponse proto.InternalMessageInfo
-
-func (m *StakerDelegatorResponse) GetDelegator() string {
-	if m != nil {
-		return m.Delegator
-	}
-	return ""
-}
+var xxx_messageInfo_StakingUnbonding proto.InternalMessageInfo
 
-func (m *StakerDelegatorResponse) GetCurrentReward() uint64 {
+func (m *StakingUnbonding) GetCreationTime() uint64 {
 	if m != nil {
-		return m.CurrentReward
+		return m.CreationTime
 	}
 	return 0
 }
 
-func (m *StakerDelegatorResponse) GetDelegationAmount() uint64 {
+func (m *StakingUnbonding) GetPool() *Pool {
 	if m != nil {
-		return m.DelegationAmount
+		return m.Pool
 	}
-	return 0
+	return nil
 }
 
-func (m *StakerDelegatorResponse) GetStaker() string {
-	if m != nil {
-		return m.Staker
-	}
-	return ""
-}
-
-// QueryDelegatorsByPoolAndStakerRequest is the request type for the Query/DelegatorsByPoolAndStaker RPC method.
-type QueryDelegatorsByPoolAndStakerRequest struct {
+// QueryAccountFundedListRequest ...
+type QueryAccountDelegationUnbondingsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// staker ...
-	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) Reset()         { *m = QueryDelegatorsByPoolAndStakerRequest{} }
-func (m *QueryDelegatorsByPoolAndStakerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorsByPoolAndStakerRequest) ProtoMessage()    {}
-func (*QueryDelegatorsByPoolAndStakerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{57}
+func (m *QueryAccountDelegationUnbondingsRequest) Reset() {
+	*m = QueryAccountDelegationUnbondingsRequest{}
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountDelegationUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountDelegationUnbondingsRequest) ProtoMessage()    {}
+func (*QueryAccountDelegationUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{60}
+}
+func (m *QueryAccountDelegationUnbondingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountDelegationUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3452,65 +3265,54 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.Merge(m, src)
+func (m *QueryAccountDelegationUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.Merge(m, src)
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Size() int {
+func (m *QueryAccountDelegationUnbondingsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.DiscardUnknown(m)
+func (m *QueryAccountDelegationUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountDelegationUnbondingsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountDelegationUnbondingsRequest proto.InternalMessageInfo
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) GetPagination() *query.PageRequest {
+func (m *QueryAccountDelegationUnbondingsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
-
-func (m *QueryDelegatorsByPoolAndStakerRequest) GetStaker() string {
+func (m *QueryAccountDelegationUnbondingsRequest) GetAddress() string {
 	if m != nil {
-		return m.Staker
+		return m.Address
 	}
 	return ""
 }
 
-// QueryDelegatorsByPoolAndStakerResponse is the response type for the Query/DelegatorsByPoolAndStaker RPC method.
-type QueryDelegatorsByPoolAndStakerResponse struct {
-	// delegators ...
-	Delegators []StakerDelegatorResponse `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
-	// delegation_pool_data ...
-	DelegationPoolData *DelegationPoolData `protobuf:"bytes,3,opt,name=delegation_pool_data,json=delegationPoolData,proto3" json:"delegation_pool_data,omitempty"`
+// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
+type QueryAccountDelegationUnbondingsResponse struct {
+	// balance ...
+	Unbondings []DelegationUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
 	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) Reset() {
-	*m = QueryDelegatorsByPoolAndStakerResponse{}
+func (m *QueryAccountDelegationUnbondingsResponse) Reset() {
+	*m = QueryAccountDelegationUnbondingsResponse{}
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDelegatorsByPoolAndStakerResponse) ProtoMessage()    {}
-func (*QueryDelegatorsByPoolAndStakerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{58}
+func (m *QueryAccountDelegationUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountDelegationUnbondingsResponse) ProtoMessage()    {}
+func (*QueryAccountDelegationUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{61}
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountDelegationUnbondingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountDelegationUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3520,68 +3322,56 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.Merge(m, src)
+func (m *QueryAccountDelegationUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.Merge(m, src)
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Size() int {
+func (m *QueryAccountDelegationUnbondingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse proto.InternalMessageInfo
-
-func (m *QueryDelegatorsByPoolAndStakerResponse) GetDelegators() []StakerDelegatorResponse {
-	if m != nil {
-		return m.Delegators
-	}
-	return nil
+func (m *QueryAccountDelegationUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountDelegationUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) GetPool() *Pool {
-	if m != nil {
-		return m.Pool
-	}
-	return nil
-}
+var xxx_messageInfo_QueryAccountDelegationUnbondingsResponse proto.InternalMessageInfo
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) GetDelegationPoolData() *DelegationPoolData {
+func (m *QueryAccountDelegationUnbondingsResponse) GetUnbondings() []DelegationUnbonding {
 	if m != nil {
-		return m.DelegationPoolData
+		return m.Unbondings
 	}
 	return nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) GetPagination() *query.PageResponse {
+func (m *QueryAccountDelegationUnbondingsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryStakersByPoolAndDelegatorRequest  is the request type for the Query/StakersByPoolAndDelegator RPC method.
-type QueryStakersByPoolAndDelegatorRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// delegator ...
-	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+// QueryAccountAssetsResponse is the response type for the Query/AccountAssets RPC method.
+type DelegationUnbonding struct {
+	// amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// creation_time
+	CreationTime uint64 `protobuf:"varint,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// creation_time
+	Staker *StakerResponse `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,4,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) Reset()         { *m = QueryStakersByPoolAndDelegatorRequest{} }
-func (m *QueryStakersByPoolAndDelegatorRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryStakersByPoolAndDelegatorRequest) ProtoMessage()    {}
-func (*QueryStakersByPoolAndDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{59}
+func (m *DelegationUnbonding) Reset()         { *m = DelegationUnbonding{} }
+func (m *DelegationUnbonding) String() string { return proto.CompactTextString(m) }
+func (*DelegationUnbonding) ProtoMessage()    {}
+func (*DelegationUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{62}
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *DelegationUnbonding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegationUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegationUnbonding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3591,65 +3381,59 @@ func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.Merge(m, src)
+func (m *DelegationUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationUnbonding.Merge(m, src)
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Size() int {
+func (m *DelegationUnbonding) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.DiscardUnknown(m)
+func (m *DelegationUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationUnbonding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_DelegationUnbonding proto.InternalMessageInfo
 
-func (m *QueryStakersByPoolAndDelegatorRequest) GetPagination() *query.PageRequest {
+func (m *DelegationUnbonding) GetCreationTime() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.CreationTime
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) GetPoolId() uint64 {
+func (m *DelegationUnbonding) GetStaker() *StakerResponse {
 	if m != nil {
-		return m.PoolId
+		return m.Staker
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) GetDelegator() string {
+func (m *DelegationUnbonding) GetPool() *Pool {
 	if m != nil {
-		return m.Delegator
+		return m.Pool
 	}
-	return ""
+	return nil
 }
 
-// QueryStakersByPoolAndDelegatorResponse  is the response type for the Query/StakersByPoolAndDelegator RPC method.
-type QueryStakersByPoolAndDelegatorResponse struct {
-	// delegator ...
-	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	// pool ...
-	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
-	// stakers ...
-	Stakers []DelegationForStakerResponse `protobuf:"bytes,3,rep,name=stakers,proto3" json:"stakers"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryAccountFundedListRequest is the request type for the account queries with pagination
+type QueryAccountFundedListRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) Reset() {
-	*m = QueryStakersByPoolAndDelegatorResponse{}
-}
-func (m *QueryStakersByPoolAndDelegatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryStakersByPoolAndDelegatorResponse) ProtoMessage()    {}
-func (*QueryStakersByPoolAndDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{60}
+func (m *QueryAccountFundedListRequest) Reset()         { *m = QueryAccountFundedListRequest{} }
+func (m *QueryAccountFundedListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFundedListRequest) ProtoMessage()    {}
+func (*QueryAccountFundedListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{63}
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAccountFundedListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAccountFundedListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAccountFundedListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3659,72 +3443,109 @@ func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.Merge(m, src)
+func (m *QueryAccountFundedListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFundedListRequest.Merge(m, src)
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Size() int {
+func (m *QueryAccountFundedListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.DiscardUnknown(m)
+func (m *QueryAccountFundedListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFundedListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAccountFundedListRequest proto.InternalMessageInfo
 
-func (m *QueryStakersByPoolAndDelegatorResponse) GetDelegator() string {
+func (m *QueryAccountFundedListRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Delegator
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) GetPool() *Pool {
+func (m *QueryAccountFundedListRequest) GetAddress() string {
 	if m != nil {
-		return m.Pool
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) GetStakers() []DelegationForStakerResponse {
-	if m != nil {
-		return m.Stakers
-	}
-	return nil
+// QueryAccountFundedListResponse is the response type for the Query/AccountFundedList RPC method.
+type QueryAccountFundedListResponse struct {
+	// funded ...
+	Funded []Funded `protobuf:"bytes,1,rep,name=funded,proto3" json:"funded"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
+func (m *QueryAccountFundedListResponse) Reset()         { *m = QueryAccountFundedListResponse{} }
+func (m *QueryAccountFundedListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFundedListResponse) ProtoMessage()    {}
+func (*QueryAccountFundedListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{64}
+}
+func (m *QueryAccountFundedListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFundedListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFundedListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountFundedListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFundedListResponse.Merge(m, src)
+}
+func (m *QueryAccountFundedListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFundedListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFundedListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountFundedListResponse proto.InternalMessageInfo
+
+func (m *QueryAccountFundedListResponse) GetFunded() []Funded {
+	if m != nil {
+		return m.Funded
 	}
 	return nil
 }
 
-// DelegationForStakerResponse ...
-type DelegationForStakerResponse struct {
-	// staker ...
-	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
-	// current_reward ...
-	CurrentReward uint64 `protobuf:"varint,2,opt,name=current_reward,json=currentReward,proto3" json:"current_reward,omitempty"`
-	// delegation_amount ...
-	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
-	// total_delegation_amount ...
-	TotalDelegationAmount uint64 `protobuf:"varint,4,opt,name=total_delegation_amount,json=totalDelegationAmount,proto3" json:"total_delegation_amount,omitempty"`
-	// delegator_count ...
-	DelegatorCount uint64 `protobuf:"varint,5,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
+func (m *QueryAccountFundedListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *DelegationForStakerResponse) Reset()         { *m = DelegationForStakerResponse{} }
-func (m *DelegationForStakerResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegationForStakerResponse) ProtoMessage()    {}
-func (*DelegationForStakerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c3b23aaee0836da, []int{61}
+// Funded ...
+type Funded struct {
+	// account ...
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
 }
-func (m *DelegationForStakerResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Funded) Reset()         { *m = Funded{} }
+func (m *Funded) String() string { return proto.CompactTextString(m) }
+func (*Funded) ProtoMessage()    {}
+func (*Funded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{65}
+}
+func (m *Funded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegationForStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Funded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegationForStakerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Funded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3734,3698 +3555,3670 @@ func (m *DelegationForStakerResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *DelegationForStakerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegationForStakerResponse.Merge(m, src)
+func (m *Funded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Funded.Merge(m, src)
 }
-func (m *DelegationForStakerResponse) XXX_Size() int {
+func (m *Funded) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegationForStakerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegationForStakerResponse.DiscardUnknown(m)
+func (m *Funded) XXX_DiscardUnknown() {
+	xxx_messageInfo_Funded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegationForStakerResponse proto.InternalMessageInfo
+var xxx_messageInfo_Funded proto.InternalMessageInfo
 
-func (m *DelegationForStakerResponse) GetStaker() string {
+func (m *Funded) GetAccount() string {
 	if m != nil {
-		return m.Staker
+		return m.Account
 	}
 	return ""
 }
 
-func (m *DelegationForStakerResponse) GetCurrentReward() uint64 {
+func (m *Funded) GetPool() *Pool {
 	if m != nil {
-		return m.CurrentReward
+		return m.Pool
 	}
-	return 0
+	return nil
 }
 
-func (m *DelegationForStakerResponse) GetDelegationAmount() uint64 {
-	if m != nil {
-		return m.DelegationAmount
+// QueryAccountStakedListRequest ...
+type QueryAccountStakedListRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountStakedListRequest) Reset()         { *m = QueryAccountStakedListRequest{} }
+func (m *QueryAccountStakedListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakedListRequest) ProtoMessage()    {}
+func (*QueryAccountStakedListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{66}
+}
+func (m *QueryAccountStakedListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStakedListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStakedListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
 }
+func (m *QueryAccountStakedListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakedListRequest.Merge(m, src)
+}
+func (m *QueryAccountStakedListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStakedListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakedListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountStakedListRequest proto.InternalMessageInfo
 
-func (m *DelegationForStakerResponse) GetTotalDelegationAmount() uint64 {
+func (m *QueryAccountStakedListRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.TotalDelegationAmount
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *DelegationForStakerResponse) GetDelegatorCount() uint64 {
+func (m *QueryAccountStakedListRequest) GetAddress() string {
 	if m != nil {
-		return m.DelegatorCount
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.registry.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.registry.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.registry.v1beta1.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.registry.v1beta1.QueryPoolResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.registry.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.registry.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryFundersListRequest)(nil), "kyve.registry.v1beta1.QueryFundersListRequest")
-	proto.RegisterType((*QueryFundersListResponse)(nil), "kyve.registry.v1beta1.QueryFundersListResponse")
-	proto.RegisterType((*QueryFunderRequest)(nil), "kyve.registry.v1beta1.QueryFunderRequest")
-	proto.RegisterType((*QueryFunderResponse)(nil), "kyve.registry.v1beta1.QueryFunderResponse")
-	proto.RegisterType((*QueryStakersListRequest)(nil), "kyve.registry.v1beta1.QueryStakersListRequest")
-	proto.RegisterType((*QueryStakersListResponse)(nil), "kyve.registry.v1beta1.QueryStakersListResponse")
-	proto.RegisterType((*QueryStakerRequest)(nil), "kyve.registry.v1beta1.QueryStakerRequest")
-	proto.RegisterType((*QueryStakerResponse)(nil), "kyve.registry.v1beta1.QueryStakerResponse")
-	proto.RegisterType((*PendingCommissionChange)(nil), "kyve.registry.v1beta1.PendingCommissionChange")
-	proto.RegisterType((*StakerResponse)(nil), "kyve.registry.v1beta1.StakerResponse")
-	proto.RegisterType((*QueryVoteStatusRequest)(nil), "kyve.registry.v1beta1.QueryVoteStatusRequest")
-	proto.RegisterType((*QueryVoteStatusResponse)(nil), "kyve.registry.v1beta1.QueryVoteStatusResponse")
-	proto.RegisterType((*VoteStatusResponse)(nil), "kyve.registry.v1beta1.VoteStatusResponse")
-	proto.RegisterType((*QueryProposalRequest)(nil), "kyve.registry.v1beta1.QueryProposalRequest")
-	proto.RegisterType((*QueryProposalResponse)(nil), "kyve.registry.v1beta1.QueryProposalResponse")
-	proto.RegisterType((*QueryProposalsRequest)(nil), "kyve.registry.v1beta1.QueryProposalsRequest")
-	proto.RegisterType((*QueryProposalsResponse)(nil), "kyve.registry.v1beta1.QueryProposalsResponse")
-	proto.RegisterType((*QueryProposalByHeightRequest)(nil), "kyve.registry.v1beta1.QueryProposalByHeightRequest")
-	proto.RegisterType((*QueryProposalByHeightResponse)(nil), "kyve.registry.v1beta1.QueryProposalByHeightResponse")
-	proto.RegisterType((*QueryProposalSinceFinalizedAtRequest)(nil), "kyve.registry.v1beta1.QueryProposalSinceFinalizedAtRequest")
-	proto.RegisterType((*QueryProposalSinceFinalizedAtResponse)(nil), "kyve.registry.v1beta1.QueryProposalSinceFinalizedAtResponse")
-	proto.RegisterType((*QueryProposalSinceIdRequest)(nil), "kyve.registry.v1beta1.QueryProposalSinceIdRequest")
-	proto.RegisterType((*QueryProposalSinceIdResponse)(nil), "kyve.registry.v1beta1.QueryProposalSinceIdResponse")
-	proto.RegisterType((*QueryCanProposeRequest)(nil), "kyve.registry.v1beta1.QueryCanProposeRequest")
-	proto.RegisterType((*QueryCanProposeResponse)(nil), "kyve.registry.v1beta1.QueryCanProposeResponse")
-	proto.RegisterType((*QueryCanVoteRequest)(nil), "kyve.registry.v1beta1.QueryCanVoteRequest")
-	proto.RegisterType((*QueryCanVoteResponse)(nil), "kyve.registry.v1beta1.QueryCanVoteResponse")
-	proto.RegisterType((*QueryStakeInfoRequest)(nil), "kyve.registry.v1beta1.QueryStakeInfoRequest")
-	proto.RegisterType((*QueryStakeInfoResponse)(nil), "kyve.registry.v1beta1.QueryStakeInfoResponse")
-	proto.RegisterType((*QueryAccountAssetsRequest)(nil), "kyve.registry.v1beta1.QueryAccountAssetsRequest")
-	proto.RegisterType((*QueryAccountAssetsResponse)(nil), "kyve.registry.v1beta1.QueryAccountAssetsResponse")
-	proto.RegisterType((*QueryAccountStakingUnbondingsRequest)(nil), "kyve.registry.v1beta1.QueryAccountStakingUnbondingsRequest")
-	proto.RegisterType((*QueryAccountStakingUnbondingsResponse)(nil), "kyve.registry.v1beta1.QueryAccountStakingUnbondingsResponse")
-	proto.RegisterType((*StakingUnbonding)(nil), "kyve.registry.v1beta1.StakingUnbonding")
-	proto.RegisterType((*QueryAccountDelegationUnbondingsRequest)(nil), "kyve.registry.v1beta1.QueryAccountDelegationUnbondingsRequest")
-	proto.RegisterType((*QueryAccountDelegationUnbondingsResponse)(nil), "kyve.registry.v1beta1.QueryAccountDelegationUnbondingsResponse")
-	proto.RegisterType((*DelegationUnbonding)(nil), "kyve.registry.v1beta1.DelegationUnbonding")
-	proto.RegisterType((*QueryAccountFundedListRequest)(nil), "kyve.registry.v1beta1.QueryAccountFundedListRequest")
-	proto.RegisterType((*QueryAccountFundedListResponse)(nil), "kyve.registry.v1beta1.QueryAccountFundedListResponse")
-	proto.RegisterType((*Funded)(nil), "kyve.registry.v1beta1.Funded")
-	proto.RegisterType((*QueryAccountStakedListRequest)(nil), "kyve.registry.v1beta1.QueryAccountStakedListRequest")
-	proto.RegisterType((*QueryAccountStakedListResponse)(nil), "kyve.registry.v1beta1.QueryAccountStakedListResponse")
-	proto.RegisterType((*Staked)(nil), "kyve.registry.v1beta1.Staked")
-	proto.RegisterType((*QueryAccountDelegationListRequest)(nil), "kyve.registry.v1beta1.QueryAccountDelegationListRequest")
-	proto.RegisterType((*QueryAccountDelegationListResponse)(nil), "kyve.registry.v1beta1.QueryAccountDelegationListResponse")
-	proto.RegisterType((*DelegatorResponse)(nil), "kyve.registry.v1beta1.DelegatorResponse")
-	proto.RegisterType((*QueryAccountRedelegationRequest)(nil), "kyve.registry.v1beta1.QueryAccountRedelegationRequest")
-	proto.RegisterType((*QueryAccountRedelegationResponse)(nil), "kyve.registry.v1beta1.QueryAccountRedelegationResponse")
-	proto.RegisterType((*QueryDelegatorRequest)(nil), "kyve.registry.v1beta1.QueryDelegatorRequest")
-	proto.RegisterType((*QueryDelegatorResponse)(nil), "kyve.registry.v1beta1.QueryDelegatorResponse")
-	proto.RegisterType((*StakerDelegatorResponse)(nil), "kyve.registry.v1beta1.StakerDelegatorResponse")
-	proto.RegisterType((*QueryDelegatorsByPoolAndStakerRequest)(nil), "kyve.registry.v1beta1.QueryDelegatorsByPoolAndStakerRequest")
-	proto.RegisterType((*QueryDelegatorsByPoolAndStakerResponse)(nil), "kyve.registry.v1beta1.QueryDelegatorsByPoolAndStakerResponse")
-	proto.RegisterType((*QueryStakersByPoolAndDelegatorRequest)(nil), "kyve.registry.v1beta1.QueryStakersByPoolAndDelegatorRequest")
-	proto.RegisterType((*QueryStakersByPoolAndDelegatorResponse)(nil), "kyve.registry.v1beta1.QueryStakersByPoolAndDelegatorResponse")
-	proto.RegisterType((*DelegationForStakerResponse)(nil), "kyve.registry.v1beta1.DelegationForStakerResponse")
+// QueryAccountStakedListResponse is the response type for the Query/StakedList RPC method.
+type QueryAccountStakedListResponse struct {
+	// staked ...
+	Staked []Staked `protobuf:"bytes,1,rep,name=staked,proto3" json:"staked"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("kyve/registry/v1beta1/query.proto", fileDescriptor_5c3b23aaee0836da) }
-
-var fileDescriptor_5c3b23aaee0836da = []byte{
-	// 2985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1c, 0x47,
-	0xf5, 0x77, 0xcd, 0x8c, 0x77, 0xbd, 0x6f, 0xed, 0xb5, 0x5d, 0xfe, 0x1a, 0x8f, 0xed, 0xb5, 0xd3,
-	0x89, 0xe3, 0xb5, 0x13, 0xcf, 0xc4, 0x1b, 0x3b, 0x4e, 0xfe, 0xb6, 0xe3, 0xac, 0xd7, 0x5e, 0xc7,
-	0x8e, 0xfd, 0x8f, 0x99, 0x0d, 0x91, 0x08, 0x87, 0x51, 0xcf, 0x74, 0xef, 0x6c, 0xe3, 0x99, 0xae,
-	0x49, 0x77, 0x8f, 0x37, 0x8b, 0xb5, 0x12, 0x04, 0x11, 0x45, 0x42, 0x42, 0x48, 0x41, 0x48, 0x88,
-	0x03, 0x44, 0x10, 0x1f, 0x02, 0x1c, 0x38, 0x20, 0x04, 0x48, 0x44, 0x02, 0x45, 0xca, 0x09, 0x45,
-	0x42, 0x48, 0x88, 0x03, 0x42, 0x09, 0x42, 0x22, 0xe2, 0xcc, 0x19, 0x75, 0xd5, 0xab, 0xee, 0xea,
-	0x99, 0xfe, 0x9a, 0xf5, 0xda, 0xe4, 0x36, 0x55, 0xf3, 0x5e, 0xbd, 0xdf, 0xfb, 0xa8, 0x57, 0x55,
-	0xef, 0x35, 0x3c, 0x72, 0x7b, 0xf5, 0x8e, 0x59, 0x73, 0xcc, 0xb6, 0xe5, 0x7a, 0xce, 0x6a, 0xed,
-	0xce, 0xa9, 0xa6, 0xe9, 0xe9, 0xa7, 0x6a, 0xaf, 0xf7, 0x4d, 0x67, 0xb5, 0xda, 0x73, 0x98, 0xc7,
-	0xe8, 0x1e, 0x9f, 0xa4, 0x2a, 0x49, 0xaa, 0x48, 0x52, 0x39, 0xd1, 0x62, 0x6e, 0x97, 0xb9, 0xb5,
-	0xa6, 0xee, 0x9a, 0x82, 0x3e, 0xe0, 0xee, 0xe9, 0x6d, 0xcb, 0xd6, 0x3d, 0x8b, 0xd9, 0x62, 0x89,
-	0xca, 0xee, 0x36, 0x6b, 0x33, 0xfe, 0xb3, 0xe6, 0xff, 0xc2, 0xd9, 0x83, 0x6d, 0xc6, 0xda, 0x1d,
-	0xb3, 0xa6, 0xf7, 0xac, 0x9a, 0x6e, 0xdb, 0xcc, 0xe3, 0x2c, 0x2e, 0xfe, 0xab, 0xc5, 0x23, 0xeb,
-	0xe9, 0x8e, 0xde, 0x95, 0x34, 0x8f, 0xc5, 0xd3, 0x04, 0x58, 0x39, 0x95, 0xb6, 0x1b, 0xe8, 0x17,
-	0x7c, 0x7c, 0xb7, 0x38, 0x6b, 0xdd, 0x7c, 0xbd, 0x6f, 0xba, 0x9e, 0x56, 0x87, 0x5d, 0x91, 0x59,
-	0xb7, 0xc7, 0x6c, 0xd7, 0xa4, 0xe7, 0x60, 0x4c, 0x88, 0x28, 0x93, 0x23, 0x64, 0x66, 0x72, 0xf6,
-	0x50, 0x35, 0x56, 0xfd, 0xaa, 0x60, 0xbb, 0x54, 0xfa, 0xe8, 0x6f, 0x87, 0x37, 0xd5, 0x91, 0x45,
-	0xd3, 0x60, 0x87, 0x58, 0x93, 0xb1, 0x0e, 0xca, 0xa1, 0x53, 0x50, 0xb0, 0x0c, 0xbe, 0x58, 0xa9,
-	0x5e, 0xb0, 0x0c, 0xed, 0x3a, 0xec, 0x54, 0x68, 0x50, 0xea, 0x19, 0x28, 0xf5, 0x18, 0xeb, 0xa0,
-	0xcc, 0x03, 0x49, 0x32, 0x19, 0xeb, 0xa0, 0x44, 0x4e, 0xae, 0xbd, 0x47, 0x94, 0xc5, 0xa4, 0x66,
-	0x74, 0x01, 0x20, 0xf4, 0x00, 0x2e, 0xf9, 0x78, 0x55, 0xb8, 0xab, 0xea, 0xbb, 0xab, 0x2a, 0xdc,
-	0x1b, 0xaa, 0xd2, 0x36, 0x91, 0xb7, 0xae, 0x70, 0xd2, 0xbd, 0x30, 0xe6, 0x9a, 0xba, 0xd3, 0x5a,
-	0x2e, 0x17, 0x8e, 0x90, 0x99, 0x89, 0x3a, 0x8e, 0x68, 0x19, 0xc6, 0x9d, 0xbe, 0xed, 0x59, 0x5d,
-	0xb3, 0x5c, 0xe4, 0x7f, 0xc8, 0xa1, 0xcf, 0xd1, 0xd3, 0xfb, 0xae, 0x69, 0x94, 0x4b, 0x47, 0xc8,
-	0xcc, 0x96, 0x3a, 0x8e, 0xb4, 0xef, 0x11, 0xe9, 0x02, 0x81, 0x13, 0xb5, 0x3e, 0x0b, 0x9b, 0x7d,
-	0x35, 0x7c, 0x53, 0x17, 0xf3, 0xa9, 0x2d, 0xe8, 0xe9, 0xd5, 0x88, 0x86, 0x05, 0xae, 0xe1, 0xb1,
-	0x4c, 0x0d, 0x85, 0x54, 0x55, 0x45, 0x6d, 0x16, 0xf6, 0x71, 0x5c, 0x0b, 0x7d, 0xdb, 0x30, 0x1d,
-	0xf7, 0x86, 0xe5, 0x7a, 0xd2, 0x8a, 0xfb, 0x60, 0xdc, 0x17, 0xd6, 0x08, 0x9c, 0x37, 0xe6, 0x0f,
-	0xaf, 0x19, 0xda, 0x22, 0x94, 0x87, 0x79, 0x02, 0x8d, 0xc6, 0x97, 0xc4, 0x34, 0xea, 0x94, 0x14,
-	0x3e, 0x82, 0xb9, 0x2e, 0xa9, 0xb5, 0x2b, 0x68, 0x20, 0x9c, 0xcf, 0xc0, 0xe0, 0x1b, 0x5a, 0x70,
-	0x4a, 0xd7, 0x88, 0x91, 0x76, 0x03, 0x83, 0x5a, 0x2e, 0x13, 0x84, 0x97, 0x24, 0x4f, 0x0f, 0x6a,
-	0x64, 0x93, 0xab, 0xfd, 0x8a, 0xa0, 0x79, 0x16, 0x3d, 0xfd, 0x76, 0x4e, 0xf3, 0xf8, 0x1b, 0xc8,
-	0xf5, 0x74, 0xaf, 0xef, 0x72, 0x68, 0x53, 0xb3, 0x8f, 0x26, 0xc8, 0x12, 0x6b, 0x2e, 0x72, 0xd2,
-	0x3a, 0xb2, 0x0c, 0x84, 0x6e, 0x71, 0xbd, 0xa1, 0xab, 0xfd, 0x84, 0xa0, 0x93, 0x22, 0xc8, 0xd1,
-	0x1a, 0x17, 0x61, 0xdc, 0x15, 0xd3, 0xe8, 0xa4, 0xa3, 0xa9, 0x10, 0x83, 0xc0, 0x91, 0x5c, 0x1b,
-	0x17, 0x7e, 0xd2, 0xeb, 0x52, 0x50, 0xb6, 0xd7, 0x05, 0x84, 0x60, 0x43, 0xf2, 0x91, 0xf6, 0x0a,
-	0x7a, 0x3d, 0x8a, 0x97, 0x5e, 0x08, 0xc8, 0x85, 0xd7, 0x73, 0xaa, 0x29, 0x57, 0x7d, 0x8b, 0xc0,
-	0xbe, 0x5b, 0xa6, 0x6d, 0x58, 0x76, 0x7b, 0x9e, 0x75, 0xbb, 0x96, 0xeb, 0x5a, 0xcc, 0x9e, 0x5f,
-	0xd6, 0xed, 0xb6, 0x49, 0x8f, 0xc2, 0x94, 0x6d, 0xae, 0x34, 0x5a, 0xc1, 0x3c, 0x17, 0x31, 0x51,
-	0xdf, 0x66, 0x9b, 0x2b, 0x21, 0x31, 0x7d, 0x14, 0xb6, 0xb5, 0x1c, 0x93, 0xeb, 0xda, 0x30, 0x74,
-	0xcf, 0xe4, 0xb8, 0x8b, 0xf5, 0xad, 0x72, 0xf2, 0xb2, 0xee, 0x99, 0xf4, 0x30, 0x4c, 0x2e, 0x59,
-	0xb6, 0xe5, 0x2e, 0x0b, 0x92, 0x22, 0x27, 0x01, 0x31, 0xe5, 0x13, 0x68, 0xef, 0x96, 0x60, 0x6a,
-	0x40, 0xb5, 0xbd, 0x11, 0xd5, 0x02, 0x4b, 0xa8, 0xa6, 0x2b, 0x44, 0x4c, 0x57, 0x86, 0x71, 0xbd,
-	0xd5, 0x62, 0x7d, 0xdb, 0x93, 0x39, 0x0b, 0x87, 0xfe, 0x52, 0x7a, 0x97, 0xff, 0x51, 0x12, 0x1c,
-	0x62, 0x44, 0x8f, 0xc3, 0x0e, 0x8f, 0x79, 0x7a, 0xa7, 0x61, 0x98, 0x1d, 0xb3, 0x2d, 0x5c, 0xbd,
-	0x99, 0x53, 0x6c, 0xe7, 0xf3, 0x97, 0x83, 0x69, 0x3a, 0x0d, 0xa0, 0x58, 0x62, 0x8c, 0xaf, 0xaf,
-	0xcc, 0xf8, 0xc2, 0xbb, 0xcc, 0xb6, 0x7c, 0xb8, 0xe3, 0x42, 0x38, 0x0e, 0xfd, 0x7f, 0x56, 0xcc,
-	0xa6, 0x6b, 0x79, 0x66, 0x79, 0x8b, 0xf8, 0x07, 0x87, 0x94, 0x42, 0xa9, 0xc3, 0xda, 0xac, 0x3c,
-	0xc1, 0xa7, 0xf9, 0x6f, 0x9e, 0x5e, 0x99, 0x65, 0x7b, 0x6e, 0x19, 0xa4, 0x72, 0xfe, 0xc8, 0x87,
-	0xda, 0xb7, 0x9b, 0x8c, 0xbb, 0xaa, 0x81, 0xca, 0x4c, 0x0a, 0xa8, 0xc1, 0xfc, 0x9c, 0xd0, 0xea,
-	0x24, 0xd0, 0x7e, 0xaf, 0xc3, 0x74, 0xa3, 0xd1, 0x73, 0x58, 0x53, 0x6f, 0x5a, 0x1d, 0xcb, 0x5b,
-	0x2d, 0x6f, 0xe5, 0x42, 0x76, 0x8a, 0x7f, 0x6e, 0x85, 0x7f, 0x28, 0x9b, 0x79, 0xdb, 0xe8, 0x9b,
-	0xf9, 0x2b, 0xb0, 0xbf, 0x27, 0xe2, 0x47, 0x09, 0x94, 0x46, 0x8b, 0x47, 0x50, 0x79, 0x8a, 0x87,
-	0x64, 0x35, 0x29, 0xe5, 0xc7, 0xc7, 0x5d, 0x7d, 0x5f, 0x2f, 0xfe, 0x0f, 0xed, 0x14, 0xec, 0xe5,
-	0x5b, 0xe0, 0x55, 0xe6, 0x99, 0x08, 0x23, 0x2b, 0x8f, 0x9b, 0x98, 0xdc, 0x54, 0x16, 0x0c, 0xaf,
-	0xeb, 0x30, 0x79, 0x87, 0x79, 0x66, 0x03, 0x75, 0x17, 0xdb, 0xe7, 0x78, 0x02, 0xd6, 0x61, 0xfe,
-	0x3a, 0xdc, 0x09, 0xe6, 0x34, 0x0f, 0x68, 0x8c, 0x84, 0xdd, 0xb0, 0xf9, 0x8e, 0xde, 0x09, 0x30,
-	0x89, 0x81, 0x1f, 0x0e, 0x96, 0x2d, 0xe6, 0x45, 0xf8, 0xca, 0x21, 0x8f, 0xdf, 0xa6, 0xeb, 0xe9,
-	0x96, 0xc8, 0x8a, 0xa5, 0xba, 0x1c, 0xfa, 0x2b, 0xf1, 0x78, 0xc4, 0xf0, 0x15, 0x03, 0xed, 0x0c,
-	0xec, 0x16, 0x07, 0xae, 0xc3, 0x7a, 0xcc, 0xd5, 0x83, 0xdb, 0xc8, 0x21, 0x00, 0xd7, 0x63, 0x8e,
-	0xde, 0x36, 0xa5, 0x41, 0x26, 0xea, 0x13, 0x38, 0x73, 0xcd, 0xd0, 0x5e, 0x83, 0x3d, 0x03, 0x6c,
-	0x88, 0x77, 0x0e, 0xb6, 0xf4, 0x70, 0x0e, 0xcd, 0x71, 0x38, 0xc9, 0x75, 0x48, 0x86, 0x27, 0x76,
-	0xc0, 0xa6, 0xbd, 0x31, 0xb0, 0xf6, 0x86, 0xdf, 0x57, 0x92, 0x36, 0xbf, 0x76, 0x8f, 0x60, 0x74,
-	0x28, 0xa2, 0x51, 0xaf, 0x79, 0x98, 0x90, 0x00, 0xe5, 0x69, 0x90, 0x53, 0xb1, 0x90, 0x6f, 0xe3,
-	0xce, 0x83, 0x97, 0xe1, 0x60, 0x04, 0xe7, 0xa5, 0xd5, 0x17, 0x4d, 0xab, 0xbd, 0xec, 0xe5, 0x39,
-	0x19, 0x96, 0x39, 0xa5, 0xd4, 0x5c, 0x8c, 0xb4, 0x26, 0x1c, 0x4a, 0x58, 0x70, 0xe3, 0xfc, 0xfa,
-	0x3e, 0x81, 0xc7, 0x22, 0x42, 0x16, 0x2d, 0xbb, 0x65, 0x2e, 0x58, 0xb6, 0xde, 0xb1, 0xbe, 0x6a,
-	0x1a, 0x73, 0xde, 0xc3, 0xf2, 0x33, 0x7d, 0x04, 0xb6, 0x2e, 0x49, 0xb1, 0x0d, 0xdd, 0xc3, 0x9d,
-	0x32, 0xb9, 0x14, 0x42, 0xd1, 0x7e, 0x49, 0xe0, 0x68, 0x06, 0xd8, 0xcf, 0x65, 0x64, 0x7c, 0x9b,
-	0xc0, 0x81, 0x61, 0xdc, 0xd7, 0x8c, 0x87, 0x66, 0x5b, 0xf1, 0x8c, 0x29, 0x06, 0xcf, 0x98, 0x9f,
-	0x91, 0x81, 0x58, 0x0d, 0x00, 0x7d, 0x2e, 0xed, 0x67, 0x63, 0x06, 0x98, 0xd7, 0x6d, 0x21, 0xcd,
-	0xcc, 0xdc, 0x53, 0x15, 0xb9, 0x35, 0x82, 0xfb, 0x56, 0x30, 0xe6, 0x77, 0x16, 0x87, 0x75, 0x1b,
-	0xb8, 0xe9, 0x84, 0x59, 0xc0, 0x9f, 0x12, 0xfb, 0x4b, 0xbb, 0x89, 0x87, 0x8b, 0x2a, 0x0f, 0x0d,
-	0xe3, 0xaf, 0xcb, 0x5c, 0xd7, 0x6a, 0x76, 0x4c, 0x2e, 0x71, 0x4b, 0x3d, 0x18, 0xfb, 0xfb, 0xd8,
-	0x31, 0x75, 0x17, 0x75, 0x9d, 0xa8, 0xe3, 0x48, 0x6b, 0xe1, 0x0d, 0x6f, 0x5e, 0xb7, 0xfd, 0xc3,
-	0x24, 0x13, 0xbb, 0x7f, 0xbc, 0x30, 0x2f, 0x00, 0x2e, 0x06, 0x03, 0xc9, 0xbf, 0x38, 0x98, 0xfc,
-	0xaf, 0xe3, 0x99, 0x11, 0x08, 0xb9, 0x0f, 0xc0, 0x2f, 0x62, 0xb2, 0xe7, 0x17, 0x83, 0x6b, 0xf6,
-	0x12, 0x5b, 0xf7, 0xe5, 0xf6, 0xd7, 0x32, 0x79, 0x2b, 0x4b, 0x21, 0xb0, 0x32, 0x8c, 0x37, 0xf5,
-	0x8e, 0x6e, 0xb7, 0x4c, 0x3c, 0xc9, 0xe4, 0x90, 0x5f, 0x3c, 0xfb, 0x8e, 0x63, 0xda, 0x5e, 0x83,
-	0x2f, 0x83, 0x6b, 0x6e, 0xc5, 0x49, 0xbe, 0x94, 0x4f, 0xd4, 0xb5, 0x6c, 0xab, 0xdb, 0xef, 0x22,
-	0x91, 0xb0, 0xc8, 0x56, 0x9c, 0x14, 0x44, 0xe1, 0x0d, 0xa8, 0x34, 0xf2, 0x0d, 0x48, 0x3b, 0x03,
-	0xfb, 0x39, 0xf4, 0x39, 0x71, 0xd7, 0x9c, 0x73, 0x5d, 0xd3, 0x0b, 0x8e, 0x3d, 0xff, 0x48, 0x37,
-	0x0c, 0xc7, 0x74, 0x5d, 0x89, 0x1e, 0x87, 0xda, 0x67, 0x05, 0xa8, 0xc4, 0xf1, 0xc5, 0xab, 0x5d,
-	0x0a, 0xd5, 0x3e, 0x0e, 0x3b, 0x78, 0xc9, 0xa3, 0xc5, 0x3a, 0x5c, 0x25, 0xcb, 0x6e, 0xe3, 0x36,
-	0xde, 0x2e, 0xe7, 0x17, 0xc5, 0x34, 0x3d, 0x0f, 0x95, 0x41, 0xd2, 0x46, 0x70, 0x59, 0xc4, 0x80,
-	0x2e, 0x0f, 0x30, 0x7d, 0x51, 0xfe, 0x4f, 0x6b, 0xb0, 0x2b, 0xe0, 0x56, 0xee, 0xc7, 0xe2, 0x0a,
-	0x42, 0xe5, 0x5f, 0xca, 0x15, 0xf9, 0x12, 0x1c, 0x8a, 0x61, 0x50, 0x24, 0x8a, 0xab, 0xf5, 0x81,
-	0x61, 0xd6, 0x50, 0xa8, 0xaa, 0x9d, 0x63, 0xae, 0xe8, 0x8e, 0xe1, 0xf2, 0xcb, 0xb6, 0xa2, 0x5d,
-	0x5d, 0x4c, 0x47, 0x48, 0xfd, 0xc7, 0xac, 0x2f, 0x61, 0x3c, 0x4a, 0xba, 0x20, 0xa6, 0xb5, 0xb7,
-	0xe5, 0xf1, 0x85, 0xc6, 0x1e, 0xd4, 0x75, 0xc3, 0xaf, 0x29, 0x8a, 0xdf, 0x0b, 0x51, 0xbf, 0x7f,
-	0x20, 0x0f, 0xa7, 0x64, 0x28, 0x18, 0x02, 0x37, 0x01, 0x02, 0xd3, 0xc9, 0xec, 0x7a, 0x2c, 0x25,
-	0x32, 0xd5, 0x55, 0x30, 0xcb, 0x2a, 0x0b, 0x6c, 0x5c, 0x9a, 0xfd, 0x1a, 0x81, 0x1d, 0x43, 0xc1,
-	0x12, 0xbe, 0xb0, 0x48, 0xe4, 0x85, 0xa5, 0xbe, 0x0e, 0x79, 0x35, 0x49, 0x84, 0x6a, 0xf0, 0x3a,
-	0x7c, 0xc5, 0xea, 0x9a, 0xb4, 0x86, 0x95, 0xb1, 0x62, 0x66, 0x65, 0x0c, 0x6b, 0x62, 0xdf, 0x22,
-	0x70, 0x4c, 0x35, 0x62, 0x4c, 0x24, 0x3d, 0x44, 0x97, 0x7e, 0x48, 0x60, 0x26, 0x1b, 0x0d, 0x7a,
-	0xf5, 0x56, 0x8c, 0x57, 0x4f, 0x24, 0x68, 0x1c, 0xb3, 0xd0, 0x83, 0x74, 0xec, 0xef, 0x09, 0xec,
-	0x8a, 0xdb, 0x93, 0xf7, 0xe5, 0xdb, 0xb0, 0x40, 0x51, 0x5c, 0x47, 0x81, 0x22, 0x08, 0x8d, 0x52,
-	0xde, 0xd0, 0xf8, 0x3a, 0xc1, 0xeb, 0x30, 0x3a, 0x83, 0x97, 0xbb, 0x0c, 0xb5, 0xaa, 0xf5, 0xe0,
-	0x03, 0xe2, 0x1e, 0x81, 0xe9, 0x24, 0x0c, 0x61, 0x09, 0x9a, 0x17, 0xe0, 0x8c, 0x3c, 0x35, 0x44,
-	0x43, 0x96, 0xa0, 0x05, 0xcb, 0xc6, 0x79, 0xfc, 0x36, 0x8c, 0x09, 0x01, 0x6a, 0xed, 0x84, 0x24,
-	0xd5, 0x4e, 0x0a, 0x11, 0xef, 0x8f, 0xbc, 0x69, 0x07, 0x3d, 0xc3, 0x3d, 0xfe, 0x3f, 0xf6, 0x8c,
-	0x8a, 0x21, 0xf4, 0x0c, 0x8f, 0xbd, 0x2c, 0xcf, 0x08, 0x56, 0xe9, 0x19, 0xc1, 0xb2, 0x71, 0x9e,
-	0xf9, 0x0f, 0x81, 0x31, 0x21, 0xe1, 0x61, 0xd4, 0xc1, 0xa4, 0x2f, 0xc7, 0x72, 0xfa, 0x32, 0xb6,
-	0x1a, 0x35, 0x3e, 0x4a, 0x35, 0x6a, 0x4b, 0x42, 0x35, 0x4a, 0xfb, 0x26, 0x81, 0x47, 0xe2, 0x93,
-	0xe9, 0xc3, 0x8d, 0x94, 0x0f, 0x08, 0x68, 0x69, 0x38, 0x82, 0x74, 0x3e, 0x19, 0x5e, 0x75, 0x64,
-	0x3e, 0x9f, 0x49, 0xcf, 0xe7, 0x2c, 0x48, 0x73, 0x18, 0x3d, 0xea, 0x12, 0x1b, 0x17, 0x42, 0xef,
-	0x14, 0x61, 0xe7, 0x90, 0xc4, 0x94, 0x8d, 0x2e, 0x83, 0xa0, 0x90, 0x37, 0x08, 0x8e, 0xc2, 0x94,
-	0xbc, 0x80, 0x8b, 0xab, 0x1a, 0x5e, 0x29, 0xe5, 0xb5, 0x5c, 0x5c, 0xd4, 0xe8, 0x13, 0xb0, 0x53,
-	0xb9, 0x0d, 0x46, 0xe2, 0x6f, 0x47, 0xf8, 0x07, 0x46, 0x4b, 0x18, 0xec, 0x9b, 0x23, 0xc1, 0x9e,
-	0x5a, 0x67, 0x1c, 0xdb, 0xd0, 0x3a, 0x23, 0xfd, 0x32, 0xec, 0x56, 0x00, 0xf3, 0x3d, 0x66, 0xe8,
-	0x9e, 0xce, 0x03, 0x3c, 0xb9, 0x44, 0x18, 0x06, 0x88, 0x6f, 0xa2, 0xcb, 0xba, 0xa7, 0xd7, 0xa9,
-	0x31, 0x34, 0xa7, 0x9d, 0x83, 0xc3, 0x6a, 0x58, 0xd5, 0xcd, 0x90, 0x26, 0xfb, 0xd1, 0xd0, 0x81,
-	0x23, 0xc9, 0xcc, 0xe8, 0xe0, 0x17, 0xe1, 0x90, 0xa3, 0xcc, 0x37, 0x5a, 0x8c, 0x75, 0x0c, 0xb6,
-	0x62, 0x37, 0x4c, 0xdb, 0x73, 0x2c, 0x53, 0xc4, 0x68, 0x09, 0x23, 0xef, 0x80, 0x4a, 0x3a, 0x8f,
-	0x94, 0x57, 0x04, 0xa1, 0xb6, 0x84, 0xef, 0x3b, 0x25, 0x88, 0xd6, 0xf7, 0xbe, 0xa3, 0x07, 0x61,
-	0xc2, 0x90, 0x8b, 0xc8, 0x37, 0x69, 0x30, 0xa1, 0x2d, 0xe1, 0xe3, 0x6f, 0x38, 0x58, 0x6f, 0xa8,
-	0x7c, 0x24, 0xd5, 0xcb, 0xe2, 0xfe, 0x30, 0xb4, 0x84, 0x2a, 0xe7, 0xc7, 0x04, 0xf6, 0x25, 0x90,
-	0x45, 0x11, 0x92, 0x01, 0x84, 0x31, 0x91, 0x5e, 0xc8, 0x1d, 0xe9, 0xc5, 0xcc, 0x48, 0x2f, 0x45,
-	0xde, 0xc2, 0x3f, 0x92, 0x0f, 0x84, 0x00, 0xa4, 0x7b, 0x89, 0xf7, 0x54, 0xe7, 0x6c, 0x23, 0xda,
-	0x43, 0x7a, 0xe0, 0xf5, 0xa0, 0xbd, 0x91, 0xbb, 0x5b, 0x08, 0xf1, 0xcf, 0x05, 0x78, 0x3c, 0x0b,
-	0x22, 0xda, 0xf5, 0x15, 0x80, 0xc0, 0x8c, 0x32, 0x3d, 0x8e, 0xe8, 0x42, 0x79, 0xe5, 0x0d, 0xd7,
-	0x19, 0x3d, 0x55, 0x25, 0x6d, 0xe9, 0xe2, 0x06, 0x6c, 0xe9, 0x81, 0x8c, 0x5d, 0x5a, 0x7f, 0xc6,
-	0xbe, 0x27, 0x5d, 0x8f, 0x1d, 0xcd, 0xc0, 0xa8, 0x43, 0x3b, 0xf0, 0x81, 0xbb, 0x3e, 0x7d, 0xc7,
-	0x7e, 0x57, 0x06, 0x40, 0x0a, 0xd0, 0x5c, 0x1b, 0x6b, 0x64, 0x47, 0xd6, 0xc3, 0xbe, 0x6e, 0x91,
-	0x07, 0xd3, 0x6c, 0xa6, 0xef, 0x16, 0x98, 0x13, 0x0d, 0x4a, 0x0c, 0xa8, 0x84, 0x56, 0xef, 0x7d,
-	0xf8, 0xef, 0x33, 0x02, 0x07, 0x52, 0xe4, 0x26, 0xde, 0xe4, 0x1e, 0x44, 0x7a, 0x79, 0x06, 0xf6,
-	0x0d, 0xb6, 0x36, 0xa3, 0x67, 0xef, 0x9e, 0x81, 0x0e, 0x27, 0xf2, 0x1d, 0x83, 0xed, 0x81, 0x7b,
-	0x1a, 0xe2, 0x9e, 0x20, 0xca, 0x36, 0x53, 0xc1, 0xf4, 0xbc, 0x3f, 0x3b, 0xfb, 0xd3, 0xa3, 0xb0,
-	0x99, 0xc7, 0x00, 0x7d, 0x8b, 0xc0, 0x98, 0xf8, 0x54, 0x86, 0x26, 0xed, 0xa4, 0xe1, 0x6f, 0x73,
-	0x2a, 0x27, 0xf2, 0x90, 0x0a, 0xc3, 0x69, 0x47, 0xdf, 0xfc, 0xd3, 0x3f, 0xde, 0x29, 0x1c, 0xa6,
-	0x87, 0x6a, 0x69, 0x1f, 0x0c, 0xd1, 0x6f, 0x10, 0x28, 0xf9, 0xb1, 0x42, 0x8f, 0xa5, 0xae, 0x1d,
-	0x7e, 0xb8, 0x53, 0x99, 0xc9, 0x26, 0x44, 0x08, 0x33, 0x1c, 0x82, 0x46, 0x8f, 0x24, 0x41, 0x60,
-	0xac, 0x53, 0xbb, 0x6b, 0x19, 0x6b, 0xf4, 0x4d, 0x02, 0x9b, 0xf9, 0x37, 0x30, 0x34, 0x73, 0xf5,
-	0xc0, 0x18, 0xc7, 0x73, 0x50, 0x22, 0x90, 0xc7, 0x38, 0x90, 0x69, 0x7a, 0x30, 0x05, 0x88, 0x4b,
-	0xdf, 0x23, 0x30, 0xa9, 0x7c, 0xbc, 0x42, 0xab, 0x69, 0x02, 0x86, 0xbf, 0x8c, 0xa9, 0xd4, 0x72,
-	0xd3, 0x23, 0xac, 0x33, 0x1c, 0x56, 0x8d, 0x9e, 0x4c, 0x80, 0x85, 0x1f, 0xc1, 0x34, 0x3a, 0x96,
-	0xeb, 0xd5, 0xee, 0x62, 0xd2, 0x59, 0xa3, 0xdf, 0x27, 0xf8, 0x04, 0x75, 0xd2, 0x63, 0x27, 0xf2,
-	0xcd, 0x4c, 0x7a, 0xec, 0x44, 0xbf, 0x8b, 0xd1, 0x9e, 0xe5, 0xc0, 0x66, 0xe9, 0x53, 0xa9, 0xc0,
-	0x42, 0x48, 0xb5, 0xbb, 0x62, 0x66, 0x8d, 0xdb, 0x50, 0xf9, 0xb6, 0x24, 0xdd, 0x86, 0xc3, 0x9f,
-	0xcf, 0xa4, 0xdb, 0x30, 0xe6, 0xa3, 0x95, 0x4c, 0x1b, 0x62, 0xc2, 0x8a, 0xb3, 0xa1, 0x58, 0x2e,
-	0xdd, 0x86, 0x91, 0xdb, 0x43, 0xba, 0x0d, 0xa3, 0x89, 0x2b, 0xd3, 0x86, 0x02, 0x98, 0x6a, 0x43,
-	0x31, 0xb3, 0x46, 0xdf, 0x25, 0x00, 0x61, 0x6b, 0x9c, 0x9e, 0x4c, 0x13, 0x3a, 0xd4, 0xd7, 0xaf,
-	0x54, 0xf3, 0x92, 0x23, 0xce, 0xd3, 0x1c, 0x67, 0x95, 0x3e, 0x99, 0x80, 0x53, 0x69, 0xf8, 0x2b,
-	0xf6, 0xfb, 0x01, 0x81, 0x2d, 0xb2, 0x3d, 0x45, 0x9f, 0x48, 0xdd, 0x89, 0xd1, 0x4e, 0x7b, 0xe5,
-	0xc9, 0x7c, 0xc4, 0x39, 0xd1, 0xc9, 0x96, 0x98, 0x6f, 0x3d, 0xd9, 0xc1, 0x59, 0xa3, 0x3f, 0x24,
-	0x30, 0x11, 0xf4, 0xb4, 0x69, 0x2e, 0x89, 0x81, 0xfd, 0x4e, 0xe6, 0xa4, 0x8e, 0xc4, 0xdf, 0x93,
-	0xf4, 0x44, 0x06, 0x40, 0xc5, 0x78, 0x6f, 0x17, 0x08, 0xfd, 0x03, 0x81, 0x1d, 0x83, 0xcd, 0x67,
-	0xfa, 0x74, 0x1e, 0xd1, 0x03, 0xbd, 0xef, 0xca, 0xe9, 0xd1, 0x98, 0x10, 0xf6, 0x65, 0x0e, 0xfb,
-	0x79, 0x7a, 0x3e, 0x03, 0x76, 0xa3, 0xb9, 0x8a, 0xcd, 0x3c, 0x35, 0x52, 0xc5, 0xcc, 0x1a, 0xfd,
-	0x8c, 0x40, 0x39, 0xa9, 0x61, 0x4c, 0xcf, 0xe5, 0x01, 0x96, 0xd0, 0x13, 0xaf, 0x9c, 0x5f, 0x1f,
-	0x33, 0x6a, 0xb7, 0xc8, 0xb5, 0xbb, 0x49, 0x5f, 0xca, 0xd2, 0xce, 0xf5, 0x57, 0x68, 0xa8, 0xcd,
-	0xf1, 0x48, 0x52, 0x53, 0xe6, 0xd7, 0xe8, 0x6f, 0x09, 0x6c, 0x1f, 0x68, 0xea, 0xd2, 0xd9, 0xdc,
-	0x30, 0x83, 0x96, 0x74, 0xe5, 0xe9, 0x91, 0x78, 0x50, 0xa3, 0x8b, 0x5c, 0xa3, 0xe7, 0xe8, 0xd9,
-	0x7c, 0x1a, 0x59, 0x86, 0xaa, 0x87, 0xbf, 0x25, 0x7e, 0x43, 0x00, 0xc2, 0xa6, 0x6b, 0x7a, 0x52,
-	0x19, 0x6a, 0x06, 0xa7, 0x27, 0x95, 0xe1, 0x5e, 0xae, 0x76, 0x93, 0xc3, 0xbd, 0x4a, 0xaf, 0x24,
-	0xc0, 0x6d, 0xe9, 0x76, 0x03, 0x9b, 0xc6, 0x2a, 0x50, 0xd9, 0x47, 0xf6, 0x6d, 0x1f, 0xb6, 0x91,
-	0xd7, 0xe8, 0xfb, 0x04, 0xc6, 0xb1, 0xfb, 0x4a, 0x4f, 0x64, 0x40, 0x51, 0xfa, 0xc0, 0x95, 0x27,
-	0x72, 0xd1, 0x22, 0xe6, 0x05, 0x8e, 0xf9, 0x05, 0xfa, 0x7c, 0x0a, 0x66, 0x3f, 0x19, 0xaa, 0x80,
-	0x79, 0xff, 0x78, 0x2d, 0x9a, 0x7c, 0xee, 0x11, 0x98, 0x08, 0x7a, 0xb2, 0xe9, 0xc9, 0x67, 0xb0,
-	0x0b, 0x9c, 0x9e, 0x7c, 0x86, 0x1a, 0xbd, 0xda, 0x79, 0x0e, 0xf9, 0x19, 0x7a, 0x3a, 0xed, 0x8c,
-	0x69, 0x58, 0xf6, 0x12, 0x8b, 0x3b, 0x67, 0x7e, 0x4e, 0x60, 0x5b, 0xa4, 0x93, 0x4a, 0x9f, 0x4a,
-	0x13, 0x1f, 0xd7, 0xac, 0xad, 0x9c, 0x1a, 0x81, 0x03, 0x41, 0x9f, 0xe5, 0xa0, 0x4f, 0xd1, 0x5a,
-	0x02, 0x68, 0xac, 0xad, 0x35, 0x74, 0xce, 0x56, 0xbb, 0x8b, 0x85, 0x9c, 0x35, 0xfa, 0x57, 0x02,
-	0xe5, 0xa4, 0x0e, 0x60, 0x7a, 0xb6, 0xc9, 0x68, 0x61, 0xa6, 0x67, 0x9b, 0xac, 0xa6, 0xa3, 0x36,
-	0xcf, 0x15, 0xba, 0x40, 0xcf, 0x65, 0x28, 0x34, 0xd4, 0x4e, 0x56, 0x95, 0xfb, 0x27, 0x81, 0x03,
-	0x29, 0xbd, 0x30, 0xfa, 0x7c, 0x0e, 0x88, 0x29, 0x2d, 0xbd, 0xca, 0xc5, 0x75, 0xf3, 0xe7, 0xdc,
-	0x1e, 0x52, 0xcb, 0xb8, 0x2e, 0xb6, 0xaa, 0xe8, 0xef, 0x08, 0xec, 0x1c, 0xea, 0xf1, 0xd0, 0xd3,
-	0x39, 0xe0, 0x0d, 0xb5, 0xa5, 0x2a, 0x67, 0x46, 0xe4, 0xca, 0xb9, 0x6d, 0xa4, 0x2a, 0xa2, 0x75,
-	0x84, 0x57, 0xc7, 0x38, 0x05, 0xc2, 0x56, 0x48, 0x2e, 0x05, 0x86, 0xba, 0x37, 0xb9, 0x14, 0x18,
-	0xee, 0xb7, 0xe4, 0x56, 0x40, 0x74, 0x58, 0x06, 0x15, 0xf8, 0x23, 0x81, 0x3d, 0xb1, 0x15, 0x7a,
-	0xfa, 0xec, 0x48, 0x41, 0xa2, 0x2a, 0xf2, 0xdc, 0x3a, 0x38, 0x51, 0x99, 0x17, 0xb8, 0x32, 0xff,
-	0x47, 0x9f, 0xcd, 0x1f, 0x58, 0x03, 0x0a, 0x7d, 0x48, 0x60, 0x57, 0x4c, 0x79, 0x97, 0x3e, 0x93,
-	0x03, 0x54, 0x4c, 0x31, 0xb9, 0x72, 0x76, 0x64, 0x3e, 0x54, 0xe5, 0x02, 0x57, 0xe5, 0x2c, 0x3d,
-	0x93, 0xa1, 0x8a, 0x5a, 0x41, 0x56, 0xf4, 0xf8, 0x05, 0x81, 0x89, 0xa0, 0x1a, 0x94, 0x7e, 0x72,
-	0x0c, 0x56, 0xb7, 0xd2, 0x4f, 0x8e, 0xa1, 0x12, 0x93, 0x76, 0x95, 0x23, 0x9d, 0xa3, 0x17, 0x13,
-	0x90, 0x06, 0x85, 0x8b, 0x98, 0x83, 0xa3, 0x76, 0x37, 0xf8, 0x77, 0x8d, 0xfe, 0x8b, 0xc0, 0xfe,
-	0xc4, 0x92, 0x26, 0x3d, 0x9f, 0x0b, 0x55, 0x42, 0xb1, 0xb6, 0x72, 0x61, 0x9d, 0xdc, 0xa8, 0xe3,
-	0xcb, 0x5c, 0xc7, 0x6b, 0xf4, 0x6a, 0x96, 0x8e, 0xae, 0x7f, 0xcb, 0xe5, 0x6a, 0xea, 0xb6, 0xd1,
-	0x48, 0x7e, 0x98, 0xfd, 0x9b, 0xc0, 0xfe, 0xc4, 0xea, 0x5d, 0xba, 0xae, 0x59, 0xd5, 0xc9, 0x74,
-	0x5d, 0x33, 0x4b, 0x86, 0x5a, 0x9d, 0xeb, 0x7a, 0x83, 0x5e, 0xcf, 0x78, 0x06, 0xab, 0x8a, 0xc6,
-	0xfa, 0x38, 0x74, 0xed, 0xa5, 0xab, 0x1f, 0x7d, 0x32, 0x4d, 0x3e, 0xfe, 0x64, 0x9a, 0xfc, 0xfd,
-	0x93, 0x69, 0xf2, 0x9d, 0x4f, 0xa7, 0x37, 0x7d, 0xfc, 0xe9, 0xf4, 0xa6, 0xbf, 0x7c, 0x3a, 0xbd,
-	0xe9, 0xb5, 0x93, 0x6d, 0xcb, 0x5b, 0xee, 0x37, 0xab, 0x2d, 0xd6, 0xad, 0xbd, 0xf4, 0xa5, 0x57,
-	0xaf, 0xfc, 0xbf, 0xe9, 0xad, 0x30, 0xe7, 0x76, 0xad, 0xb5, 0xac, 0x5b, 0x76, 0xed, 0x8d, 0x50,
-	0xbc, 0xb7, 0xda, 0x33, 0xdd, 0xe6, 0x18, 0xff, 0xb6, 0xe8, 0xe9, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xfb, 0x5e, 0x12, 0x2f, 0x55, 0x37, 0x00, 0x00,
+func (m *QueryAccountStakedListResponse) Reset()         { *m = QueryAccountStakedListResponse{} }
+func (m *QueryAccountStakedListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountStakedListResponse) ProtoMessage()    {}
+func (*QueryAccountStakedListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{67}
+}
+func (m *QueryAccountStakedListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountStakedListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountStakedListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountStakedListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountStakedListResponse.Merge(m, src)
+}
+func (m *QueryAccountStakedListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountStakedListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountStakedListResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryAccountStakedListResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAccountStakedListResponse) GetStaked() []Staked {
+	if m != nil {
+		return m.Staked
+	}
+	return nil
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Pool queries a pool by ID.
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// Pools queries for all pools.
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// FundersList returns all funder addresses with their corresponding funding amount for a given pool
-	FundersList(ctx context.Context, in *QueryFundersListRequest, opts ...grpc.CallOption) (*QueryFundersListResponse, error)
-	// Funder returns all funder info
-	Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error)
-	// StakersList returns all staker addresses with their corresponding staking amount for a given pool
-	StakersList(ctx context.Context, in *QueryStakersListRequest, opts ...grpc.CallOption) (*QueryStakersListResponse, error)
-	// Staker returns all staker info
-	Staker(ctx context.Context, in *QueryStakerRequest, opts ...grpc.CallOption) (*QueryStakerResponse, error)
-	// VoteStatus returns all vote info
-	VoteStatus(ctx context.Context, in *QueryVoteStatusRequest, opts ...grpc.CallOption) (*QueryVoteStatusResponse, error)
-	// Proposal ...
-	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
-	// Proposals ...
-	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
-	// ProposalByHeight ...
-	ProposalByHeight(ctx context.Context, in *QueryProposalByHeightRequest, opts ...grpc.CallOption) (*QueryProposalByHeightResponse, error)
-	// ProposalSinceFinalizedAt ...
-	ProposalSinceFinalizedAt(ctx context.Context, in *QueryProposalSinceFinalizedAtRequest, opts ...grpc.CallOption) (*QueryProposalSinceFinalizedAtResponse, error)
-	// ProposalSinceId ...
-	ProposalSinceId(ctx context.Context, in *QueryProposalSinceIdRequest, opts ...grpc.CallOption) (*QueryProposalSinceIdResponse, error)
-	// CanPropose ...
-	CanPropose(ctx context.Context, in *QueryCanProposeRequest, opts ...grpc.CallOption) (*QueryCanProposeResponse, error)
-	// CanVote checks if voter on pool can still vote for the given bundle
-	CanVote(ctx context.Context, in *QueryCanVoteRequest, opts ...grpc.CallOption) (*QueryCanVoteResponse, error)
-	// StakeInfo returns necessary information to become a staker (used by the protocol nodes)
-	StakeInfo(ctx context.Context, in *QueryStakeInfoRequest, opts ...grpc.CallOption) (*QueryStakeInfoResponse, error)
-	// AccountAssets returns an overview of the sum of all balances for a given user. e.g. balance, staking, funding, etc.
-	AccountAssets(ctx context.Context, in *QueryAccountAssetsRequest, opts ...grpc.CallOption) (*QueryAccountAssetsResponse, error)
-	// AccountStakingUnbondings ...
-	AccountStakingUnbondings(ctx context.Context, in *QueryAccountStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountStakingUnbondingsResponse, error)
-	// AccountDelegationUnbondings ...
-	AccountDelegationUnbondings(ctx context.Context, in *QueryAccountDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountDelegationUnbondingsResponse, error)
-	// AccountFundedList returns all pools the given user has funded into.
-	AccountFundedList(ctx context.Context, in *QueryAccountFundedListRequest, opts ...grpc.CallOption) (*QueryAccountFundedListResponse, error)
-	// AccountStakedList ...
-	AccountStakedList(ctx context.Context, in *QueryAccountStakedListRequest, opts ...grpc.CallOption) (*QueryAccountStakedListResponse, error)
-	// AccountDelegationList ...
-	AccountDelegationList(ctx context.Context, in *QueryAccountDelegationListRequest, opts ...grpc.CallOption) (*QueryAccountDelegationListResponse, error)
-	// AccountRedelegation ...
-	AccountRedelegation(ctx context.Context, in *QueryAccountRedelegationRequest, opts ...grpc.CallOption) (*QueryAccountRedelegationResponse, error)
-	// Delegator returns all delegation info
-	Delegator(ctx context.Context, in *QueryDelegatorRequest, opts ...grpc.CallOption) (*QueryDelegatorResponse, error)
-	// DelegatorsByPoolAndStaker ...
-	DelegatorsByPoolAndStaker(ctx context.Context, in *QueryDelegatorsByPoolAndStakerRequest, opts ...grpc.CallOption) (*QueryDelegatorsByPoolAndStakerResponse, error)
-	// StakersByPoolAndDelegator ...
-	StakersByPoolAndDelegator(ctx context.Context, in *QueryStakersByPoolAndDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByPoolAndDelegatorResponse, error)
+func (m *QueryAccountStakedListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// Staked ...
+type Staked struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// account ...
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
+	// unbonding_amount ...
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
+	// upload_probability
+	UploadProbability string `protobuf:"bytes,8,opt,name=upload_probability,json=uploadProbability,proto3" json:"upload_probability,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *Staked) Reset()         { *m = Staked{} }
+func (m *Staked) String() string { return proto.CompactTextString(m) }
+func (*Staked) ProtoMessage()    {}
+func (*Staked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{68}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Staked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Staked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Staked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Staked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Staked.Merge(m, src)
 }
-
-func (c *queryClient) FundersList(ctx context.Context, in *QueryFundersListRequest, opts ...grpc.CallOption) (*QueryFundersListResponse, error) {
-	out := new(QueryFundersListResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/FundersList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Staked) XXX_Size() int {
+	return m.Size()
+}
+func (m *Staked) XXX_DiscardUnknown() {
+	xxx_messageInfo_Staked.DiscardUnknown(m)
 }
 
-func (c *queryClient) Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error) {
-	out := new(QueryFunderResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Funder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Staked proto.InternalMessageInfo
+
+func (m *Staked) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) StakersList(ctx context.Context, in *QueryStakersListRequest, opts ...grpc.CallOption) (*QueryStakersListResponse, error) {
-	out := new(QueryStakersListResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakersList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Staked) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Staker(ctx context.Context, in *QueryStakerRequest, opts ...grpc.CallOption) (*QueryStakerResponse, error) {
-	out := new(QueryStakerResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Staker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Staked) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) VoteStatus(ctx context.Context, in *QueryVoteStatusRequest, opts ...grpc.CallOption) (*QueryVoteStatusResponse, error) {
-	out := new(QueryVoteStatusResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/VoteStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Staked) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
-	out := new(QueryProposalResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Proposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Staked) GetUploadProbability() string {
+	if m != nil {
+		return m.UploadProbability
 	}
-	return out, nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
-	out := new(QueryProposalsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Proposals", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAccountDelegationListRequest ...
+type QueryAccountDelegationListRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address ...
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) ProposalByHeight(ctx context.Context, in *QueryProposalByHeightRequest, opts ...grpc.CallOption) (*QueryProposalByHeightResponse, error) {
-	out := new(QueryProposalByHeightResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalByHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountDelegationListRequest) Reset()         { *m = QueryAccountDelegationListRequest{} }
+func (m *QueryAccountDelegationListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountDelegationListRequest) ProtoMessage()    {}
+func (*QueryAccountDelegationListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{69}
+}
+func (m *QueryAccountDelegationListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountDelegationListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountDelegationListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountDelegationListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountDelegationListRequest.Merge(m, src)
+}
+func (m *QueryAccountDelegationListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountDelegationListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountDelegationListRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProposalSinceFinalizedAt(ctx context.Context, in *QueryProposalSinceFinalizedAtRequest, opts ...grpc.CallOption) (*QueryProposalSinceFinalizedAtResponse, error) {
-	out := new(QueryProposalSinceFinalizedAtResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalSinceFinalizedAt", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountDelegationListRequest proto.InternalMessageInfo
+
+func (m *QueryAccountDelegationListRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ProposalSinceId(ctx context.Context, in *QueryProposalSinceIdRequest, opts ...grpc.CallOption) (*QueryProposalSinceIdResponse, error) {
-	out := new(QueryProposalSinceIdResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalSinceId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountDelegationListRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CanPropose(ctx context.Context, in *QueryCanProposeRequest, opts ...grpc.CallOption) (*QueryCanProposeResponse, error) {
-	out := new(QueryCanProposeResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/CanPropose", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAccountDelegationListRequest is the response type for the Query/AccountDelegationList RPC method.
+type QueryAccountDelegationListResponse struct {
+	// delegations ...
+	Delegations []DelegatorResponse `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) CanVote(ctx context.Context, in *QueryCanVoteRequest, opts ...grpc.CallOption) (*QueryCanVoteResponse, error) {
-	out := new(QueryCanVoteResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/CanVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountDelegationListResponse) Reset()         { *m = QueryAccountDelegationListResponse{} }
+func (m *QueryAccountDelegationListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountDelegationListResponse) ProtoMessage()    {}
+func (*QueryAccountDelegationListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{70}
+}
+func (m *QueryAccountDelegationListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountDelegationListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountDelegationListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountDelegationListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountDelegationListResponse.Merge(m, src)
+}
+func (m *QueryAccountDelegationListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountDelegationListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountDelegationListResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) StakeInfo(ctx context.Context, in *QueryStakeInfoRequest, opts ...grpc.CallOption) (*QueryStakeInfoResponse, error) {
-	out := new(QueryStakeInfoResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountDelegationListResponse proto.InternalMessageInfo
+
+func (m *QueryAccountDelegationListResponse) GetDelegations() []DelegatorResponse {
+	if m != nil {
+		return m.Delegations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountAssets(ctx context.Context, in *QueryAccountAssetsRequest, opts ...grpc.CallOption) (*QueryAccountAssetsResponse, error) {
-	out := new(QueryAccountAssetsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountAssets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccountDelegationListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountStakingUnbondings(ctx context.Context, in *QueryAccountStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountStakingUnbondingsResponse, error) {
-	out := new(QueryAccountStakingUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountStakingUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+// DelegatorResponse ...
+type DelegatorResponse struct {
+	// account ...
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// current_reward ...
+	CurrentReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=current_reward,json=currentReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_reward"`
+	// delegation_amount ...
+	DelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegation_amount,json=delegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amount"`
+	// staker ...
+	Staker string `protobuf:"bytes,5,opt,name=staker,proto3" json:"staker,omitempty"`
+	// pending_commission_change
+	PendingCommissionChange *PendingCommissionChange `protobuf:"bytes,6,opt,name=pending_commission_change,json=pendingCommissionChange,proto3" json:"pending_commission_change,omitempty"`
+	// delegation_pool_data ...
+	DelegationPoolData *DelegationPoolData `protobuf:"bytes,7,opt,name=delegation_pool_data,json=delegationPoolData,proto3" json:"delegation_pool_data,omitempty"`
+}
+
+func (m *DelegatorResponse) Reset()         { *m = DelegatorResponse{} }
+func (m *DelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegatorResponse) ProtoMessage()    {}
+func (*DelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{71}
+}
+func (m *DelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorResponse.Merge(m, src)
+}
+func (m *DelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountDelegationUnbondings(ctx context.Context, in *QueryAccountDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountDelegationUnbondingsResponse, error) {
-	out := new(QueryAccountDelegationUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountDelegationUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegatorResponse proto.InternalMessageInfo
+
+func (m *DelegatorResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AccountFundedList(ctx context.Context, in *QueryAccountFundedListRequest, opts ...grpc.CallOption) (*QueryAccountFundedListResponse, error) {
-	out := new(QueryAccountFundedListResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountFundedList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegatorResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountStakedList(ctx context.Context, in *QueryAccountStakedListRequest, opts ...grpc.CallOption) (*QueryAccountStakedListResponse, error) {
-	out := new(QueryAccountStakedListResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountStakedList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegatorResponse) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AccountDelegationList(ctx context.Context, in *QueryAccountDelegationListRequest, opts ...grpc.CallOption) (*QueryAccountDelegationListResponse, error) {
-	out := new(QueryAccountDelegationListResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountDelegationList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegatorResponse) GetPendingCommissionChange() *PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChange
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AccountRedelegation(ctx context.Context, in *QueryAccountRedelegationRequest, opts ...grpc.CallOption) (*QueryAccountRedelegationResponse, error) {
-	out := new(QueryAccountRedelegationResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountRedelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DelegatorResponse) GetDelegationPoolData() *DelegationPoolData {
+	if m != nil {
+		return m.DelegationPoolData
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Delegator(ctx context.Context, in *QueryDelegatorRequest, opts ...grpc.CallOption) (*QueryDelegatorResponse, error) {
-	out := new(QueryDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Delegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAccountDelegationListRequest ...
+type QueryAccountRedelegationRequest struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountRedelegationRequest) Reset()         { *m = QueryAccountRedelegationRequest{} }
+func (m *QueryAccountRedelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRedelegationRequest) ProtoMessage()    {}
+func (*QueryAccountRedelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{72}
+}
+func (m *QueryAccountRedelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRedelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRedelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountRedelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRedelegationRequest.Merge(m, src)
+}
+func (m *QueryAccountRedelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRedelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRedelegationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorsByPoolAndStaker(ctx context.Context, in *QueryDelegatorsByPoolAndStakerRequest, opts ...grpc.CallOption) (*QueryDelegatorsByPoolAndStakerResponse, error) {
-	out := new(QueryDelegatorsByPoolAndStakerResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/DelegatorsByPoolAndStaker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountRedelegationRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRedelegationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) StakersByPoolAndDelegator(ctx context.Context, in *QueryStakersByPoolAndDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByPoolAndDelegatorResponse, error) {
-	out := new(QueryStakersByPoolAndDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakersByPoolAndDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAccountDelegationListRequest is the response type for the Query/AccountDelegationList RPC method.
+type QueryAccountRedelegationResponse struct {
+	// redelegation_cooldown_entries ...
+	RedelegationCooldownEntries []uint64 `protobuf:"varint,1,rep,packed,name=redelegation_cooldown_entries,json=redelegationCooldownEntries,proto3" json:"redelegation_cooldown_entries,omitempty"`
+	// cooldowns are the active cooldown entries of the address, ordered by their index.
+	Cooldowns []RedelegationCooldown `protobuf:"bytes,2,rep,name=cooldowns,proto3" json:"cooldowns"`
+	// available_slots is the number of redelegations the address can still perform.
+	AvailableSlots uint64 `protobuf:"varint,3,opt,name=available_slots,json=availableSlots,proto3" json:"available_slots,omitempty"`
+}
+
+func (m *QueryAccountRedelegationResponse) Reset()         { *m = QueryAccountRedelegationResponse{} }
+func (m *QueryAccountRedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRedelegationResponse) ProtoMessage()    {}
+func (*QueryAccountRedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{73}
+}
+func (m *QueryAccountRedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccountRedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRedelegationResponse.Merge(m, src)
+}
+func (m *QueryAccountRedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRedelegationResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Pool queries a pool by ID.
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// Pools queries for all pools.
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// FundersList returns all funder addresses with their corresponding funding amount for a given pool
-	FundersList(context.Context, *QueryFundersListRequest) (*QueryFundersListResponse, error)
-	// Funder returns all funder info
-	Funder(context.Context, *QueryFunderRequest) (*QueryFunderResponse, error)
-	// StakersList returns all staker addresses with their corresponding staking amount for a given pool
-	StakersList(context.Context, *QueryStakersListRequest) (*QueryStakersListResponse, error)
-	// Staker returns all staker info
-	Staker(context.Context, *QueryStakerRequest) (*QueryStakerResponse, error)
-	// VoteStatus returns all vote info
-	VoteStatus(context.Context, *QueryVoteStatusRequest) (*QueryVoteStatusResponse, error)
-	// Proposal ...
-	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
-	// Proposals ...
-	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
-	// ProposalByHeight ...
-	ProposalByHeight(context.Context, *QueryProposalByHeightRequest) (*QueryProposalByHeightResponse, error)
-	// ProposalSinceFinalizedAt ...
-	ProposalSinceFinalizedAt(context.Context, *QueryProposalSinceFinalizedAtRequest) (*QueryProposalSinceFinalizedAtResponse, error)
-	// ProposalSinceId ...
-	ProposalSinceId(context.Context, *QueryProposalSinceIdRequest) (*QueryProposalSinceIdResponse, error)
-	// CanPropose ...
-	CanPropose(context.Context, *QueryCanProposeRequest) (*QueryCanProposeResponse, error)
-	// CanVote checks if voter on pool can still vote for the given bundle
-	CanVote(context.Context, *QueryCanVoteRequest) (*QueryCanVoteResponse, error)
-	// StakeInfo returns necessary information to become a staker (used by the protocol nodes)
-	StakeInfo(context.Context, *QueryStakeInfoRequest) (*QueryStakeInfoResponse, error)
-	// AccountAssets returns an overview of the sum of all balances for a given user. e.g. balance, staking, funding, etc.
-	AccountAssets(context.Context, *QueryAccountAssetsRequest) (*QueryAccountAssetsResponse, error)
-	// AccountStakingUnbondings ...
-	AccountStakingUnbondings(context.Context, *QueryAccountStakingUnbondingsRequest) (*QueryAccountStakingUnbondingsResponse, error)
-	// AccountDelegationUnbondings ...
-	AccountDelegationUnbondings(context.Context, *QueryAccountDelegationUnbondingsRequest) (*QueryAccountDelegationUnbondingsResponse, error)
-	// AccountFundedList returns all pools the given user has funded into.
-	AccountFundedList(context.Context, *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error)
-	// AccountStakedList ...
-	AccountStakedList(context.Context, *QueryAccountStakedListRequest) (*QueryAccountStakedListResponse, error)
-	// AccountDelegationList ...
-	AccountDelegationList(context.Context, *QueryAccountDelegationListRequest) (*QueryAccountDelegationListResponse, error)
-	// AccountRedelegation ...
-	AccountRedelegation(context.Context, *QueryAccountRedelegationRequest) (*QueryAccountRedelegationResponse, error)
-	// Delegator returns all delegation info
-	Delegator(context.Context, *QueryDelegatorRequest) (*QueryDelegatorResponse, error)
-	// DelegatorsByPoolAndStaker ...
-	DelegatorsByPoolAndStaker(context.Context, *QueryDelegatorsByPoolAndStakerRequest) (*QueryDelegatorsByPoolAndStakerResponse, error)
-	// StakersByPoolAndDelegator ...
-	StakersByPoolAndDelegator(context.Context, *QueryStakersByPoolAndDelegatorRequest) (*QueryStakersByPoolAndDelegatorResponse, error)
+var xxx_messageInfo_QueryAccountRedelegationResponse proto.InternalMessageInfo
+
+func (m *QueryAccountRedelegationResponse) GetRedelegationCooldownEntries() []uint64 {
+	if m != nil {
+		return m.RedelegationCooldownEntries
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAccountRedelegationResponse) GetCooldowns() []RedelegationCooldown {
+	if m != nil {
+		return m.Cooldowns
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAccountRedelegationResponse) GetAvailableSlots() uint64 {
+	if m != nil {
+		return m.AvailableSlots
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+
+// QueryAccountWithdrawAddressRequest is the request type for the Query/AccountWithdrawAddress RPC method.
+type QueryAccountWithdrawAddressRequest struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+
+func (m *QueryAccountWithdrawAddressRequest) Reset()         { *m = QueryAccountWithdrawAddressRequest{} }
+func (m *QueryAccountWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountWithdrawAddressRequest) ProtoMessage()    {}
+func (*QueryAccountWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{74}
 }
-func (*UnimplementedQueryServer) FundersList(ctx context.Context, req *QueryFundersListRequest) (*QueryFundersListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundersList not implemented")
+func (m *QueryAccountWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Funder(ctx context.Context, req *QueryFunderRequest) (*QueryFunderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Funder not implemented")
+func (m *QueryAccountWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StakersList(ctx context.Context, req *QueryStakersListRequest) (*QueryStakersListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakersList not implemented")
+func (m *QueryAccountWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountWithdrawAddressRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Staker(ctx context.Context, req *QueryStakerRequest) (*QueryStakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Staker not implemented")
+func (m *QueryAccountWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) VoteStatus(ctx context.Context, req *QueryVoteStatusRequest) (*QueryVoteStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VoteStatus not implemented")
+func (m *QueryAccountWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountWithdrawAddressRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+
+var xxx_messageInfo_QueryAccountWithdrawAddressRequest proto.InternalMessageInfo
+
+func (m *QueryAccountWithdrawAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+
+// QueryAccountWithdrawAddressResponse is the response type for the Query/AccountWithdrawAddress RPC method.
+type QueryAccountWithdrawAddressResponse struct {
+	// withdraw_address ...
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
-func (*UnimplementedQueryServer) ProposalByHeight(ctx context.Context, req *QueryProposalByHeightRequest) (*QueryProposalByHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposalByHeight not implemented")
+
+func (m *QueryAccountWithdrawAddressResponse) Reset()         { *m = QueryAccountWithdrawAddressResponse{} }
+func (m *QueryAccountWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountWithdrawAddressResponse) ProtoMessage()    {}
+func (*QueryAccountWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{75}
 }
-func (*UnimplementedQueryServer) ProposalSinceFinalizedAt(ctx context.Context, req *QueryProposalSinceFinalizedAtRequest) (*QueryProposalSinceFinalizedAtResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposalSinceFinalizedAt not implemented")
+func (m *QueryAccountWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ProposalSinceId(ctx context.Context, req *QueryProposalSinceIdRequest) (*QueryProposalSinceIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposalSinceId not implemented")
+func (m *QueryAccountWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CanPropose(ctx context.Context, req *QueryCanProposeRequest) (*QueryCanProposeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CanPropose not implemented")
+func (m *QueryAccountWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountWithdrawAddressResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CanVote(ctx context.Context, req *QueryCanVoteRequest) (*QueryCanVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CanVote not implemented")
+func (m *QueryAccountWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StakeInfo(ctx context.Context, req *QueryStakeInfoRequest) (*QueryStakeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakeInfo not implemented")
+func (m *QueryAccountWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountWithdrawAddressResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AccountAssets(ctx context.Context, req *QueryAccountAssetsRequest) (*QueryAccountAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountAssets not implemented")
+
+var xxx_messageInfo_QueryAccountWithdrawAddressResponse proto.InternalMessageInfo
+
+func (m *QueryAccountWithdrawAddressResponse) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) AccountStakingUnbondings(ctx context.Context, req *QueryAccountStakingUnbondingsRequest) (*QueryAccountStakingUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountStakingUnbondings not implemented")
+
+// QueryAccountPendingRewardsRequest is the request type for the Query/AccountPendingRewards RPC method.
+type QueryAccountPendingRewardsRequest struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) AccountDelegationUnbondings(ctx context.Context, req *QueryAccountDelegationUnbondingsRequest) (*QueryAccountDelegationUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountDelegationUnbondings not implemented")
+
+func (m *QueryAccountPendingRewardsRequest) Reset()         { *m = QueryAccountPendingRewardsRequest{} }
+func (m *QueryAccountPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryAccountPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{76}
 }
-func (*UnimplementedQueryServer) AccountFundedList(ctx context.Context, req *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountFundedList not implemented")
+func (m *QueryAccountPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AccountStakedList(ctx context.Context, req *QueryAccountStakedListRequest) (*QueryAccountStakedListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountStakedList not implemented")
+func (m *QueryAccountPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AccountDelegationList(ctx context.Context, req *QueryAccountDelegationListRequest) (*QueryAccountDelegationListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountDelegationList not implemented")
+func (m *QueryAccountPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPendingRewardsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AccountRedelegation(ctx context.Context, req *QueryAccountRedelegationRequest) (*QueryAccountRedelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountRedelegation not implemented")
+func (m *QueryAccountPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Delegator(ctx context.Context, req *QueryDelegatorRequest) (*QueryDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delegator not implemented")
+func (m *QueryAccountPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPendingRewardsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) DelegatorsByPoolAndStaker(ctx context.Context, req *QueryDelegatorsByPoolAndStakerRequest) (*QueryDelegatorsByPoolAndStakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorsByPoolAndStaker not implemented")
+
+var xxx_messageInfo_QueryAccountPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountPendingRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) StakersByPoolAndDelegator(ctx context.Context, req *QueryStakersByPoolAndDelegatorRequest) (*QueryStakersByPoolAndDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakersByPoolAndDelegator not implemented")
+
+func (m *QueryAccountPendingRewardsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryAccountPendingRewardsResponse is the response type for the Query/AccountPendingRewards RPC method.
+type QueryAccountPendingRewardsResponse struct {
+	// rewards ...
+	Rewards []PendingReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of the pending rewards on this page.
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAccountPendingRewardsResponse) Reset()         { *m = QueryAccountPendingRewardsResponse{} }
+func (m *QueryAccountPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryAccountPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{77}
+}
+func (m *QueryAccountPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAccountPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryAccountPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountPendingRewardsResponse.DiscardUnknown(m)
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+var xxx_messageInfo_QueryAccountPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountPendingRewardsResponse) GetRewards() []PendingReward {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+func (m *QueryAccountPendingRewardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FundersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFundersListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FundersList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/FundersList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FundersList(ctx, req.(*QueryFundersListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// PendingReward ...
+type PendingReward struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func _Query_Funder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFunderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Funder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Funder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Funder(ctx, req.(*QueryFunderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *PendingReward) Reset()         { *m = PendingReward{} }
+func (m *PendingReward) String() string { return proto.CompactTextString(m) }
+func (*PendingReward) ProtoMessage()    {}
+func (*PendingReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{78}
 }
-
-func _Query_StakersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakersListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StakersList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/StakersList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StakersList(ctx, req.(*QueryStakersListRequest))
+func (m *PendingReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PendingReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingReward.Merge(m, src)
+}
+func (m *PendingReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingReward.DiscardUnknown(m)
 }
 
-func _Query_Staker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Staker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Staker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Staker(ctx, req.(*QueryStakerRequest))
+var xxx_messageInfo_PendingReward proto.InternalMessageInfo
+
+func (m *PendingReward) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_VoteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VoteStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/VoteStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VoteStatus(ctx, req.(*QueryVoteStatusRequest))
+func (m *PendingReward) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Proposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorRequest is the request type for the Query/Delegator RPC method.
+type QueryDelegatorRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// delegator ...
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Proposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+func (m *QueryDelegatorRequest) Reset()         { *m = QueryDelegatorRequest{} }
+func (m *QueryDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRequest) ProtoMessage()    {}
+func (*QueryDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{79}
+}
+func (m *QueryDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRequest.DiscardUnknown(m)
 }
 
-func _Query_ProposalByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalByHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProposalByHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/ProposalByHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProposalByHeight(ctx, req.(*QueryProposalByHeightRequest))
+var xxx_messageInfo_QueryDelegatorRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ProposalSinceFinalizedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalSinceFinalizedAtRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProposalSinceFinalizedAt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/ProposalSinceFinalizedAt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProposalSinceFinalizedAt(ctx, req.(*QueryProposalSinceFinalizedAtRequest))
+func (m *QueryDelegatorRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProposalSinceId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalSinceIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProposalSinceId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/ProposalSinceId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProposalSinceId(ctx, req.(*QueryProposalSinceIdRequest))
+func (m *QueryDelegatorRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CanPropose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCanProposeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CanPropose(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/CanPropose",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CanPropose(ctx, req.(*QueryCanProposeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorResponse is the response type for the Query/Delegator RPC method.
+type QueryDelegatorResponse struct {
+	// delegator ...
+	Delegator *StakerDelegatorResponse `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func _Query_CanVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCanVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CanVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/CanVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CanVote(ctx, req.(*QueryCanVoteRequest))
+func (m *QueryDelegatorResponse) Reset()         { *m = QueryDelegatorResponse{} }
+func (m *QueryDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorResponse) ProtoMessage()    {}
+func (*QueryDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{80}
+}
+func (m *QueryDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorResponse.Merge(m, src)
+}
+func (m *QueryDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorResponse.DiscardUnknown(m)
 }
 
-func _Query_StakeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StakeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/StakeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StakeInfo(ctx, req.(*QueryStakeInfoRequest))
+var xxx_messageInfo_QueryDelegatorResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorResponse) GetDelegator() *StakerDelegatorResponse {
+	if m != nil {
+		return m.Delegator
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AccountAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountAssetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountAssets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountAssets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountAssets(ctx, req.(*QueryAccountAssetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// StakerDelegatorResponse ...
+type StakerDelegatorResponse struct {
+	// delegator ...
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// current_reward ...
+	CurrentReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current_reward,json=currentReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_reward"`
+	// delegation_amount ...
+	DelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amount"`
+	// staker ...
+	Staker string `protobuf:"bytes,4,opt,name=staker,proto3" json:"staker,omitempty"`
 }
 
-func _Query_AccountStakingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountStakingUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountStakingUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountStakingUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountStakingUnbondings(ctx, req.(*QueryAccountStakingUnbondingsRequest))
+func (m *StakerDelegatorResponse) Reset()         { *m = StakerDelegatorResponse{} }
+func (m *StakerDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*StakerDelegatorResponse) ProtoMessage()    {}
+func (*StakerDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{81}
+}
+func (m *StakerDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StakerDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerDelegatorResponse.Merge(m, src)
+}
+func (m *StakerDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerDelegatorResponse.DiscardUnknown(m)
 }
 
-func _Query_AccountDelegationUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDelegationUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountDelegationUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountDelegationUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountDelegationUnbondings(ctx, req.(*QueryAccountDelegationUnbondingsRequest))
+var xxx_messageInfo_StakerDelegatorResponse proto.InternalMessageInfo
+
+func (m *StakerDelegatorResponse) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AccountFundedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountFundedListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountFundedList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountFundedList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountFundedList(ctx, req.(*QueryAccountFundedListRequest))
+func (m *StakerDelegatorResponse) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AccountStakedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountStakedListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountStakedList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountStakedList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountStakedList(ctx, req.(*QueryAccountStakedListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorsByPoolAndStakerRequest is the request type for the Query/DelegatorsByPoolAndStaker RPC method.
+type QueryDelegatorsByPoolAndStakerRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
 }
 
-func _Query_AccountDelegationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDelegationListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountDelegationList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountDelegationList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountDelegationList(ctx, req.(*QueryAccountDelegationListRequest))
+func (m *QueryDelegatorsByPoolAndStakerRequest) Reset()         { *m = QueryDelegatorsByPoolAndStakerRequest{} }
+func (m *QueryDelegatorsByPoolAndStakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorsByPoolAndStakerRequest) ProtoMessage()    {}
+func (*QueryDelegatorsByPoolAndStakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{82}
+}
+func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.Merge(m, src)
+}
+func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorsByPoolAndStakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest.DiscardUnknown(m)
 }
 
-func _Query_AccountRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountRedelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountRedelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/AccountRedelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountRedelegation(ctx, req.(*QueryAccountRedelegationRequest))
+var xxx_messageInfo_QueryDelegatorsByPoolAndStakerRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorsByPoolAndStakerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Delegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDelegatorsByPoolAndStakerRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Delegator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/Delegator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Delegator(ctx, req.(*QueryDelegatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DelegatorsByPoolAndStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorsByPoolAndStakerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorsByPoolAndStaker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/DelegatorsByPoolAndStaker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorsByPoolAndStaker(ctx, req.(*QueryDelegatorsByPoolAndStakerRequest))
+func (m *QueryDelegatorsByPoolAndStakerRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StakersByPoolAndDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakersByPoolAndDelegatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StakersByPoolAndDelegator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Query/StakersByPoolAndDelegator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StakersByPoolAndDelegator(ctx, req.(*QueryStakersByPoolAndDelegatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDelegatorsByPoolAndStakerResponse is the response type for the Query/DelegatorsByPoolAndStaker RPC method.
+type QueryDelegatorsByPoolAndStakerResponse struct {
+	// delegators ...
+	Delegators []StakerDelegatorResponse `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// delegation_pool_data ...
+	DelegationPoolData *DelegationPoolData `protobuf:"bytes,3,opt,name=delegation_pool_data,json=delegationPoolData,proto3" json:"delegation_pool_data,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kyve.registry.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "FundersList",
-			Handler:    _Query_FundersList_Handler,
-		},
-		{
-			MethodName: "Funder",
-			Handler:    _Query_Funder_Handler,
-		},
-		{
-			MethodName: "StakersList",
-			Handler:    _Query_StakersList_Handler,
-		},
-		{
-			MethodName: "Staker",
-			Handler:    _Query_Staker_Handler,
-		},
-		{
-			MethodName: "VoteStatus",
-			Handler:    _Query_VoteStatus_Handler,
-		},
-		{
-			MethodName: "Proposal",
-			Handler:    _Query_Proposal_Handler,
-		},
-		{
-			MethodName: "Proposals",
-			Handler:    _Query_Proposals_Handler,
-		},
-		{
-			MethodName: "ProposalByHeight",
-			Handler:    _Query_ProposalByHeight_Handler,
-		},
-		{
-			MethodName: "ProposalSinceFinalizedAt",
-			Handler:    _Query_ProposalSinceFinalizedAt_Handler,
-		},
-		{
-			MethodName: "ProposalSinceId",
-			Handler:    _Query_ProposalSinceId_Handler,
-		},
-		{
-			MethodName: "CanPropose",
-			Handler:    _Query_CanPropose_Handler,
-		},
-		{
-			MethodName: "CanVote",
-			Handler:    _Query_CanVote_Handler,
-		},
-		{
-			MethodName: "StakeInfo",
-			Handler:    _Query_StakeInfo_Handler,
-		},
-		{
-			MethodName: "AccountAssets",
-			Handler:    _Query_AccountAssets_Handler,
-		},
-		{
-			MethodName: "AccountStakingUnbondings",
-			Handler:    _Query_AccountStakingUnbondings_Handler,
-		},
-		{
-			MethodName: "AccountDelegationUnbondings",
-			Handler:    _Query_AccountDelegationUnbondings_Handler,
-		},
-		{
-			MethodName: "AccountFundedList",
-			Handler:    _Query_AccountFundedList_Handler,
-		},
-		{
-			MethodName: "AccountStakedList",
-			Handler:    _Query_AccountStakedList_Handler,
-		},
-		{
-			MethodName: "AccountDelegationList",
-			Handler:    _Query_AccountDelegationList_Handler,
-		},
-		{
-			MethodName: "AccountRedelegation",
-			Handler:    _Query_AccountRedelegation_Handler,
-		},
-		{
-			MethodName: "Delegator",
-			Handler:    _Query_Delegator_Handler,
-		},
-		{
-			MethodName: "DelegatorsByPoolAndStaker",
-			Handler:    _Query_DelegatorsByPoolAndStaker_Handler,
-		},
-		{
-			MethodName: "StakersByPoolAndDelegator",
-			Handler:    _Query_StakersByPoolAndDelegator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kyve/registry/v1beta1/query.proto",
+func (m *QueryDelegatorsByPoolAndStakerResponse) Reset() {
+	*m = QueryDelegatorsByPoolAndStakerResponse{}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorsByPoolAndStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorsByPoolAndStakerResponse) ProtoMessage()    {}
+func (*QueryDelegatorsByPoolAndStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{83}
+}
+func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDelegatorsByPoolAndStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryDelegatorsByPoolAndStakerResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegatorsByPoolAndStakerResponse) GetDelegators() []StakerDelegatorResponse {
+	if m != nil {
+		return m.Delegators
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorsByPoolAndStakerResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryDelegatorsByPoolAndStakerResponse) GetDelegationPoolData() *DelegationPoolData {
+	if m != nil {
+		return m.DelegationPoolData
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDelegatorsByPoolAndStakerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryStakersByPoolAndDelegatorRequest  is the request type for the Query/StakersByPoolAndDelegator RPC method.
+type QueryStakersByPoolAndDelegatorRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// delegator ...
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStakersByPoolAndDelegatorRequest) Reset()         { *m = QueryStakersByPoolAndDelegatorRequest{} }
+func (m *QueryStakersByPoolAndDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersByPoolAndDelegatorRequest) ProtoMessage()    {}
+func (*QueryStakersByPoolAndDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{84}
+}
+func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.Merge(m, src)
 }
-
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStakersByPoolAndDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakersByPoolAndDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Paused {
-		i--
-		if m.Paused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Runtime) > 0 {
-		i -= len(m.Runtime)
-		copy(dAtA[i:], m.Runtime)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Runtime)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Search) > 0 {
-		i -= len(m.Search)
-		copy(dAtA[i:], m.Search)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Search)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryStakersByPoolAndDelegatorRequest proto.InternalMessageInfo
+
+func (m *QueryStakersByPoolAndDelegatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryStakersByPoolAndDelegatorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStakersByPoolAndDelegatorRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryStakersByPoolAndDelegatorResponse  is the response type for the Query/StakersByPoolAndDelegator RPC method.
+type QueryStakersByPoolAndDelegatorResponse struct {
+	// delegator ...
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// pool ...
+	Pool *Pool `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// stakers ...
+	Stakers []DelegationForStakerResponse `protobuf:"bytes,3,rep,name=stakers,proto3" json:"stakers"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryStakersByPoolAndDelegatorResponse) Reset() {
+	*m = QueryStakersByPoolAndDelegatorResponse{}
+}
+func (m *QueryStakersByPoolAndDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakersByPoolAndDelegatorResponse) ProtoMessage()    {}
+func (*QueryStakersByPoolAndDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{85}
+}
+func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFundersListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.Merge(m, src)
+}
+func (m *QueryStakersByPoolAndDelegatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakersByPoolAndDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFundersListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryStakersByPoolAndDelegatorResponse proto.InternalMessageInfo
+
+func (m *QueryStakersByPoolAndDelegatorResponse) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *QueryFundersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryStakersByPoolAndDelegatorResponse) GetPool() *Pool {
+	if m != nil {
+		return m.Pool
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFundersListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStakersByPoolAndDelegatorResponse) GetStakers() []DelegationForStakerResponse {
+	if m != nil {
+		return m.Stakers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFundersListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStakersByPoolAndDelegatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryFundersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Funders) > 0 {
-		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// DelegationForStakerResponse ...
+type DelegationForStakerResponse struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// current_reward ...
+	CurrentReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=current_reward,json=currentReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_reward"`
+	// delegation_amount ...
+	DelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amount"`
+	// total_delegation_amount ...
+	TotalDelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_delegation_amount,json=totalDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_delegation_amount"`
+	// delegator_count ...
+	DelegatorCount uint64 `protobuf:"varint,5,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
+}
+
+func (m *DelegationForStakerResponse) Reset()         { *m = DelegationForStakerResponse{} }
+func (m *DelegationForStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationForStakerResponse) ProtoMessage()    {}
+func (*DelegationForStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{86}
+}
+func (m *DelegationForStakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationForStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationForStakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelegationForStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationForStakerResponse.Merge(m, src)
+}
+func (m *DelegationForStakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationForStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationForStakerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFunderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegationForStakerResponse proto.InternalMessageInfo
+
+func (m *DelegationForStakerResponse) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFunderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelegationForStakerResponse) GetDelegatorCount() uint64 {
+	if m != nil {
+		return m.DelegatorCount
+	}
+	return 0
 }
 
-func (m *QueryFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Funder) > 0 {
-		i -= len(m.Funder)
-		copy(dAtA[i:], m.Funder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+// QueryDelegationCapacityRequest is the request type for the Query/DelegationCapacity RPC method.
+type QueryDelegationCapacityRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+}
+
+func (m *QueryDelegationCapacityRequest) Reset()         { *m = QueryDelegationCapacityRequest{} }
+func (m *QueryDelegationCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationCapacityRequest) ProtoMessage()    {}
+func (*QueryDelegationCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{87}
+}
+func (m *QueryDelegationCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationCapacityRequest.Merge(m, src)
+}
+func (m *QueryDelegationCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFunderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationCapacityRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFunderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationCapacityResponse is the response type for the Query/DelegationCapacity RPC method.
+type QueryDelegationCapacityResponse struct {
+	// capacities ...
+	Capacities []DelegationCapacity `protobuf:"bytes,1,rep,name=capacities,proto3" json:"capacities"`
 }
 
-func (m *QueryFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Funder != nil {
-		{
-			size, err := m.Funder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegationCapacityResponse) Reset()         { *m = QueryDelegationCapacityResponse{} }
+func (m *QueryDelegationCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationCapacityResponse) ProtoMessage()    {}
+func (*QueryDelegationCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{88}
+}
+func (m *QueryDelegationCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegationCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationCapacityResponse.Merge(m, src)
+}
+func (m *QueryDelegationCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStakersListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegationCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationCapacityResponse) GetCapacities() []DelegationCapacity {
+	if m != nil {
+		return m.Capacities
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakersListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelegationCapacity ...
+type DelegationCapacity struct {
+	// staker ...
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	// total_delegation is the current delegation of the staker.
+	TotalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_delegation,json=totalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_delegation"`
+	// unlimited is true if no delegation cap applies to the staker.
+	Unlimited bool `protobuf:"varint,3,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+	// remaining is the amount which can still be delegated to the staker.
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
 }
 
-func (m *QueryStakersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DelegationCapacity) Reset()         { *m = DelegationCapacity{} }
+func (m *DelegationCapacity) String() string { return proto.CompactTextString(m) }
+func (*DelegationCapacity) ProtoMessage()    {}
+func (*DelegationCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{89}
+}
+func (m *DelegationCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *DelegationCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationCapacity.Merge(m, src)
+}
+func (m *DelegationCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationCapacity proto.InternalMessageInfo
+
+func (m *DelegationCapacity) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStakersListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelegationCapacity) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryStakersListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateDelegationRewardsRequest is the request type for the Query/SimulateDelegationRewards RPC method.
+type QuerySimulateDelegationRewardsRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker is the staker which receives the delegation.
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// amount is the hypothetical delegation amount.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *QueryStakersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateDelegationRewardsRequest) Reset()         { *m = QuerySimulateDelegationRewardsRequest{} }
+func (m *QuerySimulateDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateDelegationRewardsRequest) ProtoMessage()    {}
+func (*QuerySimulateDelegationRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{90}
+}
+func (m *QuerySimulateDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateDelegationRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateDelegationRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Stakers) > 0 {
-		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySimulateDelegationRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateDelegationRewardsRequest.Merge(m, src)
+}
+func (m *QuerySimulateDelegationRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateDelegationRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateDelegationRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateDelegationRewardsRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateDelegationRewardsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryStakerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateDelegationRewardsRequest) GetStaker() string {
+	if m != nil {
+		return m.Staker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStakerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateDelegationRewardsRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *QueryStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
+// QuerySimulateDelegationRewardsResponse is the response type for the Query/SimulateDelegationRewards RPC method.
+type QuerySimulateDelegationRewardsResponse struct {
+	// upload_probability is the probability of the staker to be selected as uploader after the delegation.
+	UploadProbability string `protobuf:"bytes,1,opt,name=upload_probability,json=uploadProbability,proto3" json:"upload_probability,omitempty"`
+	// average_byte_size is the average byte size of the recently finalized bundles of the pool.
+	AverageByteSize uint64 `protobuf:"varint,2,opt,name=average_byte_size,json=averageByteSize,proto3" json:"average_byte_size,omitempty"`
+	// bundle_reward is the expected total reward of a bundle, including the network fee.
+	BundleReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bundle_reward,json=bundleReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bundle_reward"`
+	// reward_per_bundle is the expected reward of the delegation per finalized bundle after commission.
+	RewardPerBundle github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=reward_per_bundle,json=rewardPerBundle,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_per_bundle"`
+	// reward_per_day is the expected reward of the delegation per day after commission.
+	RewardPerDay github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=reward_per_day,json=rewardPerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_per_day"`
+}
+
+func (m *QuerySimulateDelegationRewardsResponse) Reset() {
+	*m = QuerySimulateDelegationRewardsResponse{}
+}
+func (m *QuerySimulateDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateDelegationRewardsResponse) ProtoMessage()    {}
+func (*QuerySimulateDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{91}
+}
+func (m *QuerySimulateDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateDelegationRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QuerySimulateDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateDelegationRewardsResponse.Merge(m, src)
+}
+func (m *QuerySimulateDelegationRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateDelegationRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateDelegationRewardsResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateDelegationRewardsResponse) GetUploadProbability() string {
+	if m != nil {
+		return m.UploadProbability
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStakerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateDelegationRewardsResponse) GetAverageByteSize() uint64 {
+	if m != nil {
+		return m.AverageByteSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryStakerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOpenBundleProposalsRequest is the request type for the Query/OpenBundleProposals RPC method.
+type QueryOpenBundleProposalsRequest struct {
+	// pool_id defines the unique ID of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
 
-func (m *QueryStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Staker != nil {
-		{
-			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOpenBundleProposalsRequest) Reset()         { *m = QueryOpenBundleProposalsRequest{} }
+func (m *QueryOpenBundleProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenBundleProposalsRequest) ProtoMessage()    {}
+func (*QueryOpenBundleProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{92}
+}
+func (m *QueryOpenBundleProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenBundleProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenBundleProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenBundleProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenBundleProposalsRequest.Merge(m, src)
+}
+func (m *QueryOpenBundleProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenBundleProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenBundleProposalsRequest.DiscardUnknown(m)
 }
 
-func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenBundleProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryOpenBundleProposalsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOpenBundleProposalsResponse is the response type for the Query/OpenBundleProposals RPC method.
+type QueryOpenBundleProposalsResponse struct {
+	// proposals are the open bundle proposals, starting with the one which is finalized next.
+	Proposals []OpenBundleProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
 }
 
-func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinishDate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinishDate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CreationDate != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreationDate))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.NewCommission) > 0 {
-		i -= len(m.NewCommission)
-		copy(dAtA[i:], m.NewCommission)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewCommission)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryOpenBundleProposalsResponse) Reset()         { *m = QueryOpenBundleProposalsResponse{} }
+func (m *QueryOpenBundleProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenBundleProposalsResponse) ProtoMessage()    {}
+func (*QueryOpenBundleProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{93}
+}
+func (m *QueryOpenBundleProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenBundleProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenBundleProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenBundleProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenBundleProposalsResponse.Merge(m, src)
+}
+func (m *QueryOpenBundleProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenBundleProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenBundleProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *StakerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenBundleProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryOpenBundleProposalsResponse) GetProposals() []OpenBundleProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *StakerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OpenBundleProposal ...
+type OpenBundleProposal struct {
+	// from_height is the height from where the bundle proposal starts.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// bundle_proposal ...
+	BundleProposal *BundleProposal `protobuf:"bytes,2,opt,name=bundle_proposal,json=bundleProposal,proto3" json:"bundle_proposal,omitempty"`
 }
 
-func (m *StakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PendingCommissionChange != nil {
-		{
-			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *OpenBundleProposal) Reset()         { *m = OpenBundleProposal{} }
+func (m *OpenBundleProposal) String() string { return proto.CompactTextString(m) }
+func (*OpenBundleProposal) ProtoMessage()    {}
+func (*OpenBundleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c3b23aaee0836da, []int{94}
+}
+func (m *OpenBundleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenBundleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenBundleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.UploadProbability) > 0 {
-		i -= len(m.UploadProbability)
-		copy(dAtA[i:], m.UploadProbability)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadProbability)))
-		i--
-		dAtA[i] = 0x62
+		return b[:n], nil
 	}
-	if m.UnbondingAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingAmount))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *OpenBundleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenBundleProposal.Merge(m, src)
+}
+func (m *OpenBundleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenBundleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenBundleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenBundleProposal proto.InternalMessageInfo
+
+func (m *OpenBundleProposal) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	if m.Points != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Logo)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Website)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Commission) > 0 {
-		i -= len(m.Commission)
-		copy(dAtA[i:], m.Commission)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commission)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TotalDelegation != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDelegation))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVoteStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OpenBundleProposal) GetBundleProposal() *BundleProposal {
+	if m != nil {
+		return m.BundleProposal
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVoteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kyve.registry.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kyve.registry.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "kyve.registry.v1beta1.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "kyve.registry.v1beta1.QueryPoolResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "kyve.registry.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "kyve.registry.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryStorageProviderRequest)(nil), "kyve.registry.v1beta1.QueryStorageProviderRequest")
+	proto.RegisterType((*QueryStorageProviderResponse)(nil), "kyve.registry.v1beta1.QueryStorageProviderResponse")
+	proto.RegisterType((*QueryStorageProvidersRequest)(nil), "kyve.registry.v1beta1.QueryStorageProvidersRequest")
+	proto.RegisterType((*QueryStorageProvidersResponse)(nil), "kyve.registry.v1beta1.QueryStorageProvidersResponse")
+	proto.RegisterType((*QueryRuntimeRequest)(nil), "kyve.registry.v1beta1.QueryRuntimeRequest")
+	proto.RegisterType((*QueryRuntimeResponse)(nil), "kyve.registry.v1beta1.QueryRuntimeResponse")
+	proto.RegisterType((*QueryRuntimesRequest)(nil), "kyve.registry.v1beta1.QueryRuntimesRequest")
+	proto.RegisterType((*QueryRuntimesResponse)(nil), "kyve.registry.v1beta1.QueryRuntimesResponse")
+	proto.RegisterType((*QueryRuntimeVersionRequest)(nil), "kyve.registry.v1beta1.QueryRuntimeVersionRequest")
+	proto.RegisterType((*QueryRuntimeVersionResponse)(nil), "kyve.registry.v1beta1.QueryRuntimeVersionResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "kyve.registry.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "kyve.registry.v1beta1.QueryUpgradeReadinessResponse")
+	proto.RegisterType((*StakerReadiness)(nil), "kyve.registry.v1beta1.StakerReadiness")
+	proto.RegisterType((*QueryFundingStreamsRequest)(nil), "kyve.registry.v1beta1.QueryFundingStreamsRequest")
+	proto.RegisterType((*QueryFundingStreamsResponse)(nil), "kyve.registry.v1beta1.QueryFundingStreamsResponse")
+	proto.RegisterType((*FundingStreamStatus)(nil), "kyve.registry.v1beta1.FundingStreamStatus")
+	proto.RegisterType((*QueryProtocolFundingsRequest)(nil), "kyve.registry.v1beta1.QueryProtocolFundingsRequest")
+	proto.RegisterType((*QueryProtocolFundingsResponse)(nil), "kyve.registry.v1beta1.QueryProtocolFundingsResponse")
+	proto.RegisterType((*QueryFundersListRequest)(nil), "kyve.registry.v1beta1.QueryFundersListRequest")
+	proto.RegisterType((*QueryFundersListResponse)(nil), "kyve.registry.v1beta1.QueryFundersListResponse")
+	proto.RegisterType((*QueryFunderRequest)(nil), "kyve.registry.v1beta1.QueryFunderRequest")
+	proto.RegisterType((*QueryFunderResponse)(nil), "kyve.registry.v1beta1.QueryFunderResponse")
+	proto.RegisterType((*QueryStakersListRequest)(nil), "kyve.registry.v1beta1.QueryStakersListRequest")
+	proto.RegisterType((*QueryStakersListResponse)(nil), "kyve.registry.v1beta1.QueryStakersListResponse")
+	proto.RegisterType((*QueryStakerRequest)(nil), "kyve.registry.v1beta1.QueryStakerRequest")
+	proto.RegisterType((*QueryStakerResponse)(nil), "kyve.registry.v1beta1.QueryStakerResponse")
+	proto.RegisterType((*PendingCommissionChange)(nil), "kyve.registry.v1beta1.PendingCommissionChange")
+	proto.RegisterType((*StakerResponse)(nil), "kyve.registry.v1beta1.StakerResponse")
+	proto.RegisterType((*QueryVoteStatusRequest)(nil), "kyve.registry.v1beta1.QueryVoteStatusRequest")
+	proto.RegisterType((*QueryVoteStatusResponse)(nil), "kyve.registry.v1beta1.QueryVoteStatusResponse")
+	proto.RegisterType((*VoteStatusResponse)(nil), "kyve.registry.v1beta1.VoteStatusResponse")
+	proto.RegisterType((*QueryProposalRequest)(nil), "kyve.registry.v1beta1.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "kyve.registry.v1beta1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "kyve.registry.v1beta1.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "kyve.registry.v1beta1.QueryProposalsResponse")
+	proto.RegisterType((*QueryArchivedProposalsRequest)(nil), "kyve.registry.v1beta1.QueryArchivedProposalsRequest")
+	proto.RegisterType((*QueryArchivedProposalsResponse)(nil), "kyve.registry.v1beta1.QueryArchivedProposalsResponse")
+	proto.RegisterType((*QueryProposalByHeightRequest)(nil), "kyve.registry.v1beta1.QueryProposalByHeightRequest")
+	proto.RegisterType((*QueryProposalByHeightResponse)(nil), "kyve.registry.v1beta1.QueryProposalByHeightResponse")
+	proto.RegisterType((*QueryProposalSinceFinalizedAtRequest)(nil), "kyve.registry.v1beta1.QueryProposalSinceFinalizedAtRequest")
+	proto.RegisterType((*QueryProposalSinceFinalizedAtResponse)(nil), "kyve.registry.v1beta1.QueryProposalSinceFinalizedAtResponse")
+	proto.RegisterType((*QueryProposalSinceIdRequest)(nil), "kyve.registry.v1beta1.QueryProposalSinceIdRequest")
+	proto.RegisterType((*QueryProposalSinceIdResponse)(nil), "kyve.registry.v1beta1.QueryProposalSinceIdResponse")
+	proto.RegisterType((*QueryCanProposeRequest)(nil), "kyve.registry.v1beta1.QueryCanProposeRequest")
+	proto.RegisterType((*QueryCanProposeResponse)(nil), "kyve.registry.v1beta1.QueryCanProposeResponse")
+	proto.RegisterType((*QueryCanVoteRequest)(nil), "kyve.registry.v1beta1.QueryCanVoteRequest")
+	proto.RegisterType((*QueryCanVoteResponse)(nil), "kyve.registry.v1beta1.QueryCanVoteResponse")
+	proto.RegisterType((*QueryStakeInfoRequest)(nil), "kyve.registry.v1beta1.QueryStakeInfoRequest")
+	proto.RegisterType((*QueryStakeInfoResponse)(nil), "kyve.registry.v1beta1.QueryStakeInfoResponse")
+	proto.RegisterType((*QueryAccountAssetsRequest)(nil), "kyve.registry.v1beta1.QueryAccountAssetsRequest")
+	proto.RegisterType((*QueryAccountAssetsResponse)(nil), "kyve.registry.v1beta1.QueryAccountAssetsResponse")
+	proto.RegisterType((*QueryAccountStakingUnbondingsRequest)(nil), "kyve.registry.v1beta1.QueryAccountStakingUnbondingsRequest")
+	proto.RegisterType((*QueryAccountStakingUnbondingsResponse)(nil), "kyve.registry.v1beta1.QueryAccountStakingUnbondingsResponse")
+	proto.RegisterType((*StakingUnbonding)(nil), "kyve.registry.v1beta1.StakingUnbonding")
+	proto.RegisterType((*QueryAccountDelegationUnbondingsRequest)(nil), "kyve.registry.v1beta1.QueryAccountDelegationUnbondingsRequest")
+	proto.RegisterType((*QueryAccountDelegationUnbondingsResponse)(nil), "kyve.registry.v1beta1.QueryAccountDelegationUnbondingsResponse")
+	proto.RegisterType((*DelegationUnbonding)(nil), "kyve.registry.v1beta1.DelegationUnbonding")
+	proto.RegisterType((*QueryAccountFundedListRequest)(nil), "kyve.registry.v1beta1.QueryAccountFundedListRequest")
+	proto.RegisterType((*QueryAccountFundedListResponse)(nil), "kyve.registry.v1beta1.QueryAccountFundedListResponse")
+	proto.RegisterType((*Funded)(nil), "kyve.registry.v1beta1.Funded")
+	proto.RegisterType((*QueryAccountStakedListRequest)(nil), "kyve.registry.v1beta1.QueryAccountStakedListRequest")
+	proto.RegisterType((*QueryAccountStakedListResponse)(nil), "kyve.registry.v1beta1.QueryAccountStakedListResponse")
+	proto.RegisterType((*Staked)(nil), "kyve.registry.v1beta1.Staked")
+	proto.RegisterType((*QueryAccountDelegationListRequest)(nil), "kyve.registry.v1beta1.QueryAccountDelegationListRequest")
+	proto.RegisterType((*QueryAccountDelegationListResponse)(nil), "kyve.registry.v1beta1.QueryAccountDelegationListResponse")
+	proto.RegisterType((*DelegatorResponse)(nil), "kyve.registry.v1beta1.DelegatorResponse")
+	proto.RegisterType((*QueryAccountRedelegationRequest)(nil), "kyve.registry.v1beta1.QueryAccountRedelegationRequest")
+	proto.RegisterType((*QueryAccountRedelegationResponse)(nil), "kyve.registry.v1beta1.QueryAccountRedelegationResponse")
+	proto.RegisterType((*QueryAccountWithdrawAddressRequest)(nil), "kyve.registry.v1beta1.QueryAccountWithdrawAddressRequest")
+	proto.RegisterType((*QueryAccountWithdrawAddressResponse)(nil), "kyve.registry.v1beta1.QueryAccountWithdrawAddressResponse")
+	proto.RegisterType((*QueryAccountPendingRewardsRequest)(nil), "kyve.registry.v1beta1.QueryAccountPendingRewardsRequest")
+	proto.RegisterType((*QueryAccountPendingRewardsResponse)(nil), "kyve.registry.v1beta1.QueryAccountPendingRewardsResponse")
+	proto.RegisterType((*PendingReward)(nil), "kyve.registry.v1beta1.PendingReward")
+	proto.RegisterType((*QueryDelegatorRequest)(nil), "kyve.registry.v1beta1.QueryDelegatorRequest")
+	proto.RegisterType((*QueryDelegatorResponse)(nil), "kyve.registry.v1beta1.QueryDelegatorResponse")
+	proto.RegisterType((*StakerDelegatorResponse)(nil), "kyve.registry.v1beta1.StakerDelegatorResponse")
+	proto.RegisterType((*QueryDelegatorsByPoolAndStakerRequest)(nil), "kyve.registry.v1beta1.QueryDelegatorsByPoolAndStakerRequest")
+	proto.RegisterType((*QueryDelegatorsByPoolAndStakerResponse)(nil), "kyve.registry.v1beta1.QueryDelegatorsByPoolAndStakerResponse")
+	proto.RegisterType((*QueryStakersByPoolAndDelegatorRequest)(nil), "kyve.registry.v1beta1.QueryStakersByPoolAndDelegatorRequest")
+	proto.RegisterType((*QueryStakersByPoolAndDelegatorResponse)(nil), "kyve.registry.v1beta1.QueryStakersByPoolAndDelegatorResponse")
+	proto.RegisterType((*DelegationForStakerResponse)(nil), "kyve.registry.v1beta1.DelegationForStakerResponse")
+	proto.RegisterType((*QueryDelegationCapacityRequest)(nil), "kyve.registry.v1beta1.QueryDelegationCapacityRequest")
+	proto.RegisterType((*QueryDelegationCapacityResponse)(nil), "kyve.registry.v1beta1.QueryDelegationCapacityResponse")
+	proto.RegisterType((*DelegationCapacity)(nil), "kyve.registry.v1beta1.DelegationCapacity")
+	proto.RegisterType((*QuerySimulateDelegationRewardsRequest)(nil), "kyve.registry.v1beta1.QuerySimulateDelegationRewardsRequest")
+	proto.RegisterType((*QuerySimulateDelegationRewardsResponse)(nil), "kyve.registry.v1beta1.QuerySimulateDelegationRewardsResponse")
+	proto.RegisterType((*QueryOpenBundleProposalsRequest)(nil), "kyve.registry.v1beta1.QueryOpenBundleProposalsRequest")
+	proto.RegisterType((*QueryOpenBundleProposalsResponse)(nil), "kyve.registry.v1beta1.QueryOpenBundleProposalsResponse")
+	proto.RegisterType((*OpenBundleProposal)(nil), "kyve.registry.v1beta1.OpenBundleProposal")
 }
 
-func (m *QueryVoteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("kyve/registry/v1beta1/query.proto", fileDescriptor_5c3b23aaee0836da) }
+
+var fileDescriptor_5c3b23aaee0836da = []byte{
+	// 4339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5b, 0x6c, 0x1c, 0xd7,
+	0x79, 0xf6, 0x2c, 0x6f, 0xe2, 0x4f, 0x89, 0x94, 0x8e, 0x24, 0x6a, 0x3d, 0x12, 0x29, 0x79, 0xac,
+	0x0b, 0x2d, 0x9b, 0x5c, 0x8b, 0x16, 0x2d, 0xcb, 0xba, 0xd8, 0x14, 0x65, 0xc9, 0x72, 0x6c, 0x8b,
+	0x59, 0xda, 0x0a, 0xe4, 0x3c, 0x2c, 0x66, 0x77, 0x0e, 0x97, 0x53, 0xef, 0xce, 0xac, 0x67, 0x66,
+	0x49, 0xd3, 0x02, 0x81, 0x36, 0x41, 0x03, 0x23, 0x05, 0x82, 0x02, 0x2d, 0x0a, 0x14, 0x79, 0x68,
+	0x8b, 0x36, 0x2e, 0x10, 0xf4, 0x62, 0x03, 0x2d, 0x8a, 0xa4, 0x68, 0x83, 0xa2, 0x48, 0x91, 0xa7,
+	0x22, 0xe8, 0x0d, 0x45, 0x1e, 0x82, 0xc0, 0x2e, 0x02, 0xb4, 0xe8, 0x43, 0x51, 0x3f, 0xb5, 0x4f,
+	0xc5, 0x9c, 0xf3, 0x9f, 0x99, 0x33, 0xb3, 0x73, 0xdb, 0x25, 0xa5, 0x3a, 0x4f, 0xe4, 0x9c, 0xfd,
+	0xff, 0xff, 0x7c, 0xff, 0xe5, 0xfc, 0xe7, 0xf6, 0x1f, 0x78, 0xe2, 0xdd, 0xed, 0x4d, 0x5a, 0x71,
+	0x68, 0xd3, 0x74, 0x3d, 0x67, 0xbb, 0xb2, 0x79, 0xa1, 0x4e, 0x3d, 0xfd, 0x42, 0xe5, 0xbd, 0x2e,
+	0x75, 0xb6, 0x17, 0x3a, 0x8e, 0xed, 0xd9, 0xe4, 0xa8, 0x4f, 0xb2, 0x20, 0x48, 0x16, 0x90, 0x44,
+	0x3d, 0xdf, 0xb0, 0xdd, 0xb6, 0xed, 0x56, 0xea, 0xba, 0x4b, 0x39, 0x7d, 0xc0, 0xdd, 0xd1, 0x9b,
+	0xa6, 0xa5, 0x7b, 0xa6, 0x6d, 0x71, 0x11, 0xea, 0x91, 0xa6, 0xdd, 0xb4, 0xd9, 0xbf, 0x15, 0xff,
+	0x3f, 0x6c, 0x3d, 0xd1, 0xb4, 0xed, 0x66, 0x8b, 0x56, 0xf4, 0x8e, 0x59, 0xd1, 0x2d, 0xcb, 0xf6,
+	0x18, 0x8b, 0x8b, 0xbf, 0x6a, 0xc9, 0xc8, 0x3a, 0xba, 0xa3, 0xb7, 0x05, 0xcd, 0xe9, 0x64, 0x9a,
+	0x00, 0x2b, 0xa3, 0xd2, 0x8e, 0x00, 0xf9, 0xb2, 0x8f, 0x6f, 0x95, 0xb1, 0x56, 0xe9, 0x7b, 0x5d,
+	0xea, 0x7a, 0x5a, 0x15, 0x0e, 0x47, 0x5a, 0xdd, 0x8e, 0x6d, 0xb9, 0x94, 0x5c, 0x81, 0x51, 0xde,
+	0x45, 0x59, 0x39, 0xa5, 0xcc, 0x4d, 0x2c, 0xce, 0x2c, 0x24, 0xaa, 0xbf, 0xc0, 0xd9, 0x6e, 0x0c,
+	0xff, 0xe8, 0xa7, 0x27, 0x1f, 0xab, 0x22, 0x8b, 0xa6, 0xc1, 0x41, 0x2e, 0xd3, 0xb6, 0x5b, 0xd8,
+	0x0f, 0x99, 0x84, 0x92, 0x69, 0x30, 0x61, 0xc3, 0xd5, 0x92, 0x69, 0x68, 0xaf, 0xc1, 0x21, 0x89,
+	0x06, 0x7b, 0x5d, 0x82, 0xe1, 0x8e, 0x6d, 0xb7, 0xb0, 0xcf, 0xe3, 0x69, 0x7d, 0xda, 0x76, 0x0b,
+	0x7b, 0x64, 0xe4, 0xda, 0x77, 0x14, 0x49, 0x98, 0xd0, 0x8c, 0xdc, 0x02, 0x08, 0x3d, 0x80, 0x22,
+	0xcf, 0x2e, 0x70, 0x77, 0x2d, 0xf8, 0xee, 0x5a, 0xe0, 0xee, 0x0d, 0x55, 0x69, 0x52, 0xe4, 0xad,
+	0x4a, 0x9c, 0x64, 0x1a, 0x46, 0x5d, 0xaa, 0x3b, 0x8d, 0x8d, 0x72, 0xe9, 0x94, 0x32, 0x37, 0x5e,
+	0xc5, 0x2f, 0x52, 0x86, 0x31, 0xa7, 0x6b, 0x79, 0x66, 0x9b, 0x96, 0x87, 0xd8, 0x0f, 0xe2, 0xd3,
+	0xe7, 0xe8, 0xe8, 0x5d, 0x97, 0x1a, 0xe5, 0xe1, 0x53, 0xca, 0xdc, 0xbe, 0x2a, 0x7e, 0x69, 0xbf,
+	0xa5, 0x00, 0x91, 0x71, 0xa2, 0xd6, 0x97, 0x60, 0xc4, 0x57, 0xc3, 0x37, 0xf5, 0x50, 0x31, 0xb5,
+	0x39, 0x3d, 0xb9, 0x1d, 0xd1, 0xb0, 0xc4, 0x34, 0x3c, 0x97, 0xab, 0x21, 0xef, 0x55, 0x56, 0x51,
+	0x9b, 0x87, 0xe3, 0x0c, 0xd7, 0x9a, 0x67, 0x3b, 0x7a, 0x93, 0xae, 0x3a, 0xf6, 0xa6, 0x69, 0x50,
+	0x27, 0xcd, 0x77, 0x5b, 0x70, 0x22, 0x99, 0x1c, 0x15, 0xfa, 0x0a, 0x1c, 0x74, 0xf9, 0x4f, 0xb5,
+	0x0e, 0xfe, 0x16, 0xd8, 0x3f, 0x59, 0xb7, 0x98, 0x24, 0x54, 0x73, 0xca, 0x8d, 0x36, 0x6b, 0xb3,
+	0xc9, 0x1d, 0x07, 0xc1, 0xfc, 0x01, 0xcc, 0xa4, 0xfc, 0x8e, 0xc8, 0xee, 0xc3, 0xa1, 0x38, 0x32,
+	0x61, 0xf6, 0xfe, 0xa0, 0x1d, 0x8c, 0x41, 0x73, 0xb5, 0xa7, 0x70, 0x20, 0x55, 0x79, 0x10, 0x08,
+	0xdb, 0x11, 0x18, 0xb6, 0xf4, 0x36, 0x65, 0xfa, 0x8f, 0x57, 0xd9, 0xff, 0xda, 0x3d, 0x38, 0x12,
+	0x25, 0x45, 0x74, 0xd7, 0xc3, 0x88, 0xe2, 0xe6, 0x9a, 0x4d, 0xc1, 0x84, 0x8c, 0x88, 0x45, 0x30,
+	0x69, 0xd3, 0x51, 0xb9, 0x81, 0x59, 0xee, 0xc3, 0xd1, 0x58, 0x3b, 0x76, 0xf8, 0x32, 0xec, 0x43,
+	0x5e, 0x61, 0x85, 0x62, 0x3d, 0x06, 0x5c, 0xda, 0x2a, 0xa8, 0xb2, 0xe8, 0x7b, 0xd4, 0x71, 0x4d,
+	0xdb, 0x12, 0xca, 0x97, 0xa3, 0x0a, 0x49, 0x43, 0xa4, 0x0c, 0x63, 0x9b, 0x9c, 0x16, 0x47, 0x95,
+	0xf8, 0xd4, 0x5c, 0x38, 0x9e, 0x28, 0x11, 0x21, 0xbf, 0x05, 0x53, 0x28, 0xa3, 0x26, 0x04, 0x70,
+	0x5b, 0x9d, 0xc9, 0x46, 0x8e, 0x72, 0x50, 0x81, 0x49, 0x27, 0xd2, 0xaa, 0x5d, 0xc2, 0xc0, 0x7a,
+	0xbb, 0xd3, 0x74, 0x74, 0x83, 0x56, 0xa9, 0x6e, 0x98, 0x16, 0x75, 0x83, 0x5c, 0x72, 0x0c, 0xc6,
+	0xfc, 0x21, 0x57, 0x0b, 0x86, 0xc1, 0xa8, 0xff, 0x79, 0xc7, 0xd0, 0x3e, 0x2e, 0xc1, 0x4c, 0x0a,
+	0x27, 0x02, 0x3e, 0x03, 0x93, 0x9e, 0xee, 0x34, 0xa9, 0x17, 0xc1, 0x3b, 0x5e, 0x3d, 0xc0, 0x5b,
+	0x11, 0x01, 0xb9, 0x05, 0x63, 0xae, 0xa7, 0xbf, 0xeb, 0xc7, 0x63, 0x29, 0x27, 0x1e, 0x7d, 0xaa,
+	0xa0, 0x1f, 0x11, 0x03, 0xc8, 0x4c, 0xee, 0xc2, 0x84, 0x43, 0x75, 0x63, 0xbb, 0xc6, 0x1a, 0x78,
+	0x66, 0xba, 0xb1, 0xe0, 0xd3, 0xfc, 0xe4, 0xa7, 0x27, 0xcf, 0x36, 0x4d, 0x6f, 0xa3, 0x5b, 0x5f,
+	0x68, 0xd8, 0xed, 0x0a, 0xce, 0x5b, 0xfc, 0xcf, 0xbc, 0x6b, 0xbc, 0x5b, 0xf1, 0xb6, 0x3b, 0xd4,
+	0x5d, 0xb8, 0x63, 0x79, 0x55, 0x60, 0x22, 0x58, 0x4f, 0xbe, 0x40, 0xcf, 0xf6, 0xf4, 0x16, 0x0a,
+	0x1c, 0x1e, 0x4c, 0x20, 0x13, 0xc1, 0x04, 0x6a, 0xbf, 0xaf, 0xc0, 0x54, 0x4c, 0x09, 0x3f, 0x1c,
+	0xf4, 0x46, 0xc3, 0xee, 0x5a, 0x9e, 0x08, 0x14, 0xfc, 0x4c, 0x0f, 0x14, 0x72, 0x0b, 0x46, 0xf5,
+	0x36, 0x63, 0x19, 0x4c, 0x49, 0xe4, 0x26, 0x47, 0x60, 0x84, 0xa9, 0x8b, 0xc9, 0x9a, 0x7f, 0x68,
+	0x4b, 0x18, 0xd8, 0xb7, 0xba, 0x96, 0x61, 0x5a, 0xcd, 0x35, 0xcf, 0xa1, 0x7a, 0x3b, 0x3f, 0x1e,
+	0xde, 0x87, 0xe3, 0x89, 0x6c, 0x41, 0xfe, 0x99, 0x5a, 0xe7, 0xbf, 0xd4, 0x5c, 0xfe, 0x13, 0x8e,
+	0xbb, 0xf3, 0x29, 0xde, 0x8e, 0xc8, 0x59, 0xf3, 0x74, 0xaf, 0x2b, 0x3c, 0x3e, 0xb9, 0x1e, 0xe9,
+	0x42, 0xfb, 0x27, 0x05, 0x0e, 0x27, 0x50, 0x93, 0x2f, 0xc3, 0x64, 0xb4, 0x4b, 0x1c, 0x2f, 0xa7,
+	0x8b, 0xf4, 0x88, 0x7d, 0x1d, 0x88, 0xf4, 0x45, 0xaa, 0x70, 0xc0, 0xed, 0x50, 0xcb, 0xa8, 0x75,
+	0xa8, 0x53, 0x33, 0xf4, 0xed, 0x72, 0x69, 0x20, 0x07, 0x4c, 0x30, 0x21, 0xab, 0xd4, 0xb9, 0xa9,
+	0x6f, 0xfb, 0x73, 0xa6, 0xd3, 0xb5, 0xb6, 0xf4, 0x6d, 0xe6, 0xcd, 0xe1, 0x2a, 0x7e, 0x05, 0x29,
+	0x7f, 0xd5, 0xb1, 0x3d, 0xbb, 0x61, 0xb7, 0x10, 0x5e, 0x4f, 0xca, 0xef, 0xfd, 0x3d, 0x4c, 0xf9,
+	0x1d, 0xfc, 0xad, 0x86, 0x6a, 0xe4, 0xa5, 0xfc, 0x98, 0x2c, 0x91, 0xf2, 0x3b, 0xb1, 0x2e, 0xb4,
+	0x45, 0x38, 0x16, 0x38, 0x9b, 0x3a, 0xee, 0xeb, 0xa6, 0xeb, 0xe5, 0x06, 0xc8, 0x1a, 0x94, 0x7b,
+	0x79, 0x82, 0x85, 0xc0, 0xd8, 0x3a, 0x6f, 0x46, 0x80, 0x33, 0x19, 0x3e, 0xa2, 0x4e, 0x55, 0x50,
+	0x6b, 0xaf, 0xe0, 0xba, 0x02, 0xdb, 0x73, 0x30, 0xf8, 0xb6, 0xe6, 0x9c, 0x62, 0x45, 0xc3, 0xbf,
+	0xb4, 0xd7, 0xe1, 0x70, 0x44, 0x4c, 0xb0, 0x2a, 0x13, 0xe4, 0xd9, 0x6b, 0x41, 0x64, 0x13, 0xd2,
+	0xfe, 0x42, 0x41, 0xf3, 0xf0, 0xc1, 0x5e, 0xc8, 0x3c, 0xfe, 0xba, 0xd3, 0x65, 0x71, 0xcb, 0xa0,
+	0x4d, 0x2e, 0x3e, 0x99, 0x99, 0x05, 0x79, 0x88, 0x57, 0x91, 0x25, 0xb6, 0xe2, 0x1b, 0x1a, 0x74,
+	0xc5, 0xa7, 0xfd, 0x81, 0x82, 0x4e, 0x8a, 0x20, 0x47, 0x6b, 0xbc, 0x14, 0x26, 0x6a, 0xee, 0xa4,
+	0x33, 0x39, 0x89, 0x9a, 0xf3, 0x85, 0x19, 0x7a, 0xcf, 0x56, 0x6d, 0xc2, 0xeb, 0xa2, 0xa3, 0x7c,
+	0xaf, 0x73, 0x08, 0xc1, 0x3a, 0x96, 0x7d, 0x69, 0x6f, 0xc1, 0xe1, 0x88, 0x18, 0xd4, 0xf3, 0x5a,
+	0x40, 0x9e, 0x3d, 0xbf, 0xc6, 0xd4, 0x14, 0x52, 0xbf, 0xa1, 0xc0, 0xb1, 0x55, 0xca, 0x06, 0xca,
+	0x8a, 0xdd, 0x6e, 0x9b, 0xae, 0x9f, 0xb3, 0x57, 0x36, 0x74, 0xab, 0xc9, 0xa6, 0x44, 0x8b, 0x6e,
+	0xd5, 0x1a, 0x41, 0xbb, 0x98, 0x12, 0x2d, 0xba, 0x15, 0x12, 0x93, 0x27, 0xe1, 0x40, 0xc3, 0xa1,
+	0x4c, 0xd7, 0x9a, 0xa1, 0x7b, 0x94, 0xe1, 0x1e, 0xaa, 0xee, 0x17, 0x8d, 0x37, 0x75, 0x8f, 0x92,
+	0x93, 0x30, 0xb1, 0x6e, 0x5a, 0xa6, 0xbb, 0xc1, 0x49, 0x86, 0x18, 0x09, 0xf0, 0x26, 0x9f, 0x40,
+	0xfb, 0x64, 0x04, 0x26, 0x63, 0xaa, 0x4d, 0x47, 0x54, 0x0b, 0x2c, 0x21, 0x9b, 0xae, 0x14, 0x31,
+	0x9d, 0x34, 0x3d, 0x0d, 0x45, 0xa7, 0xa7, 0x70, 0x12, 0x1a, 0xde, 0xd5, 0x24, 0x74, 0x1f, 0x0e,
+	0xf2, 0x59, 0xd6, 0xa0, 0x2d, 0xda, 0xe4, 0xa1, 0x31, 0x32, 0x90, 0xc4, 0x29, 0x26, 0xe7, 0x66,
+	0x20, 0x86, 0xcc, 0x02, 0x48, 0x96, 0x1e, 0x65, 0xf8, 0xa5, 0x16, 0x5f, 0xb9, 0xb6, 0x6d, 0x99,
+	0xbe, 0x39, 0xc6, 0xb8, 0x72, 0xf8, 0xe9, 0xff, 0xb2, 0x45, 0xeb, 0xae, 0xe9, 0xd1, 0xf2, 0x3e,
+	0xfe, 0x0b, 0x7e, 0xfa, 0xab, 0xda, 0x96, 0xdd, 0xb4, 0xcb, 0xe3, 0x7c, 0x55, 0xeb, 0xff, 0xcf,
+	0x76, 0x3d, 0xb6, 0x69, 0x79, 0x6e, 0x19, 0x84, 0xf1, 0xfc, 0x2f, 0x5f, 0xb5, 0xae, 0x55, 0xb7,
+	0xf9, 0x14, 0x84, 0xc6, 0x9a, 0x18, 0x4c, 0xb5, 0x40, 0xce, 0x32, 0xb7, 0xda, 0x3c, 0x90, 0x6e,
+	0xa7, 0x65, 0xeb, 0x86, 0xbf, 0x9a, 0xaf, 0xeb, 0x75, 0xb3, 0x65, 0x7a, 0xdb, 0xe5, 0xfd, 0x0c,
+	0xd4, 0x21, 0xfe, 0xcb, 0x6a, 0xf8, 0x83, 0x94, 0x5c, 0x0e, 0xf4, 0x9f, 0x5c, 0x7e, 0x09, 0x1e,
+	0xef, 0xf0, 0x78, 0x96, 0x02, 0xb7, 0xd6, 0x60, 0x11, 0x5d, 0x9e, 0x64, 0x43, 0x64, 0x21, 0x6d,
+	0x3e, 0x49, 0x1e, 0x07, 0xd5, 0x63, 0x9d, 0xe4, 0x1f, 0xb4, 0x55, 0x98, 0x66, 0x43, 0xf2, 0x9e,
+	0xed, 0x51, 0x84, 0x91, 0x37, 0xba, 0x67, 0x00, 0xc4, 0xce, 0x06, 0xc3, 0x77, 0xbc, 0x3a, 0x8e,
+	0x2d, 0x77, 0x0c, 0x8d, 0xc2, 0xb1, 0x1e, 0x89, 0x38, 0x1a, 0x5e, 0x83, 0x89, 0x4d, 0xdb, 0xa3,
+	0x35, 0x34, 0x0d, 0x1f, 0xed, 0x4f, 0xa5, 0xa8, 0xd2, 0xcb, 0x5f, 0x85, 0xcd, 0xa0, 0x4d, 0xfb,
+	0xb3, 0x12, 0x90, 0x84, 0x2e, 0x6e, 0xc2, 0xc8, 0xa6, 0xde, 0x42, 0xcc, 0xfd, 0xfb, 0x9d, 0x33,
+	0x93, 0x57, 0x61, 0xcc, 0xb4, 0xb8, 0x9c, 0xc1, 0x16, 0x1c, 0x82, 0xdd, 0x97, 0xa4, 0xd7, 0x5d,
+	0x4f, 0x37, 0xad, 0x01, 0xd7, 0x8e, 0x82, 0xdd, 0xd7, 0x8c, 0x8d, 0xb7, 0x01, 0x87, 0x3f, 0x67,
+	0xd6, 0x96, 0x70, 0xe3, 0xb6, 0xea, 0xd8, 0x1d, 0xdb, 0xd5, 0x83, 0x43, 0x93, 0xa8, 0x53, 0x95,
+	0xb8, 0x53, 0xdf, 0x81, 0xa3, 0x31, 0x36, 0xb4, 0xf7, 0x32, 0xec, 0xeb, 0x60, 0x1b, 0xfa, 0xf3,
+	0x64, 0xfa, 0x52, 0x87, 0x91, 0x89, 0x8d, 0x9d, 0x60, 0xd3, 0xde, 0x8f, 0xc9, 0xde, 0xf3, 0x63,
+	0x95, 0xb4, 0x64, 0xab, 0x7d, 0xa4, 0xc0, 0x74, 0xbc, 0x6b, 0xd4, 0x6b, 0x05, 0xc6, 0x05, 0x40,
+	0x31, 0xfb, 0x16, 0x54, 0x2c, 0xe4, 0xdb, 0xbb, 0xf9, 0xf7, 0x97, 0x15, 0x5c, 0x7b, 0x2e, 0x3b,
+	0x8d, 0x0d, 0x73, 0x93, 0x1a, 0x8f, 0xde, 0x56, 0x7f, 0xa2, 0xc0, 0x6c, 0x1a, 0x84, 0x2f, 0xa4,
+	0xcd, 0xee, 0x86, 0xcb, 0x79, 0xde, 0xd5, 0xf6, 0xab, 0xd4, 0x6c, 0x6e, 0x78, 0x45, 0x56, 0x2f,
+	0x1b, 0x8c, 0x52, 0x58, 0x80, 0x7f, 0x69, 0x75, 0x98, 0x49, 0x11, 0xb8, 0x77, 0x63, 0xe1, 0xbb,
+	0x0a, 0x9c, 0x8e, 0x74, 0xb2, 0x66, 0x5a, 0x0d, 0x7a, 0xcb, 0xb4, 0xf4, 0x96, 0xf9, 0x01, 0x35,
+	0x96, 0xbd, 0x47, 0xe5, 0x6f, 0xf2, 0x04, 0xec, 0x5f, 0x17, 0xdd, 0xd6, 0x74, 0x0f, 0xf7, 0x4a,
+	0x13, 0xeb, 0x21, 0x14, 0xed, 0xcf, 0x15, 0x38, 0x93, 0x03, 0xf6, 0x0b, 0x19, 0x19, 0xdf, 0x52,
+	0x70, 0xeb, 0x1c, 0xc1, 0x7d, 0xc7, 0x78, 0x64, 0xb6, 0xe5, 0xa7, 0x9c, 0x43, 0xc1, 0x29, 0xe7,
+	0x1f, 0x29, 0x70, 0x22, 0x19, 0xd0, 0x17, 0xd2, 0x7e, 0x16, 0x66, 0xcd, 0x15, 0xdd, 0xe2, 0xbd,
+	0xd1, 0xdc, 0x31, 0xa5, 0x8a, 0xa1, 0x11, 0xec, 0x09, 0x82, 0x6f, 0xb6, 0xae, 0x76, 0xec, 0x76,
+	0x0d, 0x07, 0x1d, 0x37, 0x0b, 0xf8, 0x4d, 0x7c, 0x7c, 0x69, 0x6f, 0xc0, 0xb1, 0x9e, 0xfe, 0xd0,
+	0x30, 0xbe, 0x5c, 0xdb, 0x75, 0xcd, 0x7a, 0x8b, 0x9f, 0xfb, 0xed, 0xab, 0x06, 0xdf, 0x6c, 0x9f,
+	0x4f, 0x75, 0x37, 0x38, 0xce, 0xc1, 0x2f, 0xad, 0x81, 0xbb, 0x90, 0x15, 0xdd, 0xf2, 0x17, 0x10,
+	0xb9, 0xd8, 0x8f, 0xc0, 0x88, 0xbf, 0xee, 0x10, 0xc0, 0xf9, 0x47, 0x6c, 0xc2, 0x1c, 0x8a, 0x4f,
+	0x98, 0xaf, 0xc1, 0x91, 0x68, 0x27, 0xbb, 0x00, 0xfc, 0x2a, 0x4e, 0x90, 0x6c, 0xb1, 0x78, 0xc7,
+	0x5a, 0xb7, 0x07, 0xde, 0x80, 0x7d, 0x4f, 0x4c, 0x78, 0x92, 0x28, 0x04, 0x56, 0x86, 0xb1, 0xba,
+	0xde, 0xd2, 0xad, 0x46, 0x70, 0x80, 0x8a, 0x9f, 0x6c, 0x73, 0xd4, 0x75, 0x1c, 0x6a, 0x79, 0x78,
+	0x30, 0xc7, 0x65, 0xee, 0xc7, 0x46, 0x26, 0xca, 0x27, 0x6a, 0x9b, 0x96, 0xd9, 0xee, 0xb6, 0xe5,
+	0xe3, 0xc0, 0xea, 0x7e, 0x6c, 0xe4, 0x44, 0xe1, 0xaa, 0x78, 0xb8, 0xef, 0x55, 0xb1, 0xb6, 0x04,
+	0x8f, 0xf3, 0xf9, 0x87, 0xef, 0x87, 0x96, 0x5d, 0x97, 0x7a, 0xae, 0x74, 0xfc, 0xab, 0x1b, 0x86,
+	0x43, 0x5d, 0x57, 0xa0, 0xc7, 0x4f, 0xed, 0xfb, 0x23, 0xa0, 0x26, 0xf1, 0xa1, 0xda, 0xaf, 0xc6,
+	0xd4, 0xee, 0x7f, 0x7d, 0x26, 0xcc, 0x74, 0x1f, 0x82, 0x63, 0x1b, 0x66, 0x02, 0xd3, 0x6a, 0x0e,
+	0xb8, 0x78, 0x9c, 0x12, 0x72, 0xd6, 0xb8, 0x18, 0xd2, 0x02, 0x35, 0x2e, 0xba, 0x16, 0x6c, 0x50,
+	0x06, 0x5c, 0x57, 0x96, 0x63, 0x9d, 0xbc, 0x2d, 0xe4, 0x91, 0x1a, 0x1c, 0x0e, 0x7a, 0x93, 0xf6,
+	0x88, 0x83, 0x2d, 0x3b, 0x89, 0x10, 0x25, 0x6d, 0x13, 0x1d, 0x98, 0x49, 0xe8, 0x40, 0xd2, 0x68,
+	0xb0, 0xed, 0xe8, 0xf1, 0xde, 0xae, 0x42, 0xa5, 0x64, 0xef, 0x38, 0x74, 0x4b, 0x77, 0x0c, 0xb7,
+	0x3c, 0x3a, 0x50, 0x37, 0x81, 0x77, 0xaa, 0x5c, 0x4c, 0x44, 0x34, 0x1e, 0xfb, 0x95, 0xc7, 0x76,
+	0x27, 0x1a, 0xcf, 0xfd, 0xb4, 0x0f, 0xc5, 0x72, 0x00, 0x83, 0x37, 0xee, 0xab, 0x3d, 0x5f, 0xfe,
+	0x49, 0xe3, 0xa8, 0x14, 0x1d, 0x47, 0x3f, 0x10, 0x93, 0x7d, 0x3a, 0x14, 0x1c, 0x52, 0x6f, 0x00,
+	0x04, 0xae, 0x14, 0xb3, 0xd5, 0xb9, 0x8c, 0x91, 0x2e, 0x4b, 0xc1, 0x59, 0x4b, 0x12, 0xb0, 0x77,
+	0xd3, 0xd6, 0xc7, 0x0a, 0x1c, 0xec, 0x09, 0xf6, 0xf0, 0x54, 0x45, 0xd9, 0xd5, 0xa9, 0x8a, 0x7c,
+	0x82, 0xc4, 0x6e, 0xa1, 0xf8, 0x8c, 0x1f, 0x9c, 0x20, 0xbd, 0xe5, 0x5f, 0x45, 0x55, 0xf0, 0xd2,
+	0x79, 0x28, 0xf7, 0xd2, 0x19, 0xaf, 0x9b, 0x7f, 0x4d, 0x81, 0x73, 0xb2, 0xd1, 0x13, 0x22, 0xfb,
+	0x11, 0x86, 0xc0, 0x0f, 0x15, 0x98, 0xcb, 0x47, 0x83, 0x51, 0xb0, 0x9a, 0x10, 0x05, 0x69, 0x57,
+	0x0f, 0x09, 0x82, 0x1e, 0x66, 0x20, 0xfc, 0xb7, 0x02, 0x87, 0x93, 0x72, 0xc4, 0x23, 0x8d, 0x85,
+	0xf0, 0xd0, 0x73, 0x68, 0x80, 0x43, 0xcf, 0x20, 0x94, 0x86, 0x8b, 0x86, 0xd2, 0xaf, 0x04, 0x5b,
+	0x48, 0xee, 0x3c, 0x76, 0x84, 0x6e, 0xc8, 0x27, 0xe5, 0x0f, 0x3f, 0x80, 0x3e, 0x0a, 0xf6, 0x90,
+	0xbd, 0x18, 0xc2, 0x6a, 0x10, 0x76, 0xa8, 0x6f, 0x14, 0xb9, 0x97, 0x30, 0x44, 0x35, 0x08, 0x67,
+	0xd9, 0xbb, 0x08, 0xf9, 0xb6, 0x02, 0xa3, 0xbc, 0x87, 0x8c, 0xfb, 0xc2, 0x30, 0x5c, 0x4a, 0xbb,
+	0x0a, 0x97, 0xbe, 0xb3, 0x42, 0xdc, 0x95, 0x2c, 0x44, 0xfe, 0x9f, 0x5d, 0x29, 0x63, 0x08, 0x5d,
+	0xc9, 0x82, 0x35, 0xcf, 0x95, 0x9c, 0x55, 0xb8, 0x92, 0xb3, 0xec, 0x9d, 0x2b, 0xff, 0xa5, 0x04,
+	0xa3, 0xbc, 0x87, 0x2f, 0xe2, 0x61, 0xbc, 0xf0, 0xfd, 0x68, 0x41, 0xdf, 0x27, 0x1e, 0x71, 0x8f,
+	0x3d, 0xcc, 0x23, 0xee, 0x7d, 0x29, 0x47, 0xdc, 0xda, 0xaf, 0x2a, 0xf0, 0x44, 0xf2, 0x6c, 0xf0,
+	0x68, 0x23, 0xf1, 0x07, 0x0a, 0x68, 0x59, 0x38, 0x82, 0xf9, 0x68, 0x22, 0x5c, 0x6b, 0x8a, 0x09,
+	0x69, 0x2e, 0x7b, 0x42, 0xb2, 0x83, 0xbc, 0x8b, 0xd1, 0x29, 0x8b, 0xd8, 0xbb, 0x10, 0xfd, 0x7c,
+	0x08, 0x0e, 0xf5, 0xf4, 0x98, 0x91, 0x78, 0x44, 0xd0, 0x94, 0x8a, 0x06, 0xcd, 0xdb, 0x30, 0x29,
+	0x76, 0x70, 0x7c, 0xed, 0x3b, 0xe0, 0x9e, 0x41, 0xec, 0x03, 0xf9, 0xca, 0x97, 0x7c, 0x15, 0x0e,
+	0x49, 0xcb, 0xf7, 0x5d, 0x8d, 0x87, 0x83, 0xa1, 0x20, 0x8c, 0xc6, 0x70, 0xb0, 0x8e, 0x44, 0x06,
+	0x6b, 0xe6, 0xe5, 0xc8, 0xe8, 0x9e, 0x5e, 0x8e, 0x90, 0xaf, 0xc2, 0x11, 0x49, 0x41, 0x96, 0x23,
+	0x0c, 0xdd, 0xd3, 0xcb, 0x63, 0x99, 0x17, 0x17, 0x61, 0x00, 0xfa, 0x2e, 0xb8, 0xa9, 0x7b, 0x7a,
+	0x95, 0x18, 0x3d, 0x6d, 0xda, 0x15, 0x38, 0x29, 0x87, 0x6d, 0x95, 0x86, 0x34, 0xf9, 0xbb, 0xda,
+	0x9f, 0x2b, 0x70, 0x2a, 0x9d, 0x3b, 0xd8, 0xdb, 0xce, 0x38, 0x52, 0x7b, 0xad, 0x61, 0xdb, 0x2d,
+	0xc3, 0xde, 0xb2, 0x6a, 0xd4, 0xf2, 0x1c, 0x13, 0x0b, 0xb1, 0x86, 0x31, 0xb4, 0x8f, 0xcb, 0xa4,
+	0x2b, 0x48, 0xf9, 0x0a, 0x27, 0x24, 0x77, 0x61, 0x5c, 0x30, 0x8b, 0xa2, 0xa1, 0xa7, 0x53, 0xb4,
+	0xaf, 0x26, 0x88, 0x11, 0x67, 0x51, 0x81, 0x0c, 0x72, 0x0e, 0xa6, 0xf4, 0x4d, 0xdd, 0x6c, 0xe9,
+	0xf5, 0x16, 0xad, 0xb9, 0x2d, 0xdb, 0x73, 0xf1, 0xdc, 0x67, 0x32, 0x68, 0x5e, 0xf3, 0x5b, 0xb5,
+	0xeb, 0xd1, 0xc1, 0xfd, 0x15, 0xd3, 0xdb, 0x30, 0x1c, 0x7d, 0x6b, 0x99, 0xdb, 0x21, 0xdf, 0x50,
+	0xab, 0xf0, 0x64, 0x26, 0x3f, 0x9a, 0xea, 0x29, 0x38, 0xb8, 0x85, 0x3f, 0xd5, 0xa2, 0x92, 0xa6,
+	0xb6, 0xa2, 0x2c, 0x3d, 0x79, 0x0f, 0xa3, 0x0a, 0x77, 0x83, 0xb9, 0x88, 0x62, 0x19, 0xb1, 0x34,
+	0x70, 0xe9, 0xc0, 0xff, 0xc4, 0xf2, 0x5e, 0x1c, 0x47, 0x70, 0x21, 0x36, 0x26, 0xf6, 0xbb, 0x3c,
+	0xe7, 0x9d, 0xce, 0x1e, 0x1d, 0x9c, 0x3f, 0xa8, 0xf7, 0xe3, 0xac, 0xe1, 0xe5, 0x53, 0x69, 0x17,
+	0x97, 0x4f, 0xb1, 0x8c, 0x39, 0x34, 0x78, 0xc6, 0xfc, 0x50, 0x81, 0x03, 0x11, 0xbc, 0x7d, 0x1f,
+	0x85, 0xed, 0x55, 0x4d, 0x97, 0xb6, 0x8e, 0x87, 0x73, 0x52, 0x02, 0x1f, 0xec, 0x70, 0x8e, 0x9c,
+	0x80, 0x71, 0x43, 0x08, 0x11, 0x07, 0x8a, 0x41, 0x83, 0xb6, 0x0e, 0xd3, 0xf1, 0x7e, 0xd0, 0xc3,
+	0xaf, 0xcb, 0x7c, 0x4a, 0x66, 0x06, 0xe4, 0x9b, 0x89, 0x1e, 0x11, 0x72, 0x3f, 0x5f, 0x2f, 0xc1,
+	0xb1, 0x14, 0x32, 0x72, 0x22, 0xde, 0x93, 0x8c, 0x30, 0x61, 0x96, 0x29, 0x3d, 0xb4, 0x59, 0x66,
+	0x68, 0xcf, 0x67, 0x99, 0xe1, 0xc8, 0x41, 0xe9, 0xef, 0x8a, 0xd3, 0x8e, 0xc0, 0x08, 0xee, 0x0d,
+	0x56, 0x4b, 0xbd, 0x6c, 0x19, 0xd1, 0x22, 0x98, 0x87, 0x7e, 0x59, 0x30, 0x1d, 0xd9, 0x28, 0x86,
+	0x10, 0xff, 0xb9, 0x04, 0x67, 0xf3, 0x20, 0x06, 0x95, 0xac, 0x10, 0xb8, 0x49, 0xa4, 0x81, 0x3e,
+	0x43, 0x44, 0xec, 0xc7, 0x43, 0x39, 0xfd, 0x2f, 0x43, 0xd2, 0xa6, 0xd3, 0xa1, 0x3d, 0x98, 0x4e,
+	0x63, 0xb9, 0x65, 0x78, 0xf0, 0xdc, 0xf2, 0x91, 0x70, 0x3d, 0xb7, 0x44, 0x68, 0xd4, 0x9e, 0x11,
+	0xfe, 0xd0, 0x5d, 0x9f, 0x9d, 0x11, 0x7e, 0x53, 0x04, 0x40, 0x06, 0xd0, 0x42, 0x03, 0xb7, 0x6f,
+	0x47, 0x56, 0xc3, 0xc2, 0xb4, 0x21, 0x16, 0x4c, 0x8b, 0xb9, 0xbe, 0xbb, 0x65, 0x3b, 0xd1, 0xa0,
+	0x8c, 0x57, 0x13, 0xef, 0x99, 0xff, 0xfe, 0xb7, 0x04, 0xc7, 0x33, 0xfa, 0x4d, 0xdd, 0x05, 0xfe,
+	0x22, 0xa6, 0xaf, 0x75, 0x38, 0x16, 0xaf, 0xe5, 0xda, 0xdd, 0x3a, 0xfc, 0x68, 0xac, 0xa4, 0x0b,
+	0xfb, 0x39, 0x07, 0x53, 0x41, 0xb8, 0xd4, 0xf8, 0x9e, 0x64, 0x84, 0x2f, 0xd7, 0x82, 0xe6, 0x15,
+	0x36, 0x1b, 0x5e, 0xc6, 0x53, 0x81, 0x50, 0xc2, 0x8a, 0xde, 0xd1, 0x1b, 0xa6, 0xb7, 0x9d, 0x5b,
+	0xae, 0xea, 0xc0, 0xc9, 0x54, 0x56, 0x74, 0xdd, 0x5d, 0x80, 0x06, 0x6f, 0x33, 0x83, 0x67, 0x04,
+	0xf9, 0x69, 0x43, 0x88, 0x11, 0x29, 0x2c, 0x14, 0xa1, 0x7d, 0xae, 0x00, 0xe9, 0x25, 0x4c, 0x0d,
+	0x91, 0xa4, 0xd2, 0xb9, 0xd2, 0xde, 0x94, 0xce, 0x9d, 0x80, 0xf1, 0xae, 0xd5, 0x32, 0xdb, 0xa6,
+	0x47, 0xf9, 0xee, 0x6c, 0x5f, 0x35, 0x6c, 0xf0, 0xa7, 0x78, 0x87, 0xb6, 0x75, 0xd3, 0xf2, 0xef,
+	0x16, 0x06, 0xf3, 0x6c, 0x28, 0x40, 0xeb, 0x88, 0x04, 0x67, 0xb6, 0xbb, 0x2d, 0xdd, 0xa3, 0x37,
+	0xa5, 0xad, 0x43, 0x64, 0x11, 0xdb, 0xf7, 0x12, 0x66, 0x3a, 0xba, 0xa8, 0x0a, 0x16, 0x49, 0xdf,
+	0x1c, 0x82, 0xb3, 0x79, 0x5d, 0xa2, 0x8f, 0x93, 0x4f, 0x21, 0x94, 0xb4, 0x42, 0xbb, 0xf3, 0x70,
+	0x48, 0xdf, 0xa4, 0xec, 0x1a, 0xb6, 0xbe, 0xed, 0xd1, 0x9a, 0x6b, 0x7e, 0x20, 0xce, 0x5b, 0xa7,
+	0xf0, 0x87, 0x1b, 0xdb, 0x1e, 0x5d, 0x33, 0x3f, 0xa0, 0x64, 0x0d, 0x0e, 0xd4, 0xbb, 0x96, 0xd1,
+	0xa2, 0xbb, 0xdb, 0x05, 0xef, 0xe7, 0x42, 0x70, 0x7c, 0xbf, 0x03, 0x87, 0xb8, 0x34, 0x56, 0xa2,
+	0xce, 0x7f, 0x1a, 0xd0, 0x45, 0x53, 0x5c, 0xd0, 0x2a, 0x75, 0x6e, 0x30, 0x31, 0xe4, 0x2d, 0x98,
+	0x94, 0x64, 0xfb, 0xe5, 0xef, 0x83, 0xdd, 0x8c, 0xed, 0x0f, 0x04, 0xdf, 0xd4, 0xb7, 0xb5, 0x17,
+	0x71, 0xa0, 0xdd, 0xed, 0x50, 0x8b, 0x77, 0xd4, 0x53, 0x4d, 0x94, 0x3a, 0x48, 0xdf, 0x83, 0x53,
+	0xe9, 0xbc, 0xc1, 0xfd, 0x4f, 0x4f, 0xb1, 0x42, 0xda, 0x20, 0xed, 0x15, 0xd3, 0x53, 0xb6, 0xe0,
+	0xef, 0xb7, 0x48, 0x2f, 0x5d, 0xbc, 0x6a, 0x40, 0x89, 0x57, 0x0d, 0x90, 0x37, 0x61, 0x0a, 0xbd,
+	0x2d, 0x64, 0x95, 0x4b, 0x99, 0x27, 0xed, 0xd1, 0x0e, 0xaa, 0x93, 0xf5, 0xc8, 0xf7, 0xe2, 0xcf,
+	0x2e, 0xc3, 0x08, 0xd3, 0x9d, 0x7c, 0x43, 0x81, 0x51, 0xfe, 0x1a, 0x91, 0xa4, 0x29, 0xd6, 0xfb,
+	0xfc, 0x51, 0x3d, 0x5f, 0x84, 0x94, 0x9b, 0x50, 0x3b, 0xf3, 0xb5, 0x7f, 0xfc, 0xb7, 0xdf, 0x28,
+	0x9d, 0x24, 0x33, 0x95, 0xac, 0x37, 0x99, 0xe4, 0xeb, 0x0a, 0x0c, 0xfb, 0xd3, 0x32, 0x39, 0x97,
+	0x29, 0x3b, 0x7c, 0x1b, 0xa9, 0xce, 0xe5, 0x13, 0x22, 0x84, 0x39, 0x06, 0x41, 0x23, 0xa7, 0xd2,
+	0x20, 0xd8, 0x76, 0xab, 0xf2, 0xc0, 0x34, 0x76, 0xc8, 0xd7, 0x14, 0x18, 0x59, 0x65, 0xaf, 0x04,
+	0x73, 0xa5, 0x07, 0xc6, 0x78, 0xaa, 0x00, 0x25, 0x02, 0x39, 0xcd, 0x80, 0xcc, 0x92, 0x13, 0x19,
+	0x40, 0x5c, 0xf2, 0x31, 0x7b, 0xea, 0x13, 0x79, 0x28, 0x47, 0x16, 0xb3, 0x3a, 0x49, 0x7e, 0x80,
+	0xa8, 0x3e, 0xd7, 0x17, 0x0f, 0x42, 0xbc, 0xc8, 0x20, 0x2e, 0x90, 0x67, 0x52, 0x20, 0xc6, 0x1f,
+	0x02, 0x72, 0xbb, 0xfd, 0x29, 0xbb, 0x8f, 0x8c, 0x48, 0x74, 0x49, 0x3f, 0xfd, 0x07, 0xd6, 0xbc,
+	0xd8, 0x1f, 0x13, 0xa2, 0x7e, 0x96, 0xa1, 0x3e, 0x4f, 0xe6, 0x0a, 0xa2, 0x76, 0xc9, 0x37, 0x15,
+	0x18, 0xc3, 0x47, 0x6e, 0x24, 0x33, 0x9c, 0xa3, 0x2f, 0x13, 0xd5, 0xa7, 0x0b, 0xd1, 0x22, 0xac,
+	0xb3, 0x0c, 0xd6, 0x29, 0x32, 0x9b, 0x02, 0x4b, 0xbc, 0xeb, 0xfb, 0x96, 0x02, 0xfb, 0x90, 0xd7,
+	0x25, 0x45, 0x7a, 0x08, 0xcc, 0xf5, 0x4c, 0x31, 0x62, 0xc4, 0x73, 0x8e, 0xe1, 0x79, 0x82, 0x9c,
+	0xcc, 0xc6, 0xe3, 0x92, 0x3f, 0x54, 0x60, 0x32, 0xfa, 0x04, 0x90, 0x5c, 0x28, 0xd0, 0x53, 0xf4,
+	0x21, 0xa3, 0xba, 0xd8, 0x0f, 0x0b, 0x42, 0x5c, 0x60, 0x10, 0xe7, 0xc8, 0xd9, 0x6c, 0x88, 0xe2,
+	0x59, 0x20, 0xf9, 0x9e, 0x02, 0x07, 0xe3, 0xaf, 0x08, 0xb3, 0x23, 0x2f, 0xe5, 0xb5, 0xa2, 0x7a,
+	0xb1, 0x3f, 0x26, 0xc4, 0xfb, 0x22, 0xc3, 0x7b, 0x91, 0x2c, 0xa6, 0xe0, 0xed, 0x72, 0xc6, 0x9a,
+	0x23, 0x38, 0x2b, 0x0f, 0x70, 0x3a, 0xda, 0x21, 0x9f, 0x28, 0x30, 0x19, 0x7d, 0xf2, 0x96, 0x6d,
+	0xe5, 0xc4, 0x57, 0x75, 0xea, 0x62, 0x3f, 0x2c, 0x88, 0xfa, 0x05, 0x86, 0x7a, 0x91, 0x3c, 0x9b,
+	0x82, 0x3a, 0xf6, 0xdc, 0x4e, 0xc2, 0xec, 0x8f, 0xf4, 0xf8, 0xab, 0xb1, 0x6c, 0x7b, 0xa7, 0xbc,
+	0x41, 0x53, 0x2f, 0xf6, 0xc7, 0x54, 0x70, 0xa4, 0xf7, 0xbc, 0x5a, 0x23, 0xdf, 0x51, 0x60, 0x42,
+	0x7a, 0x37, 0x46, 0x16, 0xf2, 0xec, 0x15, 0x7d, 0x75, 0xa5, 0x56, 0x0a, 0xd3, 0x23, 0xc4, 0x25,
+	0x06, 0xb1, 0x42, 0xe6, 0x33, 0x8c, 0x4b, 0x1d, 0xb7, 0xd6, 0x32, 0x5d, 0x4f, 0xb2, 0xec, 0x6f,
+	0x8b, 0x8b, 0x5a, 0x27, 0x7b, 0x2a, 0x8e, 0x3c, 0x57, 0x53, 0xcf, 0x17, 0x21, 0xed, 0xc3, 0xeb,
+	0xd4, 0x09, 0x21, 0x55, 0x1e, 0xf0, 0x96, 0x1d, 0x66, 0x43, 0xe9, 0x59, 0x57, 0xb6, 0x0d, 0x7b,
+	0x5f, 0xae, 0xa9, 0x95, 0xc2, 0xf4, 0x05, 0x6d, 0x88, 0x5b, 0xed, 0x24, 0x1b, 0x72, 0x71, 0xd9,
+	0x36, 0x8c, 0x9c, 0x7b, 0xa9, 0xe7, 0x8b, 0x90, 0x16, 0xb4, 0x21, 0x07, 0x26, 0xdb, 0x90, 0xb7,
+	0xec, 0x90, 0xdf, 0x53, 0x00, 0xc2, 0x57, 0x1e, 0x64, 0x3e, 0xab, 0xd3, 0x9e, 0x27, 0x2c, 0xea,
+	0x42, 0x51, 0xf2, 0x82, 0xf3, 0xb8, 0xf4, 0x78, 0x45, 0xb2, 0xdf, 0xb7, 0x15, 0xd8, 0x17, 0x2c,
+	0x4b, 0x9f, 0xce, 0x19, 0xa0, 0xf2, 0xa3, 0x0b, 0xf5, 0x99, 0x62, 0xc4, 0x05, 0xd1, 0x89, 0x65,
+	0x6e, 0xe5, 0x81, 0x98, 0xb9, 0x7d, 0x74, 0xbf, 0xa3, 0xc0, 0xf8, 0x6a, 0x50, 0x03, 0x5c, 0xa8,
+	0xc7, 0xc0, 0x7e, 0xf3, 0x05, 0xa9, 0x23, 0xf1, 0xf7, 0x0c, 0x39, 0x9f, 0x03, 0x50, 0x32, 0xde,
+	0x87, 0x25, 0x85, 0xfc, 0x95, 0x02, 0x87, 0x7a, 0x1e, 0x15, 0x90, 0xcc, 0x4c, 0x97, 0xf6, 0x0c,
+	0x42, 0x5d, 0xea, 0x93, 0x0b, 0x91, 0x5f, 0x61, 0xc8, 0x97, 0xc8, 0x73, 0x29, 0xc8, 0x75, 0xe4,
+	0xac, 0x25, 0xa8, 0x40, 0xfe, 0x96, 0x67, 0xf7, 0xc8, 0x9b, 0x80, 0xdc, 0xec, 0x9e, 0xf4, 0x24,
+	0x41, 0xbd, 0xd8, 0x1f, 0x13, 0x82, 0xbf, 0xc9, 0xc0, 0x5f, 0x27, 0x57, 0x73, 0xcc, 0x5e, 0xab,
+	0x6f, 0xe3, 0x6e, 0x49, 0x1e, 0x69, 0xbc, 0x65, 0x87, 0xfc, 0x87, 0x02, 0xe5, 0xb4, 0x3a, 0x7e,
+	0x72, 0xa5, 0x08, 0xb0, 0x94, 0xa7, 0x0a, 0xea, 0xd5, 0xc1, 0x98, 0x51, 0xbb, 0x35, 0xa6, 0xdd,
+	0x1b, 0xe4, 0x4b, 0x79, 0xda, 0xb9, 0xbe, 0x84, 0x9a, 0xfc, 0x66, 0x21, 0x92, 0x94, 0xa5, 0xf6,
+	0x1d, 0xf2, 0x97, 0x0a, 0x4c, 0xc5, 0x6a, 0xed, 0xb3, 0x77, 0x0b, 0xc9, 0x2f, 0x05, 0xd4, 0xe7,
+	0xfa, 0xe2, 0x41, 0x8d, 0x5e, 0x62, 0x1a, 0x5d, 0x26, 0x97, 0x8a, 0x69, 0x64, 0x1a, 0xb2, 0x1e,
+	0x7e, 0xc0, 0x7d, 0x5f, 0x01, 0x08, 0x6b, 0xe1, 0xb3, 0x93, 0x62, 0x4f, 0x8d, 0xbe, 0xba, 0x50,
+	0x94, 0x1c, 0xe1, 0xbe, 0xc1, 0xe0, 0xde, 0x26, 0xaf, 0xa4, 0xc0, 0x6d, 0xe8, 0x16, 0x0e, 0x0b,
+	0x2a, 0x03, 0xc5, 0x26, 0xc7, 0xb7, 0x7d, 0xb8, 0x4f, 0xdf, 0x21, 0xdf, 0x55, 0x60, 0x0c, 0x8b,
+	0xe2, 0xb3, 0xf7, 0x10, 0xd1, 0xf2, 0x7c, 0xf5, 0xe9, 0x42, 0xb4, 0x88, 0xf9, 0x16, 0xc3, 0xfc,
+	0x32, 0xb9, 0x9e, 0x81, 0xd9, 0x4f, 0xe6, 0x32, 0x60, 0xff, 0xdb, 0xd9, 0x89, 0x26, 0xcf, 0x8f,
+	0x14, 0x18, 0x0f, 0x4a, 0xe5, 0xb3, 0x93, 0x67, 0xbc, 0x38, 0x5f, 0x9d, 0x2f, 0x48, 0x8d, 0x90,
+	0xaf, 0x32, 0xc8, 0xcf, 0x93, 0x8b, 0x59, 0x73, 0x64, 0xcd, 0xb4, 0xd6, 0xed, 0xa4, 0x79, 0xf2,
+	0x8f, 0x15, 0x38, 0x10, 0x29, 0x70, 0x27, 0xcf, 0x66, 0x66, 0xc2, 0x84, 0x1a, 0x7a, 0xf5, 0x42,
+	0x1f, 0x1c, 0x08, 0xfa, 0x12, 0x03, 0x7d, 0x81, 0x54, 0xd2, 0xf2, 0x26, 0xe7, 0xaa, 0xe9, 0x8c,
+	0xad, 0xf2, 0x00, 0xef, 0xc0, 0x77, 0xc8, 0x4f, 0x14, 0x28, 0xa7, 0x15, 0x12, 0x67, 0x67, 0x9b,
+	0x9c, 0x4a, 0x68, 0xf5, 0xea, 0x60, 0xcc, 0xa8, 0xd0, 0x0a, 0x53, 0xe8, 0x1a, 0xb9, 0x92, 0xa3,
+	0x50, 0x4f, 0x15, 0xbe, 0xac, 0xdc, 0xcf, 0x15, 0x38, 0x9e, 0x51, 0x22, 0x4b, 0xae, 0x17, 0x80,
+	0x98, 0x51, 0xe9, 0xab, 0xbe, 0x34, 0x30, 0x7f, 0xc1, 0xe1, 0x21, 0xb4, 0x4c, 0x2a, 0xce, 0x97,
+	0x15, 0xfd, 0x6b, 0x7f, 0xe6, 0x8e, 0x97, 0x72, 0xe6, 0xcc, 0xdc, 0x29, 0xd5, 0xa7, 0xea, 0x52,
+	0x9f, 0x5c, 0x05, 0x87, 0x8d, 0x50, 0x85, 0x57, 0x88, 0xe2, 0xd2, 0x37, 0x49, 0x81, 0xb0, 0x80,
+	0xb1, 0x90, 0x02, 0x3d, 0x35, 0x97, 0xea, 0x52, 0x9f, 0x5c, 0x7d, 0x2a, 0xc0, 0xeb, 0x22, 0xe3,
+	0x0a, 0xfc, 0xbd, 0x02, 0x47, 0x13, 0xeb, 0xde, 0xc8, 0x0b, 0x7d, 0x05, 0x89, 0xac, 0xc8, 0xe5,
+	0x01, 0x38, 0x51, 0x99, 0x97, 0x99, 0x32, 0x2f, 0x92, 0x17, 0x8a, 0x07, 0x56, 0x4c, 0xa1, 0x1f,
+	0x2a, 0x70, 0x38, 0xa1, 0xa6, 0x89, 0x3c, 0x5f, 0x00, 0x54, 0x42, 0x09, 0x95, 0x7a, 0xa9, 0x6f,
+	0x3e, 0x54, 0xe5, 0x1a, 0x53, 0xe5, 0x12, 0x59, 0xca, 0x51, 0x45, 0x2e, 0x9b, 0x92, 0xf4, 0xf8,
+	0x07, 0x05, 0xa6, 0x93, 0x6b, 0x8e, 0x48, 0x11, 0xfb, 0x26, 0xd7, 0x39, 0xa9, 0x2f, 0x0e, 0xc2,
+	0x8a, 0x0a, 0x2d, 0x33, 0x85, 0xae, 0x90, 0xcb, 0x39, 0x0a, 0xc5, 0xeb, 0xa0, 0x92, 0xa3, 0x2d,
+	0x5a, 0x6d, 0x54, 0x28, 0xda, 0x12, 0x0b, 0xa5, 0xd4, 0xcb, 0x03, 0x70, 0xf6, 0x19, 0x6d, 0xa2,
+	0x5e, 0x10, 0x8b, 0x99, 0x24, 0x85, 0x3e, 0x51, 0x60, 0x3c, 0xb8, 0x2d, 0xcf, 0x9e, 0xdf, 0xe3,
+	0xb7, 0xff, 0xea, 0x7c, 0x41, 0x6a, 0x04, 0x7b, 0x9b, 0x81, 0x5d, 0x26, 0x2f, 0xa5, 0x80, 0x0d,
+	0x2e, 0x52, 0x13, 0xa6, 0xf7, 0xca, 0x83, 0xe0, 0xd7, 0x1d, 0xf2, 0xef, 0x0a, 0x3c, 0x9e, 0x5a,
+	0xf2, 0x41, 0xae, 0x16, 0x42, 0x95, 0x52, 0xcc, 0xa2, 0x5e, 0x1b, 0x90, 0x1b, 0x75, 0xbc, 0xcb,
+	0x74, 0xbc, 0x43, 0x6e, 0xe7, 0xe9, 0xe8, 0xfa, 0x7b, 0x11, 0xa6, 0xa6, 0x6e, 0x19, 0xb5, 0xf4,
+	0xed, 0xff, 0x7f, 0x2a, 0xf0, 0x78, 0x6a, 0x75, 0x43, 0xb6, 0xae, 0x79, 0xd5, 0x1b, 0xea, 0xb5,
+	0x01, 0xb9, 0x51, 0xd7, 0x2a, 0xd3, 0xf5, 0x75, 0xf2, 0x5a, 0xce, 0x61, 0x8b, 0xac, 0x68, 0xa2,
+	0x8f, 0x25, 0xd7, 0xfe, 0x4d, 0xf2, 0x75, 0xf4, 0x52, 0x01, 0xaf, 0xf4, 0xde, 0xb4, 0xab, 0xcf,
+	0xf7, 0xcb, 0x56, 0x70, 0x46, 0x92, 0x2b, 0x4a, 0x91, 0x57, 0xda, 0x0d, 0xff, 0x9d, 0x02, 0x87,
+	0x13, 0x6e, 0x07, 0xb3, 0x13, 0x78, 0xfa, 0x55, 0xa4, 0x7a, 0xa9, 0x6f, 0x3e, 0x54, 0xe3, 0x3a,
+	0x53, 0xe3, 0x05, 0xf2, 0x7c, 0x8a, 0x1a, 0x76, 0x87, 0x5a, 0xb5, 0xd8, 0x0d, 0xa1, 0xbc, 0xad,
+	0xff, 0x2f, 0x3f, 0xf6, 0xd2, 0xae, 0xab, 0x73, 0x62, 0x2f, 0xe7, 0x62, 0x5d, 0xbd, 0x36, 0x20,
+	0x37, 0xaa, 0x76, 0x8f, 0xa9, 0xb6, 0x4a, 0xde, 0x4c, 0x8b, 0x3d, 0x94, 0x20, 0xcf, 0xb3, 0x41,
+	0xf2, 0x4b, 0xc8, 0x2e, 0xfc, 0x96, 0x7e, 0xe7, 0xc6, 0xed, 0x1f, 0x7d, 0x3a, 0xab, 0xfc, 0xf8,
+	0xd3, 0x59, 0xe5, 0x67, 0x9f, 0xce, 0x2a, 0xbf, 0xfe, 0xd9, 0xec, 0x63, 0x3f, 0xfe, 0x6c, 0xf6,
+	0xb1, 0x7f, 0xfd, 0x6c, 0xf6, 0xb1, 0x77, 0xe6, 0xa5, 0x9b, 0xe6, 0x2f, 0xdd, 0xbf, 0xf7, 0xca,
+	0x9b, 0xd4, 0xdb, 0xb2, 0x9d, 0x77, 0x2b, 0x8d, 0x0d, 0xdd, 0xb4, 0x2a, 0xef, 0x87, 0x10, 0xd8,
+	0xa5, 0x73, 0x7d, 0x94, 0x9d, 0x28, 0x3f, 0xf7, 0x7f, 0x03, 0x00, 0xea, 0xd8, 0x69, 0xe4, 0xed,
+	0x56, 0x00, 0x00,
 }
 
-func (m *QueryVoteStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Pool queries a pool by ID.
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// Pools queries for all pools.
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// StorageProvider queries a storage provider by ID.
+	StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error)
+	// StorageProviders queries for all storage providers.
+	StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error)
+	// Runtime queries a runtime by name.
+	Runtime(ctx context.Context, in *QueryRuntimeRequest, opts ...grpc.CallOption) (*QueryRuntimeResponse, error)
+	// Runtimes queries for all runtimes.
+	Runtimes(ctx context.Context, in *QueryRuntimesRequest, opts ...grpc.CallOption) (*QueryRuntimesResponse, error)
+	// RuntimeVersion queries a single version of a runtime.
+	RuntimeVersion(ctx context.Context, in *QueryRuntimeVersionRequest, opts ...grpc.CallOption) (*QueryRuntimeVersionResponse, error)
+	// UpgradeReadiness returns the attested protocol node versions of all stakers of a pool.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
+	// FundingStreams returns all funding streams of a pool with their remaining budget and projected runway.
+	FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error)
+	// ProtocolFundings returns all pools which receive a continuous allocation from inflation or the community pool.
+	ProtocolFundings(ctx context.Context, in *QueryProtocolFundingsRequest, opts ...grpc.CallOption) (*QueryProtocolFundingsResponse, error)
+	// FundersList returns all funder addresses with their corresponding funding amount for a given pool
+	FundersList(ctx context.Context, in *QueryFundersListRequest, opts ...grpc.CallOption) (*QueryFundersListResponse, error)
+	// Funder returns all funder info
+	Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error)
+	// StakersList returns all staker addresses with their corresponding staking amount for a given pool
+	StakersList(ctx context.Context, in *QueryStakersListRequest, opts ...grpc.CallOption) (*QueryStakersListResponse, error)
+	// Staker returns all staker info
+	Staker(ctx context.Context, in *QueryStakerRequest, opts ...grpc.CallOption) (*QueryStakerResponse, error)
+	// VoteStatus returns all vote info
+	VoteStatus(ctx context.Context, in *QueryVoteStatusRequest, opts ...grpc.CallOption) (*QueryVoteStatusResponse, error)
+	// Proposal ...
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// Proposals ...
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// ArchivedProposals returns all proposals of a retired pool.
+	ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error)
+	// ProposalByHeight ...
+	ProposalByHeight(ctx context.Context, in *QueryProposalByHeightRequest, opts ...grpc.CallOption) (*QueryProposalByHeightResponse, error)
+	// ProposalSinceFinalizedAt ...
+	ProposalSinceFinalizedAt(ctx context.Context, in *QueryProposalSinceFinalizedAtRequest, opts ...grpc.CallOption) (*QueryProposalSinceFinalizedAtResponse, error)
+	// ProposalSinceId ...
+	ProposalSinceId(ctx context.Context, in *QueryProposalSinceIdRequest, opts ...grpc.CallOption) (*QueryProposalSinceIdResponse, error)
+	// CanPropose ...
+	CanPropose(ctx context.Context, in *QueryCanProposeRequest, opts ...grpc.CallOption) (*QueryCanProposeResponse, error)
+	// CanVote checks if voter on pool can still vote for the given bundle
+	CanVote(ctx context.Context, in *QueryCanVoteRequest, opts ...grpc.CallOption) (*QueryCanVoteResponse, error)
+	// StakeInfo returns necessary information to become a staker (used by the protocol nodes)
+	StakeInfo(ctx context.Context, in *QueryStakeInfoRequest, opts ...grpc.CallOption) (*QueryStakeInfoResponse, error)
+	// AccountAssets returns an overview of the sum of all balances for a given user. e.g. balance, staking, funding, etc.
+	AccountAssets(ctx context.Context, in *QueryAccountAssetsRequest, opts ...grpc.CallOption) (*QueryAccountAssetsResponse, error)
+	// AccountStakingUnbondings ...
+	AccountStakingUnbondings(ctx context.Context, in *QueryAccountStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountStakingUnbondingsResponse, error)
+	// AccountDelegationUnbondings ...
+	AccountDelegationUnbondings(ctx context.Context, in *QueryAccountDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountDelegationUnbondingsResponse, error)
+	// AccountFundedList returns all pools the given user has funded into.
+	AccountFundedList(ctx context.Context, in *QueryAccountFundedListRequest, opts ...grpc.CallOption) (*QueryAccountFundedListResponse, error)
+	// AccountStakedList ...
+	AccountStakedList(ctx context.Context, in *QueryAccountStakedListRequest, opts ...grpc.CallOption) (*QueryAccountStakedListResponse, error)
+	// AccountDelegationList ...
+	AccountDelegationList(ctx context.Context, in *QueryAccountDelegationListRequest, opts ...grpc.CallOption) (*QueryAccountDelegationListResponse, error)
+	// AccountRedelegation ...
+	AccountRedelegation(ctx context.Context, in *QueryAccountRedelegationRequest, opts ...grpc.CallOption) (*QueryAccountRedelegationResponse, error)
+	// AccountWithdrawAddress returns the account which receives the rewards of an address.
+	AccountWithdrawAddress(ctx context.Context, in *QueryAccountWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryAccountWithdrawAddressResponse, error)
+	// AccountPendingRewards returns the outstanding rewards of all delegations of an address.
+	AccountPendingRewards(ctx context.Context, in *QueryAccountPendingRewardsRequest, opts ...grpc.CallOption) (*QueryAccountPendingRewardsResponse, error)
+	// Delegator returns all delegation info
+	Delegator(ctx context.Context, in *QueryDelegatorRequest, opts ...grpc.CallOption) (*QueryDelegatorResponse, error)
+	// DelegatorsByPoolAndStaker ...
+	DelegatorsByPoolAndStaker(ctx context.Context, in *QueryDelegatorsByPoolAndStakerRequest, opts ...grpc.CallOption) (*QueryDelegatorsByPoolAndStakerResponse, error)
+	// StakersByPoolAndDelegator ...
+	StakersByPoolAndDelegator(ctx context.Context, in *QueryStakersByPoolAndDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByPoolAndDelegatorResponse, error)
+	// DelegationCapacity returns the remaining delegation capacity of all stakers of a pool.
+	DelegationCapacity(ctx context.Context, in *QueryDelegationCapacityRequest, opts ...grpc.CallOption) (*QueryDelegationCapacityResponse, error)
+	// OpenBundleProposals returns all bundle proposals of a pool which are not finalized yet, in order.
+	OpenBundleProposals(ctx context.Context, in *QueryOpenBundleProposalsRequest, opts ...grpc.CallOption) (*QueryOpenBundleProposalsResponse, error)
+	// SimulateDelegationRewards estimates the upload probability and rewards of a hypothetical delegation.
+	SimulateDelegationRewards(ctx context.Context, in *QuerySimulateDelegationRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateDelegationRewardsResponse, error)
 }
 
-func (m *QueryVoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryVoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteStatus != nil {
-		{
-			size, err := m.VoteStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *VoteStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *VoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Pool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *VoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Total != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Abstain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Abstain))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Invalid != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Invalid))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Valid != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Valid))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProvider(ctx context.Context, in *QueryStorageProviderRequest, opts ...grpc.CallOption) (*QueryStorageProviderResponse, error) {
+	out := new(QueryStorageProviderResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StorageProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StorageProviders(ctx context.Context, in *QueryStorageProvidersRequest, opts ...grpc.CallOption) (*QueryStorageProvidersResponse, error) {
+	out := new(QueryStorageProvidersResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StorageProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Runtime(ctx context.Context, in *QueryRuntimeRequest, opts ...grpc.CallOption) (*QueryRuntimeResponse, error) {
+	out := new(QueryRuntimeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Runtime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Runtimes(ctx context.Context, in *QueryRuntimesRequest, opts ...grpc.CallOption) (*QueryRuntimesResponse, error) {
+	out := new(QueryRuntimesResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Runtimes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RuntimeVersion(ctx context.Context, in *QueryRuntimeVersionRequest, opts ...grpc.CallOption) (*QueryRuntimeVersionResponse, error) {
+	out := new(QueryRuntimeVersionResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/RuntimeVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FundingStreams(ctx context.Context, in *QueryFundingStreamsRequest, opts ...grpc.CallOption) (*QueryFundingStreamsResponse, error) {
+	out := new(QueryFundingStreamsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/FundingStreams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProtocolFundings(ctx context.Context, in *QueryProtocolFundingsRequest, opts ...grpc.CallOption) (*QueryProtocolFundingsResponse, error) {
+	out := new(QueryProtocolFundingsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProtocolFundings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) FundersList(ctx context.Context, in *QueryFundersListRequest, opts ...grpc.CallOption) (*QueryFundersListResponse, error) {
+	out := new(QueryFundersListResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/FundersList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Funder(ctx context.Context, in *QueryFunderRequest, opts ...grpc.CallOption) (*QueryFunderResponse, error) {
+	out := new(QueryFunderResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Funder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StakersList(ctx context.Context, in *QueryStakersListRequest, opts ...grpc.CallOption) (*QueryStakersListResponse, error) {
+	out := new(QueryStakersListResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakersList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Staker(ctx context.Context, in *QueryStakerRequest, opts ...grpc.CallOption) (*QueryStakerResponse, error) {
+	out := new(QueryStakerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Staker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalByHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VoteStatus(ctx context.Context, in *QueryVoteStatusRequest, opts ...grpc.CallOption) (*QueryVoteStatusResponse, error) {
+	out := new(QueryVoteStatusResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/VoteStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Proposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+// Deprecated: Do not use.
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error) {
+	out := new(QueryArchivedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ArchivedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalByHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProposalByHeight(ctx context.Context, in *QueryProposalByHeightRequest, opts ...grpc.CallOption) (*QueryProposalByHeightResponse, error) {
+	out := new(QueryProposalByHeightResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalByHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProposalSinceFinalizedAt(ctx context.Context, in *QueryProposalSinceFinalizedAtRequest, opts ...grpc.CallOption) (*QueryProposalSinceFinalizedAtResponse, error) {
+	out := new(QueryProposalSinceFinalizedAtResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalSinceFinalizedAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ProposalSinceId(ctx context.Context, in *QueryProposalSinceIdRequest, opts ...grpc.CallOption) (*QueryProposalSinceIdResponse, error) {
+	out := new(QueryProposalSinceIdResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/ProposalSinceId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CanPropose(ctx context.Context, in *QueryCanProposeRequest, opts ...grpc.CallOption) (*QueryCanProposeResponse, error) {
+	out := new(QueryCanProposeResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/CanPropose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CanVote(ctx context.Context, in *QueryCanVoteRequest, opts ...grpc.CallOption) (*QueryCanVoteResponse, error) {
+	out := new(QueryCanVoteResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/CanVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalizedAt != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedAt))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) StakeInfo(ctx context.Context, in *QueryStakeInfoRequest, opts ...grpc.CallOption) (*QueryStakeInfoResponse, error) {
+	out := new(QueryStakeInfoResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountAssets(ctx context.Context, in *QueryAccountAssetsRequest, opts ...grpc.CallOption) (*QueryAccountAssetsResponse, error) {
+	out := new(QueryAccountAssetsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountAssets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountStakingUnbondings(ctx context.Context, in *QueryAccountStakingUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountStakingUnbondingsResponse, error) {
+	out := new(QueryAccountStakingUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountStakingUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AccountDelegationUnbondings(ctx context.Context, in *QueryAccountDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryAccountDelegationUnbondingsResponse, error) {
+	out := new(QueryAccountDelegationUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountDelegationUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountFundedList(ctx context.Context, in *QueryAccountFundedListRequest, opts ...grpc.CallOption) (*QueryAccountFundedListResponse, error) {
+	out := new(QueryAccountFundedListResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountFundedList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountStakedList(ctx context.Context, in *QueryAccountStakedListRequest, opts ...grpc.CallOption) (*QueryAccountStakedListResponse, error) {
+	out := new(QueryAccountStakedListResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountStakedList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AccountDelegationList(ctx context.Context, in *QueryAccountDelegationListRequest, opts ...grpc.CallOption) (*QueryAccountDelegationListResponse, error) {
+	out := new(QueryAccountDelegationListResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountDelegationList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountRedelegation(ctx context.Context, in *QueryAccountRedelegationRequest, opts ...grpc.CallOption) (*QueryAccountRedelegationResponse, error) {
+	out := new(QueryAccountRedelegationResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountRedelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AccountWithdrawAddress(ctx context.Context, in *QueryAccountWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryAccountWithdrawAddressResponse, error) {
+	out := new(QueryAccountWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryProposalSinceIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) AccountPendingRewards(ctx context.Context, in *QueryAccountPendingRewardsRequest, opts ...grpc.CallOption) (*QueryAccountPendingRewardsResponse, error) {
+	out := new(QueryAccountPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/AccountPendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Delegator(ctx context.Context, in *QueryDelegatorRequest, opts ...grpc.CallOption) (*QueryDelegatorResponse, error) {
+	out := new(QueryDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/Delegator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCanProposeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegatorsByPoolAndStaker(ctx context.Context, in *QueryDelegatorsByPoolAndStakerRequest, opts ...grpc.CallOption) (*QueryDelegatorsByPoolAndStakerResponse, error) {
+	out := new(QueryDelegatorsByPoolAndStakerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/DelegatorsByPoolAndStaker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCanProposeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StakersByPoolAndDelegator(ctx context.Context, in *QueryStakersByPoolAndDelegatorRequest, opts ...grpc.CallOption) (*QueryStakersByPoolAndDelegatorResponse, error) {
+	out := new(QueryStakersByPoolAndDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/StakersByPoolAndDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCanProposeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryCanProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) DelegationCapacity(ctx context.Context, in *QueryDelegationCapacityRequest, opts ...grpc.CallOption) (*QueryDelegationCapacityResponse, error) {
+	out := new(QueryDelegationCapacityResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/DelegationCapacity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCanProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryCanProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Possible {
-		i--
-		if m.Possible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) OpenBundleProposals(ctx context.Context, in *QueryOpenBundleProposalsRequest, opts ...grpc.CallOption) (*QueryOpenBundleProposalsResponse, error) {
+	out := new(QueryOpenBundleProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/OpenBundleProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCanVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateDelegationRewards(ctx context.Context, in *QuerySimulateDelegationRewardsRequest, opts ...grpc.CallOption) (*QuerySimulateDelegationRewardsResponse, error) {
+	out := new(QuerySimulateDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Query/SimulateDelegationRewards", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCanVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Pool queries a pool by ID.
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// Pools queries for all pools.
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// StorageProvider queries a storage provider by ID.
+	StorageProvider(context.Context, *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error)
+	// StorageProviders queries for all storage providers.
+	StorageProviders(context.Context, *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error)
+	// Runtime queries a runtime by name.
+	Runtime(context.Context, *QueryRuntimeRequest) (*QueryRuntimeResponse, error)
+	// Runtimes queries for all runtimes.
+	Runtimes(context.Context, *QueryRuntimesRequest) (*QueryRuntimesResponse, error)
+	// RuntimeVersion queries a single version of a runtime.
+	RuntimeVersion(context.Context, *QueryRuntimeVersionRequest) (*QueryRuntimeVersionResponse, error)
+	// UpgradeReadiness returns the attested protocol node versions of all stakers of a pool.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
+	// FundingStreams returns all funding streams of a pool with their remaining budget and projected runway.
+	FundingStreams(context.Context, *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error)
+	// ProtocolFundings returns all pools which receive a continuous allocation from inflation or the community pool.
+	ProtocolFundings(context.Context, *QueryProtocolFundingsRequest) (*QueryProtocolFundingsResponse, error)
+	// FundersList returns all funder addresses with their corresponding funding amount for a given pool
+	FundersList(context.Context, *QueryFundersListRequest) (*QueryFundersListResponse, error)
+	// Funder returns all funder info
+	Funder(context.Context, *QueryFunderRequest) (*QueryFunderResponse, error)
+	// StakersList returns all staker addresses with their corresponding staking amount for a given pool
+	StakersList(context.Context, *QueryStakersListRequest) (*QueryStakersListResponse, error)
+	// Staker returns all staker info
+	Staker(context.Context, *QueryStakerRequest) (*QueryStakerResponse, error)
+	// VoteStatus returns all vote info
+	VoteStatus(context.Context, *QueryVoteStatusRequest) (*QueryVoteStatusResponse, error)
+	// Proposal ...
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Proposals ...
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// ArchivedProposals returns all proposals of a retired pool.
+	ArchivedProposals(context.Context, *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error)
+	// ProposalByHeight ...
+	ProposalByHeight(context.Context, *QueryProposalByHeightRequest) (*QueryProposalByHeightResponse, error)
+	// ProposalSinceFinalizedAt ...
+	ProposalSinceFinalizedAt(context.Context, *QueryProposalSinceFinalizedAtRequest) (*QueryProposalSinceFinalizedAtResponse, error)
+	// ProposalSinceId ...
+	ProposalSinceId(context.Context, *QueryProposalSinceIdRequest) (*QueryProposalSinceIdResponse, error)
+	// CanPropose ...
+	CanPropose(context.Context, *QueryCanProposeRequest) (*QueryCanProposeResponse, error)
+	// CanVote checks if voter on pool can still vote for the given bundle
+	CanVote(context.Context, *QueryCanVoteRequest) (*QueryCanVoteResponse, error)
+	// StakeInfo returns necessary information to become a staker (used by the protocol nodes)
+	StakeInfo(context.Context, *QueryStakeInfoRequest) (*QueryStakeInfoResponse, error)
+	// AccountAssets returns an overview of the sum of all balances for a given user. e.g. balance, staking, funding, etc.
+	AccountAssets(context.Context, *QueryAccountAssetsRequest) (*QueryAccountAssetsResponse, error)
+	// AccountStakingUnbondings ...
+	AccountStakingUnbondings(context.Context, *QueryAccountStakingUnbondingsRequest) (*QueryAccountStakingUnbondingsResponse, error)
+	// AccountDelegationUnbondings ...
+	AccountDelegationUnbondings(context.Context, *QueryAccountDelegationUnbondingsRequest) (*QueryAccountDelegationUnbondingsResponse, error)
+	// AccountFundedList returns all pools the given user has funded into.
+	AccountFundedList(context.Context, *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error)
+	// AccountStakedList ...
+	AccountStakedList(context.Context, *QueryAccountStakedListRequest) (*QueryAccountStakedListResponse, error)
+	// AccountDelegationList ...
+	AccountDelegationList(context.Context, *QueryAccountDelegationListRequest) (*QueryAccountDelegationListResponse, error)
+	// AccountRedelegation ...
+	AccountRedelegation(context.Context, *QueryAccountRedelegationRequest) (*QueryAccountRedelegationResponse, error)
+	// AccountWithdrawAddress returns the account which receives the rewards of an address.
+	AccountWithdrawAddress(context.Context, *QueryAccountWithdrawAddressRequest) (*QueryAccountWithdrawAddressResponse, error)
+	// AccountPendingRewards returns the outstanding rewards of all delegations of an address.
+	AccountPendingRewards(context.Context, *QueryAccountPendingRewardsRequest) (*QueryAccountPendingRewardsResponse, error)
+	// Delegator returns all delegation info
+	Delegator(context.Context, *QueryDelegatorRequest) (*QueryDelegatorResponse, error)
+	// DelegatorsByPoolAndStaker ...
+	DelegatorsByPoolAndStaker(context.Context, *QueryDelegatorsByPoolAndStakerRequest) (*QueryDelegatorsByPoolAndStakerResponse, error)
+	// StakersByPoolAndDelegator ...
+	StakersByPoolAndDelegator(context.Context, *QueryStakersByPoolAndDelegatorRequest) (*QueryStakersByPoolAndDelegatorResponse, error)
+	// DelegationCapacity returns the remaining delegation capacity of all stakers of a pool.
+	DelegationCapacity(context.Context, *QueryDelegationCapacityRequest) (*QueryDelegationCapacityResponse, error)
+	// OpenBundleProposals returns all bundle proposals of a pool which are not finalized yet, in order.
+	OpenBundleProposals(context.Context, *QueryOpenBundleProposalsRequest) (*QueryOpenBundleProposalsResponse, error)
+	// SimulateDelegationRewards estimates the upload probability and rewards of a hypothetical delegation.
+	SimulateDelegationRewards(context.Context, *QuerySimulateDelegationRewardsRequest) (*QuerySimulateDelegationRewardsResponse, error)
 }
 
-func (m *QueryCanVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryCanVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryCanVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
 }
-
-func (m *QueryCanVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Possible {
-		i--
-		if m.Possible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
 }
-
-func (m *QueryStakeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) StorageProvider(ctx context.Context, req *QueryStorageProviderRequest) (*QueryStorageProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProvider not implemented")
 }
-
-func (m *QueryStakeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) StorageProviders(ctx context.Context, req *QueryStorageProvidersRequest) (*QueryStorageProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageProviders not implemented")
 }
-
-func (m *QueryStakeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) Runtime(ctx context.Context, req *QueryRuntimeRequest) (*QueryRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runtime not implemented")
 }
-
-func (m *QueryStakeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Runtimes(ctx context.Context, req *QueryRuntimesRequest) (*QueryRuntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runtimes not implemented")
 }
-
-func (m *QueryStakeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) RuntimeVersion(ctx context.Context, req *QueryRuntimeVersionRequest) (*QueryRuntimeVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RuntimeVersion not implemented")
 }
-
-func (m *QueryStakeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.MinimumStake) > 0 {
-		i -= len(m.MinimumStake)
-		copy(dAtA[i:], m.MinimumStake)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinimumStake)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CurrentStake) > 0 {
-		i -= len(m.CurrentStake)
-		copy(dAtA[i:], m.CurrentStake)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentStake)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
+func (*UnimplementedQueryServer) FundingStreams(ctx context.Context, req *QueryFundingStreamsRequest) (*QueryFundingStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingStreams not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFundings(ctx context.Context, req *QueryProtocolFundingsRequest) (*QueryProtocolFundingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFundings not implemented")
+}
+func (*UnimplementedQueryServer) FundersList(ctx context.Context, req *QueryFundersListRequest) (*QueryFundersListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundersList not implemented")
+}
+func (*UnimplementedQueryServer) Funder(ctx context.Context, req *QueryFunderRequest) (*QueryFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Funder not implemented")
+}
+func (*UnimplementedQueryServer) StakersList(ctx context.Context, req *QueryStakersListRequest) (*QueryStakersListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakersList not implemented")
+}
+func (*UnimplementedQueryServer) Staker(ctx context.Context, req *QueryStakerRequest) (*QueryStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Staker not implemented")
+}
+func (*UnimplementedQueryServer) VoteStatus(ctx context.Context, req *QueryVoteStatusRequest) (*QueryVoteStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteStatus not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) ArchivedProposals(ctx context.Context, req *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedProposals not implemented")
+}
+func (*UnimplementedQueryServer) ProposalByHeight(ctx context.Context, req *QueryProposalByHeightRequest) (*QueryProposalByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalByHeight not implemented")
+}
+func (*UnimplementedQueryServer) ProposalSinceFinalizedAt(ctx context.Context, req *QueryProposalSinceFinalizedAtRequest) (*QueryProposalSinceFinalizedAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalSinceFinalizedAt not implemented")
+}
+func (*UnimplementedQueryServer) ProposalSinceId(ctx context.Context, req *QueryProposalSinceIdRequest) (*QueryProposalSinceIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalSinceId not implemented")
+}
+func (*UnimplementedQueryServer) CanPropose(ctx context.Context, req *QueryCanProposeRequest) (*QueryCanProposeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanPropose not implemented")
+}
+func (*UnimplementedQueryServer) CanVote(ctx context.Context, req *QueryCanVoteRequest) (*QueryCanVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanVote not implemented")
+}
+func (*UnimplementedQueryServer) StakeInfo(ctx context.Context, req *QueryStakeInfoRequest) (*QueryStakeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeInfo not implemented")
+}
+func (*UnimplementedQueryServer) AccountAssets(ctx context.Context, req *QueryAccountAssetsRequest) (*QueryAccountAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAssets not implemented")
+}
+func (*UnimplementedQueryServer) AccountStakingUnbondings(ctx context.Context, req *QueryAccountStakingUnbondingsRequest) (*QueryAccountStakingUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountStakingUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) AccountDelegationUnbondings(ctx context.Context, req *QueryAccountDelegationUnbondingsRequest) (*QueryAccountDelegationUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDelegationUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) AccountFundedList(ctx context.Context, req *QueryAccountFundedListRequest) (*QueryAccountFundedListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFundedList not implemented")
+}
+func (*UnimplementedQueryServer) AccountStakedList(ctx context.Context, req *QueryAccountStakedListRequest) (*QueryAccountStakedListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountStakedList not implemented")
+}
+func (*UnimplementedQueryServer) AccountDelegationList(ctx context.Context, req *QueryAccountDelegationListRequest) (*QueryAccountDelegationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDelegationList not implemented")
+}
+func (*UnimplementedQueryServer) AccountRedelegation(ctx context.Context, req *QueryAccountRedelegationRequest) (*QueryAccountRedelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRedelegation not implemented")
+}
+func (*UnimplementedQueryServer) AccountWithdrawAddress(ctx context.Context, req *QueryAccountWithdrawAddressRequest) (*QueryAccountWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountWithdrawAddress not implemented")
+}
+func (*UnimplementedQueryServer) AccountPendingRewards(ctx context.Context, req *QueryAccountPendingRewardsRequest) (*QueryAccountPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) Delegator(ctx context.Context, req *QueryDelegatorRequest) (*QueryDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegator not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorsByPoolAndStaker(ctx context.Context, req *QueryDelegatorsByPoolAndStakerRequest) (*QueryDelegatorsByPoolAndStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorsByPoolAndStaker not implemented")
+}
+func (*UnimplementedQueryServer) StakersByPoolAndDelegator(ctx context.Context, req *QueryStakersByPoolAndDelegatorRequest) (*QueryStakersByPoolAndDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakersByPoolAndDelegator not implemented")
+}
+func (*UnimplementedQueryServer) DelegationCapacity(ctx context.Context, req *QueryDelegationCapacityRequest) (*QueryDelegationCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationCapacity not implemented")
+}
+func (*UnimplementedQueryServer) OpenBundleProposals(ctx context.Context, req *QueryOpenBundleProposalsRequest) (*QueryOpenBundleProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenBundleProposals not implemented")
+}
+func (*UnimplementedQueryServer) SimulateDelegationRewards(ctx context.Context, req *QuerySimulateDelegationRewardsRequest) (*QuerySimulateDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateDelegationRewards not implemented")
 }
 
-func (m *QueryAccountAssetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StorageProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProviderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/StorageProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProvider(ctx, req.(*QueryStorageProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_StorageProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/StorageProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageProviders(ctx, req.(*QueryStorageProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProtocolFunding != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolFunding))
-		i--
-		dAtA[i] = 0x38
+func _Query_Runtime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProtocolRewards != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolRewards))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(QueryServer).Runtime(ctx, in)
 	}
-	if m.ProtocolDelegationUnbonding != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolDelegationUnbonding))
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Runtime",
 	}
-	if m.ProtocolDelegation != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolDelegation))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runtime(ctx, req.(*QueryRuntimeRequest))
 	}
-	if m.ProtocolStakingUnbonding != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolStakingUnbonding))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Runtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ProtocolStaking != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProtocolStaking))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).Runtimes(ctx, in)
 	}
-	if m.Balance != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Balance))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Runtimes",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runtimes(ctx, req.(*QueryRuntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RuntimeVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRuntimeVersionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RuntimeVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/RuntimeVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RuntimeVersion(ctx, req.(*QueryRuntimeVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func _Query_FundingStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).FundingStreams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/FundingStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingStreams(ctx, req.(*QueryFundingStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProtocolFundings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFundingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountStakingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountStakingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFundings(ctx, in)
 	}
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/ProtocolFundings",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFundings(ctx, req.(*QueryProtocolFundingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StakingUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FundersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundersListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).FundersList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/FundersList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundersList(ctx, req.(*QueryFundersListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_Funder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CreationTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreationTime))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).Funder(ctx, in)
 	}
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Funder",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Funder(ctx, req.(*QueryFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StakersList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakersListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).StakersList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/StakersList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakersList(ctx, req.(*QueryStakersListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Staker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Staker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Staker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Staker(ctx, req.(*QueryStakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func _Query_VoteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).VoteStatus(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/VoteStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteStatus(ctx, req.(*QueryVoteStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Proposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ArchivedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedProposals(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DelegationUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/ArchivedProposals",
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegationUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedProposals(ctx, req.(*QueryArchivedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegationUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func _Query_ProposalByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Staker != nil {
-		{
-			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalByHeight(ctx, in)
 	}
-	if m.CreationTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CreationTime))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/ProposalByHeight",
 	}
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalByHeight(ctx, req.(*QueryProposalByHeightRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountFundedListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProposalSinceFinalizedAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalSinceFinalizedAtRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountFundedListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountFundedListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalSinceFinalizedAt(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/ProposalSinceFinalizedAt",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalSinceFinalizedAt(ctx, req.(*QueryProposalSinceFinalizedAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountFundedListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProposalSinceId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalSinceIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountFundedListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountFundedListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalSinceId(ctx, in)
 	}
-	if len(m.Funded) > 0 {
-		for iNdEx := len(m.Funded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Funded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/ProposalSinceId",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalSinceId(ctx, req.(*QueryProposalSinceIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Funded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CanPropose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanProposeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Funded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Funded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).CanPropose(ctx, in)
 	}
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/CanPropose",
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanPropose(ctx, req.(*QueryCanProposeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakedListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CanVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanVoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountStakedListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountStakedListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).CanVote(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/CanVote",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanVote(ctx, req.(*QueryCanVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakedListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StakeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountStakedListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).StakeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/StakeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakeInfo(ctx, req.(*QueryStakeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountStakedListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_AccountAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Staked) > 0 {
-		for iNdEx := len(m.Staked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Staked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAssets(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAssets(ctx, req.(*QueryAccountAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Staked) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountStakingUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountStakingUnbondingsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AccountStakingUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountStakingUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountStakingUnbondings(ctx, req.(*QueryAccountStakingUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Staked) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AccountDelegationUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDelegationUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDelegationUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountDelegationUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDelegationUnbondings(ctx, req.(*QueryAccountDelegationUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Staked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UploadProbability) > 0 {
-		i -= len(m.UploadProbability)
-		copy(dAtA[i:], m.UploadProbability)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadProbability)))
-		i--
-		dAtA[i] = 0x42
+func _Query_AccountFundedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFundedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UnbondingAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingAmount))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFundedList(ctx, in)
 	}
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountFundedList",
 	}
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFundedList(ctx, req.(*QueryAccountFundedListRequest))
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountStakedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountStakedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).AccountStakedList(ctx, in)
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountStakedList",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountStakedList(ctx, req.(*QueryAccountStakedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountDelegationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDelegationListRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDelegationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountDelegationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDelegationList(ctx, req.(*QueryAccountDelegationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AccountRedelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRedelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountRedelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountRedelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountRedelegation(ctx, req.(*QueryAccountRedelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func _Query_AccountWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).AccountWithdrawAddress(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountWithdrawAddress(ctx, req.(*QueryAccountWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountDelegationListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountPendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountPendingRewardsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountDelegationListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAccountDelegationListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).AccountPendingRewards(ctx, in)
 	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/AccountPendingRewards",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountPendingRewards(ctx, req.(*QueryAccountPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Delegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegationPoolData != nil {
-		{
-			size, err := m.DelegationPoolData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).Delegator(ctx, in)
 	}
-	if m.PendingCommissionChange != nil {
-		{
-			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/Delegator",
 	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegator(ctx, req.(*QueryDelegatorRequest))
 	}
-	if m.DelegationAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationAmount))
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorsByPoolAndStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorsByPoolAndStakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CurrentReward != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentReward))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorsByPoolAndStaker(ctx, in)
 	}
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/DelegatorsByPoolAndStaker",
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorsByPoolAndStaker(ctx, req.(*QueryDelegatorsByPoolAndStakerRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountRedelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_StakersByPoolAndDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakersByPoolAndDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountRedelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).StakersByPoolAndDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/StakersByPoolAndDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakersByPoolAndDelegator(ctx, req.(*QueryStakersByPoolAndDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountRedelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_DelegationCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/DelegationCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationCapacity(ctx, req.(*QueryDelegationCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountRedelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OpenBundleProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenBundleProposalsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OpenBundleProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/OpenBundleProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenBundleProposals(ctx, req.(*QueryOpenBundleProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountRedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SimulateDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Query/SimulateDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateDelegationRewards(ctx, req.(*QuerySimulateDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountRedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RedelegationCooldownEntries) > 0 {
-		dAtA38 := make([]byte, len(m.RedelegationCooldownEntries)*10)
-		var j37 int
-		for _, num := range m.RedelegationCooldownEntries {
-			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j37++
-			}
-			dAtA38[j37] = uint8(num)
-			j37++
-		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintQuery(dAtA, i, uint64(j37))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kyve.registry.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "StorageProvider",
+			Handler:    _Query_StorageProvider_Handler,
+		},
+		{
+			MethodName: "StorageProviders",
+			Handler:    _Query_StorageProviders_Handler,
+		},
+		{
+			MethodName: "Runtime",
+			Handler:    _Query_Runtime_Handler,
+		},
+		{
+			MethodName: "Runtimes",
+			Handler:    _Query_Runtimes_Handler,
+		},
+		{
+			MethodName: "RuntimeVersion",
+			Handler:    _Query_RuntimeVersion_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
+		{
+			MethodName: "FundingStreams",
+			Handler:    _Query_FundingStreams_Handler,
+		},
+		{
+			MethodName: "ProtocolFundings",
+			Handler:    _Query_ProtocolFundings_Handler,
+		},
+		{
+			MethodName: "FundersList",
+			Handler:    _Query_FundersList_Handler,
+		},
+		{
+			MethodName: "Funder",
+			Handler:    _Query_Funder_Handler,
+		},
+		{
+			MethodName: "StakersList",
+			Handler:    _Query_StakersList_Handler,
+		},
+		{
+			MethodName: "Staker",
+			Handler:    _Query_Staker_Handler,
+		},
+		{
+			MethodName: "VoteStatus",
+			Handler:    _Query_VoteStatus_Handler,
+		},
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "ArchivedProposals",
+			Handler:    _Query_ArchivedProposals_Handler,
+		},
+		{
+			MethodName: "ProposalByHeight",
+			Handler:    _Query_ProposalByHeight_Handler,
+		},
+		{
+			MethodName: "ProposalSinceFinalizedAt",
+			Handler:    _Query_ProposalSinceFinalizedAt_Handler,
+		},
+		{
+			MethodName: "ProposalSinceId",
+			Handler:    _Query_ProposalSinceId_Handler,
+		},
+		{
+			MethodName: "CanPropose",
+			Handler:    _Query_CanPropose_Handler,
+		},
+		{
+			MethodName: "CanVote",
+			Handler:    _Query_CanVote_Handler,
+		},
+		{
+			MethodName: "StakeInfo",
+			Handler:    _Query_StakeInfo_Handler,
+		},
+		{
+			MethodName: "AccountAssets",
+			Handler:    _Query_AccountAssets_Handler,
+		},
+		{
+			MethodName: "AccountStakingUnbondings",
+			Handler:    _Query_AccountStakingUnbondings_Handler,
+		},
+		{
+			MethodName: "AccountDelegationUnbondings",
+			Handler:    _Query_AccountDelegationUnbondings_Handler,
+		},
+		{
+			MethodName: "AccountFundedList",
+			Handler:    _Query_AccountFundedList_Handler,
+		},
+		{
+			MethodName: "AccountStakedList",
+			Handler:    _Query_AccountStakedList_Handler,
+		},
+		{
+			MethodName: "AccountDelegationList",
+			Handler:    _Query_AccountDelegationList_Handler,
+		},
+		{
+			MethodName: "AccountRedelegation",
+			Handler:    _Query_AccountRedelegation_Handler,
+		},
+		{
+			MethodName: "AccountWithdrawAddress",
+			Handler:    _Query_AccountWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "AccountPendingRewards",
+			Handler:    _Query_AccountPendingRewards_Handler,
+		},
+		{
+			MethodName: "Delegator",
+			Handler:    _Query_Delegator_Handler,
+		},
+		{
+			MethodName: "DelegatorsByPoolAndStaker",
+			Handler:    _Query_DelegatorsByPoolAndStaker_Handler,
+		},
+		{
+			MethodName: "StakersByPoolAndDelegator",
+			Handler:    _Query_StakersByPoolAndDelegator_Handler,
+		},
+		{
+			MethodName: "DelegationCapacity",
+			Handler:    _Query_DelegationCapacity_Handler,
+		},
+		{
+			MethodName: "OpenBundleProposals",
+			Handler:    _Query_OpenBundleProposals_Handler,
+		},
+		{
+			MethodName: "SimulateDelegationRewards",
+			Handler:    _Query_SimulateDelegationRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyve/registry/v1beta1/query.proto",
 }
 
-func (m *QueryDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7435,39 +7228,20 @@ func (m *QueryDelegatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7477,32 +7251,30 @@ func (m *QueryDelegatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Delegator != nil {
-		{
-			size, err := m.Delegator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StakerDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7512,44 +7284,25 @@ func (m *StakerDelegatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StakerDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StakerDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DelegationAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationAmount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CurrentReward != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentReward))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7559,44 +7312,30 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7606,31 +7345,43 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.DelegationPoolData != nil {
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Search) > 0 {
+		i -= len(m.Search)
+		copy(dAtA[i:], m.Search)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Search)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
 		{
-			size, err := m.DelegationPoolData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7638,11 +7389,34 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalToSizedBuffer(dAtA []byt
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.Pool != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7652,10 +7426,10 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalToSizedBuffer(dAtA []byt
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Delegators) > 0 {
-		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Delegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7669,7 +7443,7 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageProviderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7679,44 +7453,58 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageProviderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StorageProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageProvidersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7726,32 +7514,43 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Stakers) > 0 {
-		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageProviders) > 0 {
+		for iNdEx := len(m.StorageProviders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StorageProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7759,32 +7558,13 @@ func (m *QueryStakersByPoolAndDelegatorResponse) MarshalToSizedBuffer(dAtA []byt
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationForStakerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryRuntimeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7794,1308 +7574,9006 @@ func (m *DelegationForStakerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationForStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRuntimeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRuntimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DelegatorCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TotalDelegationAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalDelegationAmount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.DelegationAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationAmount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CurrentReward != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentReward))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRuntimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRuntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRuntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.Runtime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryRuntimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRuntimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRuntimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Search)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Runtime)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Paused {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRuntimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFundersListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+func (m *QueryRuntimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFundersListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRuntimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Funders) > 0 {
-		for _, e := range m.Funders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Runtimes) > 0 {
+		for iNdEx := len(m.Runtimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Runtimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFunderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Funder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRuntimeVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Funder != nil {
-		l = m.Funder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRuntimeVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakersListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRuntimeVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Runtime) > 0 {
+		i -= len(m.Runtime)
+		copy(dAtA[i:], m.Runtime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Runtime)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakersListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRuntimeVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRuntimeVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRuntimeVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Stakers) > 0 {
-		for _, e := range m.Stakers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.RuntimeVersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Staker != nil {
-		l = m.Staker.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PendingCommissionChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NewCommission)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CreationDate != 0 {
-		n += 1 + sovQuery(uint64(m.CreationDate))
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.FinishDate != 0 {
-		n += 1 + sovQuery(uint64(m.FinishDate))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ReadyStake.Size()
+		i -= size
+		if _, err := m.ReadyStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TargetVersion) > 0 {
+		i -= len(m.TargetVersion)
+		copy(dAtA[i:], m.TargetVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StakerReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
-	}
-	if m.TotalDelegation != 0 {
-		n += 1 + sovQuery(uint64(m.TotalDelegation))
-	}
-	l = len(m.Commission)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Points != 0 {
-		n += 1 + sovQuery(uint64(m.Points))
-	}
-	if m.UnbondingAmount != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingAmount))
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.UploadProbability)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PendingCommissionChange != nil {
-		l = m.PendingCommissionChange.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundingStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VoteStatus != nil {
-		l = m.VoteStatus.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VoteStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FundingStreamStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStreamStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStreamStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Valid != 0 {
-		n += 1 + sovQuery(uint64(m.Valid))
-	}
-	if m.Invalid != 0 {
-		n += 1 + sovQuery(uint64(m.Invalid))
+	if m.Runway != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Runway))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Abstain != 0 {
-		n += 1 + sovQuery(uint64(m.Abstain))
+	{
+		size := m.SpendPerDay.Size()
+		i -= size
+		if _, err := m.SpendPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Total != 0 {
-		n += 1 + sovQuery(uint64(m.Total))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FundingStream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProtocolFundingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProtocolFundingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProtocolFundingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProtocolFundingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFundingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFundingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProtocolFundings) > 0 {
+		for iNdEx := len(m.ProtocolFundings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFundings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalByHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *QueryFundersListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalByHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFundersListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalSinceFinalizedAtRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFundersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.FinalizedAt != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedAt))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalSinceFinalizedAtResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFundersListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundersListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalSinceIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalSinceIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Funder != nil {
+		{
+			size, err := m.Funder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCanProposeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FromHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FromHeight))
+func (m *QueryStakersListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCanProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStakersListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakersListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Possible {
-		n += 2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCanVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStakersListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCanVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Possible {
-		n += 2
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStakersListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStakersListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CurrentStake)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MinimumStake)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+func (m *QueryStakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Balance != 0 {
-		n += 1 + sovQuery(uint64(m.Balance))
-	}
-	if m.ProtocolStaking != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolStaking))
-	}
-	if m.ProtocolStakingUnbonding != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolStakingUnbonding))
-	}
-	if m.ProtocolDelegation != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolDelegation))
-	}
-	if m.ProtocolDelegationUnbonding != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolDelegationUnbonding))
-	}
-	if m.ProtocolRewards != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolRewards))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ProtocolFunding != 0 {
-		n += 1 + sovQuery(uint64(m.ProtocolFunding))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountStakingUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountStakingUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Staker != nil {
+		{
+			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StakingUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+	if m.FinishDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinishDate))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CreationTime != 0 {
-		n += 1 + sovQuery(uint64(m.CreationTime))
+	if m.CreationDate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationDate))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NewCommission) > 0 {
+		i -= len(m.NewCommission)
+		copy(dAtA[i:], m.NewCommission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewCommission)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountDelegationUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *StakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountDelegationUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *StakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+	if m.PendingCommissionChange != nil {
+		{
+			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.CreationTime != 0 {
-		n += 1 + sovQuery(uint64(m.CreationTime))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.Staker != nil {
-		l = m.Staker.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UploadProbability) > 0 {
+		i -= len(m.UploadProbability)
+		copy(dAtA[i:], m.UploadProbability)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadProbability)))
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *QueryAccountFundedListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x5a
+	if m.Points != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x50
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *QueryAccountFundedListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if len(m.Funded) > 0 {
-		for _, e := range m.Funded {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Commission) > 0 {
+		i -= len(m.Commission)
+		copy(dAtA[i:], m.Commission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commission)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.TotalDelegation.Size()
+		i -= size
+		if _, err := m.TotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *Funded) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountStakedListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVoteStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountStakedListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVoteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Staked) > 0 {
-		for _, e := range m.Staked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.VoteStatus != nil {
+		{
+			size, err := m.VoteStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Staked) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoteStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
-	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UnbondingAmount != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingAmount))
-	}
-	l = len(m.UploadProbability)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountDelegationListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *VoteStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountDelegationListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VoteStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *DelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentReward))
-	}
-	if m.DelegationAmount != 0 {
-		n += 1 + sovQuery(uint64(m.DelegationAmount))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Abstain.Size()
+		i -= size
+		if _, err := m.Abstain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.PendingCommissionChange != nil {
-		l = m.PendingCommissionChange.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Invalid.Size()
+		i -= size
+		if _, err := m.Invalid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.DelegationPoolData != nil {
-		l = m.DelegationPoolData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Valid.Size()
+		i -= size
+		if _, err := m.Valid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRedelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRedelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RedelegationCooldownEntries) > 0 {
-		l = 0
-		for _, e := range m.RedelegationCooldownEntries {
-			l += sovQuery(uint64(e))
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Delegator != nil {
-		l = m.Delegator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StakerDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentReward))
-	}
-	if m.DelegationAmount != 0 {
-		n += 1 + sovQuery(uint64(m.DelegationAmount))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorsByPoolAndStakerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorsByPoolAndStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryArchivedProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegators) > 0 {
-		for _, e := range m.Delegators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.DelegationPoolData != nil {
-		l = m.DelegationPoolData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryArchivedProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArchivedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArchivedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakersByPoolAndDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Stakers) > 0 {
-		for _, e := range m.Stakers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationForStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProposalByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CurrentReward != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentReward))
-	}
-	if m.DelegationAmount != 0 {
-		n += 1 + sovQuery(uint64(m.DelegationAmount))
-	}
-	if m.TotalDelegationAmount != 0 {
-		n += 1 + sovQuery(uint64(m.TotalDelegationAmount))
-	}
-	if m.DelegatorCount != 0 {
-		n += 1 + sovQuery(uint64(m.DelegatorCount))
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryProposalSinceFinalizedAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryProposalSinceFinalizedAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryProposalSinceFinalizedAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalSinceFinalizedAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalSinceFinalizedAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalSinceFinalizedAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalSinceIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalSinceIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalSinceIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalSinceIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalSinceIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalSinceIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanProposeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanProposeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanProposeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanProposeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanProposeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanProposeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Possible {
+		i--
+		if m.Possible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Possible {
+		i--
+		if m.Possible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinimumStake) > 0 {
+		i -= len(m.MinimumStake)
+		copy(dAtA[i:], m.MinimumStake)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinimumStake)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentStake) > 0 {
+		i -= len(m.CurrentStake)
+		copy(dAtA[i:], m.CurrentStake)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentStake)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAssetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProtocolFunding.Size()
+		i -= size
+		if _, err := m.ProtocolFunding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ProtocolRewards.Size()
+		i -= size
+		if _, err := m.ProtocolRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ProtocolDelegationUnbonding.Size()
+		i -= size
+		if _, err := m.ProtocolDelegationUnbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProtocolDelegation.Size()
+		i -= size
+		if _, err := m.ProtocolDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProtocolStakingUnbonding.Size()
+		i -= size
+		if _, err := m.ProtocolStakingUnbonding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ProtocolStaking.Size()
+		i -= size
+		if _, err := m.ProtocolStaking.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStakingUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakingUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakingUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStakingUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakingUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakingUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakingUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakingUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakingUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreationTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDelegationUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDelegationUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDelegationUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDelegationUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDelegationUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDelegationUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Staker != nil {
+		{
+			size, err := m.Staker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreationTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFundedListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFundedListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFundedListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFundedListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFundedListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFundedListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funded) > 0 {
+		for iNdEx := len(m.Funded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Funded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Funded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Funded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStakedListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakedListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakedListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountStakedListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountStakedListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountStakedListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Staked) > 0 {
+		for iNdEx := len(m.Staked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Staked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Staked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Staked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UploadProbability) > 0 {
+		i -= len(m.UploadProbability)
+		copy(dAtA[i:], m.UploadProbability)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadProbability)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDelegationListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDelegationListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDelegationListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDelegationListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDelegationListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDelegationListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegationPoolData != nil {
+		{
+			size, err := m.DelegationPoolData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PendingCommissionChange != nil {
+		{
+			size, err := m.PendingCommissionChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentReward.Size()
+		i -= size
+		if _, err := m.CurrentReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRedelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRedelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRedelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountRedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AvailableSlots != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvailableSlots))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cooldowns) > 0 {
+		for iNdEx := len(m.Cooldowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cooldowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RedelegationCooldownEntries) > 0 {
+		dAtA44 := make([]byte, len(m.RedelegationCooldownEntries)*10)
+		var j43 int
+		for _, num := range m.RedelegationCooldownEntries {
+			for num >= 1<<7 {
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j43++
+			}
+			dAtA44[j43] = uint8(num)
+			j43++
+		}
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintQuery(dAtA, i, uint64(j43))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delegator != nil {
+		{
+			size, err := m.Delegator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentReward.Size()
+		i -= size
+		if _, err := m.CurrentReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorsByPoolAndStakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorsByPoolAndStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorsByPoolAndStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorsByPoolAndStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorsByPoolAndStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DelegationPoolData != nil {
+		{
+			size, err := m.DelegationPoolData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakersByPoolAndDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakersByPoolAndDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakersByPoolAndDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakersByPoolAndDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakersByPoolAndDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakersByPoolAndDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stakers) > 0 {
+		for iNdEx := len(m.Stakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationForStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationForStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationForStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegatorCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalDelegationAmount.Size()
+		i -= size
+		if _, err := m.TotalDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CurrentReward.Size()
+		i -= size
+		if _, err := m.CurrentReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for iNdEx := len(m.Capacities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Capacities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TotalDelegation.Size()
+		i -= size
+		if _, err := m.TotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardPerDay.Size()
+		i -= size
+		if _, err := m.RewardPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardPerBundle.Size()
+		i -= size
+		if _, err := m.RewardPerBundle.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BundleReward.Size()
+		i -= size
+		if _, err := m.BundleReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AverageByteSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageByteSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UploadProbability) > 0 {
+		i -= len(m.UploadProbability)
+		copy(dAtA[i:], m.UploadProbability)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UploadProbability)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenBundleProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenBundleProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenBundleProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenBundleProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenBundleProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenBundleProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenBundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BundleProposal != nil {
+		{
+			size, err := m.BundleProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Search)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryStorageProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStorageProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageProviders) > 0 {
+		for _, e := range m.StorageProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRuntimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Runtime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRuntimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRuntimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Runtimes) > 0 {
+		for _, e := range m.Runtimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRuntimeVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Runtime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRuntimeVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RuntimeVersion.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Stakers) > 0 {
+		for _, e := range m.Stakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ReadyStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StakerReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Ready {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFundingStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFundingStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingStreamStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundingStream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpendPerDay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Runway != 0 {
+		n += 1 + sovQuery(uint64(m.Runway))
+	}
+	return n
+}
+
+func (m *QueryProtocolFundingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtocolFundingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolFundings) > 0 {
+		for _, e := range m.ProtocolFundings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFundersListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryFundersListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Funder != nil {
+		l = m.Funder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakers) > 0 {
+		for _, e := range m.Stakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Staker != nil {
+		l = m.Staker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewCommission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreationDate != 0 {
+		n += 1 + sovQuery(uint64(m.CreationDate))
+	}
+	if m.FinishDate != 0 {
+		n += 1 + sovQuery(uint64(m.FinishDate))
+	}
+	return n
+}
+
+func (m *StakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Commission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovQuery(uint64(m.Points))
+	}
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.UploadProbability)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.PendingCommissionChange != nil {
+		l = m.PendingCommissionChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteStatus != nil {
+		l = m.VoteStatus.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Invalid.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Abstain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArchivedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryArchivedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryProposalByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalSinceFinalizedAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.FinalizedAt != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedAt))
+	}
+	return n
+}
+
+func (m *QueryProposalSinceFinalizedAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalSinceIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryProposalSinceIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanProposeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *QueryCanProposeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Possible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Possible {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurrentStake)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MinimumStake)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryAccountAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolStaking.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolStakingUnbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolDelegationUnbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolFunding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountStakingUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountStakingUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakingUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CreationTime != 0 {
+		n += 1 + sovQuery(uint64(m.CreationTime))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CreationTime != 0 {
+		n += 1 + sovQuery(uint64(m.CreationTime))
+	}
+	if m.Staker != nil {
+		l = m.Staker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFundedListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFundedListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funded) > 0 {
+		for _, e := range m.Funded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Funded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountStakedListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountStakedListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Staked) > 0 {
+		for _, e := range m.Staked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Staked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.UploadProbability)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDelegationListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingCommissionChange != nil {
+		l = m.PendingCommissionChange.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelegationPoolData != nil {
+		l = m.DelegationPoolData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRedelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationCooldownEntries) > 0 {
+		l = 0
+		for _, e := range m.RedelegationCooldownEntries {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Cooldowns) > 0 {
+		for _, e := range m.Cooldowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AvailableSlots != 0 {
+		n += 1 + sovQuery(uint64(m.AvailableSlots))
+	}
+	return n
+}
+
+func (m *QueryAccountWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegator != nil {
+		l = m.Delegator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakerDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorsByPoolAndStakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorsByPoolAndStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, e := range m.Delegators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelegationPoolData != nil {
+		l = m.DelegationPoolData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersByPoolAndDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakersByPoolAndDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Stakers) > 0 {
+		for _, e := range m.Stakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationForStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DelegatorCount != 0 {
+		n += 1 + sovQuery(uint64(m.DelegatorCount))
+	}
+	return n
+}
+
+func (m *QueryDelegationCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryDelegationCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Capacities) > 0 {
+		for _, e := range m.Capacities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TotalDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UploadProbability)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AverageByteSize != 0 {
+		n += 1 + sovQuery(uint64(m.AverageByteSize))
+	}
+	l = m.BundleReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardPerBundle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RewardPerDay.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOpenBundleProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryOpenBundleProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpenBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.BundleProposal != nil {
+		l = m.BundleProposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Search = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageProviders = append(m.StorageProviders, StorageProvider{})
+			if err := m.StorageProviders[len(m.StorageProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Runtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtimes = append(m.Runtimes, Runtime{})
+			if err := m.Runtimes[len(m.Runtimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRuntimeVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRuntimeVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRuntimeVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeVersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RuntimeVersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, StakerReadiness{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReadyStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, FundingStreamStatus{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStreamStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStreamStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStreamStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runway", wireType)
+			}
+			m.Runway = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFundingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFundingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFundingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFundingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFundingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFundingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFundings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFundings = append(m.ProtocolFundings, ProtocolFunding{})
+			if err := m.ProtocolFundings[len(m.ProtocolFundings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundersListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundersListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundersListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, &Funder{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Funder == nil {
+				m.Funder = &Funder{}
+			}
+			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakersListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StakerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakersListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, &StakerResponse{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Staker == nil {
+				m.Staker = &StakerResponse{}
+			}
+			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCommission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			}
+			m.CreationDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishDate", wireType)
+			}
+			m.FinishDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Commission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadProbability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadProbability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StakerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9122,7 +16600,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PendingCommissionChange == nil {
+				m.PendingCommissionChange = &PendingCommissionChange{}
+			}
+			if err := m.PendingCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9147,7 +16628,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9170,17 +16651,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9190,11 +16671,43 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9216,7 +16729,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9239,15 +16752,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9274,7 +16787,10 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VoteStatus == nil {
+				m.VoteStatus = &VoteStatusResponse{}
+			}
+			if err := m.VoteStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9299,7 +16815,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9322,17 +16838,17 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9342,31 +16858,29 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Valid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Search", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9394,11 +16908,13 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Search = string(dAtA[iNdEx:postIndex])
+			if err := m.Invalid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9426,13 +16942,15 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9442,12 +16960,26 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9469,7 +17001,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9492,51 +17024,17 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9546,27 +17044,23 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9589,7 +17083,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundersListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9612,17 +17106,17 @@ func (m *QueryFundersListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9632,11 +17126,25 @@ func (m *QueryFundersListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9658,7 +17166,7 @@ func (m *QueryFundersListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFundersListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9681,15 +17189,15 @@ func (m *QueryFundersListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFundersListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFundersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9716,11 +17224,32 @@ func (m *QueryFundersListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funders = append(m.Funders, &Funder{})
-			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9742,7 +17271,7 @@ func (m *QueryFundersListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9765,17 +17294,17 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9785,16 +17314,31 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9804,23 +17348,27 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funder = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9843,7 +17391,7 @@ func (m *QueryFunderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9866,15 +17414,15 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9901,13 +17449,32 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Funder == nil {
-				m.Funder = &Funder{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9929,7 +17496,7 @@ func (m *QueryFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArchivedProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9952,17 +17519,17 @@ func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArchivedProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArchivedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9972,31 +17539,27 @@ func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StakerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -10026,7 +17589,7 @@ func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10053,7 +17616,7 @@ func (m *QueryStakersListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10076,17 +17639,17 @@ func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10096,31 +17659,16 @@ func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stakers = append(m.Stakers, &StakerResponse{})
-			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10130,28 +17678,11 @@ func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10173,7 +17704,7 @@ func (m *QueryStakersListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10196,36 +17727,17 @@ func (m *QueryStakerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10235,23 +17747,24 @@ func (m *QueryStakerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10274,7 +17787,7 @@ func (m *QueryStakerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10297,15 +17810,15 @@ func (m *QueryStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10317,28 +17830,66 @@ func (m *QueryStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			}
+			m.FinalizedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Staker == nil {
-				m.Staker = &StakerResponse{}
-			}
-			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10360,7 +17911,7 @@ func (m *QueryStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10383,17 +17934,17 @@ func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCommission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10403,29 +17954,31 @@ func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewCommission = string(dAtA[iNdEx:postIndex])
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.CreationDate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10435,30 +17988,28 @@ func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationDate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishDate", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.FinishDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinishDate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10480,7 +18031,7 @@ func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10503,17 +18054,17 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalSinceIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalSinceIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10523,23 +18074,27 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -10561,80 +18116,10 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
-			}
-			m.TotalDelegation = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10644,61 +18129,66 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalSinceIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalSinceIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalSinceIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10708,29 +18198,31 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10740,48 +18232,83 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Points = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Points |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 11:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCanProposeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCanProposeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCanProposeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.UnbondingAmount = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10791,14 +18318,14 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingAmount |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadProbability", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10826,32 +18353,13 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UploadProbability = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StakerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			var msglen int
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10861,28 +18369,11 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PendingCommissionChange == nil {
-				m.PendingCommissionChange = &PendingCommissionChange{}
-			}
-			if err := m.PendingCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10904,7 +18395,7 @@ func (m *StakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10927,17 +18418,17 @@ func (m *QueryVoteStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanProposeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
 			}
-			m.PoolId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10947,11 +18438,44 @@ func (m *QueryVoteStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Possible = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10973,7 +18497,7 @@ func (m *QueryVoteStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10996,17 +18520,68 @@ func (m *QueryVoteStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanVoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11016,27 +18591,23 @@ func (m *QueryVoteStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VoteStatus == nil {
-				m.VoteStatus = &VoteStatusResponse{}
-			}
-			if err := m.VoteStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11059,7 +18630,7 @@ func (m *QueryVoteStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11082,17 +18653,17 @@ func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
 			}
-			m.Valid = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11102,16 +18673,17 @@ func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Valid |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Possible = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Invalid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11121,49 +18693,24 @@ func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Invalid |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
-			}
-			m.Abstain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Abstain |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11185,7 +18732,7 @@ func (m *VoteStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11208,15 +18755,34 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11244,7 +18810,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11267,7 +18833,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11290,17 +18856,17 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11310,25 +18876,107 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentStake = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MinimumStake = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StakerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11350,7 +18998,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAssetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11373,17 +19021,17 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAssetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11393,47 +19041,24 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11455,7 +19080,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11478,17 +19103,17 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11498,31 +19123,31 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolStaking", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11532,83 +19157,133 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolStakingUnbonding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolStakingUnbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProposalByHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.ProtocolDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolDelegationUnbonding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProtocolDelegationUnbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalByHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRewards", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11618,16 +19293,31 @@ func (m *QueryProposalByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Height = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFunding", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11637,11 +19327,26 @@ func (m *QueryProposalByHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11663,7 +19368,7 @@ func (m *QueryProposalByHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalByHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11686,15 +19391,15 @@ func (m *QueryProposalByHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalByHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountStakingUnbondingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountStakingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11711,19 +19416,54 @@ func (m *QueryProposalByHeightResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11746,7 +19486,7 @@ func (m *QueryProposalByHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11769,15 +19509,15 @@ func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountStakingUnbondingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountStakingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11804,18 +19544,16 @@ func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, StakingUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11825,30 +19563,28 @@ func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.FinalizedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizedAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11870,7 +19606,7 @@ func (m *QueryProposalSinceFinalizedAtRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
+func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11893,17 +19629,17 @@ func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakingUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalSinceFinalizedAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11913,29 +19649,48 @@ func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			m.CreationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11962,10 +19717,10 @@ func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11990,7 +19745,7 @@ func (m *QueryProposalSinceFinalizedAtResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12013,10 +19768,10 @@ func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalSinceIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalSinceIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12056,10 +19811,10 @@ func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12069,30 +19824,24 @@ func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12114,7 +19863,7 @@ func (m *QueryProposalSinceIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalSinceIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDelegationUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12137,15 +19886,15 @@ func (m *QueryProposalSinceIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalSinceIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalSinceIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12172,8 +19921,8 @@ func (m *QueryProposalSinceIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, DelegationUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12234,7 +19983,7 @@ func (m *QueryProposalSinceIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCanProposeRequest) Unmarshal(dAtA []byte) error {
+func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12257,34 +20006,15 @@ func (m *QueryCanProposeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanProposeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanProposeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12312,13 +20042,15 @@ func (m *QueryCanProposeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			m.FromHeight = 0
+			m.CreationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12328,66 +20060,16 @@ func (m *QueryCanProposeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
+				m.CreationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanProposeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12397,17 +20079,33 @@ func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Possible = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Staker == nil {
+				m.Staker = &StakerResponse{}
+			}
+			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12417,23 +20115,27 @@ func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12456,7 +20158,7 @@ func (m *QueryCanProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFundedListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12479,36 +20181,17 @@ func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFundedListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFundedListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12518,27 +20201,31 @@ func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12566,7 +20253,7 @@ func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12589,7 +20276,7 @@ func (m *QueryCanVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12612,17 +20299,17 @@ func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCanVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFundedListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCanVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFundedListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Possible", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12632,17 +20319,31 @@ func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Possible = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funded = append(m.Funded, Funded{})
+			if err := m.Funded[len(m.Funded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12652,23 +20353,27 @@ func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12691,7 +20396,7 @@ func (m *QueryCanVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *Funded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12714,17 +20419,17 @@ func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Funded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Funded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12734,14 +20439,27 @@ func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12769,7 +20487,45 @@ func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12792,7 +20548,7 @@ func (m *QueryStakeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountStakedListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12805,59 +20561,27 @@ func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakeInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountStakedListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountStakedListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12867,27 +20591,31 @@ func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentStake = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12915,27 +20643,8 @@ func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinimumStake = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= StakerStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12957,7 +20666,7 @@ func (m *QueryStakeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12980,17 +20689,17 @@ func (m *QueryAccountAssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountStakedListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountStakedListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13000,23 +20709,61 @@ func (m *QueryAccountAssetsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Staked = append(m.Staked, Staked{})
+			if err := m.Staked[len(m.Staked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13039,7 +20786,7 @@ func (m *QueryAccountAssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *Staked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13062,17 +20809,17 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Staked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Staked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			m.Balance = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13082,54 +20829,29 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolStaking", wireType)
-			}
-			m.ProtocolStaking = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProtocolStaking |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolStakingUnbonding", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ProtocolStakingUnbonding = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProtocolStakingUnbonding |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.ProtocolDelegation = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13139,16 +20861,16 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolDelegation |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolDelegationUnbonding", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.ProtocolDelegationUnbonding = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13158,35 +20880,29 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolDelegationUnbonding |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRewards", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ProtocolRewards = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProtocolRewards |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFunding", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.ProtocolFunding = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13196,64 +20912,29 @@ func (m *QueryAccountAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProtocolFunding |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAccountStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountStakingUnbondingsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountStakingUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13280,16 +20961,16 @@ func (m *QueryAccountStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13317,7 +20998,41 @@ func (m *QueryAccountStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadProbability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadProbability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13340,7 +21055,7 @@ func (m *QueryAccountStakingUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13363,15 +21078,15 @@ func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountStakingUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDelegationListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountStakingUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDelegationListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13398,16 +21113,18 @@ func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, StakingUnbonding{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13417,27 +21134,23 @@ func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13460,7 +21173,7 @@ func (m *QueryAccountStakingUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13483,17 +21196,17 @@ func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakingUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountDelegationListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountDelegationListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			m.Amount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13503,33 +21216,29 @@ func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.CreationTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegatorResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13556,10 +21265,10 @@ func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13584,7 +21293,7 @@ func (m *StakingUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13607,17 +21316,17 @@ func (m *QueryAccountDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13627,33 +21336,29 @@ func (m *QueryAccountDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13663,79 +21368,33 @@ func (m *QueryAccountDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAccountDelegationUnbondingsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pool == nil {
+				m.Pool = &Pool{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDelegationUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13745,31 +21404,31 @@ func (m *QueryAccountDelegationUnbondingsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.Unbondings = append(m.Unbondings, DelegationUnbonding{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			if err := m.CurrentReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13779,83 +21438,31 @@ func (m *QueryAccountDelegationUnbondingsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationUnbonding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13865,33 +21472,27 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.CreationTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13918,16 +21519,16 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Staker == nil {
-				m.Staker = &StakerResponse{}
+			if m.PendingCommissionChange == nil {
+				m.PendingCommissionChange = &PendingCommissionChange{}
 			}
-			if err := m.Staker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPoolData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13954,10 +21555,10 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.DelegationPoolData == nil {
+				m.DelegationPoolData = &DelegationPoolData{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegationPoolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13982,7 +21583,7 @@ func (m *DelegationUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountFundedListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRedelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14005,49 +21606,13 @@ func (m *QueryAccountFundedListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountFundedListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRedelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountFundedListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRedelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -14100,7 +21665,7 @@ func (m *QueryAccountFundedListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountRedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14123,15 +21688,91 @@ func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountFundedListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountRedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountFundedListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountRedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RedelegationCooldownEntries = append(m.RedelegationCooldownEntries, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RedelegationCooldownEntries) == 0 {
+					m.RedelegationCooldownEntries = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RedelegationCooldownEntries = append(m.RedelegationCooldownEntries, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationCooldownEntries", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14158,16 +21799,16 @@ func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funded = append(m.Funded, Funded{})
-			if err := m.Funded[len(m.Funded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Cooldowns = append(m.Cooldowns, RedelegationCooldown{})
+			if err := m.Cooldowns[len(m.Cooldowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableSlots", wireType)
 			}
-			var msglen int
+			m.AvailableSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14177,28 +21818,11 @@ func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AvailableSlots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -14220,7 +21844,7 @@ func (m *QueryAccountFundedListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Funded) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14243,15 +21867,15 @@ func (m *Funded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Funded: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14279,62 +21903,7 @@ func (m *Funded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14357,7 +21926,7 @@ func (m *Funded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountStakedListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14380,51 +21949,15 @@ func (m *QueryAccountStakedListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountStakedListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountStakedListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14452,7 +21985,7 @@ func (m *QueryAccountStakedListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14475,7 +22008,7 @@ func (m *QueryAccountStakedListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14498,17 +22031,17 @@ func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountStakedListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountStakedListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14518,25 +22051,23 @@ func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staked = append(m.Staked, Staked{})
-			if err := m.Staked[len(m.Staked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -14568,7 +22099,7 @@ func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14595,7 +22126,7 @@ func (m *QueryAccountStakedListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Staked) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14618,117 +22149,15 @@ func (m *Staked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staked: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14755,18 +22184,16 @@ func (m *Staked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, PendingReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.UnbondingAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14776,16 +22203,31 @@ func (m *Staked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadProbability", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14795,23 +22237,27 @@ func (m *Staked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UploadProbability = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14834,7 +22280,7 @@ func (m *Staked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14857,17 +22303,36 @@ func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDelegationListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDelegationListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14877,31 +22342,27 @@ func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14929,7 +22390,9 @@ func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14952,7 +22415,7 @@ func (m *QueryAccountDelegationListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14975,17 +22438,36 @@ func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDelegationListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDelegationListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -14995,31 +22477,29 @@ func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegatorResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15029,27 +22509,23 @@ func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15072,7 +22548,7 @@ func (m *QueryAccountDelegationListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15095,47 +22571,15 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15162,37 +22606,68 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.Delegator == nil {
+				m.Delegator = &StakerDelegatorResponse{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CurrentReward = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.DelegationAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15202,14 +22677,27 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15237,13 +22725,15 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if err := m.CurrentReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15253,33 +22743,31 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingCommissionChange == nil {
-				m.PendingCommissionChange = &PendingCommissionChange{}
-			}
-			if err := m.PendingCommissionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPoolData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15289,27 +22777,23 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationPoolData == nil {
-				m.DelegationPoolData = &DelegationPoolData{}
-			}
-			if err := m.DelegationPoolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15332,7 +22816,7 @@ func (m *DelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRedelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15355,15 +22839,70 @@ func (m *QueryAccountRedelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRedelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRedelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15391,7 +22930,7 @@ func (m *QueryAccountRedelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15414,7 +22953,7 @@ func (m *QueryAccountRedelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15437,88 +22976,154 @@ func (m *QueryAccountRedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.RedelegationCooldownEntries = append(m.RedelegationCooldownEntries, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, StakerDelegatorResponse{})
+			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPoolData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationPoolData == nil {
+				m.DelegationPoolData = &DelegationPoolData{}
+			}
+			if err := m.DelegationPoolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RedelegationCooldownEntries) == 0 {
-					m.RedelegationCooldownEntries = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RedelegationCooldownEntries = append(m.RedelegationCooldownEntries, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationCooldownEntries", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15540,7 +23145,7 @@ func (m *QueryAccountRedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15563,17 +23168,17 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15583,16 +23188,33 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15602,24 +23224,11 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
@@ -15673,7 +23282,7 @@ func (m *QueryDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15696,16 +23305,48 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -15731,10 +23372,80 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delegator == nil {
-				m.Delegator = &StakerDelegatorResponse{}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
 			}
-			if err := m.Delegator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakers = append(m.Stakers, DelegationForStakerResponse{})
+			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15759,7 +23470,7 @@ func (m *QueryDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15782,15 +23493,15 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationForStakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationForStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15818,13 +23529,13 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
 			}
-			m.CurrentReward = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15834,16 +23545,31 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			m.DelegationAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15853,14 +23579,29 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15888,8 +23629,29 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+			}
+			m.DelegatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -15911,7 +23673,7 @@ func (m *StakerDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15934,17 +23696,17 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -15954,52 +23716,66 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16009,23 +23785,25 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Capacities = append(m.Capacities, DelegationCapacity{})
+			if err := m.Capacities[len(m.Capacities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16048,7 +23826,7 @@ func (m *QueryDelegatorsByPoolAndStakerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegationCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16071,17 +23849,17 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorsByPoolAndStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16091,31 +23869,29 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegators = append(m.Delegators, StakerDelegatorResponse{})
-			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16125,33 +23901,31 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPoolData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16161,33 +23935,17 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DelegationPoolData == nil {
-				m.DelegationPoolData = &DelegationPoolData{}
-			}
-			if err := m.DelegationPoolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Unlimited = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16197,25 +23955,23 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16240,7 +23996,7 @@ func (m *QueryDelegatorsByPoolAndStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16263,17 +24019,17 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16283,33 +24039,16 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16319,14 +24058,27 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16354,7 +24106,7 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16377,7 +24129,7 @@ func (m *QueryStakersByPoolAndDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16400,15 +24152,15 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakersByPoolAndDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadProbability", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16436,13 +24188,32 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.UploadProbability = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageByteSize", wireType)
+			}
+			m.AverageByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleReward", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16452,33 +24223,31 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
-			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BundleReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBundle", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16488,31 +24257,31 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakers = append(m.Stakers, DelegationForStakerResponse{})
-			if err := m.Stakers[len(m.Stakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPerBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerDay", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16522,25 +24291,23 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16565,7 +24332,7 @@ func (m *QueryStakersByPoolAndDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenBundleProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16588,17 +24355,17 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationForStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOpenBundleProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationForStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOpenBundleProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16608,48 +24375,66 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentReward", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpenBundleProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.CurrentReward = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentReward |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenBundleProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenBundleProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			m.DelegationAmount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16659,16 +24444,81 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegationAmount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, OpenBundleProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenBundleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenBundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.TotalDelegationAmount = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16678,16 +24528,16 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalDelegationAmount |= uint64(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
 			}
-			m.DelegatorCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -16697,11 +24547,28 @@ func (m *DelegationForStakerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegatorCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BundleProposal == nil {
+				m.BundleProposal = &BundleProposal{}
+			}
+			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
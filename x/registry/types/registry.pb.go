@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -41,6 +42,10 @@ const (
 	POOL_STATUS_NOT_ENOUGH_STAKE PoolStatus = 5
 	// POOL_STATUS_UPGRADING ...
 	POOL_STATUS_UPGRADING PoolStatus = 6
+	// POOL_STATUS_COMPLETED ...
+	POOL_STATUS_COMPLETED PoolStatus = 7
+	// POOL_STATUS_RETIRED ...
+	POOL_STATUS_RETIRED PoolStatus = 8
 )
 
 var PoolStatus_name = map[int32]string{
@@ -51,6 +56,8 @@ var PoolStatus_name = map[int32]string{
 	4: "POOL_STATUS_NOT_ENOUGH_VALIDATORS",
 	5: "POOL_STATUS_NOT_ENOUGH_STAKE",
 	6: "POOL_STATUS_UPGRADING",
+	7: "POOL_STATUS_COMPLETED",
+	8: "POOL_STATUS_RETIRED",
 }
 
 var PoolStatus_value = map[string]int32{
@@ -61,6 +68,8 @@ var PoolStatus_value = map[string]int32{
 	"POOL_STATUS_NOT_ENOUGH_VALIDATORS": 4,
 	"POOL_STATUS_NOT_ENOUGH_STAKE":      5,
 	"POOL_STATUS_UPGRADING":             6,
+	"POOL_STATUS_COMPLETED":             7,
+	"POOL_STATUS_RETIRED":               8,
 }
 
 func (x PoolStatus) String() string {
@@ -131,6 +140,17 @@ type BundleProposal struct {
 	ToValue string `protobuf:"bytes,12,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
 	// bundle_hash ...
 	BundleHash string `protobuf:"bytes,13,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	// storage_provider_id is the storage provider the bundle was stored on.
+	StorageProviderId uint64 `protobuf:"varint,14,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// compression is the compression algorithm of the bundle, e.g. none or gzip.
+	Compression string `protobuf:"bytes,15,opt,name=compression,proto3" json:"compression,omitempty"`
+	// data_item_count is the amount of data items in the bundle.
+	DataItemCount uint64 `protobuf:"varint,16,opt,name=data_item_count,json=dataItemCount,proto3" json:"data_item_count,omitempty"`
+	// uncompressed_byte_size is the size of the bundle in bytes before compression.
+	UncompressedByteSize uint64 `protobuf:"varint,17,opt,name=uncompressed_byte_size,json=uncompressedByteSize,proto3" json:"uncompressed_byte_size,omitempty"`
+	// uploader_assigned_at is the time the uploader role was handed to the next uploader by a skip.
+	// The upload interval and the upload timeout of the next uploader start from it instead of created_at.
+	UploaderAssignedAt uint64 `protobuf:"varint,18,opt,name=uploader_assigned_at,json=uploaderAssignedAt,proto3" json:"uploader_assigned_at,omitempty"`
 }
 
 func (m *BundleProposal) Reset()         { *m = BundleProposal{} }
@@ -258,6 +278,41 @@ func (m *BundleProposal) GetBundleHash() string {
 	return ""
 }
 
+func (m *BundleProposal) GetStorageProviderId() uint64 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *BundleProposal) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *BundleProposal) GetDataItemCount() uint64 {
+	if m != nil {
+		return m.DataItemCount
+	}
+	return 0
+}
+
+func (m *BundleProposal) GetUncompressedByteSize() uint64 {
+	if m != nil {
+		return m.UncompressedByteSize
+	}
+	return 0
+}
+
+func (m *BundleProposal) GetUploaderAssignedAt() uint64 {
+	if m != nil {
+		return m.UploaderAssignedAt
+	}
+	return 0
+}
+
 // Protocol ...
 type Protocol struct {
 	// version ...
@@ -268,6 +323,19 @@ type Protocol struct {
 	LastUpgrade uint64 `protobuf:"varint,3,opt,name=last_upgrade,json=lastUpgrade,proto3" json:"last_upgrade,omitempty"`
 	// test
 	Test string `protobuf:"bytes,4,opt,name=test,proto3" json:"test,omitempty"`
+	// version_enforced is set once an upgrade window has passed, from then on stakers need to attest the version
+	VersionEnforced bool `protobuf:"varint,5,opt,name=version_enforced,json=versionEnforced,proto3" json:"version_enforced,omitempty"`
+	// previous_version is the version before the last upgrade, used for rollbacks.
+	PreviousVersion string `protobuf:"bytes,6,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	// previous_binaries are the binaries before the last upgrade, used for rollbacks.
+	PreviousBinaries string `protobuf:"bytes,7,opt,name=previous_binaries,json=previousBinaries,proto3" json:"previous_binaries,omitempty"`
+	// rollback_at is the unix time the last upgrade is rolled back if the pool has not finalized a bundle until then.
+	// Zero means the upgrade is not monitored.
+	RollbackAt uint64 `protobuf:"varint,8,opt,name=rollback_at,json=rollbackAt,proto3" json:"rollback_at,omitempty"`
+	// rollback_total_bundles is the number of finalized bundles at the time of the last upgrade.
+	RollbackTotalBundles uint64 `protobuf:"varint,9,opt,name=rollback_total_bundles,json=rollbackTotalBundles,proto3" json:"rollback_total_bundles,omitempty"`
+	// rollback_window is the time in seconds the pool has to finalize a bundle once it is able to produce bundles again.
+	RollbackWindow uint64 `protobuf:"varint,10,opt,name=rollback_window,json=rollbackWindow,proto3" json:"rollback_window,omitempty"`
 }
 
 func (m *Protocol) Reset()         { *m = Protocol{} }
@@ -331,6 +399,48 @@ func (m *Protocol) GetTest() string {
 	return ""
 }
 
+func (m *Protocol) GetVersionEnforced() bool {
+	if m != nil {
+		return m.VersionEnforced
+	}
+	return false
+}
+
+func (m *Protocol) GetPreviousVersion() string {
+	if m != nil {
+		return m.PreviousVersion
+	}
+	return ""
+}
+
+func (m *Protocol) GetPreviousBinaries() string {
+	if m != nil {
+		return m.PreviousBinaries
+	}
+	return ""
+}
+
+func (m *Protocol) GetRollbackAt() uint64 {
+	if m != nil {
+		return m.RollbackAt
+	}
+	return 0
+}
+
+func (m *Protocol) GetRollbackTotalBundles() uint64 {
+	if m != nil {
+		return m.RollbackTotalBundles
+	}
+	return 0
+}
+
+func (m *Protocol) GetRollbackWindow() uint64 {
+	if m != nil {
+		return m.RollbackWindow
+	}
+	return 0
+}
+
 // Upgrade ...
 type UpgradePlan struct {
 	// version ...
@@ -341,6 +451,10 @@ type UpgradePlan struct {
 	ScheduledAt uint64 `protobuf:"varint,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
 	// duration ...
 	Duration uint64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// rollback_window is the time in seconds after the upgrade window in which the pool has to finalize a bundle.
+	RollbackWindow uint64 `protobuf:"varint,5,opt,name=rollback_window,json=rollbackWindow,proto3" json:"rollback_window,omitempty"`
+	// canary is true if the pool upgrades before the other pools of the runtime.
+	Canary bool `protobuf:"varint,6,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
 func (m *UpgradePlan) Reset()         { *m = UpgradePlan{} }
@@ -404,6 +518,20 @@ func (m *UpgradePlan) GetDuration() uint64 {
 	return 0
 }
 
+func (m *UpgradePlan) GetRollbackWindow() uint64 {
+	if m != nil {
+		return m.RollbackWindow
+	}
+	return 0
+}
+
+func (m *UpgradePlan) GetCanary() bool {
+	if m != nil {
+		return m.Canary
+	}
+	return false
+}
+
 // DelegationEntries ...
 type DelegationEntries struct {
 	// id ...
@@ -484,15 +612,18 @@ type DelegationPoolData struct {
 	// staker ...
 	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
 	// current_rewards ...
-	CurrentRewards uint64 `protobuf:"varint,3,opt,name=current_rewards,json=currentRewards,proto3" json:"current_rewards,omitempty"`
+	CurrentRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=current_rewards,json=currentRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_rewards"`
 	// total_delegation ...
-	TotalDelegation uint64 `protobuf:"varint,4,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
+	TotalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_delegation,json=totalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_delegation"`
 	// latest_index_k ...
 	LatestIndexK uint64 `protobuf:"varint,5,opt,name=latest_index_k,json=latestIndexK,proto3" json:"latest_index_k,omitempty"`
 	// delegator_count ...
 	DelegatorCount uint64 `protobuf:"varint,6,opt,name=delegator_count,json=delegatorCount,proto3" json:"delegator_count,omitempty"`
 	// latest_index_was_undelegation ...
 	LatestIndexWasUndelegation bool `protobuf:"varint,7,opt,name=latest_index_was_undelegation,json=latestIndexWasUndelegation,proto3" json:"latest_index_was_undelegation,omitempty"`
+	// slash_remainder is the part of the total delegation which is not yet assigned to the delegators
+	// because slashes are applied to the individual delegations only on their next interaction
+	SlashRemainder string `protobuf:"bytes,8,opt,name=slash_remainder,json=slashRemainder,proto3" json:"slash_remainder,omitempty"`
 }
 
 func (m *DelegationPoolData) Reset()         { *m = DelegationPoolData{} }
@@ -542,20 +673,6 @@ func (m *DelegationPoolData) GetStaker() string {
 	return ""
 }
 
-func (m *DelegationPoolData) GetCurrentRewards() uint64 {
-	if m != nil {
-		return m.CurrentRewards
-	}
-	return 0
-}
-
-func (m *DelegationPoolData) GetTotalDelegation() uint64 {
-	if m != nil {
-		return m.TotalDelegation
-	}
-	return 0
-}
-
 func (m *DelegationPoolData) GetLatestIndexK() uint64 {
 	if m != nil {
 		return m.LatestIndexK
@@ -577,6 +694,13 @@ func (m *DelegationPoolData) GetLatestIndexWasUndelegation() bool {
 	return false
 }
 
+func (m *DelegationPoolData) GetSlashRemainder() string {
+	if m != nil {
+		return m.SlashRemainder
+	}
+	return ""
+}
+
 // Delegator ...
 type Delegator struct {
 	// id ...
@@ -584,7 +708,7 @@ type Delegator struct {
 	// k_index ...
 	KIndex uint64 `protobuf:"varint,2,opt,name=k_index,json=kIndex,proto3" json:"k_index,omitempty"`
 	// delegation_amount ...
-	DelegationAmount uint64 `protobuf:"varint,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
+	DelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amount"`
 	// staker ...
 	Staker string `protobuf:"bytes,4,opt,name=staker,proto3" json:"staker,omitempty"`
 	// delegator ...
@@ -638,13 +762,6 @@ func (m *Delegator) GetKIndex() uint64 {
 	return 0
 }
 
-func (m *Delegator) GetDelegationAmount() uint64 {
-	if m != nil {
-		return m.DelegationAmount
-	}
-	return 0
-}
-
 func (m *Delegator) GetStaker() string {
 	if m != nil {
 		return m.Staker
@@ -666,7 +783,7 @@ type Funder struct {
 	// fund_id ...
 	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *Funder) Reset()         { *m = Funder{} }
@@ -716,13 +833,154 @@ func (m *Funder) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *Funder) GetAmount() uint64 {
+// FundingStream is a budget of a sponsor which pays for the bundles of a pool with spending limits.
+type FundingStream struct {
+	// id is the unique ID of the funding stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// account is the address of the sponsor which receives unspent funds.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// amount is the remaining budget of the funding stream.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// amount_per_bundle is the maximum amount spent on a single bundle, zero means no limit.
+	AmountPerBundle github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount_per_bundle,json=amountPerBundle,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_per_bundle"`
+	// amount_per_day is the maximum amount spent within a day, zero means no limit.
+	AmountPerDay github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount_per_day,json=amountPerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_per_day"`
+	// end_time is the unix time the unspent budget is returned, zero means no end.
+	EndTime uint64 `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// day_start is the unix time the current spending day started.
+	DayStart uint64 `protobuf:"varint,8,opt,name=day_start,json=dayStart,proto3" json:"day_start,omitempty"`
+	// spent_today is the amount spent since day_start.
+	SpentToday github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=spent_today,json=spentToday,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spent_today"`
+}
+
+func (m *FundingStream) Reset()         { *m = FundingStream{} }
+func (m *FundingStream) String() string { return proto.CompactTextString(m) }
+func (*FundingStream) ProtoMessage()    {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{7}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FundingStream) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *FundingStream) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *FundingStream) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *FundingStream) GetDayStart() uint64 {
+	if m != nil {
+		return m.DayStart
+	}
+	return 0
+}
+
+// ProtocolFunding is a continuous allocation governance assigned to a public-good pool.
+// The allocation is credited to the pool as the protocol funder.
+type ProtocolFunding struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// inflation_share is the share of the tokens minted in every block the pool receives.
+	InflationShare string `protobuf:"bytes,2,opt,name=inflation_share,json=inflationShare,proto3" json:"inflation_share,omitempty"`
+	// community_pool_amount is the amount drawn from the community pool in every block.
+	CommunityPoolAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=community_pool_amount,json=communityPoolAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"community_pool_amount"`
+}
+
+func (m *ProtocolFunding) Reset()         { *m = ProtocolFunding{} }
+func (m *ProtocolFunding) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFunding) ProtoMessage()    {}
+func (*ProtocolFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{8}
+}
+func (m *ProtocolFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFunding.Merge(m, src)
+}
+func (m *ProtocolFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFunding proto.InternalMessageInfo
+
+func (m *ProtocolFunding) GetPoolId() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.PoolId
 	}
 	return 0
 }
 
+func (m *ProtocolFunding) GetInflationShare() string {
+	if m != nil {
+		return m.InflationShare
+	}
+	return ""
+}
+
 // Pool ...
 type Pool struct {
 	// id ...
@@ -746,13 +1004,13 @@ type Pool struct {
 	// total_bundles ...
 	TotalBundles uint64 `protobuf:"varint,10,opt,name=total_bundles,json=totalBundles,proto3" json:"total_bundles,omitempty"`
 	// total_bundle_rewards ...
-	TotalBundleRewards uint64 `protobuf:"varint,11,opt,name=total_bundle_rewards,json=totalBundleRewards,proto3" json:"total_bundle_rewards,omitempty"`
+	TotalBundleRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_bundle_rewards,json=totalBundleRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_bundle_rewards"`
 	// start_height ...
 	StartHeight uint64 `protobuf:"varint,12,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"` // Deprecated: Do not use.
 	// upload_interval ...
 	UploadInterval uint64 `protobuf:"varint,13,opt,name=upload_interval,json=uploadInterval,proto3" json:"upload_interval,omitempty"`
 	// operating_cost ...
-	OperatingCost uint64 `protobuf:"varint,14,opt,name=operating_cost,json=operatingCost,proto3" json:"operating_cost,omitempty"`
+	OperatingCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=operating_cost,json=operatingCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"operating_cost"`
 	// paused ...
 	Paused bool `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
 	// funders ...
@@ -760,15 +1018,15 @@ type Pool struct {
 	// lowest_funder ...
 	LowestFunder string `protobuf:"bytes,17,opt,name=lowest_funder,json=lowestFunder,proto3" json:"lowest_funder,omitempty"`
 	// total_funds ...
-	TotalFunds uint64 `protobuf:"varint,18,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	TotalFunds github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=total_funds,json=totalFunds,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_funds"`
 	// stakers ...
 	Stakers []string `protobuf:"bytes,19,rep,name=stakers,proto3" json:"stakers,omitempty"`
 	// lowest_staker ...
 	LowestStaker string `protobuf:"bytes,20,opt,name=lowest_staker,json=lowestStaker,proto3" json:"lowest_staker,omitempty"`
 	// total_stake ...
-	TotalStake uint64 `protobuf:"varint,21,opt,name=total_stake,json=totalStake,proto3" json:"total_stake,omitempty"`
+	TotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=total_stake,json=totalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stake"`
 	// total_delegation ...
-	TotalDelegation uint64 `protobuf:"varint,22,opt,name=total_delegation,json=totalDelegation,proto3" json:"total_delegation,omitempty"`
+	TotalDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=total_delegation,json=totalDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_delegation"`
 	// bundle_proposal ...
 	BundleProposal *BundleProposal `protobuf:"bytes,23,opt,name=bundle_proposal,json=bundleProposal,proto3" json:"bundle_proposal,omitempty"`
 	// max_bundle_size ...
@@ -786,18 +1044,43 @@ type Pool struct {
 	// inactive_stakers ...
 	InactiveStakers []string `protobuf:"bytes,30,rep,name=inactive_stakers,json=inactiveStakers,proto3" json:"inactive_stakers,omitempty"`
 	// total_inactive_stake ...
-	TotalInactiveStake uint64 `protobuf:"varint,31,opt,name=total_inactive_stake,json=totalInactiveStake,proto3" json:"total_inactive_stake,omitempty"`
+	TotalInactiveStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,31,opt,name=total_inactive_stake,json=totalInactiveStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_inactive_stake"`
 	// min_stake ...
-	MinStake uint64 `protobuf:"varint,32,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
+	MinStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,32,opt,name=min_stake,json=minStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_stake"`
 	// status ...
 	Status PoolStatus `protobuf:"varint,33,opt,name=status,proto3,enum=kyve.registry.v1beta1.PoolStatus" json:"status,omitempty"`
+	// pipeline_depth is the maximum number of bundle proposals which can be open at the same time.
+	// Zero and one both allow only a single open bundle proposal.
+	PipelineDepth uint64 `protobuf:"varint,34,opt,name=pipeline_depth,json=pipelineDepth,proto3" json:"pipeline_depth,omitempty"`
+	// pipelined_proposals are the open bundle proposals which chain after the bundle_proposal, in order.
+	PipelinedProposals []*BundleProposal `protobuf:"bytes,35,rep,name=pipelined_proposals,json=pipelinedProposals,proto3" json:"pipelined_proposals,omitempty"`
+	// storage_provider_id is the storage provider the pool stores its bundles on.
+	// Zero is the default storage provider (Arweave) which is charged with the storage_cost param.
+	StorageProviderId uint64 `protobuf:"varint,36,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// allowed_compressions are the compression algorithms bundles of the pool can use.
+	// Uncompressed bundles are always allowed.
+	AllowedCompressions []string `protobuf:"bytes,37,rep,name=allowed_compressions,json=allowedCompressions,proto3" json:"allowed_compressions,omitempty"`
+	// charge_uncompressed_size charges the storage cost of bundles by their uncompressed instead of their compressed size.
+	ChargeUncompressedSize bool `protobuf:"varint,38,opt,name=charge_uncompressed_size,json=chargeUncompressedSize,proto3" json:"charge_uncompressed_size,omitempty"`
+	// end_key is the key at which the pool is completed. An empty key means the pool has no end key.
+	EndKey string `protobuf:"bytes,39,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// end_height is the height at which the pool is completed. Zero means the pool has no end height.
+	EndHeight uint64 `protobuf:"varint,40,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// average_bundle_cost is the moving average of the cost of the recently finalized bundles.
+	AverageBundleCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,41,opt,name=average_bundle_cost,json=averageBundleCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"average_bundle_cost"`
+	// burn_rate is the projected amount of funds spent per day.
+	BurnRate github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,42,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burn_rate"`
+	// runway is the projected number of seconds until the pool runs out of funds.
+	Runway uint64 `protobuf:"varint,43,opt,name=runway,proto3" json:"runway,omitempty"`
+	// runway_alert_level is the number of runway alert thresholds the runway is currently below.
+	RunwayAlertLevel uint64 `protobuf:"varint,44,opt,name=runway_alert_level,json=runwayAlertLevel,proto3" json:"runway_alert_level,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{7}
+	return fileDescriptor_db13ea1584a90e6e, []int{9}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,13 +1180,6 @@ func (m *Pool) GetTotalBundles() uint64 {
 	return 0
 }
 
-func (m *Pool) GetTotalBundleRewards() uint64 {
-	if m != nil {
-		return m.TotalBundleRewards
-	}
-	return 0
-}
-
 // Deprecated: Do not use.
 func (m *Pool) GetStartHeight() uint64 {
 	if m != nil {
@@ -919,13 +1195,6 @@ func (m *Pool) GetUploadInterval() uint64 {
 	return 0
 }
 
-func (m *Pool) GetOperatingCost() uint64 {
-	if m != nil {
-		return m.OperatingCost
-	}
-	return 0
-}
-
 func (m *Pool) GetPaused() bool {
 	if m != nil {
 		return m.Paused
@@ -947,13 +1216,6 @@ func (m *Pool) GetLowestFunder() string {
 	return ""
 }
 
-func (m *Pool) GetTotalFunds() uint64 {
-	if m != nil {
-		return m.TotalFunds
-	}
-	return 0
-}
-
 func (m *Pool) GetStakers() []string {
 	if m != nil {
 		return m.Stakers
@@ -968,20 +1230,6 @@ func (m *Pool) GetLowestStaker() string {
 	return ""
 }
 
-func (m *Pool) GetTotalStake() uint64 {
-	if m != nil {
-		return m.TotalStake
-	}
-	return 0
-}
-
-func (m *Pool) GetTotalDelegation() uint64 {
-	if m != nil {
-		return m.TotalDelegation
-	}
-	return 0
-}
-
 func (m *Pool) GetBundleProposal() *BundleProposal {
 	if m != nil {
 		return m.BundleProposal
@@ -1038,25 +1286,74 @@ func (m *Pool) GetInactiveStakers() []string {
 	return nil
 }
 
-func (m *Pool) GetTotalInactiveStake() uint64 {
+func (m *Pool) GetStatus() PoolStatus {
+	if m != nil {
+		return m.Status
+	}
+	return POOL_STATUS_UNSPECIFIED
+}
+
+func (m *Pool) GetPipelineDepth() uint64 {
 	if m != nil {
-		return m.TotalInactiveStake
+		return m.PipelineDepth
 	}
 	return 0
 }
 
-func (m *Pool) GetMinStake() uint64 {
+func (m *Pool) GetPipelinedProposals() []*BundleProposal {
 	if m != nil {
-		return m.MinStake
+		return m.PipelinedProposals
+	}
+	return nil
+}
+
+func (m *Pool) GetStorageProviderId() uint64 {
+	if m != nil {
+		return m.StorageProviderId
 	}
 	return 0
 }
 
-func (m *Pool) GetStatus() PoolStatus {
+func (m *Pool) GetAllowedCompressions() []string {
 	if m != nil {
-		return m.Status
+		return m.AllowedCompressions
 	}
-	return POOL_STATUS_UNSPECIFIED
+	return nil
+}
+
+func (m *Pool) GetChargeUncompressedSize() bool {
+	if m != nil {
+		return m.ChargeUncompressedSize
+	}
+	return false
+}
+
+func (m *Pool) GetEndKey() string {
+	if m != nil {
+		return m.EndKey
+	}
+	return ""
+}
+
+func (m *Pool) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Pool) GetRunway() uint64 {
+	if m != nil {
+		return m.Runway
+	}
+	return 0
+}
+
+func (m *Pool) GetRunwayAlertLevel() uint64 {
+	if m != nil {
+		return m.RunwayAlertLevel
+	}
+	return 0
 }
 
 // Proposal ...
@@ -1081,13 +1378,23 @@ type Proposal struct {
 	Value string `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
 	// bundle_hash ...
 	BundleHash string `protobuf:"bytes,10,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	// byte_size is the size of the bundle in bytes.
+	ByteSize uint64 `protobuf:"varint,11,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
+	// storage_provider_id is the storage provider the bundle was stored on.
+	StorageProviderId uint64 `protobuf:"varint,12,opt,name=storage_provider_id,json=storageProviderId,proto3" json:"storage_provider_id,omitempty"`
+	// compression is the compression algorithm of the bundle, e.g. none or gzip.
+	Compression string `protobuf:"bytes,13,opt,name=compression,proto3" json:"compression,omitempty"`
+	// data_item_count is the amount of data items in the bundle.
+	DataItemCount uint64 `protobuf:"varint,14,opt,name=data_item_count,json=dataItemCount,proto3" json:"data_item_count,omitempty"`
+	// uncompressed_byte_size is the size of the bundle in bytes before compression.
+	UncompressedByteSize uint64 `protobuf:"varint,15,opt,name=uncompressed_byte_size,json=uncompressedByteSize,proto3" json:"uncompressed_byte_size,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{8}
+	return fileDescriptor_db13ea1584a90e6e, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,35 +1493,335 @@ func (m *Proposal) GetBundleHash() string {
 	return ""
 }
 
-// Staker ...
-type Staker struct {
-	// staker ...
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// pool_id ...
-	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
-	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// unbonding_amount ...
-	UnbondingAmount uint64 `protobuf:"varint,4,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount,omitempty"`
-	// commission ...
-	Commission string `protobuf:"bytes,5,opt,name=commission,proto3" json:"commission,omitempty"`
-	// moniker ...
-	Moniker string `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// website ...
-	Website string `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
-	// logo
-	Logo string `protobuf:"bytes,8,opt,name=logo,proto3" json:"logo,omitempty"`
-	// points
-	Points uint64 `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
-	// status
-	Status StakerStatus `protobuf:"varint,10,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+func (m *Proposal) GetByteSize() uint64 {
+	if m != nil {
+		return m.ByteSize
+	}
+	return 0
+}
+
+func (m *Proposal) GetStorageProviderId() uint64 {
+	if m != nil {
+		return m.StorageProviderId
+	}
+	return 0
+}
+
+func (m *Proposal) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *Proposal) GetDataItemCount() uint64 {
+	if m != nil {
+		return m.DataItemCount
+	}
+	return 0
+}
+
+func (m *Proposal) GetUncompressedByteSize() uint64 {
+	if m != nil {
+		return m.UncompressedByteSize
+	}
+	return 0
+}
+
+// StorageProvider is a storage backend registered by governance which pools can store their bundles on.
+type StorageProvider struct {
+	// id is the unique ID of the storage provider.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name ...
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// storage_cost is the cost per byte which is paid to the uploader of a bundle.
+	StorageCost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=storage_cost,json=storageCost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"storage_cost"`
+	// storage_id_format is a regular expression every storage id of the provider has to match.
+	StorageIdFormat string `protobuf:"bytes,4,opt,name=storage_id_format,json=storageIdFormat,proto3" json:"storage_id_format,omitempty"`
+}
+
+func (m *StorageProvider) Reset()         { *m = StorageProvider{} }
+func (m *StorageProvider) String() string { return proto.CompactTextString(m) }
+func (*StorageProvider) ProtoMessage()    {}
+func (*StorageProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{11}
+}
+func (m *StorageProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProvider.Merge(m, src)
+}
+func (m *StorageProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProvider proto.InternalMessageInfo
+
+func (m *StorageProvider) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorageProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageProvider) GetStorageIdFormat() string {
+	if m != nil {
+		return m.StorageIdFormat
+	}
+	return ""
+}
+
+// Runtime is a protocol runtime registered by governance. Pools of a registered runtime
+// need a config which validates against the config schema of the runtime.
+type Runtime struct {
+	// name is the unique name of the runtime, e.g. @kyve/evm.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// config_schema is the JSON schema every pool config of the runtime has to match.
+	ConfigSchema string `protobuf:"bytes,2,opt,name=config_schema,json=configSchema,proto3" json:"config_schema,omitempty"`
+	// versions are all released versions of the runtime which pools can upgrade to.
+	Versions []RuntimeVersion `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *Runtime) Reset()         { *m = Runtime{} }
+func (m *Runtime) String() string { return proto.CompactTextString(m) }
+func (*Runtime) ProtoMessage()    {}
+func (*Runtime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{12}
+}
+func (m *Runtime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Runtime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Runtime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Runtime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Runtime.Merge(m, src)
+}
+func (m *Runtime) XXX_Size() int {
+	return m.Size()
+}
+func (m *Runtime) XXX_DiscardUnknown() {
+	xxx_messageInfo_Runtime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Runtime proto.InternalMessageInfo
+
+func (m *Runtime) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Runtime) GetConfigSchema() string {
+	if m != nil {
+		return m.ConfigSchema
+	}
+	return ""
+}
+
+func (m *Runtime) GetVersions() []RuntimeVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// RuntimeVersion is a released version of a runtime.
+type RuntimeVersion struct {
+	// version ...
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// binaries are the protocol node binaries of the version for every supported platform.
+	Binaries []RuntimeBinary `protobuf:"bytes,2,rep,name=binaries,proto3" json:"binaries"`
+	// created_at is the unix time the version was registered.
+	CreatedAt uint64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *RuntimeVersion) Reset()         { *m = RuntimeVersion{} }
+func (m *RuntimeVersion) String() string { return proto.CompactTextString(m) }
+func (*RuntimeVersion) ProtoMessage()    {}
+func (*RuntimeVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{13}
+}
+func (m *RuntimeVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeVersion.Merge(m, src)
+}
+func (m *RuntimeVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuntimeVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeVersion proto.InternalMessageInfo
+
+func (m *RuntimeVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RuntimeVersion) GetBinaries() []RuntimeBinary {
+	if m != nil {
+		return m.Binaries
+	}
+	return nil
+}
+
+func (m *RuntimeVersion) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// RuntimeBinary is a protocol node binary of a runtime version for a single platform.
+type RuntimeBinary struct {
+	// platform, e.g. linux or macos.
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url the binary can be downloaded from.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// sha256 is the hex encoded checksum of the binary.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *RuntimeBinary) Reset()         { *m = RuntimeBinary{} }
+func (m *RuntimeBinary) String() string { return proto.CompactTextString(m) }
+func (*RuntimeBinary) ProtoMessage()    {}
+func (*RuntimeBinary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{14}
+}
+func (m *RuntimeBinary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuntimeBinary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RuntimeBinary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RuntimeBinary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeBinary.Merge(m, src)
+}
+func (m *RuntimeBinary) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuntimeBinary) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeBinary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeBinary proto.InternalMessageInfo
+
+func (m *RuntimeBinary) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *RuntimeBinary) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RuntimeBinary) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
+// Staker ...
+type Staker struct {
+	// staker ...
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unbonding_amount ...
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
+	// commission ...
+	Commission string `protobuf:"bytes,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	// moniker ...
+	Moniker string `protobuf:"bytes,6,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// website ...
+	Website string `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	// logo
+	Logo string `protobuf:"bytes,8,opt,name=logo,proto3" json:"logo,omitempty"`
+	// points
+	Points uint64 `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
+	// status
+	Status StakerStatus `protobuf:"varint,10,opt,name=status,proto3,enum=kyve.registry.v1beta1.StakerStatus" json:"status,omitempty"`
+	// last_transfer is the unix time the staking position was last moved to this account
+	LastTransfer uint64 `protobuf:"varint,11,opt,name=last_transfer,json=lastTransfer,proto3" json:"last_transfer,omitempty"`
+	// last_uploader_role_skip is the unix time the staker last skipped the uploader role
+	LastUploaderRoleSkip uint64 `protobuf:"varint,12,opt,name=last_uploader_role_skip,json=lastUploaderRoleSkip,proto3" json:"last_uploader_role_skip,omitempty"`
+	// version is the protocol node version the staker attested to run
+	Version string `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *Staker) Reset()         { *m = Staker{} }
 func (m *Staker) String() string { return proto.CompactTextString(m) }
 func (*Staker) ProtoMessage()    {}
 func (*Staker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{9}
+	return fileDescriptor_db13ea1584a90e6e, []int{15}
 }
 func (m *Staker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,20 +1864,6 @@ func (m *Staker) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *Staker) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-func (m *Staker) GetUnbondingAmount() uint64 {
-	if m != nil {
-		return m.UnbondingAmount
-	}
-	return 0
-}
-
 func (m *Staker) GetCommission() string {
 	if m != nil {
 		return m.Commission
@@ -1313,6 +1906,27 @@ func (m *Staker) GetStatus() StakerStatus {
 	return STAKER_STATUS_UNSPECIFIED
 }
 
+func (m *Staker) GetLastTransfer() uint64 {
+	if m != nil {
+		return m.LastTransfer
+	}
+	return 0
+}
+
+func (m *Staker) GetLastUploaderRoleSkip() uint64 {
+	if m != nil {
+		return m.LastUploaderRoleSkip
+	}
+	return 0
+}
+
+func (m *Staker) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
 // UnbondingStakingEntry
 // Creates an entry for an upcoming unbonding of a staker which is put in the unbonding fifo queue and
 // executed after the unbonding time is over.
@@ -1324,7 +1938,7 @@ type UnbondingStakingQueueEntry struct {
 	// pool_id ...
 	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// creation_time ...
 	CreationTime uint64 `protobuf:"varint,5,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 }
@@ -1333,7 +1947,7 @@ func (m *UnbondingStakingQueueEntry) Reset()         { *m = UnbondingStakingQueu
 func (m *UnbondingStakingQueueEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingStakingQueueEntry) ProtoMessage()    {}
 func (*UnbondingStakingQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{10}
+	return fileDescriptor_db13ea1584a90e6e, []int{16}
 }
 func (m *UnbondingStakingQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,13 +1997,6 @@ func (m *UnbondingStakingQueueEntry) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *UnbondingStakingQueueEntry) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *UnbondingStakingQueueEntry) GetCreationTime() uint64 {
 	if m != nil {
 		return m.CreationTime
@@ -1406,14 +2013,14 @@ type UnbondingStaker struct {
 	// pool_id ...
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// amount ...
-	UnbondingAmount uint64 `protobuf:"varint,3,opt,name=unbonding_amount,json=unbondingAmount,proto3" json:"unbonding_amount,omitempty"`
+	UnbondingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=unbonding_amount,json=unbondingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_amount"`
 }
 
 func (m *UnbondingStaker) Reset()         { *m = UnbondingStaker{} }
 func (m *UnbondingStaker) String() string { return proto.CompactTextString(m) }
 func (*UnbondingStaker) ProtoMessage()    {}
 func (*UnbondingStaker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{11}
+	return fileDescriptor_db13ea1584a90e6e, []int{17}
 }
 func (m *UnbondingStaker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,13 +2063,6 @@ func (m *UnbondingStaker) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *UnbondingStaker) GetUnbondingAmount() uint64 {
-	if m != nil {
-		return m.UnbondingAmount
-	}
-	return 0
-}
-
 // UnbondingState stores the state for the unbonding of stakes and delegations.
 type UnbondingStakingQueueState struct {
 	// low_index ...
@@ -1475,7 +2075,7 @@ func (m *UnbondingStakingQueueState) Reset()         { *m = UnbondingStakingQueu
 func (m *UnbondingStakingQueueState) String() string { return proto.CompactTextString(m) }
 func (*UnbondingStakingQueueState) ProtoMessage()    {}
 func (*UnbondingStakingQueueState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{12}
+	return fileDescriptor_db13ea1584a90e6e, []int{18}
 }
 func (m *UnbondingStakingQueueState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1531,7 +2131,7 @@ type UnbondingDelegationQueueEntry struct {
 	// pool_id ...
 	PoolId uint64 `protobuf:"varint,4,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// creation_time ...
 	CreationTime uint64 `protobuf:"varint,6,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 }
@@ -1540,7 +2140,7 @@ func (m *UnbondingDelegationQueueEntry) Reset()         { *m = UnbondingDelegati
 func (m *UnbondingDelegationQueueEntry) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationQueueEntry) ProtoMessage()    {}
 func (*UnbondingDelegationQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{13}
+	return fileDescriptor_db13ea1584a90e6e, []int{19}
 }
 func (m *UnbondingDelegationQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,13 +2197,6 @@ func (m *UnbondingDelegationQueueEntry) GetPoolId() uint64 {
 	return 0
 }
 
-func (m *UnbondingDelegationQueueEntry) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 func (m *UnbondingDelegationQueueEntry) GetCreationTime() uint64 {
 	if m != nil {
 		return m.CreationTime
@@ -1623,7 +2216,7 @@ func (m *UnbondingDelegationQueueState) Reset()         { *m = UnbondingDelegati
 func (m *UnbondingDelegationQueueState) String() string { return proto.CompactTextString(m) }
 func (*UnbondingDelegationQueueState) ProtoMessage()    {}
 func (*UnbondingDelegationQueueState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{14}
+	return fileDescriptor_db13ea1584a90e6e, []int{20}
 }
 func (m *UnbondingDelegationQueueState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1672,13 +2265,16 @@ type RedelegationCooldown struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// high_index ...
 	CreationDate uint64 `protobuf:"varint,2,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	// index is a per-delegator sequence number which orders the cooldown entries
+	// and allows multiple redelegations within the same block.
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
 }
 
 func (m *RedelegationCooldown) Reset()         { *m = RedelegationCooldown{} }
 func (m *RedelegationCooldown) String() string { return proto.CompactTextString(m) }
 func (*RedelegationCooldown) ProtoMessage()    {}
 func (*RedelegationCooldown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{15}
+	return fileDescriptor_db13ea1584a90e6e, []int{21}
 }
 func (m *RedelegationCooldown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1721,6 +2317,13 @@ func (m *RedelegationCooldown) GetCreationDate() uint64 {
 	return 0
 }
 
+func (m *RedelegationCooldown) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 // CommissionChangeQueueEntry ...
 type CommissionChangeQueueEntry struct {
 	// index is a monotonically increasing integer to order the entries
@@ -1739,7 +2342,7 @@ func (m *CommissionChangeQueueEntry) Reset()         { *m = CommissionChangeQueu
 func (m *CommissionChangeQueueEntry) String() string { return proto.CompactTextString(m) }
 func (*CommissionChangeQueueEntry) ProtoMessage()    {}
 func (*CommissionChangeQueueEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{16}
+	return fileDescriptor_db13ea1584a90e6e, []int{22}
 }
 func (m *CommissionChangeQueueEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,7 +2418,7 @@ func (m *CommissionChangeQueueState) Reset()         { *m = CommissionChangeQueu
 func (m *CommissionChangeQueueState) String() string { return proto.CompactTextString(m) }
 func (*CommissionChangeQueueState) ProtoMessage()    {}
 func (*CommissionChangeQueueState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db13ea1584a90e6e, []int{17}
+	return fileDescriptor_db13ea1584a90e6e, []int{23}
 }
 func (m *CommissionChangeQueueState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1858,6 +2461,374 @@ func (m *CommissionChangeQueueState) GetHighIndex() uint64 {
 	return 0
 }
 
+// DelegationSlash stores a slash event of a delegation pool for the F1 distribution.
+type DelegationSlash struct {
+	// id ...
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// k_index is the F1 index at which the slash occurred
+	KIndex uint64 `protobuf:"varint,3,opt,name=k_index,json=kIndex,proto3" json:"k_index,omitempty"`
+	// fraction is the share of the delegation which got slashed
+	Fraction string `protobuf:"bytes,4,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	// balance is the F1 reward balance at k_index
+	Balance string `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	// pending_delegators is the number of delegators which have not yet applied the slash
+	PendingDelegators uint64 `protobuf:"varint,6,opt,name=pending_delegators,json=pendingDelegators,proto3" json:"pending_delegators,omitempty"`
+}
+
+func (m *DelegationSlash) Reset()         { *m = DelegationSlash{} }
+func (m *DelegationSlash) String() string { return proto.CompactTextString(m) }
+func (*DelegationSlash) ProtoMessage()    {}
+func (*DelegationSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{24}
+}
+func (m *DelegationSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationSlash.Merge(m, src)
+}
+func (m *DelegationSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationSlash proto.InternalMessageInfo
+
+func (m *DelegationSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DelegationSlash) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *DelegationSlash) GetKIndex() uint64 {
+	if m != nil {
+		return m.KIndex
+	}
+	return 0
+}
+
+func (m *DelegationSlash) GetFraction() string {
+	if m != nil {
+		return m.Fraction
+	}
+	return ""
+}
+
+func (m *DelegationSlash) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *DelegationSlash) GetPendingDelegators() uint64 {
+	if m != nil {
+		return m.PendingDelegators
+	}
+	return 0
+}
+
+// RedelegationQueueEntry stores a redelegation which can still be slashed
+// for infractions of the source staker.
+type RedelegationQueueEntry struct {
+	// index is a monotonically increasing integer to order the entries
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// delegator ...
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// from_pool_id ...
+	FromPoolId uint64 `protobuf:"varint,3,opt,name=from_pool_id,json=fromPoolId,proto3" json:"from_pool_id,omitempty"`
+	// from_staker ...
+	FromStaker string `protobuf:"bytes,4,opt,name=from_staker,json=fromStaker,proto3" json:"from_staker,omitempty"`
+	// to_pool_id ...
+	ToPoolId uint64 `protobuf:"varint,5,opt,name=to_pool_id,json=toPoolId,proto3" json:"to_pool_id,omitempty"`
+	// to_staker ...
+	ToStaker string `protobuf:"bytes,6,opt,name=to_staker,json=toStaker,proto3" json:"to_staker,omitempty"`
+	// amount ...
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// creation_time ...
+	CreationTime uint64 `protobuf:"varint,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+}
+
+func (m *RedelegationQueueEntry) Reset()         { *m = RedelegationQueueEntry{} }
+func (m *RedelegationQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*RedelegationQueueEntry) ProtoMessage()    {}
+func (*RedelegationQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{25}
+}
+func (m *RedelegationQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationQueueEntry.Merge(m, src)
+}
+func (m *RedelegationQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationQueueEntry proto.InternalMessageInfo
+
+func (m *RedelegationQueueEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RedelegationQueueEntry) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *RedelegationQueueEntry) GetFromPoolId() uint64 {
+	if m != nil {
+		return m.FromPoolId
+	}
+	return 0
+}
+
+func (m *RedelegationQueueEntry) GetFromStaker() string {
+	if m != nil {
+		return m.FromStaker
+	}
+	return ""
+}
+
+func (m *RedelegationQueueEntry) GetToPoolId() uint64 {
+	if m != nil {
+		return m.ToPoolId
+	}
+	return 0
+}
+
+func (m *RedelegationQueueEntry) GetToStaker() string {
+	if m != nil {
+		return m.ToStaker
+	}
+	return ""
+}
+
+func (m *RedelegationQueueEntry) GetCreationTime() uint64 {
+	if m != nil {
+		return m.CreationTime
+	}
+	return 0
+}
+
+// RedelegationQueueState ...
+type RedelegationQueueState struct {
+	// low_index ...
+	LowIndex uint64 `protobuf:"varint,1,opt,name=low_index,json=lowIndex,proto3" json:"low_index,omitempty"`
+	// high_index ...
+	HighIndex uint64 `protobuf:"varint,2,opt,name=high_index,json=highIndex,proto3" json:"high_index,omitempty"`
+}
+
+func (m *RedelegationQueueState) Reset()         { *m = RedelegationQueueState{} }
+func (m *RedelegationQueueState) String() string { return proto.CompactTextString(m) }
+func (*RedelegationQueueState) ProtoMessage()    {}
+func (*RedelegationQueueState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{26}
+}
+func (m *RedelegationQueueState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationQueueState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationQueueState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationQueueState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationQueueState.Merge(m, src)
+}
+func (m *RedelegationQueueState) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationQueueState) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationQueueState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationQueueState proto.InternalMessageInfo
+
+func (m *RedelegationQueueState) GetLowIndex() uint64 {
+	if m != nil {
+		return m.LowIndex
+	}
+	return 0
+}
+
+func (m *RedelegationQueueState) GetHighIndex() uint64 {
+	if m != nil {
+		return m.HighIndex
+	}
+	return 0
+}
+
+// AutoCompound marks a delegation whose rewards are compounded automatically.
+type AutoCompound struct {
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	// delegator ...
+	Delegator string `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *AutoCompound) Reset()         { *m = AutoCompound{} }
+func (m *AutoCompound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompound) ProtoMessage()    {}
+func (*AutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{27}
+}
+func (m *AutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompound.Merge(m, src)
+}
+func (m *AutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompound proto.InternalMessageInfo
+
+func (m *AutoCompound) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *AutoCompound) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *AutoCompound) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+// WithdrawAddress is the account which receives the rewards and unbonded tokens of an address.
+type WithdrawAddress struct {
+	// address ...
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address ...
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *WithdrawAddress) Reset()         { *m = WithdrawAddress{} }
+func (m *WithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddress) ProtoMessage()    {}
+func (*WithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db13ea1584a90e6e, []int{28}
+}
+func (m *WithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddress.Merge(m, src)
+}
+func (m *WithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddress proto.InternalMessageInfo
+
+func (m *WithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("kyve.registry.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
 	proto.RegisterEnum("kyve.registry.v1beta1.StakerStatus", StakerStatus_name, StakerStatus_value)
@@ -1868,8 +2839,14 @@ func init() {
 	proto.RegisterType((*DelegationPoolData)(nil), "kyve.registry.v1beta1.DelegationPoolData")
 	proto.RegisterType((*Delegator)(nil), "kyve.registry.v1beta1.Delegator")
 	proto.RegisterType((*Funder)(nil), "kyve.registry.v1beta1.Funder")
+	proto.RegisterType((*FundingStream)(nil), "kyve.registry.v1beta1.FundingStream")
+	proto.RegisterType((*ProtocolFunding)(nil), "kyve.registry.v1beta1.ProtocolFunding")
 	proto.RegisterType((*Pool)(nil), "kyve.registry.v1beta1.Pool")
 	proto.RegisterType((*Proposal)(nil), "kyve.registry.v1beta1.Proposal")
+	proto.RegisterType((*StorageProvider)(nil), "kyve.registry.v1beta1.StorageProvider")
+	proto.RegisterType((*Runtime)(nil), "kyve.registry.v1beta1.Runtime")
+	proto.RegisterType((*RuntimeVersion)(nil), "kyve.registry.v1beta1.RuntimeVersion")
+	proto.RegisterType((*RuntimeBinary)(nil), "kyve.registry.v1beta1.RuntimeBinary")
 	proto.RegisterType((*Staker)(nil), "kyve.registry.v1beta1.Staker")
 	proto.RegisterType((*UnbondingStakingQueueEntry)(nil), "kyve.registry.v1beta1.UnbondingStakingQueueEntry")
 	proto.RegisterType((*UnbondingStaker)(nil), "kyve.registry.v1beta1.UnbondingStaker")
@@ -1879,6 +2856,11 @@ func init() {
 	proto.RegisterType((*RedelegationCooldown)(nil), "kyve.registry.v1beta1.RedelegationCooldown")
 	proto.RegisterType((*CommissionChangeQueueEntry)(nil), "kyve.registry.v1beta1.CommissionChangeQueueEntry")
 	proto.RegisterType((*CommissionChangeQueueState)(nil), "kyve.registry.v1beta1.CommissionChangeQueueState")
+	proto.RegisterType((*DelegationSlash)(nil), "kyve.registry.v1beta1.DelegationSlash")
+	proto.RegisterType((*RedelegationQueueEntry)(nil), "kyve.registry.v1beta1.RedelegationQueueEntry")
+	proto.RegisterType((*RedelegationQueueState)(nil), "kyve.registry.v1beta1.RedelegationQueueState")
+	proto.RegisterType((*AutoCompound)(nil), "kyve.registry.v1beta1.AutoCompound")
+	proto.RegisterType((*WithdrawAddress)(nil), "kyve.registry.v1beta1.WithdrawAddress")
 }
 
 func init() {
@@ -1886,121 +2868,192 @@ func init() {
 }
 
 var fileDescriptor_db13ea1584a90e6e = []byte{
-	// 1811 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0xb2, 0xac, 0x79, 0x52, 0x64, 0xa5, 0x71, 0x92, 0x89, 0x13, 0xcb, 0xb6, 0xb2,
-	0x61, 0xbd, 0x4b, 0x61, 0xb3, 0xcb, 0x89, 0xda, 0x93, 0x62, 0x29, 0x89, 0x2a, 0x5b, 0xb6, 0x19,
-	0x59, 0x66, 0x81, 0xc3, 0x54, 0x4b, 0xd3, 0x91, 0xba, 0x3c, 0x9a, 0x56, 0x4d, 0xf7, 0x58, 0x76,
-	0x8e, 0x9c, 0x38, 0x72, 0x83, 0x23, 0x05, 0x17, 0xce, 0x5c, 0x39, 0x71, 0xe3, 0xb8, 0x37, 0xb8,
-	0x50, 0x45, 0x25, 0xff, 0x08, 0xd5, 0x1f, 0x33, 0x9a, 0xb1, 0x25, 0x8a, 0xda, 0xec, 0x4d, 0xef,
-	0xd7, 0x6f, 0x5e, 0xbf, 0x7e, 0x5f, 0xbf, 0x57, 0x82, 0x4f, 0x2e, 0x6f, 0xae, 0xc8, 0x51, 0x44,
-	0xc6, 0x94, 0x8b, 0xe8, 0xe6, 0xe8, 0xea, 0x8b, 0x21, 0x11, 0xf8, 0x8b, 0x14, 0x38, 0x9c, 0x45,
-	0x4c, 0x30, 0xf4, 0x40, 0x6a, 0x1d, 0xa6, 0xa0, 0xd1, 0xda, 0xde, 0x1a, 0xb3, 0x31, 0x53, 0x1a,
-	0x47, 0xf2, 0x97, 0x56, 0x6e, 0xfd, 0xb5, 0x08, 0xf5, 0x17, 0x71, 0xe8, 0x07, 0xe4, 0x2c, 0x62,
-	0x33, 0xc6, 0x71, 0x80, 0xb6, 0xa1, 0x12, 0xcf, 0x02, 0x86, 0x7d, 0x12, 0x39, 0xd6, 0x9e, 0x75,
-	0x60, 0xbb, 0xa9, 0x8c, 0x9e, 0xc1, 0xbd, 0x90, 0x5c, 0x0b, 0x2f, 0x55, 0x28, 0x28, 0x85, 0x9a,
-	0x04, 0x07, 0x89, 0xd2, 0x0e, 0x00, 0x17, 0x2c, 0xc2, 0x63, 0xe2, 0x51, 0xdf, 0x29, 0x2a, 0x0d,
-	0xdb, 0x20, 0x3d, 0x1f, 0x3d, 0x01, 0x7b, 0x78, 0x23, 0x88, 0xc7, 0xe9, 0x3b, 0xe2, 0x94, 0xf6,
-	0xac, 0x83, 0x92, 0x5b, 0x91, 0x40, 0x9f, 0xbe, 0x23, 0xe8, 0x19, 0x54, 0xdf, 0x46, 0x6c, 0xea,
-	0x4d, 0x08, 0x1d, 0x4f, 0x84, 0xb3, 0x2e, 0x8f, 0x5f, 0x14, 0x1c, 0xcb, 0x05, 0x09, 0xbf, 0x56,
-	0xa8, 0xb4, 0x20, 0x58, 0xa2, 0x52, 0xd6, 0x16, 0x04, 0x33, 0x87, 0x3b, 0x00, 0xa3, 0x88, 0x60,
-	0x41, 0x7c, 0x0f, 0x0b, 0x67, 0x43, 0x9d, 0xda, 0x06, 0x69, 0x0b, 0xb4, 0x0f, 0xb5, 0x2b, 0x26,
-	0x48, 0xc4, 0xbd, 0x2b, 0x1c, 0x50, 0xdf, 0xa9, 0xec, 0x15, 0x0f, 0x6c, 0xb7, 0xaa, 0xb1, 0x0b,
-	0x09, 0xa1, 0xe7, 0x50, 0x37, 0x2a, 0x34, 0xd4, 0x4a, 0xb6, 0x52, 0xba, 0xa7, 0xd1, 0x9e, 0x06,
-	0x33, 0x6a, 0x78, 0xc8, 0x05, 0xa6, 0xa1, 0x03, 0x59, 0xb5, 0xb6, 0x06, 0xd1, 0x03, 0x28, 0x0b,
-	0xe6, 0x5d, 0x92, 0x1b, 0xa7, 0xaa, 0x22, 0xb1, 0x2e, 0xd8, 0x1b, 0x72, 0x83, 0x1e, 0x43, 0x45,
-	0x30, 0xe9, 0x43, 0x4c, 0x9c, 0x9a, 0x3a, 0xd8, 0x10, 0xec, 0x42, 0x8a, 0x68, 0x17, 0xaa, 0x43,
-	0x95, 0x12, 0x6f, 0x82, 0xf9, 0xc4, 0xb9, 0xa7, 0x4e, 0x41, 0x43, 0xaf, 0x31, 0x9f, 0xb4, 0xe6,
-	0x50, 0x39, 0x93, 0xd9, 0x1b, 0xb1, 0x00, 0x39, 0xb0, 0x71, 0x45, 0x22, 0x4e, 0x59, 0x68, 0x92,
-	0x95, 0x88, 0x32, 0x8f, 0x43, 0x1a, 0xe2, 0x88, 0x12, 0x6e, 0xd2, 0x94, 0xca, 0x32, 0x0a, 0x01,
-	0xe6, 0x32, 0x8f, 0xe3, 0x08, 0xfb, 0x44, 0x25, 0xa9, 0xe4, 0x56, 0x25, 0x36, 0xd0, 0x10, 0x42,
-	0x50, 0x12, 0x84, 0x0b, 0x95, 0x21, 0xdb, 0x55, 0xbf, 0x5b, 0xbf, 0xb1, 0xa0, 0x6a, 0xce, 0xcf,
-	0x02, 0x1c, 0x7e, 0xf7, 0xcb, 0xf9, 0x68, 0x42, 0xfc, 0x38, 0xd0, 0x39, 0x32, 0x97, 0xa7, 0x58,
-	0x5b, 0xc8, 0xcf, 0xfd, 0x38, 0xc2, 0x42, 0x5a, 0x36, 0x25, 0x92, 0xc8, 0xad, 0x10, 0xee, 0x77,
-	0x48, 0x40, 0xc6, 0x4a, 0xea, 0x86, 0x42, 0xd9, 0xac, 0x43, 0x81, 0xfa, 0xca, 0x89, 0x92, 0x5b,
-	0xa0, 0xbe, 0xf4, 0x6c, 0x88, 0x03, 0x1c, 0x8e, 0x88, 0xb9, 0x3e, 0x11, 0xd1, 0x43, 0x28, 0x73,
-	0x81, 0x2f, 0x49, 0x64, 0x2a, 0xd3, 0x48, 0xe8, 0x11, 0x6c, 0x5c, 0x7a, 0x34, 0xf4, 0xc9, 0xb5,
-	0xb9, 0xb1, 0x7c, 0xd9, 0x93, 0x52, 0xeb, 0x4f, 0x05, 0x40, 0x8b, 0x0b, 0xcf, 0x18, 0x0b, 0x3a,
-	0x58, 0xe0, 0x3b, 0x37, 0x2e, 0xec, 0x16, 0x72, 0x76, 0x3f, 0x85, 0xcd, 0x51, 0x1c, 0x45, 0x24,
-	0x14, 0x5e, 0x44, 0xe6, 0x38, 0xf2, 0xb9, 0x79, 0x70, 0xdd, 0xc0, 0xae, 0x46, 0xd1, 0x67, 0xd0,
-	0x10, 0x4c, 0xe0, 0xc0, 0xf3, 0xd3, 0xcb, 0x8c, 0x27, 0x9b, 0x0a, 0x5f, 0xf8, 0x80, 0x3e, 0x81,
-	0x7a, 0x80, 0x65, 0x46, 0xb4, 0xc3, 0xde, 0xa5, 0x6e, 0x14, 0xb7, 0xa6, 0x51, 0xe5, 0xf7, 0x1b,
-	0x79, 0xb3, 0x31, 0xc5, 0x22, 0x6f, 0xc4, 0xe2, 0x30, 0x69, 0x96, 0x7a, 0x0a, 0x1f, 0x4b, 0x14,
-	0xb5, 0x61, 0x27, 0x67, 0x6e, 0x8e, 0xb9, 0x17, 0x87, 0x19, 0x37, 0x64, 0x17, 0x55, 0xdc, 0xed,
-	0x8c, 0xf5, 0x5f, 0x60, 0x3e, 0xc8, 0x68, 0xb4, 0x7e, 0x6f, 0x81, 0xdd, 0x49, 0xac, 0xde, 0x89,
-	0x4d, 0x26, 0xb6, 0x85, 0x6c, 0x6c, 0xd1, 0x8f, 0xe0, 0xfe, 0xc2, 0x88, 0x87, 0xa7, 0xca, 0x49,
-	0x1d, 0x9e, 0xc6, 0xe2, 0xa0, 0xad, 0xf0, 0x4c, 0x84, 0x4b, 0xb9, 0x08, 0x3f, 0x05, 0x3b, 0x7d,
-	0x90, 0x0a, 0x84, 0xed, 0x2e, 0x80, 0x56, 0x1f, 0xca, 0x2f, 0xe5, 0x63, 0x22, 0x59, 0x13, 0x78,
-	0xa4, 0xe3, 0x60, 0x6a, 0xc2, 0x88, 0xd2, 0xbf, 0x19, 0x63, 0x81, 0x97, 0x3a, 0x5d, 0x96, 0x62,
-	0x4f, 0x25, 0x35, 0xe7, 0x94, 0x91, 0x5a, 0xff, 0xb4, 0xa1, 0x24, 0x2b, 0x61, 0x59, 0xdd, 0xa9,
-	0x59, 0xc3, 0x92, 0x32, 0x48, 0x44, 0xd9, 0x4f, 0x21, 0x9e, 0x12, 0x53, 0x75, 0xea, 0xb7, 0xd4,
-	0x8e, 0xe2, 0x50, 0xd0, 0x29, 0x31, 0x4f, 0x4a, 0x44, 0xa9, 0x1d, 0xb0, 0x31, 0x33, 0xcf, 0x51,
-	0xbf, 0x51, 0x13, 0x2a, 0xa6, 0xbd, 0xb8, 0x4a, 0xa4, 0xad, 0x06, 0x63, 0x8a, 0x49, 0x67, 0x47,
-	0x2c, 0x7c, 0x4b, 0xc7, 0x2a, 0x5f, 0xb6, 0x6b, 0x24, 0x39, 0xa8, 0x92, 0x0a, 0x34, 0x33, 0xb3,
-	0xa2, 0xfc, 0xbd, 0x67, 0x50, 0x33, 0x38, 0x77, 0xa1, 0xaa, 0xeb, 0x4f, 0x0e, 0x63, 0xee, 0xd8,
-	0x4a, 0x07, 0x14, 0xf4, 0x42, 0x22, 0x72, 0xf8, 0x1b, 0x05, 0x35, 0x8a, 0xb8, 0x03, 0xba, 0xe8,
-	0xb4, 0x8a, 0xc6, 0xd0, 0x4f, 0x60, 0x2b, 0xab, 0x94, 0xd6, 0x7c, 0x55, 0xe9, 0xa2, 0x8c, 0x6e,
-	0x52, 0xf7, 0xcf, 0xa1, 0xc6, 0x05, 0x8e, 0x52, 0xe7, 0x6a, 0xe9, 0xcc, 0xaf, 0x2a, 0xdc, 0xb8,
-	0xf7, 0x29, 0x6c, 0x6a, 0xd6, 0xf1, 0x68, 0x28, 0x48, 0x74, 0x85, 0x03, 0x35, 0x19, 0x4b, 0x6e,
-	0x5d, 0xc3, 0x3d, 0x83, 0xca, 0xe7, 0xb2, 0x19, 0x91, 0xc3, 0x22, 0x1c, 0x7b, 0x23, 0xc6, 0x85,
-	0x53, 0xd7, 0xcf, 0x4d, 0xd1, 0x63, 0xc6, 0x55, 0x35, 0xcd, 0x70, 0xcc, 0x89, 0xef, 0x6c, 0xaa,
-	0xea, 0x36, 0x92, 0xcc, 0xc9, 0x5b, 0x55, 0x2f, 0xdc, 0x69, 0xa8, 0x79, 0x9e, 0x88, 0xf2, 0xfd,
-	0x01, 0x9b, 0xcb, 0x36, 0xd1, 0x88, 0x73, 0x5f, 0x93, 0x9f, 0x06, 0x4d, 0x91, 0xa5, 0x51, 0x94,
-	0x3a, 0xdc, 0x41, 0x99, 0x28, 0x4a, 0x0d, 0x2e, 0xed, 0xeb, 0xba, 0xe5, 0xce, 0x0f, 0xb4, 0x7d,
-	0x23, 0x66, 0xec, 0x9b, 0x32, 0xdf, 0xca, 0xda, 0xef, 0xeb, 0x62, 0x4f, 0xed, 0x2b, 0x1d, 0xe7,
-	0x41, 0xc6, 0xbe, 0xd2, 0x58, 0x3a, 0x46, 0x1e, 0x2e, 0x1f, 0x23, 0x27, 0xb0, 0x69, 0xb2, 0x34,
-	0x33, 0xe4, 0xef, 0x3c, 0xda, 0xb3, 0x0e, 0xaa, 0x5f, 0x3e, 0x3f, 0x5c, 0xba, 0x43, 0x1c, 0xe6,
-	0x37, 0x05, 0xb7, 0x3e, 0xcc, 0x6f, 0x0e, 0x3f, 0x84, 0xcd, 0x29, 0xbe, 0x4e, 0x32, 0xaf, 0xf8,
-	0xdd, 0xd1, 0xa1, 0x9f, 0xe2, 0x6b, 0xfd, 0xad, 0x22, 0xf9, 0xaf, 0xa0, 0x32, 0x33, 0xfc, 0xe5,
-	0x3c, 0x56, 0x17, 0xee, 0xae, 0xb8, 0x30, 0xa1, 0x39, 0x37, 0xfd, 0x00, 0x75, 0xa1, 0x66, 0x58,
-	0xcb, 0x9b, 0x05, 0x38, 0x74, 0xb6, 0x95, 0x81, 0xd6, 0x0a, 0x03, 0x19, 0xb6, 0x72, 0xab, 0x71,
-	0x86, 0xba, 0x9e, 0x80, 0xad, 0xab, 0x4e, 0x32, 0xf3, 0x13, 0xcd, 0x50, 0x0a, 0x90, 0xe4, 0xbc,
-	0x0b, 0xd5, 0xa4, 0x63, 0xe4, 0xf1, 0x53, 0xcd, 0xc0, 0x06, 0x92, 0x0a, 0xcf, 0x20, 0x69, 0x1e,
-	0x43, 0xe1, 0x3b, 0x3a, 0x55, 0x06, 0xd4, 0x3c, 0xfe, 0x19, 0x34, 0x68, 0x88, 0x47, 0x82, 0x5e,
-	0x11, 0x2f, 0x49, 0x79, 0x53, 0xa5, 0x7c, 0x33, 0xc1, 0xfb, 0x26, 0xf5, 0x69, 0xd7, 0xe4, 0x3f,
-	0x70, 0x76, 0x33, 0x5d, 0xd3, 0xcb, 0x7e, 0x23, 0xfd, 0x9f, 0xd2, 0xd0, 0xa8, 0xed, 0x69, 0x8a,
-	0x9c, 0xd2, 0x50, 0x1f, 0xfe, 0x4c, 0x4d, 0x4a, 0x11, 0x73, 0x67, 0x7f, 0xcf, 0x3a, 0xa8, 0x7f,
-	0xb9, 0xbf, 0x2a, 0xbc, 0x8c, 0xc9, 0xaa, 0x11, 0x31, 0x77, 0xcd, 0x07, 0xad, 0x3f, 0x14, 0xd4,
-	0x72, 0xa1, 0x13, 0x9a, 0xdf, 0xe4, 0xac, 0xdb, 0x9b, 0x5c, 0x66, 0x6c, 0x16, 0x72, 0x63, 0x33,
-	0xbb, 0x42, 0x16, 0x6f, 0xad, 0x90, 0xbb, 0xf9, 0x0d, 0x4f, 0x33, 0xdc, 0xca, 0xed, 0x6e, 0xfd,
-	0xd6, 0x76, 0xb7, 0x0f, 0xb5, 0xb7, 0x34, 0xc4, 0x01, 0x7d, 0xa7, 0x77, 0x07, 0x4d, 0x68, 0xd5,
-	0x14, 0x6b, 0x0b, 0x33, 0x92, 0x37, 0xd2, 0x91, 0xdc, 0x80, 0xa2, 0x4c, 0x62, 0x45, 0xf9, 0x21,
-	0x7f, 0xa2, 0x2d, 0x58, 0xd7, 0x59, 0xb3, 0xf5, 0x46, 0x76, 0xb5, 0x6c, 0xed, 0x82, 0x3b, 0x6b,
-	0xd7, 0xdf, 0x0a, 0x50, 0x36, 0x5d, 0x98, 0xa1, 0x12, 0x6b, 0x25, 0x95, 0x14, 0xfe, 0x1f, 0x2a,
-	0x91, 0x55, 0x12, 0x87, 0x43, 0x16, 0xfa, 0x72, 0x5c, 0x19, 0x0d, 0x43, 0xfb, 0x29, 0x6e, 0x08,
-	0xb0, 0x09, 0x30, 0x62, 0xd3, 0x29, 0xe5, 0x6a, 0xe3, 0x5a, 0x37, 0x55, 0x99, 0x22, 0xd2, 0xab,
-	0x29, 0x0b, 0xa9, 0x1c, 0x1d, 0x65, 0xed, 0x95, 0x11, 0xe5, 0xc9, 0x9c, 0x0c, 0x39, 0x15, 0xc4,
-	0x70, 0x43, 0x22, 0xa6, 0x44, 0x53, 0xc9, 0x10, 0x8d, 0x1c, 0x8d, 0x8c, 0x86, 0x22, 0x21, 0x01,
-	0x23, 0xa1, 0xaf, 0xd2, 0xb2, 0x02, 0x55, 0x56, 0xcf, 0x56, 0x94, 0x95, 0x0e, 0xd2, 0xad, 0xc2,
-	0xfa, 0xa3, 0x05, 0xdb, 0x83, 0xe4, 0x41, 0x52, 0x83, 0x86, 0xe3, 0x9f, 0xc7, 0x24, 0x26, 0x72,
-	0x85, 0x53, 0x39, 0xd1, 0x0b, 0x82, 0xe6, 0x52, 0x2d, 0xac, 0x5c, 0xaa, 0x32, 0x51, 0x2e, 0xae,
-	0x88, 0x72, 0x29, 0x17, 0x65, 0xd9, 0xb0, 0x92, 0x88, 0xe5, 0x9a, 0xa1, 0xf8, 0xd6, 0x2c, 0x4c,
-	0x09, 0x78, 0x4e, 0xa7, 0xa4, 0x35, 0x85, 0xcd, 0x9c, 0x87, 0x24, 0xca, 0x38, 0x60, 0xad, 0x72,
-	0x20, 0x9f, 0xe6, 0x65, 0xe9, 0x2c, 0x2e, 0x4d, 0x67, 0xeb, 0x9b, 0x15, 0x01, 0x91, 0x81, 0x53,
-	0x0d, 0x1e, 0xb0, 0xb9, 0x97, 0x0d, 0x4a, 0x25, 0x60, 0x73, 0xbd, 0x37, 0xed, 0x00, 0x4c, 0xe8,
-	0x78, 0x92, 0xdb, 0xa9, 0x6c, 0x89, 0xe8, 0x95, 0xf5, 0xef, 0x16, 0xec, 0xa4, 0xa6, 0x17, 0x03,
-	0xff, 0x3b, 0x87, 0x3b, 0xb7, 0x61, 0x15, 0x6f, 0x6d, 0x58, 0xd9, 0x58, 0x94, 0x56, 0x24, 0x63,
-	0xfd, 0x7f, 0x27, 0xa3, 0xbc, 0x24, 0x19, 0xbf, 0x5e, 0xfd, 0x84, 0x8f, 0x0f, 0xd0, 0x00, 0xb6,
-	0x5c, 0xb2, 0x20, 0xc8, 0x63, 0xc6, 0x02, 0x9f, 0xcd, 0x55, 0x07, 0x61, 0xdf, 0x8f, 0x08, 0xe7,
-	0x69, 0x5f, 0x6b, 0x31, 0xe7, 0xb3, 0x8f, 0x05, 0x31, 0x36, 0x53, 0x9f, 0x3b, 0x58, 0x90, 0xd6,
-	0x5f, 0x2c, 0xd8, 0x3e, 0x4e, 0xfb, 0xf1, 0x78, 0x82, 0xc3, 0x31, 0xf9, 0xfe, 0x6b, 0x3c, 0x3f,
-	0x06, 0x4a, 0x77, 0xc6, 0xc0, 0x1d, 0x57, 0x65, 0xf4, 0x8b, 0xb7, 0x5c, 0xfd, 0x66, 0x85, 0xa7,
-	0x1f, 0x1d, 0xdb, 0xcf, 0xff, 0x6d, 0x01, 0x2c, 0x88, 0x05, 0x3d, 0x81, 0x47, 0x67, 0xa7, 0xa7,
-	0x5f, 0x7b, 0xfd, 0xf3, 0xf6, 0xf9, 0xa0, 0xef, 0x0d, 0x4e, 0xfa, 0x67, 0xdd, 0xe3, 0xde, 0xcb,
-	0x5e, 0xb7, 0xd3, 0x58, 0x43, 0x0f, 0x01, 0x65, 0x0f, 0xdb, 0xc7, 0xe7, 0xbd, 0x8b, 0x6e, 0xc3,
-	0xba, 0x8d, 0x9f, 0xb5, 0x07, 0xfd, 0x6e, 0xa7, 0x51, 0x40, 0x0e, 0x6c, 0x65, 0xf1, 0x93, 0x53,
-	0xef, 0xe5, 0xe0, 0xa4, 0xd3, 0x6f, 0x14, 0xd1, 0x73, 0xd8, 0xcf, 0x9f, 0x9c, 0x7b, 0xdd, 0x93,
-	0xd3, 0xc1, 0xab, 0xd7, 0xde, 0x45, 0xfb, 0xeb, 0x5e, 0xa7, 0x7d, 0x7e, 0xea, 0xf6, 0x1b, 0x25,
-	0xb4, 0x07, 0x4f, 0x57, 0xa8, 0xf5, 0xcf, 0xdb, 0x6f, 0xba, 0x8d, 0x75, 0xf4, 0x18, 0x1e, 0xe4,
-	0xfc, 0x3d, 0x7b, 0xe5, 0xb6, 0x3b, 0xbd, 0x93, 0x57, 0x8d, 0xf2, 0x76, 0xe9, 0xb7, 0x7f, 0x6e,
-	0xae, 0x7d, 0x4e, 0xa1, 0x96, 0x1d, 0x70, 0x68, 0x07, 0x1e, 0xab, 0x6f, 0xdd, 0xe5, 0x4f, 0x74,
-	0x60, 0x2b, 0x7f, 0x9c, 0x3e, 0x72, 0x1b, 0x1e, 0xe6, 0x4f, 0x7a, 0x27, 0xe6, 0xac, 0xa0, 0xaf,
-	0x7a, 0xf1, 0xea, 0x1f, 0xef, 0x9b, 0xd6, 0xb7, 0xef, 0x9b, 0xd6, 0x7f, 0xde, 0x37, 0xad, 0xdf,
-	0x7d, 0x68, 0xae, 0x7d, 0xfb, 0xa1, 0xb9, 0xf6, 0xaf, 0x0f, 0xcd, 0xb5, 0x5f, 0xfd, 0x78, 0x4c,
-	0xc5, 0x24, 0x1e, 0x1e, 0x8e, 0xd8, 0xf4, 0xe8, 0xcd, 0x2f, 0x2f, 0xba, 0x27, 0x44, 0xcc, 0x59,
-	0x74, 0x79, 0x34, 0x9a, 0x60, 0x1a, 0x1e, 0x5d, 0x2f, 0xfe, 0x24, 0x12, 0x37, 0x33, 0xc2, 0x87,
-	0x65, 0xb5, 0x3e, 0xfd, 0xf4, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0x74, 0x20, 0xc2, 0x42,
-	0x12, 0x00, 0x00,
+	// 2954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0x23, 0xc7,
+	0xd1, 0x5f, 0x3e, 0x24, 0x91, 0x45, 0x8a, 0xa4, 0x5a, 0x5a, 0xed, 0xac, 0x6c, 0x3d, 0x96, 0xbb,
+	0xeb, 0x7d, 0xd8, 0x96, 0x3e, 0xfb, 0x8b, 0x83, 0x04, 0x3e, 0x51, 0x12, 0x77, 0x4d, 0xec, 0x46,
+	0x92, 0x87, 0x94, 0xd6, 0x0f, 0x24, 0x93, 0x26, 0xa7, 0x45, 0x0e, 0x34, 0x9c, 0x26, 0x66, 0x9a,
+	0xe2, 0xca, 0xd7, 0x5c, 0x02, 0xe4, 0x12, 0x03, 0xb9, 0xe6, 0x94, 0x4b, 0x80, 0x5c, 0x03, 0xf8,
+	0x0f, 0xc8, 0x21, 0x3e, 0xfa, 0x12, 0x20, 0xc8, 0xc1, 0x08, 0x6c, 0xe4, 0x1a, 0x20, 0xd7, 0x9c,
+	0x82, 0xea, 0xee, 0x19, 0xce, 0x70, 0x45, 0x67, 0x4d, 0x6d, 0x4e, 0xcb, 0xfa, 0x55, 0x4d, 0x75,
+	0x75, 0x57, 0x75, 0x75, 0x55, 0x69, 0xe1, 0xce, 0xd9, 0xc5, 0x39, 0xdb, 0xf1, 0x59, 0xd7, 0x09,
+	0x84, 0x7f, 0xb1, 0x73, 0xfe, 0x4e, 0x9b, 0x09, 0xfa, 0x4e, 0x04, 0x6c, 0x0f, 0x7c, 0x2e, 0x38,
+	0xb9, 0x8e, 0x52, 0xdb, 0x11, 0xa8, 0xa5, 0xd6, 0x56, 0xba, 0xbc, 0xcb, 0xa5, 0xc4, 0x0e, 0xfe,
+	0x52, 0xc2, 0xd5, 0xdf, 0xcc, 0x41, 0x69, 0x77, 0xe8, 0xd9, 0x2e, 0x3b, 0xf2, 0xf9, 0x80, 0x07,
+	0xd4, 0x25, 0x6b, 0x90, 0x1b, 0x0e, 0x5c, 0x4e, 0x6d, 0xe6, 0x1b, 0xa9, 0xad, 0xd4, 0xfd, 0xbc,
+	0x19, 0xd1, 0xe4, 0x36, 0x2c, 0x7a, 0xec, 0xb9, 0xb0, 0x22, 0x81, 0xb4, 0x14, 0x28, 0x22, 0x78,
+	0x1c, 0x0a, 0xad, 0x03, 0x04, 0x82, 0xfb, 0xb4, 0xcb, 0x2c, 0xc7, 0x36, 0x32, 0x52, 0x22, 0xaf,
+	0x91, 0x86, 0x4d, 0x5e, 0x83, 0x7c, 0xfb, 0x42, 0x30, 0x2b, 0x70, 0x3e, 0x63, 0x46, 0x76, 0x2b,
+	0x75, 0x3f, 0x6b, 0xe6, 0x10, 0x68, 0x3a, 0x9f, 0x31, 0x72, 0x1b, 0x0a, 0xa7, 0x3e, 0xef, 0x5b,
+	0x3d, 0xe6, 0x74, 0x7b, 0xc2, 0x98, 0x43, 0xf6, 0x6e, 0xda, 0x48, 0x99, 0x80, 0xf0, 0x07, 0x12,
+	0x45, 0x0d, 0x82, 0x87, 0x22, 0xf3, 0x4a, 0x83, 0xe0, 0x9a, 0xb9, 0x0e, 0xd0, 0xf1, 0x19, 0x15,
+	0xcc, 0xb6, 0xa8, 0x30, 0x16, 0x24, 0x37, 0xaf, 0x91, 0x9a, 0x20, 0xb7, 0xa0, 0x78, 0xce, 0x05,
+	0xf3, 0x03, 0xeb, 0x9c, 0xba, 0x8e, 0x6d, 0xe4, 0xb6, 0x32, 0xf7, 0xf3, 0x66, 0x41, 0x61, 0x27,
+	0x08, 0x91, 0xbb, 0x50, 0xd2, 0x22, 0x8e, 0xa7, 0x84, 0xf2, 0x52, 0x68, 0x51, 0xa1, 0x0d, 0xef,
+	0x7c, 0x42, 0x8c, 0xb6, 0x03, 0x41, 0x1d, 0xcf, 0x80, 0xb8, 0x58, 0x4d, 0x81, 0xe4, 0x3a, 0xcc,
+	0x0b, 0x6e, 0x9d, 0xb1, 0x0b, 0xa3, 0x20, 0x4f, 0x62, 0x4e, 0xf0, 0x27, 0xec, 0x82, 0xdc, 0x84,
+	0x9c, 0xe0, 0x68, 0xc3, 0x90, 0x19, 0x45, 0xc9, 0x58, 0x10, 0xfc, 0x04, 0x49, 0xb2, 0x09, 0x85,
+	0xb6, 0x74, 0x89, 0xd5, 0xa3, 0x41, 0xcf, 0x58, 0x94, 0x5c, 0x50, 0xd0, 0x07, 0x34, 0xe8, 0x91,
+	0x6d, 0x58, 0x0e, 0x0f, 0x78, 0xe0, 0xf3, 0x73, 0xc7, 0x66, 0x3e, 0x9e, 0x74, 0x49, 0xee, 0x75,
+	0x49, 0xb3, 0x8e, 0x34, 0xa7, 0x61, 0x93, 0x2d, 0x28, 0x74, 0x78, 0x7f, 0xe0, 0xb3, 0x20, 0x70,
+	0xb8, 0x67, 0x94, 0xa5, 0xc2, 0x38, 0x44, 0xde, 0x80, 0xb2, 0x4d, 0x05, 0xb5, 0x1c, 0xc1, 0xfa,
+	0x56, 0x87, 0x0f, 0x3d, 0x61, 0x54, 0xa4, 0xb6, 0x45, 0x84, 0x1b, 0x82, 0xf5, 0xf7, 0x10, 0x24,
+	0x3f, 0x80, 0xd5, 0xa1, 0x17, 0x7e, 0xc8, 0x6c, 0x6b, 0xec, 0xc8, 0x25, 0x29, 0xbe, 0x12, 0xe7,
+	0xee, 0x86, 0x4e, 0xfd, 0x3f, 0x58, 0x09, 0x03, 0xc6, 0xa2, 0x41, 0xe0, 0x74, 0x3d, 0xe5, 0x1c,
+	0x22, 0xbf, 0x21, 0x21, 0xaf, 0xa6, 0x59, 0x35, 0x51, 0xfd, 0x77, 0x1a, 0x72, 0x47, 0x18, 0xa0,
+	0x1d, 0xee, 0x12, 0x03, 0x16, 0xce, 0x99, 0x2f, 0x4d, 0x57, 0xf1, 0x18, 0x92, 0x18, 0xaa, 0x6d,
+	0xc7, 0xa3, 0xbe, 0xc3, 0x02, 0x1d, 0x89, 0x11, 0x8d, 0x8e, 0x76, 0x69, 0x80, 0xa1, 0xda, 0xf5,
+	0xa9, 0xcd, 0x64, 0x1c, 0x66, 0xcd, 0x02, 0x62, 0xc7, 0x0a, 0x22, 0x04, 0xb2, 0x82, 0x05, 0x42,
+	0x06, 0x61, 0xde, 0x94, 0xbf, 0xc9, 0x03, 0xa8, 0x68, 0xed, 0x16, 0xf3, 0x4e, 0xb9, 0xdf, 0x61,
+	0xb6, 0x8c, 0xc2, 0x9c, 0x59, 0xd6, 0x78, 0x5d, 0xc3, 0x28, 0x3a, 0xf0, 0xd9, 0xb9, 0xc3, 0x87,
+	0x81, 0x15, 0x1a, 0x38, 0x2f, 0x55, 0x95, 0x43, 0xfc, 0x44, 0x1b, 0xfa, 0x26, 0x2c, 0x45, 0xa2,
+	0x91, 0xc5, 0x0b, 0x52, 0x36, 0xd2, 0xb1, 0x1b, 0x5a, 0xbe, 0x09, 0x05, 0x9f, 0xbb, 0x6e, 0x9b,
+	0x76, 0xce, 0xf0, 0x94, 0x72, 0xd2, 0x70, 0x08, 0xa1, 0x9a, 0xf4, 0x42, 0x24, 0x20, 0xb8, 0xa0,
+	0xae, 0xa5, 0x82, 0x23, 0x30, 0xf2, 0xca, 0x0b, 0x21, 0xb7, 0x85, 0x4c, 0x75, 0xbd, 0x03, 0x72,
+	0x0f, 0xca, 0xd1, 0x57, 0x23, 0xc7, 0xb3, 0xf9, 0xc8, 0x00, 0x29, 0x5e, 0x0a, 0xe1, 0x67, 0x12,
+	0xad, 0xfe, 0x29, 0x05, 0x05, 0x7d, 0x44, 0x47, 0x2e, 0xf5, 0x66, 0x3f, 0xff, 0xa0, 0xd3, 0x63,
+	0xf6, 0xd0, 0x55, 0xce, 0xd6, 0xe7, 0x1f, 0x61, 0x35, 0x81, 0x9f, 0xdb, 0x43, 0x9f, 0x0a, 0xd4,
+	0xac, 0x13, 0x41, 0x48, 0x5f, 0x66, 0xed, 0xdc, 0x65, 0xd6, 0x92, 0x55, 0x98, 0xef, 0x50, 0x8f,
+	0xfa, 0x17, 0xf2, 0xec, 0x73, 0xa6, 0xa6, 0xaa, 0x1e, 0x2c, 0xed, 0x33, 0x97, 0x75, 0xa5, 0xba,
+	0xba, 0x27, 0xa4, 0x51, 0x25, 0x48, 0x3b, 0xb6, 0xdc, 0x45, 0xd6, 0x4c, 0x3b, 0x36, 0x6e, 0xad,
+	0x4d, 0x5d, 0xea, 0x75, 0x98, 0xb6, 0x3f, 0x24, 0x51, 0x6d, 0x20, 0xe8, 0x19, 0xf3, 0x75, 0x02,
+	0xd3, 0x14, 0xb9, 0x01, 0x0b, 0x67, 0x96, 0xe3, 0xd9, 0xec, 0xb9, 0x36, 0x79, 0xfe, 0xac, 0x81,
+	0x54, 0xf5, 0x0f, 0x19, 0x20, 0xe3, 0x05, 0x8f, 0x38, 0x77, 0xf7, 0xa9, 0xa0, 0x2f, 0xac, 0x38,
+	0xd6, 0x9b, 0x4e, 0xe8, 0x7d, 0x06, 0xe5, 0xce, 0xd0, 0xf7, 0x99, 0x27, 0x2c, 0x9f, 0x8d, 0xa8,
+	0x6f, 0x07, 0x6a, 0xe1, 0xdd, 0xed, 0x2f, 0xbf, 0xde, 0xbc, 0xf6, 0xb7, 0xaf, 0x37, 0xdf, 0xe8,
+	0x3a, 0xa2, 0x37, 0x6c, 0x6f, 0x77, 0x78, 0x7f, 0xa7, 0xc3, 0x83, 0x3e, 0x0f, 0xf4, 0x3f, 0x6f,
+	0x07, 0xf6, 0xd9, 0x8e, 0xb8, 0x18, 0xb0, 0x60, 0xbb, 0xe1, 0x09, 0xb3, 0xa4, 0xd5, 0x98, 0x4a,
+	0x0b, 0xf9, 0x18, 0x2a, 0x2a, 0x46, 0xec, 0xc8, 0x38, 0x23, 0x3b, 0x93, 0xe6, 0xb2, 0xd4, 0x33,
+	0xde, 0x23, 0xb9, 0x03, 0x25, 0x97, 0xe2, 0xad, 0x51, 0x07, 0x62, 0x9d, 0x69, 0x17, 0x15, 0x15,
+	0x2a, 0xcf, 0xe5, 0x09, 0x7a, 0x52, 0x2f, 0xcd, 0x7d, 0x9d, 0x5b, 0x54, 0xce, 0x2e, 0x45, 0xb0,
+	0x4a, 0x2e, 0x35, 0x58, 0x4f, 0xa8, 0x1b, 0xd1, 0xc0, 0x1a, 0x7a, 0x31, 0xb3, 0x17, 0xa4, 0x83,
+	0xd7, 0x62, 0xda, 0x9f, 0xd1, 0xe0, 0x38, 0x26, 0x81, 0x6b, 0x05, 0x2e, 0x0d, 0x7a, 0x96, 0xcf,
+	0xfa, 0x14, 0xb5, 0xf8, 0xf2, 0xfa, 0xe4, 0xcd, 0x92, 0x84, 0xcd, 0x10, 0xad, 0xfe, 0x39, 0x05,
+	0xf9, 0xfd, 0x70, 0xf9, 0x17, 0x9c, 0x14, 0x73, 0x72, 0x3a, 0xee, 0x64, 0xf2, 0x29, 0x2c, 0x8d,
+	0x57, 0xb3, 0x68, 0x5f, 0xee, 0x66, 0x36, 0x3f, 0x55, 0xc6, 0x8a, 0x6a, 0x52, 0x4f, 0x2c, 0x34,
+	0xb2, 0x89, 0xd0, 0x78, 0x1d, 0xf2, 0xd1, 0x49, 0xc9, 0x13, 0xce, 0x9b, 0x63, 0xa0, 0xfa, 0x8b,
+	0x14, 0xcc, 0x3f, 0xc2, 0x63, 0xf2, 0x31, 0x9a, 0x69, 0x47, 0x9d, 0xb0, 0x8e, 0x66, 0x4d, 0xe2,
+	0x86, 0x06, 0x9c, 0xbb, 0x56, 0xb4, 0xcb, 0x79, 0x24, 0x1b, 0x36, 0x79, 0x04, 0xf3, 0x57, 0xda,
+	0x85, 0xfe, 0xba, 0xfa, 0x97, 0x0c, 0x2c, 0xa2, 0x15, 0x8e, 0xd7, 0x6d, 0x0a, 0x9f, 0xd1, 0xfe,
+	0x65, 0x67, 0x1a, 0x9a, 0x90, 0x4e, 0x98, 0x10, 0xb3, 0x3a, 0x93, 0xb4, 0x7a, 0x6c, 0x5c, 0xf6,
+	0x2a, 0xc6, 0x91, 0x4f, 0x60, 0x49, 0xfd, 0xb2, 0x06, 0xcc, 0xd7, 0xb9, 0xd2, 0x98, 0x9b, 0x49,
+	0x65, 0x59, 0x29, 0x3a, 0x62, 0xbe, 0x4a, 0xab, 0xa4, 0x05, 0xa5, 0x98, 0x6e, 0x9b, 0xaa, 0x34,
+	0xf4, 0xfd, 0x15, 0x17, 0x23, 0xc5, 0xfb, 0x54, 0x56, 0x07, 0xcc, 0xb3, 0x2d, 0xe1, 0xf4, 0x99,
+	0x2e, 0x61, 0x16, 0x98, 0x67, 0xb7, 0x9c, 0x3e, 0xc3, 0xe2, 0xc7, 0xa6, 0x17, 0x56, 0x20, 0xa8,
+	0x1f, 0xbe, 0x0d, 0x39, 0x9b, 0x5e, 0x34, 0x91, 0x26, 0x87, 0x50, 0x08, 0x06, 0x98, 0x43, 0x04,
+	0x47, 0x53, 0xf2, 0x33, 0x99, 0x02, 0x52, 0x45, 0x0b, 0x35, 0x54, 0xbf, 0x48, 0x41, 0x39, 0x7c,
+	0x88, 0xb5, 0x7f, 0xa7, 0x07, 0xd3, 0x3d, 0x28, 0x3b, 0xde, 0xa9, 0xab, 0x2e, 0x47, 0xd0, 0xa3,
+	0x7e, 0x98, 0x55, 0x4b, 0x11, 0xdc, 0x44, 0x94, 0xb4, 0xe1, 0x7a, 0x87, 0xf7, 0xfb, 0x43, 0xcf,
+	0x11, 0x17, 0x96, 0xd4, 0x75, 0xa5, 0x20, 0x5c, 0x8e, 0x94, 0x61, 0xda, 0x55, 0xb7, 0xa9, 0xfa,
+	0xcf, 0x0a, 0x64, 0x91, 0xbc, 0x2c, 0xe7, 0xcb, 0x72, 0x90, 0x87, 0x29, 0x38, 0x24, 0xb1, 0x1e,
+	0xf0, 0x68, 0x9f, 0xe9, 0x30, 0x94, 0xbf, 0x51, 0xda, 0x1f, 0x7a, 0xd2, 0x11, 0xea, 0x56, 0x86,
+	0x24, 0x4a, 0xbb, 0xbc, 0xcb, 0xf5, 0x8d, 0x94, 0xbf, 0xc9, 0x06, 0xe4, 0xf4, 0xdb, 0x18, 0xe8,
+	0x38, 0xc0, 0xda, 0x35, 0xc2, 0xe4, 0x63, 0xc5, 0xbd, 0x53, 0xa7, 0xab, 0x1f, 0x7f, 0x4d, 0x61,
+	0x2d, 0x19, 0x66, 0x7f, 0x5d, 0xd6, 0x2a, 0xcf, 0x2e, 0x6a, 0x54, 0xd7, 0xb6, 0x9b, 0x50, 0xd0,
+	0xef, 0xfd, 0x85, 0x88, 0x5e, 0x7b, 0x90, 0x10, 0x16, 0x5b, 0x01, 0xd6, 0xe7, 0xc9, 0x82, 0x40,
+	0xbd, 0xf0, 0x45, 0x11, 0x2f, 0x04, 0x7e, 0x0e, 0x2b, 0x71, 0xa1, 0xe8, 0xbd, 0x29, 0xcc, 0x74,
+	0xf8, 0x24, 0xa6, 0x3b, 0x7c, 0x73, 0xee, 0x42, 0x51, 0xc6, 0x67, 0xb8, 0x99, 0x62, 0x54, 0xc6,
+	0x17, 0x24, 0xae, 0xb7, 0x73, 0x0f, 0xca, 0xaa, 0xf6, 0xb3, 0x1c, 0x4f, 0x30, 0xff, 0x9c, 0xba,
+	0xb2, 0xd8, 0xcd, 0x9a, 0x25, 0x05, 0x37, 0x34, 0x4a, 0x8e, 0xa1, 0xc4, 0x07, 0x0c, 0x2b, 0x03,
+	0xaf, 0x6b, 0x75, 0x78, 0x20, 0x8c, 0xd2, 0x4c, 0xb6, 0x2e, 0x46, 0x5a, 0xf6, 0x78, 0x20, 0x13,
+	0xee, 0x80, 0x0e, 0x03, 0x66, 0xcb, 0x92, 0x38, 0x67, 0x6a, 0x0a, 0x7d, 0x7e, 0x2a, 0x33, 0x6a,
+	0x60, 0x54, 0x64, 0x49, 0x1f, 0x92, 0x78, 0xbe, 0x2e, 0x1f, 0xe1, 0x13, 0xa5, 0x10, 0x59, 0xf6,
+	0xe6, 0xcd, 0xa2, 0x02, 0x75, 0x1a, 0x3e, 0x0c, 0xbd, 0x84, 0x32, 0x81, 0x41, 0x66, 0x32, 0x55,
+	0x79, 0x15, 0x35, 0x06, 0x68, 0x8f, 0x7a, 0x0a, 0x02, 0x63, 0x59, 0xd9, 0xa3, 0xc9, 0x98, 0x3d,
+	0x0a, 0x31, 0x56, 0xe2, 0xf6, 0x34, 0x25, 0x36, 0xb6, 0x47, 0xca, 0x18, 0xd7, 0xaf, 0x60, 0x8f,
+	0xd4, 0x78, 0x69, 0x49, 0xb1, 0xfa, 0x6a, 0x4a, 0x8a, 0x03, 0x28, 0xeb, 0xa8, 0x1c, 0xe8, 0x7e,
+	0xd4, 0xb8, 0xb1, 0x95, 0xba, 0x5f, 0x78, 0xf7, 0xee, 0xf6, 0xa5, 0x6d, 0xed, 0x76, 0xb2, 0x79,
+	0x35, 0x4b, 0xed, 0x04, 0x8d, 0x8d, 0x4d, 0x9f, 0x3e, 0x0f, 0x23, 0x5d, 0x76, 0x2a, 0x86, 0xba,
+	0x59, 0x7d, 0xfa, 0x5c, 0x7d, 0x2b, 0x5b, 0x94, 0xf7, 0x21, 0x37, 0xd0, 0x69, 0xce, 0xb8, 0x29,
+	0x17, 0xdc, 0x9c, 0xb2, 0x60, 0x98, 0x0d, 0xcd, 0xe8, 0x03, 0x52, 0x87, 0xa2, 0xee, 0x32, 0xac,
+	0x81, 0x4b, 0x3d, 0x63, 0x4d, 0x2a, 0xa8, 0x4e, 0x51, 0x10, 0x2b, 0xad, 0xcd, 0xc2, 0x70, 0x4c,
+	0x60, 0x66, 0x57, 0xb7, 0x06, 0x9b, 0xc5, 0xd7, 0x54, 0x39, 0x2d, 0x01, 0xec, 0x17, 0x37, 0xa1,
+	0x10, 0x66, 0x08, 0x64, 0xbf, 0x2e, 0xd9, 0xa0, 0x21, 0x14, 0xb8, 0x0d, 0x61, 0xb2, 0xd0, 0x5d,
+	0xe5, 0xba, 0x0a, 0x05, 0x0d, 0xaa, 0xd6, 0xf2, 0x01, 0x54, 0x1c, 0x8f, 0x76, 0x84, 0x73, 0xce,
+	0xac, 0x30, 0xa4, 0x36, 0x64, 0x48, 0x95, 0x43, 0x5c, 0x05, 0x4d, 0x2c, 0x4b, 0x24, 0x3f, 0x30,
+	0x36, 0xaf, 0x90, 0x25, 0x1a, 0xf1, 0x35, 0xc8, 0x13, 0xc8, 0xf7, 0x1d, 0x4f, 0xab, 0xdd, 0x9a,
+	0x49, 0x6d, 0xae, 0xef, 0x78, 0x4a, 0xd9, 0x8f, 0x65, 0xf1, 0x24, 0x86, 0x81, 0x71, 0x6b, 0x2b,
+	0x75, 0xbf, 0xf4, 0xee, 0xad, 0x69, 0xee, 0xe3, 0x1c, 0xa3, 0x58, 0x0c, 0x03, 0x53, 0x7f, 0x80,
+	0xc9, 0x77, 0xe0, 0x0c, 0x98, 0xeb, 0x78, 0xcc, 0xb2, 0xd9, 0x40, 0xf4, 0x8c, 0xaa, 0x0a, 0x91,
+	0x10, 0xdd, 0x47, 0x90, 0x9c, 0xc0, 0x72, 0x08, 0xd8, 0x51, 0x74, 0x06, 0xc6, 0xed, 0xad, 0xcc,
+	0xcb, 0x87, 0x27, 0x89, 0x34, 0x84, 0x50, 0x30, 0xad, 0x9b, 0xbf, 0x33, 0xad, 0x9b, 0x7f, 0x07,
+	0x56, 0xa8, 0x8b, 0x17, 0xdc, 0xb6, 0x62, 0x2d, 0x7c, 0x60, 0xdc, 0x95, 0x7e, 0x5c, 0xd6, 0xbc,
+	0xbd, 0x18, 0x8b, 0xfc, 0x08, 0x8c, 0x4e, 0x8f, 0xfa, 0x5d, 0x66, 0x25, 0xba, 0x77, 0x79, 0x1d,
+	0xde, 0x90, 0xa9, 0x6f, 0x55, 0xf1, 0x8f, 0x63, 0x6c, 0x79, 0x2f, 0x6e, 0x00, 0x16, 0x1e, 0x32,
+	0xe4, 0xee, 0xa9, 0x17, 0x8b, 0x79, 0x36, 0x86, 0xdb, 0x3a, 0x00, 0x32, 0x74, 0x82, 0xbf, 0xaf,
+	0xc6, 0x2c, 0xcc, 0xb3, 0x75, 0x6a, 0xff, 0x19, 0x2c, 0xd3, 0x73, 0x26, 0x37, 0xa5, 0xef, 0x9e,
+	0x4c, 0xdb, 0x0f, 0x66, 0xf2, 0xf2, 0x92, 0x56, 0xa5, 0x0e, 0x53, 0xa6, 0xee, 0x27, 0x90, 0x6f,
+	0x0f, 0x7d, 0xcf, 0xf2, 0xa9, 0x60, 0xc6, 0xc3, 0xd9, 0x62, 0x07, 0x15, 0x98, 0x54, 0xc8, 0x5e,
+	0xcf, 0x1f, 0x7a, 0x23, 0x7a, 0x61, 0xbc, 0xa9, 0xea, 0x19, 0x45, 0x91, 0xb7, 0x80, 0xa8, 0x5f,
+	0x16, 0x75, 0x99, 0x2f, 0x2c, 0x97, 0x9d, 0x33, 0xd7, 0x78, 0x4b, 0xca, 0x54, 0x14, 0xa7, 0x86,
+	0x8c, 0xa7, 0x88, 0x57, 0xff, 0x91, 0x81, 0x5c, 0xe8, 0xd5, 0x89, 0x19, 0x58, 0x6a, 0x72, 0x06,
+	0x36, 0xb5, 0x18, 0x8e, 0x0f, 0xdf, 0x32, 0x13, 0xc3, 0xb7, 0xcd, 0xe4, 0x6c, 0x4c, 0xb5, 0x9f,
+	0x53, 0xe7, 0x62, 0x73, 0x13, 0x73, 0xb1, 0x5b, 0x50, 0x3c, 0x75, 0x3c, 0xea, 0x3a, 0x9f, 0xa9,
+	0x7e, 0x5c, 0xf5, 0x60, 0x85, 0x08, 0xab, 0x09, 0x5d, 0x29, 0x2d, 0x44, 0x95, 0x52, 0x05, 0x32,
+	0xe8, 0x78, 0xd5, 0x41, 0xe1, 0x4f, 0xb2, 0x02, 0x73, 0x2a, 0xb9, 0xc8, 0xca, 0xd2, 0x54, 0xc4,
+	0xe4, 0xc0, 0x0a, 0x5e, 0x18, 0x58, 0x25, 0x46, 0x7e, 0x85, 0x89, 0x91, 0xdf, 0x94, 0xf8, 0x2f,
+	0xbe, 0xe4, 0x34, 0x6b, 0xf1, 0xa5, 0xa6, 0x59, 0xa5, 0xef, 0x37, 0xcd, 0x2a, 0x4f, 0x9f, 0x66,
+	0x55, 0xff, 0x98, 0x82, 0x72, 0x33, 0x69, 0xd5, 0x0b, 0x35, 0x66, 0x58, 0x49, 0xa6, 0x63, 0x95,
+	0xe4, 0x87, 0x58, 0x14, 0xa9, 0x7d, 0xca, 0xbb, 0x30, 0x5b, 0xad, 0x5b, 0xd0, 0x3a, 0xe4, 0x2d,
+	0x78, 0x08, 0x4b, 0xe3, 0x28, 0xb3, 0x4e, 0xb9, 0xdf, 0xa7, 0xe1, 0x34, 0xab, 0x1c, 0x05, 0xdb,
+	0x23, 0x09, 0x57, 0x7f, 0x95, 0x82, 0x05, 0x73, 0x5c, 0xba, 0x4a, 0xf3, 0x52, 0x31, 0xf3, 0xf0,
+	0xfd, 0x90, 0xc5, 0xa8, 0x85, 0x23, 0x9a, 0x3e, 0x0d, 0x47, 0xbb, 0x0a, 0x6c, 0x4a, 0x8c, 0x3c,
+	0x8e, 0xd5, 0xb7, 0x99, 0xef, 0x4c, 0x7c, 0x7a, 0x29, 0x3d, 0x00, 0xdb, 0xcd, 0xe2, 0x36, 0xc7,
+	0x85, 0x70, 0xf5, 0xf3, 0x14, 0x94, 0x92, 0x22, 0xdf, 0x31, 0x66, 0x7a, 0x94, 0x18, 0x33, 0xe1,
+	0xaa, 0x77, 0xbe, 0x7b, 0x55, 0x39, 0x4a, 0xbb, 0x08, 0x17, 0x0d, 0xbf, 0x9d, 0x18, 0x0d, 0x67,
+	0x26, 0x46, 0xc3, 0xd5, 0x63, 0x58, 0x4c, 0x7c, 0x8f, 0x97, 0x71, 0xe0, 0x52, 0x81, 0xe7, 0x1a,
+	0x4e, 0xc2, 0x43, 0x1a, 0xef, 0xc6, 0xd0, 0x77, 0xf5, 0x21, 0xe1, 0x4f, 0xd9, 0xbe, 0xf7, 0xe8,
+	0xbb, 0xef, 0xfd, 0x30, 0x9a, 0x18, 0x49, 0xaa, 0xfa, 0x79, 0x16, 0xe6, 0x75, 0x25, 0x16, 0x6b,
+	0x75, 0x53, 0x53, 0x1b, 0xf4, 0xf4, 0xff, 0xa2, 0x41, 0xc7, 0x9a, 0x6d, 0xe8, 0xb5, 0xb9, 0xec,
+	0xe0, 0xac, 0x2b, 0x75, 0xd5, 0xe5, 0x48, 0x8f, 0x9e, 0x5b, 0x6c, 0x00, 0x60, 0x03, 0xe6, 0xa8,
+	0xfb, 0x38, 0xa7, 0x2b, 0x93, 0x08, 0xc1, 0x5d, 0xf7, 0xb9, 0xe7, 0x60, 0x79, 0xaa, 0xc6, 0xa3,
+	0x21, 0x89, 0x9c, 0x11, 0x6b, 0x07, 0x8e, 0x60, 0xba, 0x1f, 0x0a, 0xc9, 0xa8, 0xb9, 0xca, 0xc5,
+	0x9a, 0x2b, 0x2c, 0xd7, 0xb9, 0xe3, 0x89, 0xb0, 0xf1, 0xd1, 0x14, 0x79, 0x3f, 0x7a, 0xfa, 0x41,
+	0x3e, 0xfd, 0xb7, 0xa7, 0x04, 0x87, 0x72, 0xc2, 0xc4, 0xe3, 0x8f, 0x15, 0x34, 0x8e, 0x89, 0x85,
+	0x4f, 0xbd, 0xe0, 0x94, 0xf9, 0x3a, 0x3d, 0xc9, 0xd9, 0x71, 0x4b, 0x63, 0xe4, 0x3d, 0xb8, 0xa1,
+	0x67, 0xc9, 0x7a, 0x8a, 0xed, 0x73, 0xac, 0x26, 0xcf, 0x9c, 0x81, 0x4e, 0x53, 0x2b, 0x6a, 0xac,
+	0xac, 0xb8, 0x26, 0x77, 0x59, 0xf3, 0xcc, 0x19, 0xc4, 0x23, 0x7a, 0x31, 0x11, 0xd1, 0xd5, 0xaf,
+	0x52, 0xb0, 0x76, 0x1c, 0x1e, 0x23, 0xda, 0xe5, 0x78, 0xdd, 0x0f, 0x87, 0x6c, 0xc8, 0x70, 0x50,
+	0x29, 0xd3, 0xac, 0x9a, 0x3e, 0xa9, 0x8c, 0xa2, 0x88, 0xa9, 0xa3, 0xc3, 0x58, 0xec, 0x64, 0xa6,
+	0xc4, 0xce, 0xd5, 0xe6, 0x27, 0x98, 0x1a, 0x7c, 0xa6, 0xda, 0x7a, 0xd9, 0x09, 0xeb, 0x31, 0x5f,
+	0x08, 0xe2, 0x5c, 0xa2, 0xfa, 0xdb, 0x14, 0x94, 0x13, 0x5b, 0x62, 0x7e, 0xcc, 0xe2, 0xd4, 0x34,
+	0x8b, 0x93, 0xd1, 0x7e, 0x59, 0x94, 0x66, 0x5e, 0x49, 0x94, 0x56, 0x3f, 0x9a, 0x72, 0xe2, 0x18,
+	0x0f, 0x72, 0xaa, 0xe2, 0xf2, 0x91, 0x15, 0x3f, 0xf5, 0x9c, 0xcb, 0x47, 0x6a, 0xea, 0xb7, 0x0e,
+	0xd0, 0x73, 0xba, 0xbd, 0xc4, 0x44, 0x30, 0x8f, 0x88, 0x64, 0x57, 0xff, 0x95, 0x82, 0xf5, 0x48,
+	0xf5, 0xb8, 0x97, 0x99, 0xd9, 0x9f, 0x89, 0x79, 0x5f, 0x66, 0x62, 0xde, 0x17, 0x3f, 0xbb, 0xec,
+	0x14, 0x6f, 0xcf, 0xbd, 0x5a, 0x6f, 0xcf, 0x5f, 0xe2, 0xed, 0x4f, 0xa7, 0x6f, 0xf9, 0xea, 0x07,
+	0x7a, 0x06, 0x2b, 0x26, 0x1b, 0xf7, 0x96, 0x7b, 0x9c, 0xbb, 0x36, 0x1f, 0xc9, 0x44, 0x42, 0x6d,
+	0x1b, 0x9f, 0xe3, 0x28, 0x7d, 0x2a, 0x32, 0x61, 0xb3, 0x8d, 0x25, 0x61, 0x3a, 0x69, 0xf3, 0x3e,
+	0x9a, 0x14, 0x79, 0x21, 0x13, 0xf3, 0x42, 0xf5, 0xf7, 0x29, 0x58, 0xdb, 0x8b, 0x92, 0xd5, 0x5e,
+	0x8f, 0x7a, 0x5d, 0xf6, 0xea, 0xaf, 0x62, 0x32, 0x47, 0x66, 0x5f, 0xc8, 0x91, 0x2f, 0x6c, 0x00,
+	0x7d, 0x98, 0x49, 0x6e, 0xa0, 0xfa, 0xd1, 0x14, 0x4b, 0xaf, 0x7e, 0xe2, 0x38, 0xe6, 0x1b, 0xbb,
+	0xb1, 0x89, 0xb3, 0xf2, 0x97, 0xfe, 0xcb, 0x45, 0x6c, 0x58, 0x9e, 0x49, 0x0c, 0xcb, 0xd7, 0x20,
+	0x77, 0xea, 0x63, 0xc3, 0x17, 0xed, 0x38, 0xa2, 0xe3, 0x7f, 0x78, 0x99, 0x4b, 0xfe, 0xe1, 0xe5,
+	0x6d, 0x20, 0x03, 0xa6, 0x12, 0x40, 0x14, 0xf4, 0x81, 0x8e, 0xc1, 0x25, 0xcd, 0x89, 0x26, 0xf7,
+	0x41, 0xf5, 0x8b, 0x34, 0xac, 0xc6, 0x83, 0xe5, 0xbf, 0xba, 0x2e, 0x71, 0xbb, 0xd2, 0x93, 0xb7,
+	0x6b, 0x0b, 0x8a, 0xb2, 0xc6, 0x4e, 0x7a, 0x51, 0x16, 0xd9, 0x47, 0xca, 0x93, 0x61, 0x15, 0x9e,
+	0x18, 0xd5, 0x4b, 0x81, 0x66, 0x78, 0x7d, 0x41, 0xf0, 0x48, 0x41, 0x54, 0x86, 0xeb, 0xcf, 0x55,
+	0x8d, 0xae, 0x3f, 0x56, 0xcf, 0x61, 0x4e, 0x70, 0xfd, 0xe9, 0xf8, 0x0a, 0x2f, 0xbc, 0xda, 0x2b,
+	0x9c, 0xbb, 0xe4, 0x0a, 0xb7, 0x2e, 0x39, 0xb8, 0xab, 0x47, 0xd2, 0x4f, 0xa1, 0x58, 0x1b, 0x0a,
+	0x8e, 0xed, 0x27, 0x1f, 0x7a, 0xf6, 0xf4, 0x61, 0xf1, 0x4c, 0xd9, 0xaf, 0x7a, 0x02, 0xe5, 0x67,
+	0x8e, 0xe8, 0xd9, 0x3e, 0x1d, 0xd5, 0xf4, 0xdd, 0x9f, 0x9e, 0x15, 0x1e, 0x40, 0x65, 0xa4, 0x85,
+	0xad, 0x50, 0x44, 0x2d, 0x56, 0x1e, 0x25, 0x95, 0x3c, 0xfc, 0x3c, 0x0d, 0x30, 0x1e, 0x0d, 0x90,
+	0xd7, 0xe0, 0xc6, 0xd1, 0xe1, 0xe1, 0x53, 0xab, 0xd9, 0xaa, 0xb5, 0x8e, 0x9b, 0xd6, 0xf1, 0x41,
+	0xf3, 0xa8, 0xbe, 0xd7, 0x78, 0xd4, 0xa8, 0xef, 0x57, 0xae, 0x91, 0x55, 0x20, 0x71, 0x66, 0x6d,
+	0xaf, 0xd5, 0x38, 0xa9, 0x57, 0x52, 0x93, 0xf8, 0x51, 0xed, 0xb8, 0x59, 0xdf, 0xaf, 0xa4, 0x89,
+	0x01, 0x2b, 0x71, 0xfc, 0xe0, 0xd0, 0x7a, 0x74, 0x7c, 0xb0, 0xdf, 0xac, 0x64, 0xc8, 0x5d, 0xb8,
+	0x95, 0xe4, 0xb4, 0xac, 0xfa, 0xc1, 0xe1, 0xf1, 0xe3, 0x0f, 0xac, 0x93, 0xda, 0xd3, 0xc6, 0x7e,
+	0xad, 0x75, 0x68, 0x36, 0x2b, 0x59, 0xb2, 0x05, 0xaf, 0x4f, 0x11, 0x6b, 0xb6, 0x6a, 0x4f, 0xea,
+	0x95, 0x39, 0x72, 0x13, 0xae, 0x27, 0xec, 0x3d, 0x7a, 0x6c, 0xd6, 0xf6, 0x1b, 0x07, 0x8f, 0x2b,
+	0xf3, 0x93, 0xac, 0xbd, 0xc3, 0x9f, 0x1c, 0x3d, 0xad, 0xb7, 0xea, 0xfb, 0x95, 0x05, 0x72, 0x03,
+	0x96, 0xe3, 0x2c, 0xb3, 0xde, 0x6a, 0x98, 0xf5, 0xfd, 0x4a, 0x6e, 0x2d, 0xfb, 0xcb, 0xdf, 0x6d,
+	0x5c, 0x7b, 0xe8, 0x40, 0x31, 0x5e, 0x32, 0x91, 0x75, 0xb8, 0x29, 0xd7, 0x33, 0x2f, 0x3f, 0x16,
+	0x03, 0x56, 0x92, 0xec, 0xe8, 0x60, 0xd6, 0x60, 0x35, 0xc9, 0x69, 0x1c, 0x68, 0x5e, 0x5a, 0x2d,
+	0xb5, 0xfb, 0xf8, 0xcb, 0x6f, 0x36, 0x52, 0x5f, 0x7d, 0xb3, 0x91, 0xfa, 0xfb, 0x37, 0x1b, 0xa9,
+	0x5f, 0x7f, 0xbb, 0x71, 0xed, 0xab, 0x6f, 0x37, 0xae, 0xfd, 0xf5, 0xdb, 0x8d, 0x6b, 0x9f, 0xbc,
+	0x1d, 0x0b, 0xfd, 0x27, 0x1f, 0x9f, 0xd4, 0x0f, 0x98, 0x18, 0x71, 0xff, 0x6c, 0xa7, 0xd3, 0xa3,
+	0x8e, 0xb7, 0xf3, 0x7c, 0xfc, 0xbf, 0x61, 0xe4, 0x2d, 0x68, 0xcf, 0xcb, 0xa1, 0xdc, 0xff, 0xff,
+	0x67, 0x00, 0x3a, 0x3c, 0x9e, 0x6c, 0x2b, 0x23, 0x00, 0x00,
 }
 
 func (m *BundleProposal) Marshal() (dAtA []byte, err error) {
@@ -2023,19 +3076,52 @@ func (m *BundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.BundleHash) > 0 {
-		i -= len(m.BundleHash)
-		copy(dAtA[i:], m.BundleHash)
-		i = encodeVarintRegistry(dAtA, i, uint64(len(m.BundleHash)))
+	if m.UploaderAssignedAt != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.UploaderAssignedAt))
 		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.ToValue) > 0 {
-		i -= len(m.ToValue)
-		copy(dAtA[i:], m.ToValue)
-		i = encodeVarintRegistry(dAtA, i, uint64(len(m.ToValue)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x90
+	}
+	if m.UncompressedByteSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.UncompressedByteSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DataItemCount != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.DataItemCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ToValue) > 0 {
+		i -= len(m.ToValue)
+		copy(dAtA[i:], m.ToValue)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.ToValue)))
+		i--
+		dAtA[i] = 0x62
 	}
 	if len(m.ToKey) > 0 {
 		i -= len(m.ToKey)
@@ -2135,6 +3221,45 @@ func (m *Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RollbackWindow != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.RollbackWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RollbackTotalBundles != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.RollbackTotalBundles))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RollbackAt != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.RollbackAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PreviousBinaries) > 0 {
+		i -= len(m.PreviousBinaries)
+		copy(dAtA[i:], m.PreviousBinaries)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PreviousBinaries)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PreviousVersion) > 0 {
+		i -= len(m.PreviousVersion)
+		copy(dAtA[i:], m.PreviousVersion)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PreviousVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VersionEnforced {
+		i--
+		if m.VersionEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Test) > 0 {
 		i -= len(m.Test)
 		copy(dAtA[i:], m.Test)
@@ -2184,6 +3309,21 @@ func (m *UpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Canary {
+		i--
+		if m.Canary {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RollbackWindow != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.RollbackWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Duration != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.Duration))
 		i--
@@ -2278,6 +3418,13 @@ func (m *DelegationPoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashRemainder) > 0 {
+		i -= len(m.SlashRemainder)
+		copy(dAtA[i:], m.SlashRemainder)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.SlashRemainder)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.LatestIndexWasUndelegation {
 		i--
 		if m.LatestIndexWasUndelegation {
@@ -2298,16 +3445,26 @@ func (m *DelegationPoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.TotalDelegation != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalDelegation))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.CurrentRewards != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.CurrentRewards))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.TotalDelegation.Size()
+		i -= size
+		if _, err := m.TotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentRewards.Size()
+		i -= size
+		if _, err := m.CurrentRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Staker) > 0 {
 		i -= len(m.Staker)
 		copy(dAtA[i:], m.Staker)
@@ -2357,11 +3514,16 @@ func (m *Delegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.DelegationAmount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.DelegationAmount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.KIndex != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.KIndex))
 		i--
@@ -2395,16 +3557,156 @@ func (m *Funder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Amount))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpentToday.Size()
+		i -= size
+		if _, err := m.SpentToday.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.DayStart != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.DayStart))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AmountPerDay.Size()
+		i -= size
+		if _, err := m.AmountPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AmountPerBundle.Size()
+		i -= size
+		if _, err := m.AmountPerBundle.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
 		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Account)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPoolAmount.Size()
+		i -= size
+		if _, err := m.CommunityPoolAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.InflationShare) > 0 {
+		i -= len(m.InflationShare)
+		copy(dAtA[i:], m.InflationShare)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.InflationShare)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.PoolId != 0 {
@@ -2435,27 +3737,144 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+	if m.RunwayAlertLevel != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.RunwayAlertLevel))
 		i--
 		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xe0
 	}
-	if m.MinStake != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.MinStake))
+	if m.Runway != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Runway))
 		i--
 		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xd8
 	}
-	if m.TotalInactiveStake != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalInactiveStake))
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.AverageBundleCost.Size()
+		i -= size
+		if _, err := m.AverageBundleCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xca
+	if m.EndHeight != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.EndHeight))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.EndKey) > 0 {
+		i -= len(m.EndKey)
+		copy(dAtA[i:], m.EndKey)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.EndKey)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ChargeUncompressedSize {
+		i--
+		if m.ChargeUncompressedSize {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for iNdEx := len(m.AllowedCompressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCompressions[iNdEx])
+			copy(dAtA[i:], m.AllowedCompressions[iNdEx])
+			i = encodeVarintRegistry(dAtA, i, uint64(len(m.AllowedCompressions[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PipelinedProposals) > 0 {
+		for iNdEx := len(m.PipelinedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PipelinedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.PipelineDepth != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PipelineDepth))
+		i--
+		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xf8
+		dAtA[i] = 0x90
+	}
+	if m.Status != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.TotalInactiveStake.Size()
+		i -= size
+		if _, err := m.TotalInactiveStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
 	if len(m.InactiveStakers) > 0 {
 		for iNdEx := len(m.InactiveStakers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InactiveStakers[iNdEx])
@@ -2543,20 +3962,30 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xba
 	}
-	if m.TotalDelegation != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalDelegation))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
-	}
-	if m.TotalStake != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalStake))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	{
+		size := m.TotalDelegation.Size()
+		i -= size
+		if _, err := m.TotalDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	if len(m.LowestStaker) > 0 {
 		i -= len(m.LowestStaker)
 		copy(dAtA[i:], m.LowestStaker)
@@ -2577,13 +4006,18 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x9a
 		}
 	}
-	if m.TotalFunds != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalFunds))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	{
+		size := m.TotalFunds.Size()
+		i -= size
+		if _, err := m.TotalFunds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if len(m.LowestFunder) > 0 {
 		i -= len(m.LowestFunder)
 		copy(dAtA[i:], m.LowestFunder)
@@ -2614,11 +4048,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x78
 	}
-	if m.OperatingCost != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.OperatingCost))
-		i--
-		dAtA[i] = 0x70
+	{
+		size := m.OperatingCost.Size()
+		i -= size
+		if _, err := m.OperatingCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x72
 	if m.UploadInterval != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.UploadInterval))
 		i--
@@ -2629,11 +4068,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x60
 	}
-	if m.TotalBundleRewards != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalBundleRewards))
-		i--
-		dAtA[i] = 0x58
+	{
+		size := m.TotalBundleRewards.Size()
+		i -= size
+		if _, err := m.TotalBundleRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x5a
 	if m.TotalBundles != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalBundles))
 		i--
@@ -2719,6 +4163,33 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UncompressedByteSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.UncompressedByteSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.DataItemCount != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.DataItemCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.StorageProviderId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.StorageProviderId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ByteSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.BundleHash) > 0 {
 		i -= len(m.BundleHash)
 		copy(dAtA[i:], m.BundleHash)
@@ -2782,7 +4253,7 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Staker) Marshal() (dAtA []byte, err error) {
+func (m *StorageProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2792,20 +4263,233 @@ func (m *Staker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Staker) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+	if len(m.StorageIdFormat) > 0 {
+		i -= len(m.StorageIdFormat)
+		copy(dAtA[i:], m.StorageIdFormat)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.StorageIdFormat)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.StorageCost.Size()
+		i -= size
+		if _, err := m.StorageCost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Runtime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Runtime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Runtime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConfigSchema) > 0 {
+		i -= len(m.ConfigSchema)
+		copy(dAtA[i:], m.ConfigSchema)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.ConfigSchema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuntimeVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RuntimeBinary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuntimeBinary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuntimeBinary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Staker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Staker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.LastUploaderRoleSkip != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.LastUploaderRoleSkip))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.LastTransfer != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.LastTransfer))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
 	}
 	if m.Points != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.Points))
@@ -2840,16 +4524,26 @@ func (m *Staker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.UnbondingAmount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.UnbondingAmount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Amount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.PoolId != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
 		i--
@@ -2890,11 +4584,16 @@ func (m *UnbondingStakingQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Amount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.PoolId != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
 		i--
@@ -2935,11 +4634,16 @@ func (m *UnbondingStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingAmount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.UnbondingAmount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.UnbondingAmount.Size()
+		i -= size
+		if _, err := m.UnbondingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.PoolId != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3013,11 +4717,16 @@ func (m *UnbondingDelegationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x30
 	}
-	if m.Amount != 0 {
-		i = encodeVarintRegistry(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x28
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x2a
 	if m.PoolId != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
 		i--
@@ -3098,6 +4807,11 @@ func (m *RedelegationCooldown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Index != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CreationDate != 0 {
 		i = encodeVarintRegistry(dAtA, i, uint64(m.CreationDate))
 		i--
@@ -3198,49 +4912,294 @@ func (m *CommissionChangeQueueState) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRegistry(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegationSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DelegationSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovRegistry(uint64(l))
+	if m.PendingDelegators != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PendingDelegators))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.NextUploader)
-	if l > 0 {
-		n += 1 + l + sovRegistry(uint64(l))
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovRegistry(uint64(l))
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ByteSize != 0 {
-		n += 1 + sovRegistry(uint64(m.ByteSize))
+	if m.KIndex != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.KIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.FromHeight != 0 {
-		n += 1 + sovRegistry(uint64(m.FromHeight))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ToHeight != 0 {
-		n += 1 + sovRegistry(uint64(m.ToHeight))
+	if m.Id != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovRegistry(uint64(m.CreatedAt))
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VotersValid) > 0 {
-		for _, s := range m.VotersValid {
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationTime != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.CreationTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ToStaker) > 0 {
+		i -= len(m.ToStaker)
+		copy(dAtA[i:], m.ToStaker)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.ToStaker)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ToPoolId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.ToPoolId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromStaker) > 0 {
+		i -= len(m.FromStaker)
+		copy(dAtA[i:], m.FromStaker)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.FromStaker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FromPoolId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.FromPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationQueueState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationQueueState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationQueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HighIndex != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.HighIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LowIndex != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.LowIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.NextUploader)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovRegistry(uint64(m.ByteSize))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovRegistry(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovRegistry(uint64(m.ToHeight))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRegistry(uint64(m.CreatedAt))
+	}
+	if len(m.VotersValid) > 0 {
+		for _, s := range m.VotersValid {
 			l = len(s)
 			n += 1 + l + sovRegistry(uint64(l))
 		}
@@ -3269,6 +5228,22 @@ func (m *BundleProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovRegistry(uint64(m.StorageProviderId))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.DataItemCount != 0 {
+		n += 2 + sovRegistry(uint64(m.DataItemCount))
+	}
+	if m.UncompressedByteSize != 0 {
+		n += 2 + sovRegistry(uint64(m.UncompressedByteSize))
+	}
+	if m.UploaderAssignedAt != 0 {
+		n += 2 + sovRegistry(uint64(m.UploaderAssignedAt))
+	}
 	return n
 }
 
@@ -3293,6 +5268,26 @@ func (m *Protocol) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
+	if m.VersionEnforced {
+		n += 2
+	}
+	l = len(m.PreviousVersion)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.PreviousBinaries)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.RollbackAt != 0 {
+		n += 1 + sovRegistry(uint64(m.RollbackAt))
+	}
+	if m.RollbackTotalBundles != 0 {
+		n += 1 + sovRegistry(uint64(m.RollbackTotalBundles))
+	}
+	if m.RollbackWindow != 0 {
+		n += 1 + sovRegistry(uint64(m.RollbackWindow))
+	}
 	return n
 }
 
@@ -3316,6 +5311,12 @@ func (m *UpgradePlan) Size() (n int) {
 	if m.Duration != 0 {
 		n += 1 + sovRegistry(uint64(m.Duration))
 	}
+	if m.RollbackWindow != 0 {
+		n += 1 + sovRegistry(uint64(m.RollbackWindow))
+	}
+	if m.Canary {
+		n += 2
+	}
 	return n
 }
 
@@ -3355,12 +5356,10 @@ func (m *DelegationPoolData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
-	if m.CurrentRewards != 0 {
-		n += 1 + sovRegistry(uint64(m.CurrentRewards))
-	}
-	if m.TotalDelegation != 0 {
-		n += 1 + sovRegistry(uint64(m.TotalDelegation))
-	}
+	l = m.CurrentRewards.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = m.TotalDelegation.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	if m.LatestIndexK != 0 {
 		n += 1 + sovRegistry(uint64(m.LatestIndexK))
 	}
@@ -3370,6 +5369,10 @@ func (m *DelegationPoolData) Size() (n int) {
 	if m.LatestIndexWasUndelegation {
 		n += 2
 	}
+	l = len(m.SlashRemainder)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
 	return n
 }
 
@@ -3385,9 +5388,8 @@ func (m *Delegator) Size() (n int) {
 	if m.KIndex != 0 {
 		n += 1 + sovRegistry(uint64(m.KIndex))
 	}
-	if m.DelegationAmount != 0 {
-		n += 1 + sovRegistry(uint64(m.DelegationAmount))
-	}
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	l = len(m.Staker)
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
@@ -3412,9 +5414,59 @@ func (m *Funder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovRegistry(uint64(m.Amount))
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	return n
+}
+
+func (m *FundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRegistry(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovRegistry(uint64(m.PoolId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = m.AmountPerBundle.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = m.AmountPerDay.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	if m.EndTime != 0 {
+		n += 1 + sovRegistry(uint64(m.EndTime))
+	}
+	if m.DayStart != 0 {
+		n += 1 + sovRegistry(uint64(m.DayStart))
+	}
+	l = m.SpentToday.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	return n
+}
+
+func (m *ProtocolFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovRegistry(uint64(m.PoolId))
 	}
+	l = len(m.InflationShare)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	return n
 }
 
@@ -3460,18 +5512,16 @@ func (m *Pool) Size() (n int) {
 	if m.TotalBundles != 0 {
 		n += 1 + sovRegistry(uint64(m.TotalBundles))
 	}
-	if m.TotalBundleRewards != 0 {
-		n += 1 + sovRegistry(uint64(m.TotalBundleRewards))
-	}
+	l = m.TotalBundleRewards.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	if m.StartHeight != 0 {
 		n += 1 + sovRegistry(uint64(m.StartHeight))
 	}
 	if m.UploadInterval != 0 {
 		n += 1 + sovRegistry(uint64(m.UploadInterval))
 	}
-	if m.OperatingCost != 0 {
-		n += 1 + sovRegistry(uint64(m.OperatingCost))
-	}
+	l = m.OperatingCost.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	if m.Paused {
 		n += 2
 	}
@@ -3485,9 +5535,8 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRegistry(uint64(l))
 	}
-	if m.TotalFunds != 0 {
-		n += 2 + sovRegistry(uint64(m.TotalFunds))
-	}
+	l = m.TotalFunds.Size()
+	n += 2 + l + sovRegistry(uint64(l))
 	if len(m.Stakers) > 0 {
 		for _, s := range m.Stakers {
 			l = len(s)
@@ -3498,12 +5547,10 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRegistry(uint64(l))
 	}
-	if m.TotalStake != 0 {
-		n += 2 + sovRegistry(uint64(m.TotalStake))
-	}
-	if m.TotalDelegation != 0 {
-		n += 2 + sovRegistry(uint64(m.TotalDelegation))
-	}
+	l = m.TotalStake.Size()
+	n += 2 + l + sovRegistry(uint64(l))
+	l = m.TotalDelegation.Size()
+	n += 2 + l + sovRegistry(uint64(l))
 	if m.BundleProposal != nil {
 		l = m.BundleProposal.Size()
 		n += 2 + l + sovRegistry(uint64(l))
@@ -3537,17 +5584,53 @@ func (m *Pool) Size() (n int) {
 			n += 2 + l + sovRegistry(uint64(l))
 		}
 	}
-	if m.TotalInactiveStake != 0 {
-		n += 2 + sovRegistry(uint64(m.TotalInactiveStake))
-	}
-	if m.MinStake != 0 {
-		n += 2 + sovRegistry(uint64(m.MinStake))
-	}
+	l = m.TotalInactiveStake.Size()
+	n += 2 + l + sovRegistry(uint64(l))
+	l = m.MinStake.Size()
+	n += 2 + l + sovRegistry(uint64(l))
 	if m.Status != 0 {
 		n += 2 + sovRegistry(uint64(m.Status))
 	}
-	return n
-}
+	if m.PipelineDepth != 0 {
+		n += 2 + sovRegistry(uint64(m.PipelineDepth))
+	}
+	if len(m.PipelinedProposals) > 0 {
+		for _, e := range m.PipelinedProposals {
+			l = e.Size()
+			n += 2 + l + sovRegistry(uint64(l))
+		}
+	}
+	if m.StorageProviderId != 0 {
+		n += 2 + sovRegistry(uint64(m.StorageProviderId))
+	}
+	if len(m.AllowedCompressions) > 0 {
+		for _, s := range m.AllowedCompressions {
+			l = len(s)
+			n += 2 + l + sovRegistry(uint64(l))
+		}
+	}
+	if m.ChargeUncompressedSize {
+		n += 3
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 2 + l + sovRegistry(uint64(l))
+	}
+	if m.EndHeight != 0 {
+		n += 2 + sovRegistry(uint64(m.EndHeight))
+	}
+	l = m.AverageBundleCost.Size()
+	n += 2 + l + sovRegistry(uint64(l))
+	l = m.BurnRate.Size()
+	n += 2 + l + sovRegistry(uint64(l))
+	if m.Runway != 0 {
+		n += 2 + sovRegistry(uint64(m.Runway))
+	}
+	if m.RunwayAlertLevel != 0 {
+		n += 2 + sovRegistry(uint64(m.RunwayAlertLevel))
+	}
+	return n
+}
 
 func (m *Proposal) Size() (n int) {
 	if m == nil {
@@ -3590,6 +5673,110 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
+	if m.ByteSize != 0 {
+		n += 1 + sovRegistry(uint64(m.ByteSize))
+	}
+	if m.StorageProviderId != 0 {
+		n += 1 + sovRegistry(uint64(m.StorageProviderId))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.DataItemCount != 0 {
+		n += 1 + sovRegistry(uint64(m.DataItemCount))
+	}
+	if m.UncompressedByteSize != 0 {
+		n += 1 + sovRegistry(uint64(m.UncompressedByteSize))
+	}
+	return n
+}
+
+func (m *StorageProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRegistry(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = m.StorageCost.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = len(m.StorageIdFormat)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *Runtime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.ConfigSchema)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RuntimeVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovRegistry(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *RuntimeBinary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
 	return n
 }
 
@@ -3606,12 +5793,10 @@ func (m *Staker) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovRegistry(uint64(m.PoolId))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovRegistry(uint64(m.Amount))
-	}
-	if m.UnbondingAmount != 0 {
-		n += 1 + sovRegistry(uint64(m.UnbondingAmount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	l = len(m.Commission)
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
@@ -3634,6 +5819,16 @@ func (m *Staker) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovRegistry(uint64(m.Status))
 	}
+	if m.LastTransfer != 0 {
+		n += 1 + sovRegistry(uint64(m.LastTransfer))
+	}
+	if m.LastUploaderRoleSkip != 0 {
+		n += 1 + sovRegistry(uint64(m.LastUploaderRoleSkip))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
 	return n
 }
 
@@ -3653,9 +5848,8 @@ func (m *UnbondingStakingQueueEntry) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovRegistry(uint64(m.PoolId))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovRegistry(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	if m.CreationTime != 0 {
 		n += 1 + sovRegistry(uint64(m.CreationTime))
 	}
@@ -3675,9 +5869,8 @@ func (m *UnbondingStaker) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovRegistry(uint64(m.PoolId))
 	}
-	if m.UnbondingAmount != 0 {
-		n += 1 + sovRegistry(uint64(m.UnbondingAmount))
-	}
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	return n
 }
 
@@ -3716,9 +5909,8 @@ func (m *UnbondingDelegationQueueEntry) Size() (n int) {
 	if m.PoolId != 0 {
 		n += 1 + sovRegistry(uint64(m.PoolId))
 	}
-	if m.Amount != 0 {
-		n += 1 + sovRegistry(uint64(m.Amount))
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
 	if m.CreationTime != 0 {
 		n += 1 + sovRegistry(uint64(m.CreationTime))
 	}
@@ -3753,6 +5945,9 @@ func (m *RedelegationCooldown) Size() (n int) {
 	if m.CreationDate != 0 {
 		n += 1 + sovRegistry(uint64(m.CreationDate))
 	}
+	if m.Index != 0 {
+		n += 1 + sovRegistry(uint64(m.Index))
+	}
 	return n
 }
 
@@ -3797,6 +5992,123 @@ func (m *CommissionChangeQueueState) Size() (n int) {
 	return n
 }
 
+func (m *DelegationSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRegistry(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.KIndex != 0 {
+		n += 1 + sovRegistry(uint64(m.KIndex))
+	}
+	l = len(m.Fraction)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.PendingDelegators != 0 {
+		n += 1 + sovRegistry(uint64(m.PendingDelegators))
+	}
+	return n
+}
+
+func (m *RedelegationQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRegistry(uint64(m.Index))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.FromPoolId != 0 {
+		n += 1 + sovRegistry(uint64(m.FromPoolId))
+	}
+	l = len(m.FromStaker)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.ToPoolId != 0 {
+		n += 1 + sovRegistry(uint64(m.ToPoolId))
+	}
+	l = len(m.ToStaker)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	if m.CreationTime != 0 {
+		n += 1 + sovRegistry(uint64(m.CreationTime))
+	}
+	return n
+}
+
+func (m *RedelegationQueueState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LowIndex != 0 {
+		n += 1 + sovRegistry(uint64(m.LowIndex))
+	}
+	if m.HighIndex != 0 {
+		n += 1 + sovRegistry(uint64(m.HighIndex))
+	}
+	return n
+}
+
+func (m *AutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovRegistry(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
 func sovRegistry(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3813,28 +6125,2666 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRegistry
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotersAbstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotersAbstain = append(m.VotersAbstain, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
+			}
+			m.StorageProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageProviderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataItemCount", wireType)
+			}
+			m.DataItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataItemCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedByteSize", wireType)
+			}
+			m.UncompressedByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploaderAssignedAt", wireType)
+			}
+			m.UploaderAssignedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploaderAssignedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Protocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpgrade", wireType)
+			}
+			m.LastUpgrade = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpgrade |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Test", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Test = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionEnforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VersionEnforced = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBinaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBinaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackAt", wireType)
+			}
+			m.RollbackAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollbackAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackTotalBundles", wireType)
+			}
+			m.RollbackTotalBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollbackTotalBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+			}
+			m.RollbackWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollbackWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+			}
+			m.RollbackWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollbackWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canary = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationPoolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationPoolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexK", wireType)
+			}
+			m.LatestIndexK = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestIndexK |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+			}
+			m.DelegatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexWasUndelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestIndexWasUndelegation = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRemainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRemainder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+			}
+			m.KIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Funder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DayStart", wireType)
+			}
+			m.DayStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DayStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentToday", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpentToday.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFunding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFunding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFunding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Runtime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundles", wireType)
+			}
+			m.TotalBundles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBundles |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundleRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBundleRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			}
+			m.UploadInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatingCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestFunder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BundleProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowestFunder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3862,11 +8812,13 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalFunds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextUploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3894,11 +8846,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextUploader = string(dAtA[iNdEx:postIndex])
+			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestStaker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3926,13 +8878,13 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.LowestStaker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
 			}
-			m.ByteSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -3942,16 +8894,31 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ByteSize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.FromHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -3961,16 +8928,31 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.ToHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -3980,16 +8962,33 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BundleProposal == nil {
+				m.BundleProposal = &BundleProposal{}
+			}
+			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
 			}
-			m.CreatedAt = 0
+			m.MaxBundleSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -3999,16 +8998,16 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= uint64(b&0x7F) << shift
+				m.MaxBundleSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersValid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4018,29 +9017,33 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersValid = append(m.VotersValid, string(dAtA[iNdEx:postIndex]))
+			if m.Protocol == nil {
+				m.Protocol = &Protocol{}
+			}
+			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersInvalid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4050,27 +9053,31 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersInvalid = append(m.VotersInvalid, string(dAtA[iNdEx:postIndex]))
+			if m.UpgradePlan == nil {
+				m.UpgradePlan = &UpgradePlan{}
+			}
+			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotersAbstain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4098,11 +9105,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VotersAbstain = append(m.VotersAbstain, string(dAtA[iNdEx:postIndex]))
+			m.StartKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4130,11 +9137,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
+			m.CurrentKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4162,11 +9169,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToValue = string(dAtA[iNdEx:postIndex])
+			m.CurrentValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveStakers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4194,61 +9201,11 @@ func (m *BundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Protocol) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InactiveStakers = append(m.InactiveStakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInactiveStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4276,11 +9233,13 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalInactiveStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4308,13 +9267,15 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 33:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.LastUpgrade = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4324,16 +9285,35 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpgrade |= uint64(b&0x7F) << shift
+				m.Status |= PoolStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineDepth", wireType)
+			}
+			m.PipelineDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Test", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelinedProposals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4343,79 +9323,31 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Test = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
+			m.PipelinedProposals = append(m.PipelinedProposals, &BundleProposal{})
+			if err := m.PipelinedProposals[len(m.PipelinedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradePlan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			var stringLen uint64
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4425,27 +9357,14 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StorageProviderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCompressions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4473,13 +9392,13 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Binaries = string(dAtA[iNdEx:postIndex])
+			m.AllowedCompressions = append(m.AllowedCompressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 38:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeUncompressedSize", wireType)
 			}
-			m.ScheduledAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4489,16 +9408,17 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledAt |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			m.ChargeUncompressedSize = bool(v != 0)
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4508,66 +9428,29 @@ func (m *UpgradePlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationEntries: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationEntries: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EndKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 40:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			m.Id = 0
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4577,14 +9460,14 @@ func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBundleCost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4612,11 +9495,13 @@ func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			if err := m.AverageBundleCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4644,13 +9529,15 @@ func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 43:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Runway", wireType)
 			}
-			m.KIndex = 0
+			m.Runway = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4660,7 +9547,26 @@ func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KIndex |= uint64(b&0x7F) << shift
+				m.Runway |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunwayAlertLevel", wireType)
+			}
+			m.RunwayAlertLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunwayAlertLevel |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4686,7 +9592,7 @@ func (m *DelegationEntries) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4699,44 +9605,25 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationPoolData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationPoolData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4764,13 +9651,13 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.StorageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.CurrentRewards = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4780,16 +9667,16 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentRewards |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
 			}
-			m.TotalDelegation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4799,16 +9686,29 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.LatestIndexK = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4818,16 +9718,16 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestIndexK |= uint64(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			m.DelegatorCount = 0
+			m.ToHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4837,16 +9737,16 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelegatorCount |= uint64(b&0x7F) << shift
+				m.ToHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestIndexWasUndelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
 			}
-			var v int
+			m.FinalizedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4856,63 +9756,12 @@ func (m *DelegationPoolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FinalizedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LatestIndexWasUndelegation = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Delegator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Delegator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -4931,11 +9780,11 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.KIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -4945,33 +9794,27 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.DelegationAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DelegationAmount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4999,11 +9842,11 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5031,63 +9874,32 @@ func (m *Delegator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Funder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Funder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Funder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageProviderId", wireType)
 			}
-			m.PoolId = 0
+			m.StorageProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5097,14 +9909,14 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.StorageProviderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5132,13 +9944,32 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Compression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataItemCount", wireType)
+			}
+			m.DataItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataItemCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedByteSize", wireType)
 			}
-			m.Amount = 0
+			m.UncompressedByteSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5148,7 +9979,7 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.UncompressedByteSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5174,7 +10005,7 @@ func (m *Funder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *StorageProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5197,10 +10028,10 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5224,7 +10055,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5252,11 +10083,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageCost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5284,11 +10115,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.StorageCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Runtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageIdFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5316,11 +10149,61 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Runtime = string(dAtA[iNdEx:postIndex])
+			m.StorageIdFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Runtime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Runtime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Runtime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,11 +10231,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5380,13 +10263,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = string(dAtA[iNdEx:postIndex])
+			m.ConfigSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5396,86 +10279,81 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, RuntimeVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CurrentHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalBytes |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundles", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalBundles = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalBundles |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBundleRewards", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.TotalBundleRewards = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5485,35 +10363,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBundleRewards |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadInterval", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UploadInterval = 0
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5523,35 +10395,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadInterval |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatingCost", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.OperatingCost = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OperatingCost |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		case 15:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, RuntimeBinary{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5561,15 +10429,64 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuntimeBinary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuntimeBinary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuntimeBinary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5597,11 +10514,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funders = append(m.Funders, string(dAtA[iNdEx:postIndex]))
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestFunder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5629,30 +10546,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestFunder = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalFunds", wireType)
-			}
-			m.TotalFunds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalFunds |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 19:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5680,11 +10578,61 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stakers = append(m.Stakers, string(dAtA[iNdEx:postIndex]))
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Staker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Staker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Staker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestStaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5712,32 +10660,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LowestStaker = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
-			}
-			m.TotalStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.TotalDelegation = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5747,16 +10676,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalDelegation |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5766,52 +10695,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BundleProposal == nil {
-				m.BundleProposal = &BundleProposal{}
-			}
-			if err := m.BundleProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBundleSize", wireType)
-			}
-			m.MaxBundleSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxBundleSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5821,33 +10729,31 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Protocol == nil {
-				m.Protocol = &Protocol{}
-			}
-			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5857,31 +10763,27 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpgradePlan == nil {
-				m.UpgradePlan = &UpgradePlan{}
-			}
-			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5909,11 +10811,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5941,11 +10843,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentKey = string(dAtA[iNdEx:postIndex])
+			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5973,13 +10875,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentValue = string(dAtA[iNdEx:postIndex])
+			m.Logo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactiveStakers", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			m.Points = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -5989,29 +10891,35 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Points |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StakerStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.InactiveStakers = append(m.InactiveStakers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 31:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalInactiveStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransfer", wireType)
 			}
-			m.TotalInactiveStake = 0
+			m.LastTransfer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6021,16 +10929,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalInactiveStake |= uint64(b&0x7F) << shift
+				m.LastTransfer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUploaderRoleSkip", wireType)
 			}
-			m.MinStake = 0
+			m.LastUploaderRoleSkip = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6040,16 +10948,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				m.LastUploaderRoleSkip |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 33:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6059,11 +10967,24 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= PoolStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -6085,7 +11006,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6108,15 +11029,34 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingStakingQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingStakingQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6144,9 +11084,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -6165,9 +11105,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6195,13 +11135,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			m.FromHeight = 0
+			m.CreationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6211,35 +11153,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
+				m.CreationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ToHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedAt", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedAt = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6249,16 +11222,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6268,14 +11254,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6303,13 +11289,65 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStakingQueueState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStakingQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
 			}
-			var stringLen uint64
+			m.LowIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6319,29 +11357,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
 			}
-			var stringLen uint64
+			m.HighIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6351,24 +11376,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HighIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -6390,7 +11402,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Staker) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6413,15 +11425,34 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Staker: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Staker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6449,13 +11480,13 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6465,35 +11496,29 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.UnbondingAmount = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6503,14 +11528,14 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingAmount |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6538,13 +11563,15 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			var stringLen uint64
+			m.CreationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6554,29 +11581,66 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingDelegationQueueState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingDelegationQueueState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingDelegationQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
+			}
+			m.LowIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6586,27 +11650,83 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.HighIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationCooldown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6634,13 +11754,13 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logo = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
 			}
-			m.Points = 0
+			m.CreationDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6650,16 +11770,16 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Points |= uint64(b&0x7F) << shift
+				m.CreationDate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Status = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6669,7 +11789,7 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= StakerStatus(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6695,7 +11815,7 @@ func (m *Staker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6718,10 +11838,10 @@ func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingStakingQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionChangeQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingStakingQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionChangeQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6795,10 +11915,10 @@ func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6808,16 +11928,29 @@ func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
 			}
-			m.CreationTime = 0
+			m.CreationDate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6827,7 +11960,7 @@ func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationTime |= uint64(b&0x7F) << shift
+				m.CreationDate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6853,7 +11986,7 @@ func (m *UnbondingStakingQueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
+func (m *CommissionChangeQueueState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6876,49 +12009,17 @@ func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingStaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionChangeQueueState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionChangeQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
 			}
-			m.PoolId = 0
+			m.LowIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6928,16 +12029,16 @@ func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.LowIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
 			}
-			m.UnbondingAmount = 0
+			m.HighIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -6947,7 +12048,7 @@ func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingAmount |= uint64(b&0x7F) << shift
+				m.HighIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6973,7 +12074,7 @@ func (m *UnbondingStaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
+func (m *DelegationSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6996,17 +12097,17 @@ func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingStakingQueueState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingStakingQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.LowIndex = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7016,16 +12117,48 @@ func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowIndex |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KIndex", wireType)
 			}
-			m.HighIndex = 0
+			m.KIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7035,7 +12168,90 @@ func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighIndex |= uint64(b&0x7F) << shift
+				m.KIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDelegators", wireType)
+			}
+			m.PendingDelegators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingDelegators |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7061,7 +12277,7 @@ func (m *UnbondingStakingQueueState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *RedelegationQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7084,10 +12300,10 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7111,7 +12327,7 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7139,13 +12355,13 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPoolId", wireType)
 			}
-			var stringLen uint64
+			m.FromPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7155,29 +12371,16 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FromPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStaker", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7187,16 +12390,29 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPoolId", wireType)
 			}
-			m.Amount = 0
+			m.ToPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7206,16 +12422,16 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.ToPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStaker", wireType)
 			}
-			m.CreationTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7225,66 +12441,29 @@ func (m *UnbondingDelegationQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationTime |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnbondingDelegationQueueState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRegistry
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationQueueState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
+			m.ToStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.LowIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7294,16 +12473,31 @@ func (m *UnbondingDelegationQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
 			}
-			m.HighIndex = 0
+			m.CreationTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7313,7 +12507,7 @@ func (m *UnbondingDelegationQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighIndex |= uint64(b&0x7F) << shift
+				m.CreationTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7339,7 +12533,7 @@ func (m *UnbondingDelegationQueueState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
+func (m *RedelegationQueueState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7362,17 +12556,17 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationCooldown: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationQueueState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationCooldown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
 			}
-			var stringLen uint64
+			m.LowIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7382,29 +12576,16 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRegistry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
 			}
-			m.CreationDate = 0
+			m.HighIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7414,7 +12595,7 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationDate |= uint64(b&0x7F) << shift
+				m.HighIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7440,7 +12621,7 @@ func (m *RedelegationCooldown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
+func (m *AutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7463,17 +12644,17 @@ func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionChangeQueueEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionChangeQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Index = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7483,7 +12664,7 @@ func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7521,27 +12702,8 @@ func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 			m.Staker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7569,27 +12731,8 @@ func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationDate", wireType)
-			}
-			m.CreationDate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationDate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -7611,7 +12754,7 @@ func (m *CommissionChangeQueueEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionChangeQueueState) Unmarshal(dAtA []byte) error {
+func (m *WithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7634,17 +12777,17 @@ func (m *CommissionChangeQueueState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionChangeQueueState: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionChangeQueueState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.LowIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7654,16 +12797,29 @@ func (m *CommissionChangeQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
-			m.HighIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -7673,11 +12829,24 @@ func (m *CommissionChangeQueueState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetVersion returns the given version of the runtime.
+func (r Runtime) GetVersion(version string) (RuntimeVersion, bool) {
+	for _, v := range r.Versions {
+		if v.Version == version {
+			return v, true
+		}
+	}
+
+	return RuntimeVersion{}, false
+}
+
+// EncodeBinaries encodes the binaries of a runtime version in the legacy binaries format of the pool protocol,
+// a JSON object with the download URL per platform and the checksum appended as query parameter.
+func (v RuntimeVersion) EncodeBinaries() string {
+	binaries := make(map[string]string)
+
+	for _, binary := range v.Binaries {
+		binaryUrl, err := url.Parse(binary.Url)
+		if err != nil {
+			continue
+		}
+
+		query := binaryUrl.Query()
+		query.Set("checksum", binary.Sha256)
+		binaryUrl.RawQuery = query.Encode()
+
+		binaries[binary.Platform] = binaryUrl.String()
+	}
+
+	// Map keys are sorted by the encoder, so the result is deterministic.
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(binaries)
+
+	return strings.TrimSpace(buf.String())
+}
+
+// ValidateRuntimeVersion checks that a version has a name and only valid binaries with at most one per platform.
+func ValidateRuntimeVersion(version string, binaries []RuntimeBinary) error {
+	if version == "" {
+		return fmt.Errorf("runtime version can not be empty")
+	}
+
+	if len(binaries) == 0 {
+		return fmt.Errorf("runtime version %v needs at least one binary", version)
+	}
+
+	platforms := make(map[string]bool)
+
+	for _, binary := range binaries {
+		if binary.Platform == "" {
+			return fmt.Errorf("binary platform can not be empty")
+		}
+
+		if platforms[binary.Platform] {
+			return fmt.Errorf("duplicated binary for platform %v", binary.Platform)
+		}
+		platforms[binary.Platform] = true
+
+		binaryUrl, err := url.Parse(binary.Url)
+		if err != nil || (binaryUrl.Scheme != "https" && binaryUrl.Scheme != "http") || binaryUrl.Host == "" {
+			return fmt.Errorf("invalid binary url %v for platform %v", binary.Url, binary.Platform)
+		}
+
+		checksum, err := hex.DecodeString(binary.Sha256)
+		if err != nil || len(checksum) != 32 || hex.EncodeToString(checksum) != binary.Sha256 {
+			return fmt.Errorf("invalid sha256 checksum %v for platform %v", binary.Sha256, binary.Platform)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"regexp"
+	"sync"
+)
+
+// storageIdFormats caches the compiled storage id formats of all storage providers.
+var storageIdFormats sync.Map
+
+// CompileStorageIdFormat compiles the storage id format of a storage provider.
+// Every format is only compiled once, as all bundle proposals are checked against it.
+func CompileStorageIdFormat(storageIdFormat string) (*regexp.Regexp, error) {
+	if cached, ok := storageIdFormats.Load(storageIdFormat); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	compiled, err := regexp.Compile(storageIdFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	storageIdFormats.Store(storageIdFormat, compiled)
+
+	return compiled, nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -71,7 +72,7 @@ type MsgFundPool struct {
 	// id ...
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgFundPool) Reset()         { *m = MsgFundPool{} }
@@ -121,13 +122,6 @@ func (m *MsgFundPool) GetId() uint64 {
 	return 0
 }
 
-func (m *MsgFundPool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgFundPoolResponse defines the Msg/FundPool response type.
 type MsgFundPoolResponse struct {
 }
@@ -172,7 +166,7 @@ type MsgDefundPool struct {
 	// id ...
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgDefundPool) Reset()         { *m = MsgDefundPool{} }
@@ -222,13 +216,6 @@ func (m *MsgDefundPool) GetId() uint64 {
 	return 0
 }
 
-func (m *MsgDefundPool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgDefundPoolResponse defines the Msg/DefundPool response type.
 type MsgDefundPoolResponse struct {
 }
@@ -266,6 +253,214 @@ func (m *MsgDefundPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDefundPoolResponse proto.InternalMessageInfo
 
+// MsgCreateFundingStream defines a SDK message for funding a pool with spending limits.
+type MsgCreateFundingStream struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount is the total budget of the funding stream.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// amount_per_bundle is the maximum amount spent on a single bundle, zero means no limit.
+	AmountPerBundle github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount_per_bundle,json=amountPerBundle,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_per_bundle"`
+	// amount_per_day is the maximum amount spent within a day, zero means no limit.
+	AmountPerDay github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount_per_day,json=amountPerDay,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount_per_day"`
+	// end_time is the unix time the unspent budget is returned, zero means no end.
+	EndTime uint64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *MsgCreateFundingStream) Reset()         { *m = MsgCreateFundingStream{} }
+func (m *MsgCreateFundingStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingStream) ProtoMessage()    {}
+func (*MsgCreateFundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{4}
+}
+func (m *MsgCreateFundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingStream.Merge(m, src)
+}
+func (m *MsgCreateFundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingStream proto.InternalMessageInfo
+
+func (m *MsgCreateFundingStream) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateFundingStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgCreateFundingStream) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// MsgCreateFundingStreamResponse defines the Msg/CreateFundingStream response type.
+type MsgCreateFundingStreamResponse struct {
+}
+
+func (m *MsgCreateFundingStreamResponse) Reset()         { *m = MsgCreateFundingStreamResponse{} }
+func (m *MsgCreateFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingStreamResponse) ProtoMessage()    {}
+func (*MsgCreateFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{5}
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingStreamResponse.Merge(m, src)
+}
+func (m *MsgCreateFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingStreamResponse proto.InternalMessageInfo
+
+// MsgCloseFundingStream defines a SDK message for closing a funding stream and returning the unspent budget.
+type MsgCloseFundingStream struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// stream_id ...
+	StreamId uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCloseFundingStream) Reset()         { *m = MsgCloseFundingStream{} }
+func (m *MsgCloseFundingStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseFundingStream) ProtoMessage()    {}
+func (*MsgCloseFundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{6}
+}
+func (m *MsgCloseFundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseFundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseFundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseFundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseFundingStream.Merge(m, src)
+}
+func (m *MsgCloseFundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseFundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseFundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseFundingStream proto.InternalMessageInfo
+
+func (m *MsgCloseFundingStream) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCloseFundingStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgCloseFundingStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// MsgCloseFundingStreamResponse defines the Msg/CloseFundingStream response type.
+type MsgCloseFundingStreamResponse struct {
+}
+
+func (m *MsgCloseFundingStreamResponse) Reset()         { *m = MsgCloseFundingStreamResponse{} }
+func (m *MsgCloseFundingStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseFundingStreamResponse) ProtoMessage()    {}
+func (*MsgCloseFundingStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{7}
+}
+func (m *MsgCloseFundingStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseFundingStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseFundingStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseFundingStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseFundingStreamResponse.Merge(m, src)
+}
+func (m *MsgCloseFundingStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseFundingStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseFundingStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseFundingStreamResponse proto.InternalMessageInfo
+
 // MsgStakePool defines a SDK message for staking in a pool.
 type MsgStakePool struct {
 	// creator ...
@@ -273,14 +468,14 @@ type MsgStakePool struct {
 	// id ...
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgStakePool) Reset()         { *m = MsgStakePool{} }
 func (m *MsgStakePool) String() string { return proto.CompactTextString(m) }
 func (*MsgStakePool) ProtoMessage()    {}
 func (*MsgStakePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{4}
+	return fileDescriptor_035c8e351cd389d1, []int{8}
 }
 func (m *MsgStakePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,13 +518,6 @@ func (m *MsgStakePool) GetId() uint64 {
 	return 0
 }
 
-func (m *MsgStakePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgStakePoolResponse defines the Msg/StakePool response type.
 type MsgStakePoolResponse struct {
 }
@@ -338,7 +526,7 @@ func (m *MsgStakePoolResponse) Reset()         { *m = MsgStakePoolResponse{} }
 func (m *MsgStakePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakePoolResponse) ProtoMessage()    {}
 func (*MsgStakePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{5}
+	return fileDescriptor_035c8e351cd389d1, []int{9}
 }
 func (m *MsgStakePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +567,7 @@ func (m *MsgReactivateStaker) Reset()         { *m = MsgReactivateStaker{} }
 func (m *MsgReactivateStaker) String() string { return proto.CompactTextString(m) }
 func (*MsgReactivateStaker) ProtoMessage()    {}
 func (*MsgReactivateStaker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{6}
+	return fileDescriptor_035c8e351cd389d1, []int{10}
 }
 func (m *MsgReactivateStaker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +618,7 @@ func (m *MsgReactivateStakerResponse) Reset()         { *m = MsgReactivateStaker
 func (m *MsgReactivateStakerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReactivateStakerResponse) ProtoMessage()    {}
 func (*MsgReactivateStakerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{7}
+	return fileDescriptor_035c8e351cd389d1, []int{11}
 }
 func (m *MsgReactivateStakerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,14 +654,14 @@ type MsgUnstakePool struct {
 	// id ...
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgUnstakePool) Reset()         { *m = MsgUnstakePool{} }
 func (m *MsgUnstakePool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakePool) ProtoMessage()    {}
 func (*MsgUnstakePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{8}
+	return fileDescriptor_035c8e351cd389d1, []int{12}
 }
 func (m *MsgUnstakePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,13 +704,6 @@ func (m *MsgUnstakePool) GetId() uint64 {
 	return 0
 }
 
-func (m *MsgUnstakePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgUnstakePoolResponse defines the Msg/UnstakePool response type.
 type MsgUnstakePoolResponse struct {
 }
@@ -531,7 +712,7 @@ func (m *MsgUnstakePoolResponse) Reset()         { *m = MsgUnstakePoolResponse{}
 func (m *MsgUnstakePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakePoolResponse) ProtoMessage()    {}
 func (*MsgUnstakePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{9}
+	return fileDescriptor_035c8e351cd389d1, []int{13}
 }
 func (m *MsgUnstakePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,6 +741,108 @@ func (m *MsgUnstakePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnstakePoolResponse proto.InternalMessageInfo
 
+// MsgTransferStaker defines a SDK message for moving a staking position
+// including all of its delegations to a new account.
+type MsgTransferStaker struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// new_staker is the account which takes over the staking position.
+	NewStaker string `protobuf:"bytes,3,opt,name=new_staker,json=newStaker,proto3" json:"new_staker,omitempty"`
+}
+
+func (m *MsgTransferStaker) Reset()         { *m = MsgTransferStaker{} }
+func (m *MsgTransferStaker) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStaker) ProtoMessage()    {}
+func (*MsgTransferStaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{14}
+}
+func (m *MsgTransferStaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferStaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStaker.Merge(m, src)
+}
+func (m *MsgTransferStaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferStaker proto.InternalMessageInfo
+
+func (m *MsgTransferStaker) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferStaker) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgTransferStaker) GetNewStaker() string {
+	if m != nil {
+		return m.NewStaker
+	}
+	return ""
+}
+
+// MsgTransferStakerResponse defines the Msg/TransferStaker response type.
+type MsgTransferStakerResponse struct {
+}
+
+func (m *MsgTransferStakerResponse) Reset()         { *m = MsgTransferStakerResponse{} }
+func (m *MsgTransferStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStakerResponse) ProtoMessage()    {}
+func (*MsgTransferStakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{15}
+}
+func (m *MsgTransferStakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStakerResponse.Merge(m, src)
+}
+func (m *MsgTransferStakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferStakerResponse proto.InternalMessageInfo
+
 // MsgDelegatePool defines a SDK message for delegating to a protocol node in a specific pool.
 type MsgDelegatePool struct {
 	// creator ...
@@ -569,14 +852,14 @@ type MsgDelegatePool struct {
 	// staker ...
 	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgDelegatePool) Reset()         { *m = MsgDelegatePool{} }
 func (m *MsgDelegatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegatePool) ProtoMessage()    {}
 func (*MsgDelegatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{10}
+	return fileDescriptor_035c8e351cd389d1, []int{16}
 }
 func (m *MsgDelegatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,13 +909,6 @@ func (m *MsgDelegatePool) GetStaker() string {
 	return ""
 }
 
-func (m *MsgDelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgDelegatePoolResponse defines the Msg/DelegatePool response type.
 type MsgDelegatePoolResponse struct {
 }
@@ -641,7 +917,7 @@ func (m *MsgDelegatePoolResponse) Reset()         { *m = MsgDelegatePoolResponse
 func (m *MsgDelegatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegatePoolResponse) ProtoMessage()    {}
 func (*MsgDelegatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{11}
+	return fileDescriptor_035c8e351cd389d1, []int{17}
 }
 func (m *MsgDelegatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +960,7 @@ func (m *MsgWithdrawPool) Reset()         { *m = MsgWithdrawPool{} }
 func (m *MsgWithdrawPool) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawPool) ProtoMessage()    {}
 func (*MsgWithdrawPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{12}
+	return fileDescriptor_035c8e351cd389d1, []int{18}
 }
 func (m *MsgWithdrawPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +1018,7 @@ func (m *MsgWithdrawPoolResponse) Reset()         { *m = MsgWithdrawPoolResponse
 func (m *MsgWithdrawPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawPoolResponse) ProtoMessage()    {}
 func (*MsgWithdrawPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{13}
+	return fileDescriptor_035c8e351cd389d1, []int{19}
 }
 func (m *MsgWithdrawPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,14 +1056,14 @@ type MsgUndelegatePool struct {
 	// staker ...
 	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgUndelegatePool) Reset()         { *m = MsgUndelegatePool{} }
 func (m *MsgUndelegatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegatePool) ProtoMessage()    {}
 func (*MsgUndelegatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{14}
+	return fileDescriptor_035c8e351cd389d1, []int{20}
 }
 func (m *MsgUndelegatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,13 +1113,6 @@ func (m *MsgUndelegatePool) GetStaker() string {
 	return ""
 }
 
-func (m *MsgUndelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgUndelegatePoolResponse defines the Msg/UndelegatePool response type.
 type MsgUndelegatePoolResponse struct {
 }
@@ -852,7 +1121,7 @@ func (m *MsgUndelegatePoolResponse) Reset()         { *m = MsgUndelegatePoolResp
 func (m *MsgUndelegatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUndelegatePoolResponse) ProtoMessage()    {}
 func (*MsgUndelegatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{15}
+	return fileDescriptor_035c8e351cd389d1, []int{21}
 }
 func (m *MsgUndelegatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,14 +1164,14 @@ type MsgRedelegatePool struct {
 	// staker ...
 	ToStaker string `protobuf:"bytes,5,opt,name=to_staker,json=toStaker,proto3" json:"to_staker,omitempty"`
 	// amount ...
-	Amount uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
 func (m *MsgRedelegatePool) Reset()         { *m = MsgRedelegatePool{} }
 func (m *MsgRedelegatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgRedelegatePool) ProtoMessage()    {}
 func (*MsgRedelegatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{16}
+	return fileDescriptor_035c8e351cd389d1, []int{22}
 }
 func (m *MsgRedelegatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,13 +1235,6 @@ func (m *MsgRedelegatePool) GetToStaker() string {
 	return ""
 }
 
-func (m *MsgRedelegatePool) GetAmount() uint64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
 // MsgUndelegatePoolResponse defines the Msg/UndelegatePool response type.
 type MsgRedelegatePoolResponse struct {
 }
@@ -981,7 +1243,7 @@ func (m *MsgRedelegatePoolResponse) Reset()         { *m = MsgRedelegatePoolResp
 func (m *MsgRedelegatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRedelegatePoolResponse) ProtoMessage()    {}
 func (*MsgRedelegatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{17}
+	return fileDescriptor_035c8e351cd389d1, []int{23}
 }
 func (m *MsgRedelegatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1272,327 @@ func (m *MsgRedelegatePoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedelegatePoolResponse proto.InternalMessageInfo
 
-// MsgSubmitBundleProposal defines a SDK message for submitting a bundle proposal.
+// MsgSetAutoCompound defines a SDK message for enabling or disabling
+// the automatic compounding of the rewards of a delegation.
+type MsgSetAutoCompound struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pool_id ...
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// staker ...
+	Staker string `protobuf:"bytes,3,opt,name=staker,proto3" json:"staker,omitempty"`
+	// enabled ...
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{24}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSetAutoCompound) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetAutoCompoundResponse defines the Msg/SetAutoCompound response type.
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{25}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgSetWithdrawAddress defines a SDK message for changing the account which
+// receives the rewards and unbonded tokens of the creator as staker and as delegator.
+type MsgSetWithdrawAddress struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// withdraw_address ...
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetWithdrawAddress) Reset()         { *m = MsgSetWithdrawAddress{} }
+func (m *MsgSetWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{26}
+}
+func (m *MsgSetWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetWithdrawAddress) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// MsgSetWithdrawAddressResponse defines the Msg/SetWithdrawAddress response type.
+type MsgSetWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetWithdrawAddressResponse) Reset()         { *m = MsgSetWithdrawAddressResponse{} }
+func (m *MsgSetWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{27}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgWithdrawAllRewards defines a SDK message for withdrawing the rewards
+// of all delegations of the creator at once.
+type MsgWithdrawAllRewards struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// limit is the maximum number of delegations which are withdrawn.
+	// If zero or larger than the max_withdraw_all_positions param, the param is used.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// start_key is the next_key of a previous response to continue with the following delegations.
+	// If empty, the withdrawal starts with the first delegation.
+	StartKey []byte `protobuf:"bytes,3,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+}
+
+func (m *MsgWithdrawAllRewards) Reset()         { *m = MsgWithdrawAllRewards{} }
+func (m *MsgWithdrawAllRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{28}
+}
+func (m *MsgWithdrawAllRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllRewards) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgWithdrawAllRewards) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *MsgWithdrawAllRewards) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+// MsgWithdrawAllRewardsResponse defines the Msg/WithdrawAllRewards response type.
+type MsgWithdrawAllRewardsResponse struct {
+	// amount is the sum of all withdrawn rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// next_key is the start_key for withdrawing the remaining delegations.
+	// It is empty if all delegations were withdrawn.
+	NextKey []byte `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *MsgWithdrawAllRewardsResponse) Reset()         { *m = MsgWithdrawAllRewardsResponse{} }
+func (m *MsgWithdrawAllRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{29}
+}
+func (m *MsgWithdrawAllRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllRewardsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+// MsgSubmitBundleProposal defines a SDK message for submitting a bundle proposal.
 type MsgSubmitBundleProposal struct {
 	// creator ...
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -1032,13 +1614,19 @@ type MsgSubmitBundleProposal struct {
 	ToValue string `protobuf:"bytes,9,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
 	// bundle_hash ...
 	BundleHash string `protobuf:"bytes,10,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
+	// compression is the compression algorithm of the bundle, e.g. none or gzip.
+	Compression string `protobuf:"bytes,11,opt,name=compression,proto3" json:"compression,omitempty"`
+	// data_item_count is the amount of data items in the bundle.
+	DataItemCount uint64 `protobuf:"varint,12,opt,name=data_item_count,json=dataItemCount,proto3" json:"data_item_count,omitempty"`
+	// uncompressed_byte_size is the size of the bundle in bytes before compression.
+	UncompressedByteSize uint64 `protobuf:"varint,13,opt,name=uncompressed_byte_size,json=uncompressedByteSize,proto3" json:"uncompressed_byte_size,omitempty"`
 }
 
 func (m *MsgSubmitBundleProposal) Reset()         { *m = MsgSubmitBundleProposal{} }
 func (m *MsgSubmitBundleProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBundleProposal) ProtoMessage()    {}
 func (*MsgSubmitBundleProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{18}
+	return fileDescriptor_035c8e351cd389d1, []int{30}
 }
 func (m *MsgSubmitBundleProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,6 +1725,27 @@ func (m *MsgSubmitBundleProposal) GetBundleHash() string {
 	return ""
 }
 
+func (m *MsgSubmitBundleProposal) GetCompression() string {
+	if m != nil {
+		return m.Compression
+	}
+	return ""
+}
+
+func (m *MsgSubmitBundleProposal) GetDataItemCount() uint64 {
+	if m != nil {
+		return m.DataItemCount
+	}
+	return 0
+}
+
+func (m *MsgSubmitBundleProposal) GetUncompressedByteSize() uint64 {
+	if m != nil {
+		return m.UncompressedByteSize
+	}
+	return 0
+}
+
 // MsgSubmitBundleProposalResponse defines the Msg/SubmitBundleProposal response type.
 type MsgSubmitBundleProposalResponse struct {
 }
@@ -1145,7 +1754,7 @@ func (m *MsgSubmitBundleProposalResponse) Reset()         { *m = MsgSubmitBundle
 func (m *MsgSubmitBundleProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBundleProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitBundleProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{19}
+	return fileDescriptor_035c8e351cd389d1, []int{31}
 }
 func (m *MsgSubmitBundleProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,7 +1799,7 @@ func (m *MsgVoteProposal) Reset()         { *m = MsgVoteProposal{} }
 func (m *MsgVoteProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteProposal) ProtoMessage()    {}
 func (*MsgVoteProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{20}
+	return fileDescriptor_035c8e351cd389d1, []int{32}
 }
 func (m *MsgVoteProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1864,7 @@ func (m *MsgVoteProposalResponse) Reset()         { *m = MsgVoteProposalResponse
 func (m *MsgVoteProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteProposalResponse) ProtoMessage()    {}
 func (*MsgVoteProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{21}
+	return fileDescriptor_035c8e351cd389d1, []int{33}
 }
 func (m *MsgVoteProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1905,7 @@ func (m *MsgClaimUploaderRole) Reset()         { *m = MsgClaimUploaderRole{} }
 func (m *MsgClaimUploaderRole) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUploaderRole) ProtoMessage()    {}
 func (*MsgClaimUploaderRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{22}
+	return fileDescriptor_035c8e351cd389d1, []int{34}
 }
 func (m *MsgClaimUploaderRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1347,7 +1956,7 @@ func (m *MsgClaimUploaderRoleResponse) Reset()         { *m = MsgClaimUploaderRo
 func (m *MsgClaimUploaderRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUploaderRoleResponse) ProtoMessage()    {}
 func (*MsgClaimUploaderRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{23}
+	return fileDescriptor_035c8e351cd389d1, []int{35}
 }
 func (m *MsgClaimUploaderRoleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1376,32 +1985,28 @@ func (m *MsgClaimUploaderRoleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimUploaderRoleResponse proto.InternalMessageInfo
 
-// MsgUpdateMetadata defines a SDK message for claiming the uploader role.
-type MsgUpdateMetadata struct {
+// MsgSkipUploaderRole defines a SDK message for handing the uploader role to another staker.
+type MsgSkipUploaderRole struct {
 	// creator ...
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	// id ...
 	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	// moniker ...
-	Moniker string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
-	// website ...
-	Website string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	// logo
-	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+	// from_height is the height from where the next bundle proposal would resume.
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
-func (m *MsgUpdateMetadata) Reset()         { *m = MsgUpdateMetadata{} }
-func (m *MsgUpdateMetadata) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateMetadata) ProtoMessage()    {}
-func (*MsgUpdateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{24}
+func (m *MsgSkipUploaderRole) Reset()         { *m = MsgSkipUploaderRole{} }
+func (m *MsgSkipUploaderRole) String() string { return proto.CompactTextString(m) }
+func (*MsgSkipUploaderRole) ProtoMessage()    {}
+func (*MsgSkipUploaderRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{36}
 }
-func (m *MsgUpdateMetadata) XXX_Unmarshal(b []byte) error {
+func (m *MsgSkipUploaderRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSkipUploaderRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSkipUploaderRole.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1411,54 +2016,260 @@ func (m *MsgUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateMetadata.Merge(m, src)
+func (m *MsgSkipUploaderRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSkipUploaderRole.Merge(m, src)
 }
-func (m *MsgUpdateMetadata) XXX_Size() int {
+func (m *MsgSkipUploaderRole) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateMetadata.DiscardUnknown(m)
+func (m *MsgSkipUploaderRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSkipUploaderRole.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateMetadata proto.InternalMessageInfo
+var xxx_messageInfo_MsgSkipUploaderRole proto.InternalMessageInfo
 
-func (m *MsgUpdateMetadata) GetCreator() string {
+func (m *MsgSkipUploaderRole) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateMetadata) GetId() uint64 {
+func (m *MsgSkipUploaderRole) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *MsgUpdateMetadata) GetMoniker() string {
+func (m *MsgSkipUploaderRole) GetFromHeight() uint64 {
 	if m != nil {
-		return m.Moniker
+		return m.FromHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateMetadata) GetWebsite() string {
-	if m != nil {
-		return m.Website
-	}
-	return ""
+// MsgSkipUploaderRoleResponse defines the Msg/SkipUploaderRole response type.
+type MsgSkipUploaderRoleResponse struct {
 }
 
-func (m *MsgUpdateMetadata) GetLogo() string {
-	if m != nil {
-		return m.Logo
-	}
-	return ""
+func (m *MsgSkipUploaderRoleResponse) Reset()         { *m = MsgSkipUploaderRoleResponse{} }
+func (m *MsgSkipUploaderRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSkipUploaderRoleResponse) ProtoMessage()    {}
+func (*MsgSkipUploaderRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{37}
 }
-
-// MsgUpdateMetadataResponse defines the Msg/MsgUpdateMetadata response type.
+func (m *MsgSkipUploaderRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSkipUploaderRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSkipUploaderRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSkipUploaderRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSkipUploaderRoleResponse.Merge(m, src)
+}
+func (m *MsgSkipUploaderRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSkipUploaderRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSkipUploaderRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSkipUploaderRoleResponse proto.InternalMessageInfo
+
+// MsgAttestVersion defines a SDK message for attesting the protocol node version a staker runs.
+type MsgAttestVersion struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// version is the semantic version of the protocol node binary.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *MsgAttestVersion) Reset()         { *m = MsgAttestVersion{} }
+func (m *MsgAttestVersion) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestVersion) ProtoMessage()    {}
+func (*MsgAttestVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{38}
+}
+func (m *MsgAttestVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestVersion.Merge(m, src)
+}
+func (m *MsgAttestVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestVersion proto.InternalMessageInfo
+
+func (m *MsgAttestVersion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAttestVersion) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgAttestVersion) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// MsgAttestVersionResponse defines the Msg/AttestVersion response type.
+type MsgAttestVersionResponse struct {
+}
+
+func (m *MsgAttestVersionResponse) Reset()         { *m = MsgAttestVersionResponse{} }
+func (m *MsgAttestVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestVersionResponse) ProtoMessage()    {}
+func (*MsgAttestVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{39}
+}
+func (m *MsgAttestVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestVersionResponse.Merge(m, src)
+}
+func (m *MsgAttestVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestVersionResponse proto.InternalMessageInfo
+
+// MsgUpdateMetadata defines a SDK message for claiming the uploader role.
+type MsgUpdateMetadata struct {
+	// creator ...
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id ...
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// moniker ...
+	Moniker string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// website ...
+	Website string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	// logo
+	Logo string `protobuf:"bytes,5,opt,name=logo,proto3" json:"logo,omitempty"`
+}
+
+func (m *MsgUpdateMetadata) Reset()         { *m = MsgUpdateMetadata{} }
+func (m *MsgUpdateMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMetadata) ProtoMessage()    {}
+func (*MsgUpdateMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_035c8e351cd389d1, []int{40}
+}
+func (m *MsgUpdateMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMetadata.Merge(m, src)
+}
+func (m *MsgUpdateMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateMetadata) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateMetadata) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpdateMetadata) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *MsgUpdateMetadata) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *MsgUpdateMetadata) GetLogo() string {
+	if m != nil {
+		return m.Logo
+	}
+	return ""
+}
+
+// MsgUpdateMetadataResponse defines the Msg/MsgUpdateMetadata response type.
 type MsgUpdateMetadataResponse struct {
 }
 
@@ -1466,7 +2277,7 @@ func (m *MsgUpdateMetadataResponse) Reset()         { *m = MsgUpdateMetadataResp
 func (m *MsgUpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMetadataResponse) ProtoMessage()    {}
 func (*MsgUpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{25}
+	return fileDescriptor_035c8e351cd389d1, []int{41}
 }
 func (m *MsgUpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,7 +2320,7 @@ func (m *MsgUpdateCommission) Reset()         { *m = MsgUpdateCommission{} }
 func (m *MsgUpdateCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCommission) ProtoMessage()    {}
 func (*MsgUpdateCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{26}
+	return fileDescriptor_035c8e351cd389d1, []int{42}
 }
 func (m *MsgUpdateCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +2378,7 @@ func (m *MsgUpdateCommissionResponse) Reset()         { *m = MsgUpdateCommission
 func (m *MsgUpdateCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCommissionResponse) ProtoMessage()    {}
 func (*MsgUpdateCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_035c8e351cd389d1, []int{27}
+	return fileDescriptor_035c8e351cd389d1, []int{43}
 }
 func (m *MsgUpdateCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1602,12 +2413,18 @@ func init() {
 	proto.RegisterType((*MsgFundPoolResponse)(nil), "kyve.registry.v1beta1.MsgFundPoolResponse")
 	proto.RegisterType((*MsgDefundPool)(nil), "kyve.registry.v1beta1.MsgDefundPool")
 	proto.RegisterType((*MsgDefundPoolResponse)(nil), "kyve.registry.v1beta1.MsgDefundPoolResponse")
+	proto.RegisterType((*MsgCreateFundingStream)(nil), "kyve.registry.v1beta1.MsgCreateFundingStream")
+	proto.RegisterType((*MsgCreateFundingStreamResponse)(nil), "kyve.registry.v1beta1.MsgCreateFundingStreamResponse")
+	proto.RegisterType((*MsgCloseFundingStream)(nil), "kyve.registry.v1beta1.MsgCloseFundingStream")
+	proto.RegisterType((*MsgCloseFundingStreamResponse)(nil), "kyve.registry.v1beta1.MsgCloseFundingStreamResponse")
 	proto.RegisterType((*MsgStakePool)(nil), "kyve.registry.v1beta1.MsgStakePool")
 	proto.RegisterType((*MsgStakePoolResponse)(nil), "kyve.registry.v1beta1.MsgStakePoolResponse")
 	proto.RegisterType((*MsgReactivateStaker)(nil), "kyve.registry.v1beta1.MsgReactivateStaker")
 	proto.RegisterType((*MsgReactivateStakerResponse)(nil), "kyve.registry.v1beta1.MsgReactivateStakerResponse")
 	proto.RegisterType((*MsgUnstakePool)(nil), "kyve.registry.v1beta1.MsgUnstakePool")
 	proto.RegisterType((*MsgUnstakePoolResponse)(nil), "kyve.registry.v1beta1.MsgUnstakePoolResponse")
+	proto.RegisterType((*MsgTransferStaker)(nil), "kyve.registry.v1beta1.MsgTransferStaker")
+	proto.RegisterType((*MsgTransferStakerResponse)(nil), "kyve.registry.v1beta1.MsgTransferStakerResponse")
 	proto.RegisterType((*MsgDelegatePool)(nil), "kyve.registry.v1beta1.MsgDelegatePool")
 	proto.RegisterType((*MsgDelegatePoolResponse)(nil), "kyve.registry.v1beta1.MsgDelegatePoolResponse")
 	proto.RegisterType((*MsgWithdrawPool)(nil), "kyve.registry.v1beta1.MsgWithdrawPool")
@@ -1616,12 +2433,22 @@ func init() {
 	proto.RegisterType((*MsgUndelegatePoolResponse)(nil), "kyve.registry.v1beta1.MsgUndelegatePoolResponse")
 	proto.RegisterType((*MsgRedelegatePool)(nil), "kyve.registry.v1beta1.MsgRedelegatePool")
 	proto.RegisterType((*MsgRedelegatePoolResponse)(nil), "kyve.registry.v1beta1.MsgRedelegatePoolResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "kyve.registry.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "kyve.registry.v1beta1.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "kyve.registry.v1beta1.MsgSetWithdrawAddress")
+	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "kyve.registry.v1beta1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgWithdrawAllRewards)(nil), "kyve.registry.v1beta1.MsgWithdrawAllRewards")
+	proto.RegisterType((*MsgWithdrawAllRewardsResponse)(nil), "kyve.registry.v1beta1.MsgWithdrawAllRewardsResponse")
 	proto.RegisterType((*MsgSubmitBundleProposal)(nil), "kyve.registry.v1beta1.MsgSubmitBundleProposal")
 	proto.RegisterType((*MsgSubmitBundleProposalResponse)(nil), "kyve.registry.v1beta1.MsgSubmitBundleProposalResponse")
 	proto.RegisterType((*MsgVoteProposal)(nil), "kyve.registry.v1beta1.MsgVoteProposal")
 	proto.RegisterType((*MsgVoteProposalResponse)(nil), "kyve.registry.v1beta1.MsgVoteProposalResponse")
 	proto.RegisterType((*MsgClaimUploaderRole)(nil), "kyve.registry.v1beta1.MsgClaimUploaderRole")
 	proto.RegisterType((*MsgClaimUploaderRoleResponse)(nil), "kyve.registry.v1beta1.MsgClaimUploaderRoleResponse")
+	proto.RegisterType((*MsgSkipUploaderRole)(nil), "kyve.registry.v1beta1.MsgSkipUploaderRole")
+	proto.RegisterType((*MsgSkipUploaderRoleResponse)(nil), "kyve.registry.v1beta1.MsgSkipUploaderRoleResponse")
+	proto.RegisterType((*MsgAttestVersion)(nil), "kyve.registry.v1beta1.MsgAttestVersion")
+	proto.RegisterType((*MsgAttestVersionResponse)(nil), "kyve.registry.v1beta1.MsgAttestVersionResponse")
 	proto.RegisterType((*MsgUpdateMetadata)(nil), "kyve.registry.v1beta1.MsgUpdateMetadata")
 	proto.RegisterType((*MsgUpdateMetadataResponse)(nil), "kyve.registry.v1beta1.MsgUpdateMetadataResponse")
 	proto.RegisterType((*MsgUpdateCommission)(nil), "kyve.registry.v1beta1.MsgUpdateCommission")
@@ -1631,74 +2458,110 @@ func init() {
 func init() { proto.RegisterFile("kyve/registry/v1beta1/tx.proto", fileDescriptor_035c8e351cd389d1) }
 
 var fileDescriptor_035c8e351cd389d1 = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe2, 0x46,
-	0x14, 0x0f, 0x84, 0x25, 0xf0, 0x92, 0x4d, 0x89, 0x1b, 0x12, 0x70, 0x76, 0x9d, 0x94, 0xfe, 0x51,
-	0x94, 0x76, 0xa1, 0x9b, 0x48, 0x3d, 0x77, 0x93, 0x65, 0x9b, 0x68, 0x45, 0x42, 0x4d, 0x92, 0x2a,
-	0x2b, 0x55, 0x74, 0xc0, 0x13, 0x63, 0x61, 0x18, 0x64, 0x0f, 0x64, 0xd9, 0x43, 0x6f, 0x95, 0x7a,
-	0x6b, 0xbf, 0x43, 0x8f, 0xfd, 0x18, 0xbd, 0xf4, 0xb8, 0xc7, 0x1e, 0xab, 0xe4, 0xd2, 0x8f, 0x51,
-	0x79, 0x6c, 0x8f, 0x6d, 0x30, 0x5e, 0x53, 0xa5, 0x7b, 0x63, 0xde, 0xfb, 0xcd, 0xfb, 0xfd, 0xde,
-	0x9b, 0x19, 0xbf, 0x07, 0x48, 0xdd, 0xf1, 0x08, 0x57, 0x0c, 0xac, 0x6a, 0x26, 0x35, 0xc6, 0x95,
-	0xd1, 0xd3, 0x16, 0xa6, 0xe8, 0x69, 0x85, 0xbe, 0x2e, 0x0f, 0x0c, 0x42, 0x89, 0x90, 0xb7, 0xfc,
-	0x65, 0xd7, 0x5f, 0x76, 0xfc, 0xe2, 0xba, 0x4a, 0x54, 0xc2, 0x10, 0x15, 0xeb, 0x97, 0x0d, 0x2e,
-	0x9d, 0xc1, 0x72, 0xcd, 0x54, 0x5f, 0x0c, 0xfb, 0x4a, 0x9d, 0x10, 0x5d, 0x28, 0xc0, 0x52, 0xdb,
-	0xc0, 0x88, 0x12, 0xa3, 0x90, 0xd8, 0x49, 0xec, 0x66, 0x65, 0x77, 0x29, 0xac, 0x42, 0x52, 0x53,
-	0x0a, 0xc9, 0x9d, 0xc4, 0x6e, 0x4a, 0x4e, 0x6a, 0x8a, 0xb0, 0x01, 0x69, 0xd4, 0x23, 0xc3, 0x3e,
-	0x2d, 0x2c, 0x32, 0x9b, 0xb3, 0x2a, 0xe5, 0xe1, 0x43, 0x5f, 0x40, 0x19, 0x9b, 0x03, 0xd2, 0x37,
-	0x71, 0xe9, 0x5b, 0x78, 0x58, 0x33, 0xd5, 0xe7, 0xf8, 0xfa, 0xfe, 0x98, 0x36, 0x21, 0x1f, 0x08,
-	0xc9, 0xb9, 0xea, 0xb0, 0x52, 0x33, 0xd5, 0x06, 0x45, 0x5d, 0x7c, 0x4f, 0x54, 0x1b, 0xb0, 0xee,
-	0x8f, 0xc8, 0x99, 0x8e, 0x59, 0xb2, 0x32, 0x46, 0x6d, 0xaa, 0x8d, 0x10, 0xc5, 0x0c, 0x61, 0x44,
-	0x10, 0x6e, 0xc2, 0xd2, 0x80, 0x10, 0xbd, 0xc9, 0x59, 0xd3, 0xd6, 0xf2, 0x44, 0x29, 0x3d, 0x86,
-	0xad, 0x90, 0x48, 0x9c, 0x48, 0x86, 0xd5, 0x9a, 0xa9, 0x5e, 0xf4, 0xcd, 0x7b, 0x4c, 0xaa, 0x00,
-	0x1b, 0xc1, 0x98, 0x9c, 0xad, 0x0b, 0x1f, 0xb0, 0xca, 0xea, 0x58, 0x45, 0xf4, 0x3f, 0xd0, 0xb1,
-	0x88, 0x06, 0xa3, 0xcb, 0xca, 0xce, 0xca, 0x27, 0x23, 0x15, 0x90, 0x51, 0x84, 0xcd, 0x09, 0x32,
-	0xae, 0xa3, 0xc1, 0x74, 0x7c, 0xa7, 0xd1, 0x8e, 0x62, 0xa0, 0x9b, 0xfb, 0xd1, 0xe1, 0xf0, 0xf9,
-	0x83, 0x72, 0xbe, 0x1e, 0xac, 0xb1, 0x8a, 0x28, 0xef, 0x27, 0xf3, 0x2d, 0x28, 0x4e, 0xd1, 0x71,
-	0x2d, 0x7f, 0x24, 0x98, 0x18, 0x19, 0xc7, 0x14, 0xb3, 0x03, 0x2b, 0xd7, 0x06, 0xe9, 0x35, 0x83,
-	0xd7, 0x0b, 0x2c, 0x5b, 0x9d, 0x5d, 0x31, 0x61, 0x1b, 0x96, 0x19, 0x22, 0xa0, 0x91, 0x01, 0x9c,
-	0x6b, 0xfb, 0x08, 0x80, 0x12, 0x1e, 0xc0, 0xd6, 0x9a, 0xa1, 0xc4, 0xd9, 0xbe, 0x05, 0x59, 0x4a,
-	0xdc, 0xcd, 0x0f, 0xd8, 0xe6, 0x0c, 0x25, 0x8d, 0xc9, 0x14, 0xd3, 0x21, 0x29, 0x06, 0x93, 0xe0,
-	0x29, 0xfe, 0x9e, 0x64, 0x47, 0xd1, 0x18, 0xb6, 0x7a, 0x1a, 0x3d, 0x1c, 0xf6, 0x15, 0x1d, 0xd7,
-	0x0d, 0x32, 0x20, 0x26, 0x9a, 0xa7, 0xea, 0x8f, 0x01, 0x4c, 0x4a, 0x0c, 0xa4, 0x62, 0x4b, 0xb5,
-	0x9d, 0x55, 0xd6, 0xb1, 0xd8, 0xb2, 0x5b, 0x63, 0x8a, 0x9b, 0xa6, 0xf6, 0x06, 0xbb, 0x39, 0x59,
-	0x86, 0x86, 0xf6, 0x06, 0xf3, 0x92, 0x74, 0xb0, 0xa6, 0x76, 0x28, 0xcb, 0xca, 0xa9, 0xd9, 0x31,
-	0xb3, 0x38, 0x49, 0x3b, 0xee, 0xb4, 0x5b, 0x11, 0xc7, 0x59, 0x84, 0x0c, 0xdb, 0xdd, 0xc5, 0xe3,
-	0xc2, 0x92, 0x2d, 0xd2, 0x5a, 0xbf, 0xc4, 0x63, 0x21, 0x0f, 0x69, 0x4a, 0x98, 0x23, 0xc3, 0x1c,
-	0x0f, 0x28, 0xb1, 0xcc, 0x45, 0xc8, 0x50, 0xd2, 0x1c, 0x21, 0x7d, 0x88, 0x0b, 0x59, 0x7b, 0x07,
-	0x25, 0x97, 0xd6, 0xd2, 0x92, 0xd2, 0x62, 0x25, 0x68, 0x76, 0x90, 0xd9, 0x29, 0x80, 0x7d, 0x3a,
-	0xb6, 0xe9, 0x18, 0x99, 0x9d, 0xd2, 0x47, 0xb0, 0x3d, 0xa3, 0x58, 0xbc, 0xa0, 0xbf, 0x24, 0xd8,
-	0x83, 0xb9, 0x24, 0xf4, 0x7f, 0x28, 0xe4, 0x01, 0xa4, 0x46, 0x84, 0xda, 0x35, 0x5c, 0xdd, 0xdf,
-	0x2e, 0x87, 0x76, 0x99, 0xb2, 0xc5, 0x7d, 0x3e, 0x1e, 0x60, 0x99, 0x81, 0x9d, 0xc7, 0xe6, 0x17,
-	0xc4, 0xc5, 0x7e, 0xcd, 0xbe, 0xa9, 0x47, 0x3a, 0xd2, 0x7a, 0x17, 0x03, 0x9d, 0x20, 0x05, 0x1b,
-	0x32, 0xd1, 0x71, 0x7c, 0xc1, 0x25, 0x09, 0x1e, 0x85, 0x45, 0xe0, 0x0c, 0x3f, 0xd9, 0x4f, 0xe8,
-	0x62, 0xa0, 0x20, 0x8a, 0x6b, 0x98, 0x22, 0x05, 0x51, 0x34, 0x47, 0x41, 0x0a, 0xb0, 0xd4, 0x23,
-	0x7d, 0xcd, 0x7b, 0x2c, 0xee, 0xd2, 0xf2, 0xdc, 0xe0, 0x96, 0xa9, 0x39, 0xe5, 0xc8, 0xca, 0xee,
-	0x52, 0x10, 0x20, 0xa5, 0x13, 0x95, 0x38, 0x0f, 0x84, 0xfd, 0x76, 0xdf, 0x79, 0x40, 0x06, 0x17,
-	0xd9, 0x64, 0x2d, 0xc4, 0x76, 0x1e, 0x91, 0x5e, 0x4f, 0x33, 0x4d, 0x8d, 0xf4, 0xe7, 0x50, 0x29,
-	0x01, 0xb4, 0xf9, 0x3e, 0xf7, 0x55, 0x7b, 0x16, 0xa7, 0xb3, 0x4c, 0x12, 0xb8, 0xfc, 0x7b, 0x2a,
-	0x64, 0xdc, 0x33, 0x13, 0x8a, 0x90, 0xbf, 0x3c, 0x3b, 0xaf, 0x36, 0xcf, 0xaf, 0xea, 0xd5, 0xe6,
-	0xc5, 0x69, 0xa3, 0x5e, 0x3d, 0x3a, 0x79, 0x71, 0x52, 0x7d, 0x9e, 0x5b, 0x10, 0xd6, 0xe0, 0xa1,
-	0xe7, 0xba, 0xaa, 0x36, 0x72, 0x09, 0x21, 0x07, 0x2b, 0x9e, 0xe9, 0xf4, 0x2c, 0x97, 0x14, 0xf2,
-	0xb0, 0xe6, 0x59, 0x9e, 0x1d, 0x36, 0xce, 0x9f, 0x9d, 0x9c, 0xe6, 0x16, 0xc5, 0xd4, 0xcf, 0xbf,
-	0x49, 0x0b, 0xfb, 0xff, 0x2c, 0xc3, 0x62, 0xcd, 0x54, 0x85, 0x57, 0x90, 0xe1, 0xe3, 0x46, 0x69,
-	0xc6, 0x2d, 0xf2, 0x4d, 0x10, 0xe2, 0xde, 0xbb, 0x31, 0x6e, 0x32, 0xc2, 0x0f, 0x00, 0xbe, 0x11,
-	0xe3, 0x93, 0xd9, 0x3b, 0x3d, 0x94, 0xf8, 0x45, 0x1c, 0x14, 0x67, 0xf8, 0x1e, 0xb2, 0xde, 0x60,
-	0xf1, 0xf1, 0xec, 0xad, 0x1c, 0x24, 0x7e, 0x1e, 0x03, 0xc4, 0xc3, 0x1b, 0x90, 0x9b, 0x9a, 0x26,
-	0x22, 0x0a, 0x30, 0x89, 0x15, 0xf7, 0xe3, 0x63, 0x39, 0x67, 0x1b, 0x96, 0xfd, 0x83, 0xc5, 0xa7,
-	0xb3, 0x43, 0xf8, 0x60, 0xe2, 0x93, 0x58, 0x30, 0x4e, 0x72, 0x0d, 0x2b, 0x81, 0x79, 0xe2, 0xb3,
-	0xa8, 0xaa, 0x7b, 0x38, 0xb1, 0x1c, 0x0f, 0xe7, 0xe7, 0x09, 0xcc, 0x0b, 0x11, 0x3c, 0x7e, 0x5c,
-	0x14, 0x4f, 0xd8, 0xa8, 0x20, 0xe8, 0xb0, 0x3a, 0x31, 0x27, 0xec, 0x46, 0x15, 0xc4, 0x8f, 0x14,
-	0xbf, 0x8c, 0x8b, 0xf4, 0xb3, 0x4d, 0x0c, 0x02, 0xbb, 0x51, 0x07, 0x1d, 0x97, 0x2d, 0xbc, 0x2f,
-	0x0b, 0x3f, 0xc2, 0x7a, 0x68, 0x4f, 0x8e, 0xa8, 0x51, 0x18, 0x5e, 0xfc, 0x6a, 0x3e, 0xbc, 0xff,
-	0x0c, 0x03, 0x2d, 0x2c, 0xe2, 0x0c, 0xfd, 0xb8, 0xa8, 0x33, 0x0c, 0xeb, 0x40, 0xc2, 0x10, 0xd6,
-	0xa6, 0xdb, 0x4f, 0xc4, 0x73, 0x9d, 0x02, 0x8b, 0x07, 0x73, 0x80, 0x03, 0x57, 0x27, 0xd8, 0x92,
-	0xa2, 0xae, 0x4e, 0x00, 0x19, 0x79, 0x75, 0x42, 0xfb, 0x8b, 0xf5, 0x45, 0x99, 0x6a, 0x2e, 0x7b,
-	0xef, 0x8a, 0xe2, 0x61, 0xa3, 0xbe, 0x28, 0xb3, 0x7a, 0xca, 0xe1, 0x37, 0x7f, 0xde, 0x4a, 0x89,
-	0xb7, 0xb7, 0x52, 0xe2, 0xef, 0x5b, 0x29, 0xf1, 0xeb, 0x9d, 0xb4, 0xf0, 0xf6, 0x4e, 0x5a, 0xf8,
-	0xeb, 0x4e, 0x5a, 0x78, 0xf5, 0x44, 0xd5, 0x68, 0x67, 0xd8, 0x2a, 0xb7, 0x49, 0xaf, 0xf2, 0xf2,
-	0xea, 0xb2, 0x7a, 0x8a, 0xe9, 0x0d, 0x31, 0xba, 0x95, 0x76, 0x07, 0x69, 0xfd, 0xca, 0x6b, 0xef,
-	0x5f, 0x2d, 0x1d, 0x0f, 0xb0, 0xd9, 0x4a, 0xb3, 0x3f, 0xa9, 0x07, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xc4, 0xaf, 0x61, 0x09, 0xf3, 0x0e, 0x00, 0x00,
+	// 1641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0xb6, 0x6c, 0x59, 0x96, 0xc6, 0x5f, 0x32, 0xfd, 0x11, 0x99, 0x8e, 0x65, 0xbf, 0x7a, 0xdb,
+	0xd4, 0xf9, 0xb0, 0x94, 0x38, 0x6e, 0xcf, 0xb5, 0x1d, 0xa7, 0x31, 0x02, 0x39, 0x06, 0x65, 0xbb,
+	0x48, 0xd0, 0x96, 0xa1, 0xc4, 0x35, 0x45, 0x98, 0xe4, 0x0a, 0xe4, 0xca, 0xb2, 0x52, 0xa0, 0x5f,
+	0x40, 0x81, 0xde, 0xda, 0x9e, 0x8b, 0x9e, 0x7a, 0xec, 0x1f, 0xc9, 0x31, 0xb7, 0x16, 0x3d, 0x04,
+	0x45, 0xf2, 0x13, 0xfa, 0x07, 0x0a, 0x2e, 0xc9, 0x15, 0x29, 0x51, 0x0c, 0xe5, 0xa4, 0x4d, 0x4f,
+	0xd6, 0xee, 0x3c, 0x3b, 0xcf, 0xc3, 0xd9, 0xd9, 0xdd, 0x19, 0x18, 0xf2, 0xa7, 0xed, 0x33, 0x54,
+	0x32, 0x91, 0xa2, 0x5a, 0xc4, 0x6c, 0x97, 0xce, 0x6e, 0x55, 0x11, 0x91, 0x6e, 0x95, 0xc8, 0x79,
+	0xb1, 0x61, 0x62, 0x82, 0xb9, 0x79, 0xdb, 0x5e, 0xf4, 0xec, 0x45, 0xd7, 0xce, 0xcf, 0x29, 0x58,
+	0xc1, 0x14, 0x51, 0xb2, 0x7f, 0x39, 0xe0, 0xc2, 0x97, 0x30, 0x5e, 0xb6, 0x94, 0xbb, 0x4d, 0x43,
+	0x3e, 0xc0, 0x58, 0xe3, 0x72, 0x30, 0x56, 0x33, 0x91, 0x44, 0xb0, 0x99, 0x4b, 0xac, 0x26, 0xd6,
+	0x32, 0x82, 0x37, 0xe4, 0xa6, 0x60, 0x58, 0x95, 0x73, 0xc3, 0xab, 0x89, 0xb5, 0xa4, 0x30, 0xac,
+	0xca, 0xdc, 0x5d, 0x48, 0x49, 0x3a, 0x6e, 0x1a, 0x24, 0x37, 0x62, 0x03, 0xb7, 0x8b, 0x4f, 0x9f,
+	0xaf, 0x0c, 0xfd, 0xf1, 0x7c, 0xe5, 0x8a, 0xa2, 0x92, 0x7a, 0xb3, 0x5a, 0xac, 0x61, 0xbd, 0x54,
+	0xc3, 0x96, 0x8e, 0x2d, 0xf7, 0xcf, 0xba, 0x25, 0x9f, 0x96, 0x48, 0xbb, 0x81, 0xac, 0xe2, 0x9e,
+	0x41, 0x04, 0x77, 0x75, 0x61, 0x1e, 0x66, 0x7d, 0x02, 0x04, 0x64, 0x35, 0xb0, 0x61, 0xa1, 0xc2,
+	0xd7, 0x09, 0x98, 0x2c, 0x5b, 0xca, 0x1d, 0x74, 0xf2, 0xf6, 0xa4, 0x5d, 0x82, 0xf9, 0x80, 0x04,
+	0x26, 0xee, 0xb7, 0x61, 0x58, 0x28, 0x5b, 0xca, 0x8e, 0xcd, 0x8f, 0x6c, 0xe9, 0xaa, 0xa1, 0x54,
+	0x88, 0x89, 0x24, 0xfd, 0xdf, 0x57, 0xc9, 0x3d, 0x82, 0x19, 0xe7, 0x97, 0xd8, 0x40, 0xa6, 0x58,
+	0x6d, 0x1a, 0xb2, 0x86, 0x72, 0xc9, 0x0b, 0xb9, 0x9c, 0x76, 0x1c, 0x1d, 0x20, 0x73, 0x9b, 0xba,
+	0xe1, 0x0e, 0x61, 0xca, 0xe7, 0x5b, 0x96, 0xda, 0xb9, 0xd1, 0x0b, 0x39, 0x9e, 0x60, 0x8e, 0xef,
+	0x48, 0x6d, 0x6e, 0x11, 0xd2, 0xc8, 0x90, 0x45, 0xa2, 0xea, 0x28, 0x97, 0xa2, 0xf1, 0x18, 0x43,
+	0x86, 0x7c, 0xa8, 0xea, 0xa8, 0xb0, 0x0a, 0xf9, 0xf0, 0xc0, 0xb2, 0xd8, 0x7f, 0x46, 0x37, 0x65,
+	0x47, 0xc3, 0xd6, 0x85, 0x23, 0xbf, 0x04, 0x19, 0x8b, 0xae, 0x11, 0x55, 0x99, 0x06, 0x3f, 0x29,
+	0xa4, 0x9d, 0x89, 0x3d, 0xb9, 0xb0, 0x02, 0xcb, 0xa1, 0xfe, 0x99, 0x80, 0xaf, 0x12, 0x30, 0x51,
+	0xb6, 0x94, 0x0a, 0x91, 0x4e, 0xd1, 0x5b, 0x4a, 0xcc, 0x05, 0x98, 0xf3, 0x2b, 0x60, 0xd2, 0xee,
+	0xd1, 0xb3, 0x24, 0x20, 0xa9, 0x46, 0xd4, 0x33, 0x89, 0x20, 0x8a, 0x30, 0x23, 0x04, 0x5e, 0x82,
+	0xb1, 0x06, 0xc6, 0x9a, 0xc8, 0x54, 0xa6, 0xec, 0xe1, 0x9e, 0x5c, 0x58, 0x86, 0xa5, 0x10, 0x4f,
+	0x8c, 0xe8, 0x9b, 0x04, 0x4c, 0x95, 0x2d, 0xe5, 0xc8, 0xb0, 0xde, 0x62, 0x14, 0x72, 0xb0, 0x10,
+	0xd4, 0xc0, 0xe4, 0x21, 0x98, 0x29, 0x5b, 0xca, 0xa1, 0x29, 0x19, 0xd6, 0x09, 0x32, 0x2f, 0x1c,
+	0x05, 0x6e, 0x19, 0xc0, 0x40, 0x2d, 0x91, 0x12, 0x98, 0x8e, 0x5a, 0x21, 0x63, 0xa0, 0x96, 0xe3,
+	0xb1, 0xb0, 0x04, 0x8b, 0x3d, 0x34, 0x4c, 0xc3, 0x4f, 0x09, 0x98, 0xa6, 0xb7, 0x87, 0x86, 0x14,
+	0x89, 0x0c, 0x1a, 0xa3, 0x05, 0x48, 0x05, 0x58, 0xdd, 0x91, 0x2f, 0x76, 0xc9, 0xd7, 0x8a, 0xdd,
+	0x22, 0x5c, 0xea, 0x12, 0xc7, 0x84, 0x57, 0xa8, 0xee, 0x8f, 0x55, 0x52, 0x97, 0x4d, 0xa9, 0xf5,
+	0x66, 0x74, 0xbb, 0x7c, 0x7e, 0xa7, 0x8c, 0xef, 0xe7, 0x04, 0xdd, 0xad, 0x23, 0x43, 0xfe, 0x6f,
+	0x86, 0xca, 0xd9, 0xe5, 0xa0, 0x3c, 0x26, 0xfe, 0x2f, 0x47, 0xbc, 0x80, 0x62, 0x8a, 0x5f, 0x85,
+	0x89, 0x13, 0x13, 0xeb, 0x62, 0x30, 0xdf, 0xc0, 0x9e, 0x3b, 0x70, 0x72, 0x6e, 0x05, 0xc6, 0x29,
+	0x22, 0xf0, 0x4d, 0x14, 0xe0, 0xe6, 0xf1, 0x65, 0x00, 0x82, 0x99, 0x83, 0xa4, 0x73, 0x7d, 0x11,
+	0xec, 0x2e, 0x5f, 0x82, 0x0c, 0xc1, 0xde, 0x62, 0x7a, 0x59, 0xdb, 0xc6, 0x4a, 0x77, 0x48, 0x52,
+	0x6f, 0x20, 0x24, 0x02, 0x0a, 0x0d, 0x49, 0x1b, 0x38, 0xfb, 0x72, 0x42, 0x64, 0xab, 0x49, 0xf0,
+	0x0e, 0xd6, 0x1b, 0xb8, 0x69, 0xc8, 0x17, 0x39, 0x7d, 0xfd, 0x36, 0x36, 0x07, 0x63, 0xc8, 0x90,
+	0xaa, 0x1a, 0x72, 0xbe, 0x3e, 0x2d, 0x78, 0xc3, 0xc2, 0x65, 0xe0, 0x7b, 0xa9, 0x99, 0xb0, 0x4f,
+	0xe8, 0xcb, 0x51, 0x41, 0xc4, 0x4b, 0xc3, 0x2d, 0x59, 0x36, 0x91, 0x65, 0x45, 0x68, 0xbb, 0x0a,
+	0xd9, 0x96, 0x0b, 0x16, 0x25, 0x07, 0x4d, 0x45, 0x66, 0x84, 0xe9, 0x56, 0xd0, 0x89, 0xfb, 0x6e,
+	0xf4, 0x7a, 0x67, 0xf4, 0x32, 0xa5, 0x67, 0x56, 0x4d, 0x13, 0x50, 0x4b, 0x32, 0xe5, 0x28, 0xfa,
+	0x39, 0x18, 0xd5, 0x54, 0x5d, 0x25, 0x6e, 0x60, 0x9c, 0x81, 0xf3, 0x7c, 0x49, 0x26, 0x11, 0x4f,
+	0x51, 0x9b, 0x86, 0x66, 0xc2, 0x7e, 0xbe, 0x24, 0x93, 0xdc, 0x47, 0x6d, 0xfb, 0x66, 0x5e, 0x0e,
+	0xa5, 0xf1, 0x74, 0xf8, 0x92, 0x20, 0xf1, 0x5a, 0x75, 0xc7, 0x22, 0xa4, 0x0d, 0x74, 0xee, 0xa8,
+	0x18, 0xa6, 0x2a, 0xc6, 0xec, 0xb1, 0x2d, 0xe2, 0xd7, 0x11, 0x7a, 0xdc, 0x2b, 0xcd, 0xaa, 0xae,
+	0x12, 0xa7, 0x94, 0x38, 0x30, 0x71, 0x03, 0x5b, 0xd2, 0x20, 0x07, 0x7b, 0x19, 0xc0, 0x22, 0xd8,
+	0x94, 0x14, 0xe4, 0xbd, 0xd3, 0x19, 0x21, 0xe3, 0xce, 0x38, 0x99, 0x5e, 0x6d, 0x13, 0x24, 0x5a,
+	0xea, 0x13, 0xe4, 0x1d, 0x03, 0x7b, 0xa2, 0xa2, 0x3e, 0x41, 0xec, 0x14, 0xd5, 0x91, 0xaa, 0xd4,
+	0x49, 0x6e, 0xb4, 0x73, 0xcc, 0xee, 0xd1, 0x19, 0xf7, 0x9c, 0xb8, 0xe6, 0x94, 0x77, 0x88, 0x5c,
+	0xe3, 0x22, 0xa4, 0xe9, 0x6a, 0xfb, 0xd3, 0xc6, 0x1c, 0x91, 0xf6, 0xf8, 0x3e, 0x6a, 0x73, 0xf3,
+	0x90, 0x22, 0x98, 0x1a, 0xd2, 0xd4, 0x30, 0x4a, 0xb0, 0x3d, 0xbd, 0x08, 0x69, 0x82, 0xc5, 0x33,
+	0x49, 0x6b, 0xa2, 0x5c, 0xc6, 0x59, 0x41, 0xf0, 0xb1, 0x3d, 0xb4, 0xa5, 0x38, 0x45, 0x99, 0x58,
+	0x97, 0xac, 0x7a, 0x0e, 0xa8, 0x15, 0x9c, 0xa9, 0x7b, 0x92, 0x55, 0xe7, 0x56, 0x61, 0xbc, 0x86,
+	0xf5, 0x86, 0x9d, 0x2c, 0x2a, 0x36, 0x72, 0xe3, 0x14, 0xe0, 0x9f, 0xe2, 0xae, 0xc0, 0xb4, 0x2c,
+	0x11, 0x49, 0x54, 0x09, 0xd2, 0xc5, 0x1a, 0xdd, 0xbb, 0x09, 0x2a, 0x79, 0xd2, 0x9e, 0xde, 0x23,
+	0x48, 0xdf, 0xa1, 0x5b, 0xb2, 0x09, 0x0b, 0x4d, 0xc3, 0x5b, 0x88, 0x64, 0xb1, 0x13, 0x9f, 0x49,
+	0x0a, 0x9f, 0xf3, 0x5b, 0xb7, 0xdd, 0x58, 0x15, 0xfe, 0x07, 0x2b, 0x7d, 0x36, 0x8b, 0xe5, 0xee,
+	0xf7, 0xce, 0x63, 0x76, 0x8c, 0xc9, 0x3f, 0xb0, 0x91, 0xb7, 0x21, 0x79, 0x86, 0x89, 0xb3, 0x87,
+	0x53, 0x1b, 0x2b, 0xc5, 0xd0, 0xf6, 0xa5, 0x68, 0x73, 0x1f, 0xb6, 0x1b, 0x48, 0xa0, 0x60, 0xf7,
+	0x41, 0xf1, 0x0b, 0x62, 0x62, 0x3f, 0xa4, 0xd5, 0xd1, 0x8e, 0x26, 0xa9, 0xfa, 0x51, 0x43, 0xc3,
+	0x92, 0x8c, 0x4c, 0x01, 0x6b, 0x28, 0xbe, 0xe0, 0x42, 0x1e, 0x2e, 0x87, 0x79, 0x60, 0x0c, 0x8f,
+	0x69, 0x9d, 0x55, 0x39, 0x55, 0x1b, 0x17, 0x23, 0xe8, 0x4e, 0xcf, 0x91, 0xee, 0xf4, 0x74, 0xeb,
+	0xaf, 0x6e, 0x06, 0x26, 0xe0, 0x18, 0xb2, 0x65, 0x4b, 0xd9, 0x22, 0x04, 0x59, 0xe4, 0x18, 0x99,
+	0x34, 0x49, 0xe2, 0xb3, 0xe7, 0x60, 0xec, 0xcc, 0x59, 0xe4, 0x6e, 0x86, 0x37, 0x2c, 0xf0, 0x90,
+	0xeb, 0xf6, 0xcb, 0x38, 0xbf, 0x75, 0xdf, 0xe9, 0x86, 0x2c, 0x11, 0x54, 0x46, 0x44, 0xb2, 0x73,
+	0x6f, 0x30, 0x56, 0x1d, 0x1b, 0x6a, 0xe7, 0x3e, 0xf7, 0x86, 0xb6, 0xa5, 0x85, 0xaa, 0x96, 0xea,
+	0xe6, 0x40, 0x46, 0xf0, 0x86, 0x1c, 0x07, 0x49, 0x0d, 0x2b, 0xd8, 0x7d, 0xc8, 0xe8, 0x6f, 0xef,
+	0x3d, 0x0e, 0xc8, 0x60, 0x22, 0x45, 0x98, 0x65, 0xc6, 0x1d, 0xac, 0xeb, 0xaa, 0x35, 0x60, 0x6c,
+	0xf2, 0x00, 0x35, 0xb6, 0xce, 0x7b, 0x7d, 0x3b, 0x33, 0xee, 0xc6, 0x74, 0x13, 0x78, 0xfc, 0xd7,
+	0x14, 0x48, 0x7b, 0x89, 0xca, 0x2d, 0xc2, 0xfc, 0xf1, 0x83, 0xc3, 0x5d, 0xf1, 0xf0, 0xe1, 0xc1,
+	0xae, 0x78, 0xb4, 0x5f, 0x39, 0xd8, 0xdd, 0xd9, 0xbb, 0xbb, 0xb7, 0x7b, 0x27, 0x3b, 0xc4, 0xcd,
+	0xc0, 0x64, 0xc7, 0xf4, 0x70, 0xb7, 0x92, 0x4d, 0x70, 0x59, 0x98, 0xe8, 0x4c, 0xed, 0x3f, 0xc8,
+	0x0e, 0x73, 0xf3, 0x30, 0xd3, 0x99, 0xd9, 0xda, 0xae, 0x1c, 0x6e, 0xed, 0xed, 0x67, 0x47, 0xf8,
+	0xe4, 0x77, 0xbf, 0xe4, 0x87, 0x36, 0x7e, 0x9c, 0x85, 0x91, 0xb2, 0xa5, 0x70, 0x8f, 0x20, 0xcd,
+	0x9a, 0xf7, 0x42, 0x9f, 0xa3, 0xe3, 0xeb, 0xaf, 0xf9, 0x6b, 0xaf, 0xc6, 0xb0, 0x97, 0xe2, 0x31,
+	0x80, 0xaf, 0xff, 0x7e, 0xa7, 0xff, 0xca, 0x0e, 0x8a, 0xbf, 0x11, 0x07, 0xc5, 0x18, 0x3e, 0x87,
+	0xd9, 0xb0, 0x26, 0x7a, 0xbd, 0xbf, 0x93, 0x10, 0x38, 0xff, 0xfe, 0x40, 0x70, 0x46, 0x7e, 0x0e,
+	0x5c, 0x48, 0x1b, 0x19, 0xf1, 0x01, 0xbd, 0x68, 0x7e, 0x73, 0x10, 0x34, 0x63, 0xfe, 0x14, 0x32,
+	0x9d, 0xf6, 0xf1, 0xff, 0xfd, 0x5d, 0x30, 0x10, 0x7f, 0x3d, 0x06, 0x88, 0xb9, 0x37, 0x21, 0xdb,
+	0xd3, 0x03, 0x46, 0xec, 0x7b, 0x37, 0x96, 0xdf, 0x88, 0x8f, 0x65, 0x9c, 0x35, 0x18, 0xf7, 0x77,
+	0x83, 0xef, 0xf6, 0x77, 0xe1, 0x83, 0xf1, 0xeb, 0xb1, 0x60, 0x8c, 0x44, 0x83, 0xa9, 0xae, 0xa6,
+	0x6e, 0xad, 0xbf, 0x83, 0x20, 0x92, 0xbf, 0x19, 0x17, 0xc9, 0xd8, 0x4e, 0x60, 0x22, 0xd0, 0xbd,
+	0x5d, 0x89, 0x4a, 0xed, 0x0e, 0x8e, 0x2f, 0xc6, 0xc3, 0xf9, 0x79, 0x02, 0xdd, 0x56, 0x04, 0x8f,
+	0x1f, 0xc7, 0x17, 0xe3, 0xe1, 0xfc, 0xd1, 0xeb, 0x6a, 0xb2, 0xd6, 0xa2, 0xc2, 0xef, 0x47, 0xf2,
+	0x37, 0xe3, 0x22, 0xfd, 0x6c, 0x02, 0x8a, 0xcb, 0x26, 0xa0, 0xb8, 0x6c, 0xe1, 0x4d, 0x07, 0x87,
+	0x61, 0xba, 0xbb, 0xe3, 0xb8, 0x1a, 0x71, 0x64, 0x82, 0x50, 0xfe, 0x56, 0x6c, 0xa8, 0xff, 0xf2,
+	0x08, 0xe9, 0x24, 0x6e, 0x44, 0x3a, 0xea, 0x42, 0xf3, 0x9b, 0x83, 0xa0, 0xfd, 0xcc, 0x21, 0x4d,
+	0xc4, 0x8d, 0x57, 0x27, 0x43, 0x07, 0xcd, 0x6f, 0x0e, 0x82, 0x66, 0xcc, 0x5f, 0xc0, 0x5c, 0x68,
+	0x49, 0x1f, 0x91, 0x88, 0x61, 0x78, 0xfe, 0x83, 0xc1, 0xf0, 0xfe, 0x83, 0x12, 0xa8, 0x40, 0x23,
+	0x0e, 0x8a, 0x1f, 0xc7, 0x17, 0xe3, 0xe1, 0x18, 0x4f, 0x13, 0x66, 0x7a, 0xab, 0xc7, 0xeb, 0x51,
+	0x37, 0x7d, 0x17, 0x98, 0xbf, 0x3d, 0x00, 0xd8, 0x7f, 0x6d, 0xf7, 0x94, 0x94, 0x11, 0xd7, 0x76,
+	0x37, 0x96, 0xdf, 0x88, 0x8f, 0x65, 0x9c, 0x2a, 0x4c, 0x06, 0xab, 0xc8, 0xf7, 0xfa, 0x3b, 0x09,
+	0x00, 0xf9, 0x52, 0x4c, 0x60, 0xe0, 0xfa, 0x09, 0xd6, 0x8e, 0x51, 0xd7, 0x4f, 0x00, 0xc9, 0xdf,
+	0x8c, 0x8b, 0xf4, 0x07, 0xb3, 0xa7, 0x0a, 0xbc, 0xf6, 0x2a, 0x2f, 0x1d, 0x2c, 0xbf, 0x11, 0x1f,
+	0xeb, 0x71, 0x6e, 0x7f, 0xf4, 0xf4, 0x45, 0x3e, 0xf1, 0xec, 0x45, 0x3e, 0xf1, 0xe7, 0x8b, 0x7c,
+	0xe2, 0x87, 0x97, 0xf9, 0xa1, 0x67, 0x2f, 0xf3, 0x43, 0xbf, 0xbf, 0xcc, 0x0f, 0x3d, 0x5a, 0xf7,
+	0xf5, 0xd6, 0xf7, 0x1f, 0x1e, 0xef, 0xee, 0x23, 0xd2, 0xc2, 0xe6, 0x69, 0xa9, 0x56, 0x97, 0x54,
+	0xa3, 0x74, 0xde, 0xf9, 0x67, 0x0e, 0x6d, 0xb3, 0xab, 0x29, 0xfa, 0xbf, 0x99, 0xdb, 0x7f, 0x0f,
+	0x00, 0x7f, 0xf8, 0x6b, 0xeb, 0xea, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1717,12 +2580,18 @@ type MsgClient interface {
 	FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error)
 	// DefundPool ...
 	DefundPool(ctx context.Context, in *MsgDefundPool, opts ...grpc.CallOption) (*MsgDefundPoolResponse, error)
+	// CreateFundingStream ...
+	CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error)
+	// CloseFundingStream ...
+	CloseFundingStream(ctx context.Context, in *MsgCloseFundingStream, opts ...grpc.CallOption) (*MsgCloseFundingStreamResponse, error)
 	// StakePool ...
 	StakePool(ctx context.Context, in *MsgStakePool, opts ...grpc.CallOption) (*MsgStakePoolResponse, error)
 	// MsgReactivateStaker ...
 	ReactivateStaker(ctx context.Context, in *MsgReactivateStaker, opts ...grpc.CallOption) (*MsgReactivateStakerResponse, error)
 	// UnstakePool ...
 	UnstakePool(ctx context.Context, in *MsgUnstakePool, opts ...grpc.CallOption) (*MsgUnstakePoolResponse, error)
+	// TransferStaker ...
+	TransferStaker(ctx context.Context, in *MsgTransferStaker, opts ...grpc.CallOption) (*MsgTransferStakerResponse, error)
 	// DelegatePool ...
 	DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error)
 	// WithdrawPool ...
@@ -1731,12 +2600,22 @@ type MsgClient interface {
 	UndelegatePool(ctx context.Context, in *MsgUndelegatePool, opts ...grpc.CallOption) (*MsgUndelegatePoolResponse, error)
 	// RedelegatePool ...
 	RedelegatePool(ctx context.Context, in *MsgRedelegatePool, opts ...grpc.CallOption) (*MsgRedelegatePoolResponse, error)
+	// SetAutoCompound ...
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// SetWithdrawAddress ...
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawAllRewards ...
+	WithdrawAllRewards(ctx context.Context, in *MsgWithdrawAllRewards, opts ...grpc.CallOption) (*MsgWithdrawAllRewardsResponse, error)
 	// SubmitBundleProposal ...
 	SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error)
 	// VoteProposal ...
 	VoteProposal(ctx context.Context, in *MsgVoteProposal, opts ...grpc.CallOption) (*MsgVoteProposalResponse, error)
 	// ClaimUploaderRole ...
 	ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderRole, opts ...grpc.CallOption) (*MsgClaimUploaderRoleResponse, error)
+	// SkipUploaderRole ...
+	SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error)
+	// AttestVersion ...
+	AttestVersion(ctx context.Context, in *MsgAttestVersion, opts ...grpc.CallOption) (*MsgAttestVersionResponse, error)
 	// UpdateMetadata ...
 	UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error)
 	// UpdateCommission ...
@@ -1769,6 +2648,24 @@ func (c *msgClient) DefundPool(ctx context.Context, in *MsgDefundPool, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CreateFundingStream(ctx context.Context, in *MsgCreateFundingStream, opts ...grpc.CallOption) (*MsgCreateFundingStreamResponse, error) {
+	out := new(MsgCreateFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/CreateFundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseFundingStream(ctx context.Context, in *MsgCloseFundingStream, opts ...grpc.CallOption) (*MsgCloseFundingStreamResponse, error) {
+	out := new(MsgCloseFundingStreamResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/CloseFundingStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) StakePool(ctx context.Context, in *MsgStakePool, opts ...grpc.CallOption) (*MsgStakePoolResponse, error) {
 	out := new(MsgStakePoolResponse)
 	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/StakePool", in, out, opts...)
@@ -1796,6 +2693,15 @@ func (c *msgClient) UnstakePool(ctx context.Context, in *MsgUnstakePool, opts ..
 	return out, nil
 }
 
+func (c *msgClient) TransferStaker(ctx context.Context, in *MsgTransferStaker, opts ...grpc.CallOption) (*MsgTransferStakerResponse, error) {
+	out := new(MsgTransferStakerResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/TransferStaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DelegatePool(ctx context.Context, in *MsgDelegatePool, opts ...grpc.CallOption) (*MsgDelegatePoolResponse, error) {
 	out := new(MsgDelegatePoolResponse)
 	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/DelegatePool", in, out, opts...)
@@ -1832,6 +2738,33 @@ func (c *msgClient) RedelegatePool(ctx context.Context, in *MsgRedelegatePool, o
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
+	out := new(MsgSetWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/SetWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllRewards(ctx context.Context, in *MsgWithdrawAllRewards, opts ...grpc.CallOption) (*MsgWithdrawAllRewardsResponse, error) {
+	out := new(MsgWithdrawAllRewardsResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/WithdrawAllRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitBundleProposal(ctx context.Context, in *MsgSubmitBundleProposal, opts ...grpc.CallOption) (*MsgSubmitBundleProposalResponse, error) {
 	out := new(MsgSubmitBundleProposalResponse)
 	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/SubmitBundleProposal", in, out, opts...)
@@ -1859,6 +2792,24 @@ func (c *msgClient) ClaimUploaderRole(ctx context.Context, in *MsgClaimUploaderR
 	return out, nil
 }
 
+func (c *msgClient) SkipUploaderRole(ctx context.Context, in *MsgSkipUploaderRole, opts ...grpc.CallOption) (*MsgSkipUploaderRoleResponse, error) {
+	out := new(MsgSkipUploaderRoleResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/SkipUploaderRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestVersion(ctx context.Context, in *MsgAttestVersion, opts ...grpc.CallOption) (*MsgAttestVersionResponse, error) {
+	out := new(MsgAttestVersionResponse)
+	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/AttestVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateMetadata(ctx context.Context, in *MsgUpdateMetadata, opts ...grpc.CallOption) (*MsgUpdateMetadataResponse, error) {
 	out := new(MsgUpdateMetadataResponse)
 	err := c.cc.Invoke(ctx, "/kyve.registry.v1beta1.Msg/UpdateMetadata", in, out, opts...)
@@ -1883,12 +2834,18 @@ type MsgServer interface {
 	FundPool(context.Context, *MsgFundPool) (*MsgFundPoolResponse, error)
 	// DefundPool ...
 	DefundPool(context.Context, *MsgDefundPool) (*MsgDefundPoolResponse, error)
+	// CreateFundingStream ...
+	CreateFundingStream(context.Context, *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error)
+	// CloseFundingStream ...
+	CloseFundingStream(context.Context, *MsgCloseFundingStream) (*MsgCloseFundingStreamResponse, error)
 	// StakePool ...
 	StakePool(context.Context, *MsgStakePool) (*MsgStakePoolResponse, error)
 	// MsgReactivateStaker ...
 	ReactivateStaker(context.Context, *MsgReactivateStaker) (*MsgReactivateStakerResponse, error)
 	// UnstakePool ...
 	UnstakePool(context.Context, *MsgUnstakePool) (*MsgUnstakePoolResponse, error)
+	// TransferStaker ...
+	TransferStaker(context.Context, *MsgTransferStaker) (*MsgTransferStakerResponse, error)
 	// DelegatePool ...
 	DelegatePool(context.Context, *MsgDelegatePool) (*MsgDelegatePoolResponse, error)
 	// WithdrawPool ...
@@ -1897,12 +2854,22 @@ type MsgServer interface {
 	UndelegatePool(context.Context, *MsgUndelegatePool) (*MsgUndelegatePoolResponse, error)
 	// RedelegatePool ...
 	RedelegatePool(context.Context, *MsgRedelegatePool) (*MsgRedelegatePoolResponse, error)
+	// SetAutoCompound ...
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// SetWithdrawAddress ...
+	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// WithdrawAllRewards ...
+	WithdrawAllRewards(context.Context, *MsgWithdrawAllRewards) (*MsgWithdrawAllRewardsResponse, error)
 	// SubmitBundleProposal ...
 	SubmitBundleProposal(context.Context, *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error)
 	// VoteProposal ...
 	VoteProposal(context.Context, *MsgVoteProposal) (*MsgVoteProposalResponse, error)
 	// ClaimUploaderRole ...
 	ClaimUploaderRole(context.Context, *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error)
+	// SkipUploaderRole ...
+	SkipUploaderRole(context.Context, *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error)
+	// AttestVersion ...
+	AttestVersion(context.Context, *MsgAttestVersion) (*MsgAttestVersionResponse, error)
 	// UpdateMetadata ...
 	UpdateMetadata(context.Context, *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error)
 	// UpdateCommission ...
@@ -1919,6 +2886,12 @@ func (*UnimplementedMsgServer) FundPool(ctx context.Context, req *MsgFundPool) (
 func (*UnimplementedMsgServer) DefundPool(ctx context.Context, req *MsgDefundPool) (*MsgDefundPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DefundPool not implemented")
 }
+func (*UnimplementedMsgServer) CreateFundingStream(ctx context.Context, req *MsgCreateFundingStream) (*MsgCreateFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingStream not implemented")
+}
+func (*UnimplementedMsgServer) CloseFundingStream(ctx context.Context, req *MsgCloseFundingStream) (*MsgCloseFundingStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFundingStream not implemented")
+}
 func (*UnimplementedMsgServer) StakePool(ctx context.Context, req *MsgStakePool) (*MsgStakePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StakePool not implemented")
 }
@@ -1928,6 +2901,9 @@ func (*UnimplementedMsgServer) ReactivateStaker(ctx context.Context, req *MsgRea
 func (*UnimplementedMsgServer) UnstakePool(ctx context.Context, req *MsgUnstakePool) (*MsgUnstakePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnstakePool not implemented")
 }
+func (*UnimplementedMsgServer) TransferStaker(ctx context.Context, req *MsgTransferStaker) (*MsgTransferStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStaker not implemented")
+}
 func (*UnimplementedMsgServer) DelegatePool(ctx context.Context, req *MsgDelegatePool) (*MsgDelegatePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatePool not implemented")
 }
@@ -1940,6 +2916,15 @@ func (*UnimplementedMsgServer) UndelegatePool(ctx context.Context, req *MsgUndel
 func (*UnimplementedMsgServer) RedelegatePool(ctx context.Context, req *MsgRedelegatePool) (*MsgRedelegatePoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RedelegatePool not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawAllRewards(ctx context.Context, req *MsgWithdrawAllRewards) (*MsgWithdrawAllRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllRewards not implemented")
+}
 func (*UnimplementedMsgServer) SubmitBundleProposal(ctx context.Context, req *MsgSubmitBundleProposal) (*MsgSubmitBundleProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBundleProposal not implemented")
 }
@@ -1949,6 +2934,12 @@ func (*UnimplementedMsgServer) VoteProposal(ctx context.Context, req *MsgVotePro
 func (*UnimplementedMsgServer) ClaimUploaderRole(ctx context.Context, req *MsgClaimUploaderRole) (*MsgClaimUploaderRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimUploaderRole not implemented")
 }
+func (*UnimplementedMsgServer) SkipUploaderRole(ctx context.Context, req *MsgSkipUploaderRole) (*MsgSkipUploaderRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipUploaderRole not implemented")
+}
+func (*UnimplementedMsgServer) AttestVersion(ctx context.Context, req *MsgAttestVersion) (*MsgAttestVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestVersion not implemented")
+}
 func (*UnimplementedMsgServer) UpdateMetadata(ctx context.Context, req *MsgUpdateMetadata) (*MsgUpdateMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
 }
@@ -1996,6 +2987,42 @@ func _Msg_DefundPool_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/CreateFundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFundingStream(ctx, req.(*MsgCreateFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseFundingStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseFundingStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseFundingStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/CloseFundingStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseFundingStream(ctx, req.(*MsgCloseFundingStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_StakePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgStakePool)
 	if err := dec(in); err != nil {
@@ -2050,27 +3077,45 @@ func _Msg_UnstakePool_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegatePool)
+func _Msg_TransferStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferStaker)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DelegatePool(ctx, in)
+		return srv.(MsgServer).TransferStaker(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/kyve.registry.v1beta1.Msg/DelegatePool",
+		FullMethod: "/kyve.registry.v1beta1.Msg/TransferStaker",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegatePool(ctx, req.(*MsgDelegatePool))
+		return srv.(MsgServer).TransferStaker(ctx, req.(*MsgTransferStaker))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WithdrawPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawPool)
-	if err := dec(in); err != nil {
+func _Msg_DelegatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/DelegatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegatePool(ctx, req.(*MsgDelegatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -2122,6 +3167,60 @@ func _Msg_RedelegatePool_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/SetWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawAddress(ctx, req.(*MsgSetWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/WithdrawAllRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllRewards(ctx, req.(*MsgWithdrawAllRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitBundleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitBundleProposal)
 	if err := dec(in); err != nil {
@@ -2176,6 +3275,42 @@ func _Msg_ClaimUploaderRole_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SkipUploaderRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSkipUploaderRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SkipUploaderRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/SkipUploaderRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SkipUploaderRole(ctx, req.(*MsgSkipUploaderRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestVersion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kyve.registry.v1beta1.Msg/AttestVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestVersion(ctx, req.(*MsgAttestVersion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateMetadata)
 	if err := dec(in); err != nil {
@@ -2224,6 +3359,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DefundPool",
 			Handler:    _Msg_DefundPool_Handler,
 		},
+		{
+			MethodName: "CreateFundingStream",
+			Handler:    _Msg_CreateFundingStream_Handler,
+		},
+		{
+			MethodName: "CloseFundingStream",
+			Handler:    _Msg_CloseFundingStream_Handler,
+		},
 		{
 			MethodName: "StakePool",
 			Handler:    _Msg_StakePool_Handler,
@@ -2236,6 +3379,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnstakePool",
 			Handler:    _Msg_UnstakePool_Handler,
 		},
+		{
+			MethodName: "TransferStaker",
+			Handler:    _Msg_TransferStaker_Handler,
+		},
 		{
 			MethodName: "DelegatePool",
 			Handler:    _Msg_DelegatePool_Handler,
@@ -2252,6 +3399,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RedelegatePool",
 			Handler:    _Msg_RedelegatePool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawAllRewards",
+			Handler:    _Msg_WithdrawAllRewards_Handler,
+		},
 		{
 			MethodName: "SubmitBundleProposal",
 			Handler:    _Msg_SubmitBundleProposal_Handler,
@@ -2264,6 +3423,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimUploaderRole",
 			Handler:    _Msg_ClaimUploaderRole_Handler,
 		},
+		{
+			MethodName: "SkipUploaderRole",
+			Handler:    _Msg_SkipUploaderRole_Handler,
+		},
+		{
+			MethodName: "AttestVersion",
+			Handler:    _Msg_AttestVersion_Handler,
+		},
 		{
 			MethodName: "UpdateMetadata",
 			Handler:    _Msg_UpdateMetadata_Handler,
@@ -2297,11 +3464,16 @@ func (m *MsgFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
@@ -2360,11 +3532,16 @@ func (m *MsgDefundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
@@ -2403,7 +3580,7 @@ func (m *MsgDefundPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateFundingStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2413,21 +3590,51 @@ func (m *MsgStakePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStakePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFundingStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+	if m.EndTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTime))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AmountPerDay.Size()
+		i -= size
+		if _, err := m.AmountPerDay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountPerBundle.Size()
+		i -= size
+		if _, err := m.AmountPerBundle.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
@@ -2443,7 +3650,7 @@ func (m *MsgStakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStakePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateFundingStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2453,12 +3660,12 @@ func (m *MsgStakePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgStakePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2466,7 +3673,7 @@ func (m *MsgStakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReactivateStaker) Marshal() (dAtA []byte, err error) {
+func (m *MsgCloseFundingStream) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2476,18 +3683,23 @@ func (m *MsgReactivateStaker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReactivateStaker) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCloseFundingStream) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReactivateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCloseFundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2501,7 +3713,7 @@ func (m *MsgReactivateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgReactivateStakerResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCloseFundingStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2511,12 +3723,12 @@ func (m *MsgReactivateStakerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgReactivateStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCloseFundingStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReactivateStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCloseFundingStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2524,7 +3736,7 @@ func (m *MsgReactivateStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2534,21 +3746,26 @@ func (m *MsgUnstakePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
@@ -2564,7 +3781,7 @@ func (m *MsgUnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2574,12 +3791,12 @@ func (m *MsgUnstakePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnstakePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStakePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnstakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2587,7 +3804,7 @@ func (m *MsgUnstakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegatePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgReactivateStaker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2597,30 +3814,18 @@ func (m *MsgDelegatePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegatePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReactivateStaker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReactivateStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2634,7 +3839,7 @@ func (m *MsgDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegatePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReactivateStakerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2644,12 +3849,12 @@ func (m *MsgDelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReactivateStakerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReactivateStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2657,7 +3862,7 @@ func (m *MsgDelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawPool) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnstakePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2667,23 +3872,26 @@ func (m *MsgWithdrawPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstakePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstakePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
@@ -2699,7 +3907,7 @@ func (m *MsgWithdrawPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnstakePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2709,12 +3917,12 @@ func (m *MsgWithdrawPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstakePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstakePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2722,7 +3930,7 @@ func (m *MsgWithdrawPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegatePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferStaker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2732,30 +3940,25 @@ func (m *MsgUndelegatePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferStaker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferStaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Staker) > 0 {
-		i -= len(m.Staker)
-		copy(dAtA[i:], m.Staker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+	if len(m.NewStaker) > 0 {
+		i -= len(m.NewStaker)
+		copy(dAtA[i:], m.NewStaker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewStaker)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2769,7 +3972,7 @@ func (m *MsgUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUndelegatePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferStakerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2779,12 +3982,12 @@ func (m *MsgUndelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferStakerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2792,7 +3995,7 @@ func (m *MsgUndelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedelegatePool) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegatePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2802,42 +4005,35 @@ func (m *MsgRedelegatePool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedelegatePool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegatePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ToStaker) > 0 {
-		i -= len(m.ToStaker)
-		copy(dAtA[i:], m.ToStaker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToStaker)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ToPoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ToPoolId))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.FromStaker) > 0 {
-		i -= len(m.FromStaker)
-		copy(dAtA[i:], m.FromStaker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromStaker)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.FromPoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromPoolId))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -2851,7 +4047,7 @@ func (m *MsgRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedelegatePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2861,12 +4057,12 @@ func (m *MsgRedelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2874,7 +4070,7 @@ func (m *MsgRedelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitBundleProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2884,63 +4080,20 @@ func (m *MsgSubmitBundleProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawPool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BundleHash) > 0 {
-		i -= len(m.BundleHash)
-		copy(dAtA[i:], m.BundleHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BundleHash)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ToValue) > 0 {
-		i -= len(m.ToValue)
-		copy(dAtA[i:], m.ToValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToValue)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ToKey) > 0 {
-		i -= len(m.ToKey)
-		copy(dAtA[i:], m.ToKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToKey)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.FromKey) > 0 {
-		i -= len(m.FromKey)
-		copy(dAtA[i:], m.FromKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ToHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ToHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.FromHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FromHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ByteSize != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ByteSize))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2959,7 +4112,7 @@ func (m *MsgSubmitBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2969,12 +4122,12 @@ func (m *MsgSubmitBundleProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2982,7 +4135,7 @@ func (m *MsgSubmitBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegatePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2992,25 +4145,30 @@ func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegatePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Vote != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
-		i--
-		dAtA[i] = 0x20
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.StorageId) > 0 {
-		i -= len(m.StorageId)
-		copy(dAtA[i:], m.StorageId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3029,7 +4187,7 @@ func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUndelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3039,12 +4197,12 @@ func (m *MsgVoteProposalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgVoteProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUndelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUndelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3052,7 +4210,7 @@ func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimUploaderRole) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegatePool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3062,18 +4220,47 @@ func (m *MsgClaimUploaderRole) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClaimUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegatePool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ToStaker) > 0 {
+		i -= len(m.ToStaker)
+		copy(dAtA[i:], m.ToStaker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToStaker)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToPoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FromStaker) > 0 {
+		i -= len(m.FromStaker)
+		copy(dAtA[i:], m.FromStaker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromStaker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromPoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromPoolId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3087,7 +4274,7 @@ func (m *MsgClaimUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegatePoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3097,12 +4284,12 @@ func (m *MsgClaimUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClaimUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3110,7 +4297,7 @@ func (m *MsgClaimUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3120,39 +4307,35 @@ func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Logo) > 0 {
-		i -= len(m.Logo)
-		copy(dAtA[i:], m.Logo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Logo)))
+	if m.Enabled {
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Website) > 0 {
-		i -= len(m.Website)
-		copy(dAtA[i:], m.Website)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Moniker) > 0 {
-		i -= len(m.Moniker)
-		copy(dAtA[i:], m.Moniker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3166,7 +4349,7 @@ func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3176,12 +4359,12 @@ func (m *MsgUpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3189,7 +4372,7 @@ func (m *MsgUpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCommission) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3199,27 +4382,22 @@ func (m *MsgUpdateCommission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commission) > 0 {
-		i -= len(m.Commission)
-		copy(dAtA[i:], m.Commission)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Commission)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -3231,7 +4409,7 @@ func (m *MsgUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateCommissionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3241,12 +4419,12 @@ func (m *MsgUpdateCommissionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3254,470 +4432,2469 @@ func (m *MsgUpdateCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawAllRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWithdrawAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawAllRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDefundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawAllRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDefundPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBundleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgStakePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitBundleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UncompressedByteSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UncompressedByteSize))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if m.DataItemCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DataItemCount))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if len(m.Compression) > 0 {
+		i -= len(m.Compression)
+		copy(dAtA[i:], m.Compression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Compression)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *MsgStakePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BundleHash) > 0 {
+		i -= len(m.BundleHash)
+		copy(dAtA[i:], m.BundleHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BundleHash)))
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgReactivateStaker) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ToValue) > 0 {
+		i -= len(m.ToValue)
+		copy(dAtA[i:], m.ToValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToValue)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToKey) > 0 {
+		i -= len(m.ToKey)
+		copy(dAtA[i:], m.ToKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToKey)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	if len(m.FromKey) > 0 {
+		i -= len(m.FromKey)
+		copy(dAtA[i:], m.FromKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *MsgReactivateStakerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ToHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUnstakePool) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FromHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ByteSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ByteSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnstakePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBundleProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBundleProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBundleProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Vote != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Vote))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if len(m.StorageId) > 0 {
+		i -= len(m.StorageId)
+		copy(dAtA[i:], m.StorageId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimUploaderRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUndelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Staker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	return n
+func (m *MsgClaimUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUndelegatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedelegatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FromPoolId != 0 {
-		n += 1 + sovTx(uint64(m.FromPoolId))
-	}
-	l = len(m.FromStaker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ToPoolId != 0 {
-		n += 1 + sovTx(uint64(m.ToPoolId))
-	}
-	l = len(m.ToStaker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+func (m *MsgSkipUploaderRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRedelegatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSkipUploaderRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitBundleProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSkipUploaderRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ByteSize != 0 {
-		n += 1 + sovTx(uint64(m.ByteSize))
-	}
 	if m.FromHeight != 0 {
-		n += 1 + sovTx(uint64(m.FromHeight))
-	}
-	if m.ToHeight != 0 {
-		n += 1 + sovTx(uint64(m.ToHeight))
-	}
-	l = len(m.FromKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ToValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BundleHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitBundleProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSkipUploaderRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.StorageId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Vote != 0 {
-		n += 1 + sovTx(uint64(m.Vote))
-	}
-	return n
+func (m *MsgSkipUploaderRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSkipUploaderRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimUploaderRole) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgAttestVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimUploaderRoleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAttestVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAttestVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Logo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAttestVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAttestVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logo) > 0 {
+		i -= len(m.Logo)
+		copy(dAtA[i:], m.Logo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Logo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		i -= len(m.Commission)
+		copy(dAtA[i:], m.Commission)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commission)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Id != 0 {
 		n += 1 + sovTx(uint64(m.Id))
 	}
-	l = len(m.Commission)
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDefundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDefundPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountPerBundle.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountPerDay.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *MsgCreateFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseFundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCloseFundingStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStakePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStakePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReactivateStaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgReactivateStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferStaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.NewStaker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedelegatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FromPoolId != 0 {
+		n += 1 + sovTx(uint64(m.FromPoolId))
+	}
+	l = len(m.FromStaker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToPoolId != 0 {
+		n += 1 + sovTx(uint64(m.ToPoolId))
+	}
+	l = len(m.ToStaker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedelegatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAllRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBundleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ByteSize != 0 {
+		n += 1 + sovTx(uint64(m.ByteSize))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovTx(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovTx(uint64(m.ToHeight))
+	}
+	l = len(m.FromKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BundleHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Compression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DataItemCount != 0 {
+		n += 1 + sovTx(uint64(m.DataItemCount))
+	}
+	if m.UncompressedByteSize != 0 {
+		n += 1 + sovTx(uint64(m.UncompressedByteSize))
+	}
+	return n
+}
+
+func (m *MsgSubmitBundleProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.StorageId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Vote != 0 {
+		n += 1 + sovTx(uint64(m.Vote))
+	}
+	return n
+}
+
+func (m *MsgVoteProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimUploaderRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimUploaderRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSkipUploaderRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovTx(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *MsgSkipUploaderRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Logo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Commission)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDefundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDefundPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDefundPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDefundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerBundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerDay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseFundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseFundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseFundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseFundingStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseFundingStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseFundingStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReactivateStaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReactivateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReactivateStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReactivateStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReactivateStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3740,10 +6917,10 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3798,10 +6975,10 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3811,11 +6988,26 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3837,7 +7029,7 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3860,10 +7052,10 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3887,7 +7079,7 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferStaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3910,10 +7102,193 @@ func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferStaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3967,11 +7342,43 @@ func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3981,11 +7388,26 @@ func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4007,7 +7429,7 @@ func (m *MsgDefundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDefundPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4030,10 +7452,10 @@ func (m *MsgDefundPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDefundPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegatePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDefundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4057,7 +7479,7 @@ func (m *MsgDefundPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,10 +7502,10 @@ func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4138,10 +7560,10 @@ func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4151,11 +7573,24 @@ func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4177,7 +7612,7 @@ func (m *MsgStakePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4200,10 +7635,10 @@ func (m *MsgStakePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4227,7 +7662,7 @@ func (m *MsgStakePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4250,10 +7685,10 @@ func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReactivateStaker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegatePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReactivateStaker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4290,9 +7725,9 @@ func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PoolId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4302,11 +7737,77 @@ func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4328,7 +7829,7 @@ func (m *MsgReactivateStaker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReactivateStakerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,10 +7852,10 @@ func (m *MsgReactivateStakerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReactivateStakerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegatePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReactivateStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4378,7 +7879,7 @@ func (m *MsgReactivateStakerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4401,10 +7902,10 @@ func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegatePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4441,9 +7942,9 @@ func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPoolId", wireType)
 			}
-			m.Id = 0
+			m.FromPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4453,16 +7954,99 @@ func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.FromPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPoolId", wireType)
+			}
+			m.ToPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStaker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToStaker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4472,11 +8056,26 @@ func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4498,7 +8097,7 @@ func (m *MsgUnstakePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4521,10 +8120,10 @@ func (m *MsgUnstakePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegatePoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4548,7 +8147,7 @@ func (m *MsgUnstakePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,10 +8170,10 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4611,9 +8210,9 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4623,7 +8222,7 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4662,9 +8261,9 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Amount = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4674,11 +8273,12 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4700,7 +8300,7 @@ func (m *MsgDelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,10 +8323,10 @@ func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4750,7 +8350,7 @@ func (m *MsgDelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4773,10 +8373,10 @@ func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4812,27 +8412,8 @@ func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4860,7 +8441,7 @@ func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4883,7 +8464,7 @@ func (m *MsgWithdrawPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4906,10 +8487,10 @@ func (m *MsgWithdrawPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4933,7 +8514,7 @@ func (m *MsgWithdrawPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4956,10 +8537,10 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4996,9 +8577,9 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Id = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5008,16 +8589,16 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5027,43 +8608,26 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Staker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5085,7 +8649,7 @@ func (m *MsgUndelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawAllRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5108,12 +8672,80 @@ func (m *MsgUndelegatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawAllRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawAllRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5135,7 +8767,7 @@ func (m *MsgUndelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5145,28 +8777,168 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
+			}
+			m.ByteSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegatePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegatePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5194,13 +8966,13 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.FromKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromPoolId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
 			}
-			m.FromPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5210,14 +8982,27 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromStaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5245,13 +9030,13 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromStaker = string(dAtA[iNdEx:postIndex])
+			m.ToValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPoolId", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
 			}
-			m.ToPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5261,14 +9046,27 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToStaker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5296,13 +9094,32 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToStaker = string(dAtA[iNdEx:postIndex])
+			m.Compression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataItemCount", wireType)
+			}
+			m.DataItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataItemCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedByteSize", wireType)
 			}
-			m.Amount = 0
+			m.UncompressedByteSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5312,7 +9129,7 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.UncompressedByteSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5338,7 +9155,7 @@ func (m *MsgRedelegatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5361,10 +9178,10 @@ func (m *MsgRedelegatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5388,7 +9205,7 @@ func (m *MsgRedelegatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,119 +9228,17 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteSize", wireType)
-			}
-			m.ByteSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ByteSize |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
-			}
-			m.FromHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5533,16 +9248,29 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.ToHeight = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5552,14 +9280,14 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ToHeight |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5587,13 +9315,13 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromKey = string(dAtA[iNdEx:postIndex])
+			m.StorageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToKey", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			var stringLen uint64
+			m.Vote = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5603,27 +9331,114 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Vote |= VoteType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5651,13 +9466,13 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToValue = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BundleHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5667,24 +9482,11 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BundleHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5706,7 +9508,7 @@ func (m *MsgSubmitBundleProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5729,10 +9531,10 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBundleProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5756,7 +9558,7 @@ func (m *MsgSubmitBundleProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgSkipUploaderRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5779,10 +9581,10 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSkipUploaderRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSkipUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5837,42 +9639,10 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StorageId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			m.Vote = 0
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5882,7 +9652,7 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Vote |= VoteType(b&0x7F) << shift
+				m.FromHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5908,7 +9678,7 @@ func (m *MsgVoteProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSkipUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5931,10 +9701,10 @@ func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSkipUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5958,7 +9728,7 @@ func (m *MsgVoteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5981,10 +9751,10 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6038,6 +9808,38 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6059,7 +9861,7 @@ func (m *MsgClaimUploaderRole) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6082,10 +9884,10 @@ func (m *MsgClaimUploaderRoleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimUploaderRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1 +1,23 @@
 package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validateAmount checks that an amount of a message is set and not negative.
+func validateAmount(amount sdk.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount (%v)", amount)
+	}
+	return nil
+}
+
+// ValidatePositiveAmount checks that an amount which moves tokens is set and positive.
+// The keeper checks it again, as messages which are executed through x/authz skip ValidateBasic.
+func ValidatePositiveAmount(amount sdk.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrInvalidAmount.Error(), amount)
+	}
+	return nil
+}